@@ -45,6 +45,10 @@ func main() {
 
 	application := app.New(log, cfg.GRPC.Port, pool, cfg.TokenTTL, cfg.Secret, minioClient, cfg.DisableAuth, cfg)
 
+	workerCtx, stopWorkers := context.WithCancel(ctx)
+	go application.EmbeddingWorkers.Run(workerCtx)
+	go application.SavedSearchWorker.Run(workerCtx)
+
 	go func() {
 		application.GRPCServer.MustRun()
 	}()
@@ -55,6 +59,7 @@ func main() {
 
 	<-stop
 
+	stopWorkers()
 	application.GRPCServer.Stop()
 	log.Info("Gracefully stopped")
 }
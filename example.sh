@@ -5,6 +5,7 @@
 # ============================================================================
 # 
 # Копируйте команды и выполняйте их вручную в терминале
+# Токены dev:<email> работают только при ENV=local и DEV_AUTH=true
 # 
 # ============================================================================
 
@@ -33,7 +34,7 @@ curl -X POST "https://calcifer0323-matching.hf.space/prepare-and-embed" \
 
 curl -X POST "http://localhost:8081/v1/leads" \
   -H "Content-Type: application/json" \
-  -H "Authorization: Bearer test" \
+  -H "Authorization: Bearer dev:user@m.c" \
   -d '{
     "title": "Ищу 3-комнатную квартиру в центре",
     "description": "Нужна просторная квартира для семьи с детьми, рядом с метро",
@@ -52,11 +53,11 @@ curl -X POST "http://localhost:8081/v1/leads" \
 
 # Замените {LEAD_ID} на реальный ID из шага 1
 curl -X GET "http://localhost:8081/v1/leads/{LEAD_ID}" \
-  -H "Authorization: Bearer test"
+  -H "Authorization: Bearer dev:user@m.c"
 
 # Или только основные поля:
 curl -X GET "http://localhost:8081/v1/leads/{LEAD_ID}" \
-  -H "Authorization: Bearer test" | jq '.lead | {lead_id, title, status, created_at}'
+  -H "Authorization: Bearer dev:user@m.c" | jq '.lead | {lead_id, title, status, created_at}'
 
 ============================================================================
 3. Создание объекта недвижимости (embedding будет сгенерирован автоматически)
@@ -64,7 +65,7 @@ curl -X GET "http://localhost:8081/v1/leads/{LEAD_ID}" \
 
 curl -X POST "http://localhost:8081/v1/properties" \
   -H "Content-Type: application/json" \
-  -H "Authorization: Bearer test" \
+  -H "Authorization: Bearer dev:user@m.c" \
   -d '{
     "title": "3-комнатная квартира в центре Москвы",
     "description": "Просторная квартира с видом на парк, современный ремонт",
@@ -85,7 +86,7 @@ curl -X POST "http://localhost:8081/v1/properties" \
 # Квартира подходящая (3 комнаты)
 curl -X POST "http://localhost:8081/v1/properties" \
   -H "Content-Type: application/json" \
-  -H "Authorization: Bearer test" \
+  -H "Authorization: Bearer dev:user@m.c" \
   -d '{
     "title": "3-комнатная квартира у метро",
     "description": "Квартира для семьи, рядом метро, детский сад",
@@ -99,7 +100,7 @@ curl -X POST "http://localhost:8081/v1/properties" \
 # Квартира не подходящая (1 комната)
 curl -X POST "http://localhost:8081/v1/properties" \
   -H "Content-Type: application/json" \
-  -H "Authorization: Bearer test" \
+  -H "Authorization: Bearer dev:user@m.c" \
   -d '{
     "title": "Студия в центре",
     "description": "Маленькая студия для одного",
@@ -113,7 +114,7 @@ curl -X POST "http://localhost:8081/v1/properties" \
 # Дом (не подходит по типу)
 curl -X POST "http://localhost:8081/v1/properties" \
   -H "Content-Type: application/json" \
-  -H "Authorization: Bearer test" \
+  -H "Authorization: Bearer dev:user@m.c" \
   -d '{
     "title": "Загородный дом с участком",
     "description": "Двухэтажный дом, 6 соток, баня, гараж",
@@ -133,7 +134,7 @@ curl -X POST "http://localhost:8081/v1/properties" \
 # Замените {LEAD_ID} на реальный ID из шага 1
 curl -X POST "http://localhost:8081/v1/properties/match" \
   -H "Content-Type: application/json" \
-  -H "Authorization: Bearer test" \
+  -H "Authorization: Bearer dev:user@m.c" \
   -d '{
     "lead_id": "df4ccc33-47c6-4e93-9396-351cd54cd0ba",
     "filter": {
@@ -150,7 +151,7 @@ curl -X POST "http://localhost:8081/v1/properties/match" \
 # Матчинг без фильтров (все объекты):
 curl -X POST "http://localhost:8081/v1/properties/match" \
   -H "Content-Type: application/json" \
-  -H "Authorization: Bearer test" \
+  -H "Authorization: Bearer dev:user@m.c" \
   -d '{
     "lead_id": "df4ccc33-47c6-4e93-9396-351cd54cd0ba",
     "limit": 10
@@ -159,7 +160,7 @@ curl -X POST "http://localhost:8081/v1/properties/match" \
 # Вывод только топ-5 с коэффициентом схожести:
 curl -X POST "http://localhost:8081/v1/properties/match" \
   -H "Content-Type: application/json" \
-  -H "Authorization: Bearer test" \
+  -H "Authorization: Bearer dev:user@m.c" \
   -d '{
     "lead_id": "df4ccc33-47c6-4e93-9396-351cd54cd0ba",
     "limit": 5
@@ -171,28 +172,28 @@ curl -X POST "http://localhost:8081/v1/properties/match" \
 
 # Получить все лиды:
 curl -X GET "http://localhost:8081/v1/leads" \
-  -H "Authorization: Bearer test"
+  -H "Authorization: Bearer dev:user@m.c"
 
 # Получить все лиды с фильтром по статусу:
 curl -X GET "http://localhost:8081/v1/leads?filter.status=LEAD_STATUS_PUBLISHED" \
-  -H "Authorization: Bearer test"
+  -H "Authorization: Bearer dev:user@m.c"
 
 # Получить все объекты недвижимости:
 curl -X GET "http://localhost:8081/v1/properties" \
-  -H "Authorization: Bearer test"
+  -H "Authorization: Bearer dev:user@m.c"
 
 # Получить объект недвижимости по ID:
 curl -X GET "http://localhost:8081/v1/properties/{PROPERTY_ID}" \
-  -H "Authorization: Bearer test"
+  -H "Authorization: Bearer dev:user@m.c"
 
 # Получить объекты с фильтром:
 curl -X GET "http://localhost:8081/v1/properties?filter.status=PROPERTY_STATUS_PUBLISHED&filter.property_type=PROPERTY_TYPE_APARTMENT" \
-  -H "Authorization: Bearer test"
+  -H "Authorization: Bearer dev:user@m.c"
 
 # Обновить статус лида (опубликовать):
 curl -X PATCH "http://localhost:8081/v1/leads/{LEAD_ID}" \
   -H "Content-Type: application/json" \
-  -H "Authorization: Bearer test" \
+  -H "Authorization: Bearer dev:user@m.c" \
   -d '{
     "status": "LEAD_STATUS_PUBLISHED"
   }'
@@ -200,7 +201,7 @@ curl -X PATCH "http://localhost:8081/v1/leads/{LEAD_ID}" \
 # Обновить статус объекта недвижимости (опубликовать):
 curl -X PATCH "http://localhost:8081/v1/properties/{PROPERTY_ID}" \
   -H "Content-Type: application/json" \
-  -H "Authorization: Bearer test" \
+  -H "Authorization: Bearer dev:user@m.c" \
   -d '{
     "status": "PROPERTY_STATUS_PUBLISHED"
   }'
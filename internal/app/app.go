@@ -2,19 +2,37 @@ package app
 
 import (
 	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
 	minio "lead_exchange/internal/lib/minio/core"
 	"lead_exchange/internal/lib/ml"
 	"lead_exchange/internal/lib/llm"
+	"lead_exchange/internal/lib/mailer"
 	"lead_exchange/internal/lib/metrics"
 	"lead_exchange/internal/lib/reranker"
 	"lead_exchange/internal/lib/vision"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/repository/agency_repository"
 	"lead_exchange/internal/repository/deal_repository"
+	"lead_exchange/internal/repository/embedding_job_repository"
+	"lead_exchange/internal/repository/lead_clarification_repository"
 	"lead_exchange/internal/repository/lead_repository"
+	"lead_exchange/internal/repository/property_image_repository"
+	"lead_exchange/internal/repository/property_jsonld_repository"
 	"lead_exchange/internal/repository/property_repository"
+	"lead_exchange/internal/repository/refresh_token_repository"
+	"lead_exchange/internal/repository/reindex_checkpoint_repository"
+	"lead_exchange/internal/repository/saved_search_repository"
+	"lead_exchange/internal/repository/user_token_repository"
+	"lead_exchange/internal/repository/wallet_repository"
+	"lead_exchange/internal/services/agency"
 	"lead_exchange/internal/services/clarification"
 	"lead_exchange/internal/services/deal"
+	"lead_exchange/internal/services/embedding"
 	"lead_exchange/internal/services/lead"
 	"lead_exchange/internal/services/property"
+	"lead_exchange/internal/services/propertyimage"
+	"lead_exchange/internal/services/savedsearch"
+	"lead_exchange/internal/services/wallet"
 	"lead_exchange/internal/services/weights"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -29,6 +47,10 @@ import (
 
 type App struct {
 	GRPCServer *grpcapp.App
+	// EmbeddingWorkers генерируют embedding из очереди, запускаются из main
+	EmbeddingWorkers *embedding.Pool
+	// SavedSearchWorker прогоняет сохранённые поиски по новым объектам, запускается из main
+	SavedSearchWorker *savedsearch.Worker
 	// AI-related clients (exported for external access)
 	LLMClient      llm.Client
 	RerankerClient reranker.Client
@@ -41,9 +63,19 @@ func New(
 	tokenTTL time.Duration, secret string, minioClient minio.Client, disableAuth bool, cfg *config.Config) *App {
 
 	userRepository := user_repository.NewUserRepository(pool, log)
+	refreshTokenRepository := refresh_token_repository.NewRefreshTokenRepository(pool, log)
+	userTokenRepository := user_token_repository.NewUserTokenRepository(pool, log)
 	leadRepository := lead_repository.NewLeadRepository(pool, log)
 	dealRepository := deal_repository.NewDealRepository(pool, log)
 	propertyRepository := property_repository.NewPropertyRepository(pool, log)
+	savedSearchRepository := saved_search_repository.NewSavedSearchRepository(pool, log)
+	leadClarificationRepository := lead_clarification_repository.NewLeadClarificationRepository(pool, log)
+	propertyJSONLDRepository := property_jsonld_repository.NewPropertyJSONLDRepository(pool, log)
+	embeddingJobRepository := embedding_job_repository.NewEmbeddingJobRepository(pool, log)
+	reindexCheckpointRepository := reindex_checkpoint_repository.NewReindexCheckpointRepository(pool, log)
+	agencyRepository := agency_repository.NewAgencyRepository(pool, log)
+	walletRepository := wallet_repository.NewWalletRepository(pool, log)
+	txManager := repository.NewTxManager(pool)
 
 	// Создаём ML клиент (embeddings)
 	mlClient := ml.NewClient(cfg.ML, log)
@@ -71,9 +103,39 @@ func New(
 	// Создаём агента для уточняющих вопросов (использует LLM)
 	clarificationAgent := clarification.NewAgent(log, llmClient, weightsAnalyzer)
 
-	userService := user.New(log, userRepository, tokenTTL, secret)
-	leadService := lead.New(log, leadRepository, mlClient)
-	dealService := deal.New(log, dealRepository)
+	// Очередь генерации embedding: сервисы ставят задачи, пул воркеров их выполняет
+	embeddingQueue := embedding.NewQueue(log, embeddingJobRepository, cfg.Embedding)
+
+	// Письма сброса пароля и подтверждения email; локально они пишутся в лог или в файлы
+	mailClient, err := mailer.New(cfg.Mailer, log)
+	if err != nil {
+		panic(err)
+	}
+
+	userService := user.New(
+		log,
+		userRepository,
+		refreshTokenRepository,
+		userTokenRepository,
+		mailClient,
+		txManager,
+		tokenTTL,
+		cfg.RefreshTokenTTL,
+		secret,
+		cfg.Account,
+	)
+
+	// Агентства: командная видимость лидов и объектов, перераспределение лидов уходящих агентов
+	agencyService := agency.New(log, agencyRepository, userRepository, leadRepository, propertyRepository, dealRepository, txManager)
+
+	leadService := lead.New(log, leadRepository, dealRepository, mlClient, embeddingQueue, reindexCheckpointRepository, txManager, agencyService)
+
+	// Кошелёк: кредиты покупателя блокируются при принятии сделки и переходят продавцу при завершении
+	walletService := wallet.New(log, walletRepository, userRepository, txManager)
+	dealService := deal.New(log, dealRepository, leadRepository, walletService, txManager)
+
+	// Очередь сохранённых поисков (в БД): сервис объектов сообщает в неё о проиндексированных объектах
+	savedSearchQueue := savedsearch.NewIndexQueue(savedSearchRepository)
 
 	// Создаём property service с поддержкой расширенного поиска
 	propertyService := property.NewWithAdvancedSearch(
@@ -84,8 +146,34 @@ func New(
 		weightsAnalyzer,
 		leadService,
 		cfg.Search,
+		embeddingQueue,
+		reindexCheckpointRepository,
+		txManager,
+		savedSearchQueue,
+		cfg.JSONLD,
+		propertyJSONLDRepository,
+		agencyService,
 	)
 
+	embeddingWorkers := embedding.NewPool(log, embeddingJobRepository, map[domain.EmbeddingEntityType]embedding.Handler{
+		domain.EmbeddingEntityLead:     leadService,
+		domain.EmbeddingEntityProperty: propertyService,
+	}, cfg.Embedding)
+
+	// Сессии уточнения сохраняются, чтобы оценивать, насколько уточнение улучшает лиды
+	clarificationService := clarification.New(log, clarificationAgent, leadClarificationRepository, leadService, txManager)
+
+	// Сохранённые поиски: воркер разбирает очередь проиндексированных объектов
+	savedSearchService := savedsearch.New(log, savedSearchRepository, leadService)
+	savedSearchWorker := savedsearch.NewWorker(log, savedSearchRepository, propertyService)
+
+	// Фотогалерея объектов хранит файлы в MinIO и без него не подключается
+	var propertyImageService grpcapp.PropertyImageService
+	if minioClient != nil {
+		propertyImageRepository := property_image_repository.NewPropertyImageRepository(pool, log)
+		propertyImageService = propertyimage.New(log, propertyImageRepository, propertyService, minioClient, visionClient, txManager, cfg.Minio.PresignExpiry)
+	}
+
 	// Создаём gRPC приложение с AI-клиентами
 	grpcApp := grpcapp.NewWithAI(
 		log,
@@ -95,20 +183,27 @@ func New(
 		leadService,
 		dealService,
 		propertyService,
-		clarificationAgent,
+		savedSearchService,
+		agencyService,
+		walletService,
+		propertyImageService,
+		clarificationService,
 		weightsAnalyzer,
 		llmClient,
 		visionClient,
 		grpcPort,
 		secret,
 		disableAuth,
+		cfg.DevAuth,
 	)
 
 	return &App{
-		GRPCServer:     grpcApp,
-		LLMClient:      llmClient,
-		RerankerClient: rerankerClient,
-		VisionClient:   visionClient,
-		AIMetrics:      aiMetrics,
+		GRPCServer:        grpcApp,
+		EmbeddingWorkers:  embeddingWorkers,
+		SavedSearchWorker: savedSearchWorker,
+		LLMClient:         llmClient,
+		RerankerClient:    rerankerClient,
+		VisionClient:      visionClient,
+		AIMetrics:         aiMetrics,
 	}
 }
@@ -5,12 +5,16 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"lead_exchange/internal/grpc/admingrpc"
+	"lead_exchange/internal/grpc/agencygrpc"
 	"lead_exchange/internal/grpc/authgrpc"
 	"lead_exchange/internal/grpc/dealgrpc"
 	"lead_exchange/internal/grpc/filegrpc"
 	"lead_exchange/internal/grpc/leadgrpc"
 	"lead_exchange/internal/grpc/propertygrpc"
+	"lead_exchange/internal/grpc/savedsearchgrpc"
 	"lead_exchange/internal/grpc/usergrpc"
+	"lead_exchange/internal/grpc/walletgrpc"
 	minio "lead_exchange/internal/lib/minio/core"
 	"lead_exchange/internal/middleware"
 	"log/slog"
@@ -40,13 +44,16 @@ type App struct {
 	port       int
 }
 
-// ClarificationAgent интерфейс для агента уточнения.
-type ClarificationAgent = leadgrpc.ClarificationAgent
+// ClarificationService интерфейс сервиса уточнения лидов.
+type ClarificationService = leadgrpc.ClarificationService
 
 // WeightsAnalyzer интерфейс для анализатора весов.
 type WeightsAnalyzer = leadgrpc.WeightsAnalyzer
 
-// New создаёт gRPC + HTTP (Gateway) сервер с Auth, User, File, Lead, Deal и Property сервисами.
+// PropertyImageService интерфейс фотогалереи объектов.
+type PropertyImageService = propertygrpc.PropertyImageService
+
+// New создаёт gRPC + HTTP (Gateway) сервер с Auth, User, File, Lead, Deal, Property, SavedSearch, Agency, Wallet и Admin сервисами.
 func New(
 	log *slog.Logger,
 	authSvc authgrpc.AuthService,
@@ -55,11 +62,15 @@ func New(
 	leadSvc leadgrpc.LeadService,
 	dealSvc dealgrpc.DealService,
 	propertySvc propertygrpc.PropertyService,
+	savedSearchSvc savedsearchgrpc.SavedSearchService,
+	agencySvc agencygrpc.AgencyService,
+	walletSvc walletgrpc.WalletService,
 	port int,
 	secret string,
 	disableAuth bool,
+	devAuth bool, // отладочные токены dev:..., только при ENV=local
 ) *App {
-	return newApp(log, authSvc, userSvc, minioClient, leadSvc, dealSvc, propertySvc, nil, nil, nil, nil, port, secret, disableAuth)
+	return newApp(log, authSvc, userSvc, minioClient, leadSvc, dealSvc, propertySvc, savedSearchSvc, agencySvc, walletSvc, nil, nil, nil, nil, nil, port, secret, disableAuth, devAuth)
 }
 
 // NewWithAI создаёт gRPC сервер с поддержкой AI-функций (LLM, Vision).
@@ -71,15 +82,20 @@ func NewWithAI(
 	leadSvc leadgrpc.LeadService,
 	dealSvc dealgrpc.DealService,
 	propertySvc propertygrpc.PropertyService,
-	clarificationAgent ClarificationAgent,
+	savedSearchSvc savedsearchgrpc.SavedSearchService,
+	agencySvc agencygrpc.AgencyService,
+	walletSvc walletgrpc.WalletService,
+	propertyImageSvc propertygrpc.PropertyImageService, // nil, если MinIO выключен
+	clarificationSvc ClarificationService,
 	weightsAnalyzer WeightsAnalyzer,
 	llmClient interface{}, // llm.Client
 	visionClient interface{}, // vision.Client
 	port int,
 	secret string,
 	disableAuth bool,
+	devAuth bool,
 ) *App {
-	return newApp(log, authSvc, userSvc, minioClient, leadSvc, dealSvc, propertySvc, llmClient, visionClient, clarificationAgent, weightsAnalyzer, port, secret, disableAuth)
+	return newApp(log, authSvc, userSvc, minioClient, leadSvc, dealSvc, propertySvc, savedSearchSvc, agencySvc, walletSvc, propertyImageSvc, llmClient, visionClient, clarificationSvc, weightsAnalyzer, port, secret, disableAuth, devAuth)
 }
 
 // newApp — внутренняя функция для создания приложения.
@@ -91,13 +107,18 @@ func newApp(
 	leadSvc leadgrpc.LeadService,
 	dealSvc dealgrpc.DealService,
 	propertySvc propertygrpc.PropertyService,
+	savedSearchSvc savedsearchgrpc.SavedSearchService,
+	agencySvc agencygrpc.AgencyService,
+	walletSvc walletgrpc.WalletService,
+	propertyImageSvc propertygrpc.PropertyImageService,
 	llmClient interface{},
 	visionClient interface{},
-	clarificationAgent interface{},
+	clarificationSvc interface{},
 	weightsAnalyzer interface{},
 	port int,
 	secret string,
 	disableAuth bool,
+	devAuth bool,
 ) *App {
 	loggingOpts := []logging.Option{
 		logging.WithLogOnEvents(logging.PayloadReceived, logging.PayloadSent),
@@ -113,17 +134,40 @@ func newApp(
 		recovery.UnaryServerInterceptor(recoveryOpts...),
 		logging.UnaryServerInterceptor(InterceptorLogger(log), loggingOpts...),
 	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		recovery.StreamServerInterceptor(recoveryOpts...),
+		logging.StreamServerInterceptor(InterceptorLogger(log), loggingOpts...),
+	}
+
+	// Отзыв токенов проверяет сервис авторизации, если умеет
+	revocation, _ := authSvc.(middleware.TokenRevocationChecker)
+
+	var devIdentities middleware.DevIdentityResolver
+	if devAuth {
+		log.Warn("Dev identities are ENABLED - Bearer dev:<email> and dev:<role> tokens are accepted without password")
+		devIdentities, _ = authSvc.(middleware.DevIdentityResolver)
+	}
 
 	// Добавляем JWT interceptor только если auth не отключен
 	if !disableAuth {
-		interceptors = append(interceptors, middleware.JWTUnaryInterceptor(secret, false))
+		interceptors = append(interceptors, middleware.JWTUnaryInterceptor(secret, false, revocation, devIdentities))
+		// Проверка ролей для административных методов
+		interceptors = append(interceptors, middleware.AuthzUnaryInterceptor(userSvc, middleware.DefaultPolicies()))
+		streamInterceptors = append(streamInterceptors,
+			middleware.JWTStreamInterceptor(secret, false, revocation, devIdentities),
+			middleware.AuthzStreamInterceptor(userSvc, middleware.DefaultPolicies()),
+		)
 	} else {
 		log.Warn("Authentication is DISABLED - all requests will use test user ID")
 		// Когда auth отключен, используем interceptor который всегда пропускает с тестовым user ID
-		interceptors = append(interceptors, middleware.JWTUnaryInterceptor(secret, true))
+		interceptors = append(interceptors, middleware.JWTUnaryInterceptor(secret, true, nil, nil))
+		streamInterceptors = append(streamInterceptors, middleware.JWTStreamInterceptor(secret, true, nil, nil))
 	}
 
-	gRPCServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	gRPCServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 
 	// Регистрируем все gRPC сервера
 	authgrpc.RegisterAuthServerGRPC(gRPCServer, authSvc)
@@ -131,9 +175,9 @@ func newApp(
 
 	// Регистрируем LeadService с опциональными AI-сервисами
 	leadOpts := []leadgrpc.ServerOption{}
-	if clarificationAgent != nil {
-		if ca, ok := clarificationAgent.(leadgrpc.ClarificationAgent); ok {
-			leadOpts = append(leadOpts, leadgrpc.WithClarificationAgent(ca))
+	if clarificationSvc != nil {
+		if cs, ok := clarificationSvc.(leadgrpc.ClarificationService); ok {
+			leadOpts = append(leadOpts, leadgrpc.WithClarificationService(cs))
 		}
 	}
 	if weightsAnalyzer != nil {
@@ -141,6 +185,9 @@ func newApp(
 			leadOpts = append(leadOpts, leadgrpc.WithWeightsAnalyzer(wa))
 		}
 	}
+	if lm, ok := propertySvc.(leadgrpc.LeadMatcher); ok {
+		leadOpts = append(leadOpts, leadgrpc.WithLeadMatcher(lm))
+	}
 	leadgrpc.RegisterLeadServerGRPC(gRPCServer, leadSvc, leadOpts...)
 
 	dealgrpc.RegisterDealServerGRPC(gRPCServer, dealSvc, userSvc)
@@ -157,8 +204,30 @@ func newApp(
 			propertyOpts = append(propertyOpts, propertygrpc.WithVisionClient(vc))
 		}
 	}
+	if propertyImageSvc != nil {
+		propertyOpts = append(propertyOpts, propertygrpc.WithImageService(propertyImageSvc))
+	}
 	propertygrpc.RegisterPropertyServerGRPC(gRPCServer, propertySvc, propertyOpts...)
 
+	if savedSearchSvc != nil {
+		savedsearchgrpc.RegisterSavedSearchServerGRPC(gRPCServer, savedSearchSvc)
+	}
+
+	if agencySvc != nil {
+		agencygrpc.RegisterAgencyServerGRPC(gRPCServer, agencySvc)
+	}
+
+	if walletSvc != nil {
+		walletgrpc.RegisterWalletServerGRPC(gRPCServer, walletSvc)
+	}
+
+	// AdminService: массовая переиндексация, если сервисы её поддерживают
+	leadReindexer, leadsOk := leadSvc.(admingrpc.LeadReindexer)
+	propertyReindexer, propertiesOk := propertySvc.(admingrpc.PropertyReindexer)
+	if leadsOk && propertiesOk {
+		admingrpc.RegisterAdminServerGRPC(gRPCServer, log, leadReindexer, propertyReindexer)
+	}
+
 	if minioClient != nil {
 		filegrpc.RegisterFileServerGRPC(gRPCServer, minioClient)
 	}
@@ -271,6 +340,10 @@ func (a *App) Run() error {
 		pb.RegisterLeadServiceHandlerFromEndpoint,
 		pb.RegisterDealServiceHandlerFromEndpoint,
 		pb.RegisterPropertyServiceHandlerFromEndpoint,
+		pb.RegisterSavedSearchServiceHandlerFromEndpoint,
+		pb.RegisterAgencyServiceHandlerFromEndpoint,
+		pb.RegisterWalletServiceHandlerFromEndpoint,
+		pb.RegisterAdminServiceHandlerFromEndpoint,
 	} {
 		if err := register(ctx, gwMux, fmt.Sprintf("localhost:%d", a.port), opts); err != nil {
 			return fmt.Errorf("%s: %w", op, err)
@@ -290,6 +363,10 @@ func (a *App) Run() error {
 		"pkg/lead.swagger.json",
 		"pkg/deal.swagger.json",
 		"pkg/property.swagger.json",
+		"pkg/saved_search.swagger.json",
+		"pkg/agency.swagger.json",
+		"pkg/wallet.swagger.json",
+		"pkg/admin.swagger.json",
 	}
 
 	// Объединённый swagger.json со всеми сервисами
@@ -311,6 +388,10 @@ func (a *App) Run() error {
 		"/swagger/lead/doc.json":      "pkg/lead.swagger.json",
 		"/swagger/deal/doc.json":      "pkg/deal.swagger.json",
 		"/swagger/property/doc.json":  "pkg/property.swagger.json",
+		"/swagger/saved-search/doc.json": "pkg/saved_search.swagger.json",
+		"/swagger/agency/doc.json": "pkg/agency.swagger.json",
+		"/swagger/wallet/doc.json": "pkg/wallet.swagger.json",
+		"/swagger/admin/doc.json": "pkg/admin.swagger.json",
 	}
 
 	for route, path := range swaggerFileMap {
@@ -1,24 +1,33 @@
 package config
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type Config struct {
-	Env         string `env:"ENV" env-default:"local"`
+	// Env — окружение (local, dev, prod); задаётся явно, чтобы образ без ENV не считался локальным
+	Env         string `env:"ENV" env-required:"true"`
 	DatabaseURL string `env:"DATABASE_URL" env-required:"true"`
 	GRPC        GRPCConfig
 	TokenTTL    time.Duration `env:"TOKEN_TTL" env-default:"1h"`
-	Secret      string        `env:"SECRET" env-required:"true"`
-	DisableAuth bool          `env:"DISABLE_AUTH" env-default:"false"`
-	Minio       MinioConfig
-	ML          MLConfig
-	Reranker    RerankerConfig
-	LLM         LLMConfig
-	Vision      VisionConfig
-	Search      SearchConfig
+	// RefreshTokenTTL — срок жизни refresh-токена; каждое обновление выдаёт новый токен на тот же срок
+	RefreshTokenTTL time.Duration `env:"REFRESH_TOKEN_TTL" env-default:"720h"`
+	Secret          string        `env:"SECRET" env-required:"true"`
+	DisableAuth     bool          `env:"DISABLE_AUTH" env-default:"false"`
+	DevAuth         bool          `env:"DEV_AUTH" env-default:"false"`
+	Minio           MinioConfig
+	ML              MLConfig
+	Reranker        RerankerConfig
+	LLM             LLMConfig
+	Vision          VisionConfig
+	Search          SearchConfig
+	Embedding       EmbeddingQueueConfig
+	JSONLD          JSONLDConfig
+	Mailer          MailerConfig
+	Account         AccountConfig
 }
 
 type GRPCConfig struct {
@@ -34,6 +43,9 @@ type MinioConfig struct {
 	MinioRootUser     string `env:"MINIO_USER"`
 	MinioRootPassword string `env:"MINIO_PASSWORD"`
 	MinioUseSSL       bool   `env:"MINIO_USE_SSL"`
+
+	// PresignExpiry — срок жизни ссылок на фотографии объектов, выдаваемых при чтении
+	PresignExpiry time.Duration `env:"MINIO_PRESIGN_EXPIRY" env-default:"1h"`
 }
 
 type MLConfig struct {
@@ -85,10 +97,87 @@ type SearchConfig struct {
 	DynamicWeightsEnabled bool `env:"DYNAMIC_WEIGHTS_ENABLE" env-default:"false"`
 }
 
+// EmbeddingQueueConfig — очередь генерации embedding для лидов и объектов.
+type EmbeddingQueueConfig struct {
+	// Workers — количество параллельных воркеров
+	Workers int `env:"EMBEDDING_WORKERS" env-default:"4"`
+	// MaxAttempts — после стольких неудачных попыток задача переходит в DEAD
+	MaxAttempts int `env:"EMBEDDING_MAX_ATTEMPTS" env-default:"5"`
+	// PollInterval — как часто воркер проверяет очередь, когда она пуста
+	PollInterval time.Duration `env:"EMBEDDING_POLL_INTERVAL" env-default:"2s"`
+	// BaseBackoff / MaxBackoff — экспоненциальная задержка между попытками
+	BaseBackoff time.Duration `env:"EMBEDDING_BASE_BACKOFF" env-default:"10s"`
+	MaxBackoff  time.Duration `env:"EMBEDDING_MAX_BACKOFF" env-default:"10m"`
+	// JobTimeout — таймаут одной попытки (вызов ML-сервиса и запись embedding)
+	JobTimeout time.Duration `env:"EMBEDDING_JOB_TIMEOUT" env-default:"30s"`
+	// StaleAfter — задача в RUNNING дольше этого времени считается брошенной и берётся повторно
+	StaleAfter time.Duration `env:"EMBEDDING_STALE_AFTER" env-default:"5m"`
+}
+
+// JSONLDConfig — JSON-LD разметка объектов (schema.org) для страниц объявлений.
+type JSONLDConfig struct {
+	// BaseURL — адрес сайта для ссылок в разметке, если base_url не передан в запросе
+	BaseURL string `env:"JSONLD_BASE_URL" env-default:"https://api.leadexchange.ru"`
+	// CacheTTL — срок жизни сгенерированной разметки в property_jsonld_cache
+	CacheTTL time.Duration `env:"JSONLD_CACHE_TTL" env-default:"24h"`
+}
+
+// MailerConfig — отправка писем пользователям.
+type MailerConfig struct {
+	// Driver — smtp; file — письма сохраняются в FileDir; log — письма только пишутся в лог.
+	// file и log разрешены только при ENV=local
+	Driver string `env:"MAILER_DRIVER" env-default:"log"`
+	From   string `env:"MAILER_FROM" env-default:"noreply@leadexchange.ru"`
+	// FileDir — каталог для писем драйвера file
+	FileDir      string `env:"MAILER_FILE_DIR" env-default:"./tmp/mail"`
+	SMTPHost     string `env:"SMTP_HOST"`
+	SMTPPort     int    `env:"SMTP_PORT" env-default:"587"`
+	SMTPUsername string `env:"SMTP_USERNAME"`
+	SMTPPassword string `env:"SMTP_PASSWORD"`
+}
+
+// AccountConfig — сброс пароля и подтверждение email.
+type AccountConfig struct {
+	// LinkBaseURL — адрес фронтенда, на который ведут ссылки из писем
+	LinkBaseURL          string        `env:"ACCOUNT_LINK_BASE_URL" env-default:"http://localhost:3000"`
+	PasswordResetTTL     time.Duration `env:"PASSWORD_RESET_TTL" env-default:"1h"`
+	EmailVerificationTTL time.Duration `env:"EMAIL_VERIFICATION_TTL" env-default:"48h"`
+	// RequireEmailVerification — не пускать в систему пользователей с неподтверждённым email
+	RequireEmailVerification bool `env:"REQUIRE_EMAIL_VERIFICATION" env-default:"false"`
+}
+
+// EnvLocal — окружение локальной разработки; только в нём разрешены DISABLE_AUTH и DEV_AUTH.
+const EnvLocal = "local"
+
 func MustLoad() *Config {
 	var cfg Config
 	if err := cleanenv.ReadEnv(&cfg); err != nil {
 		panic("cannot read config from environment: " + err.Error())
 	}
+	if err := cfg.Validate(); err != nil {
+		panic("invalid config: " + err.Error())
+	}
 	return &cfg
 }
+
+// Validate — проверки, которые нельзя выразить тегами: отладочный вход без пароля
+// и отладочная отправка писем за пределами явно заданного локального окружения не запускаются.
+func (c *Config) Validate() error {
+	if c.Env == "" {
+		return fmt.Errorf("ENV must be set explicitly")
+	}
+	if c.Env == EnvLocal {
+		return nil
+	}
+	if c.DisableAuth {
+		return fmt.Errorf("DISABLE_AUTH is allowed only with ENV=%s, got ENV=%s", EnvLocal, c.Env)
+	}
+	if c.DevAuth {
+		return fmt.Errorf("DEV_AUTH is allowed only with ENV=%s, got ENV=%s", EnvLocal, c.Env)
+	}
+	// Драйверы log и file сохраняют письма со ссылками сброса пароля в лог и на диск
+	if c.Mailer.Driver != "smtp" {
+		return fmt.Errorf("MAILER_DRIVER=%q is allowed only with ENV=%s, use smtp", c.Mailer.Driver, EnvLocal)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{name: "local with dev auth", cfg: Config{Env: EnvLocal, DevAuth: true, DisableAuth: true}},
+		{name: "prod", cfg: Config{Env: "prod", Mailer: MailerConfig{Driver: "smtp"}}},
+		{name: "local with log mailer", cfg: Config{Env: EnvLocal, Mailer: MailerConfig{Driver: "log"}}},
+		{name: "prod with log mailer", cfg: Config{Env: "prod", Mailer: MailerConfig{Driver: "log"}}, wantErr: true},
+		{name: "prod with file mailer", cfg: Config{Env: "prod", Mailer: MailerConfig{Driver: "file"}}, wantErr: true},
+		{name: "dev with default mailer", cfg: Config{Env: "dev"}, wantErr: true},
+		{name: "prod with dev auth", cfg: Config{Env: "prod", DevAuth: true, Mailer: MailerConfig{Driver: "smtp"}}, wantErr: true},
+		{name: "prod with disabled auth", cfg: Config{Env: "prod", DisableAuth: true, Mailer: MailerConfig{Driver: "smtp"}}, wantErr: true},
+		{name: "dev with dev auth", cfg: Config{Env: "dev", DevAuth: true, Mailer: MailerConfig{Driver: "smtp"}}, wantErr: true},
+		{name: "unset env", cfg: Config{}, wantErr: true},
+		{name: "unset env with dev auth", cfg: Config{DevAuth: true}, wantErr: true},
+		{name: "unset env with disabled auth", cfg: Config{DisableAuth: true}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestConfig_EnvRequired(t *testing.T) {
+	t.Setenv("DATABASE_URL", "postgres://localhost/test")
+	t.Setenv("SECRET", "secret")
+	t.Setenv("DEV_AUTH", "true")
+	t.Setenv("ENV", "")
+	os.Unsetenv("ENV")
+
+	var cfg Config
+	if err := cleanenv.ReadEnv(&cfg); err == nil {
+		t.Fatalf("expected error for unset ENV, got config with ENV=%q", cfg.Env)
+	}
+}
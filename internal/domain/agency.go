@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AgencyInvitationTTL — сколько действует приглашение в агентство.
+const AgencyInvitationTTL = 7 * 24 * time.Hour
+
+// Agency — агентство недвижимости: команда брокеров с общими лидами и объектами.
+type Agency struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedBy uuid.UUID
+	CreatedAt time.Time
+}
+
+// AgencyRole — роль участника агентства.
+type AgencyRole string
+
+const (
+	AgencyRoleUnspecified AgencyRole = ""
+	AgencyRoleOwner       AgencyRole = "OWNER"   // Владелец: управляет ролями и составом
+	AgencyRoleManager     AgencyRole = "MANAGER" // Руководитель: приглашает агентов, перераспределяет лиды
+	AgencyRoleAgent       AgencyRole = "AGENT"   // Агент
+)
+
+func (r AgencyRole) String() string {
+	return string(r)
+}
+
+// CanManage — может ли участник приглашать агентов и перераспределять лиды команды.
+func (r AgencyRole) CanManage() bool {
+	return r == AgencyRoleOwner || r == AgencyRoleManager
+}
+
+// AgencyMember — участник агентства.
+type AgencyMember struct {
+	AgencyID uuid.UUID
+	UserID   uuid.UUID
+	Role     AgencyRole
+	JoinedAt time.Time
+}
+
+// AgencyInvitationStatus — статус приглашения в агентство.
+type AgencyInvitationStatus string
+
+const (
+	AgencyInvitationPending  AgencyInvitationStatus = "PENDING"
+	AgencyInvitationAccepted AgencyInvitationStatus = "ACCEPTED"
+	AgencyInvitationDeclined AgencyInvitationStatus = "DECLINED"
+	AgencyInvitationRevoked  AgencyInvitationStatus = "REVOKED"
+)
+
+func (s AgencyInvitationStatus) String() string {
+	return string(s)
+}
+
+// AgencyInvitation — приглашение пользователя в агентство по email.
+type AgencyInvitation struct {
+	ID          uuid.UUID
+	AgencyID    uuid.UUID
+	Email       string
+	Role        AgencyRole
+	InvitedBy   uuid.UUID
+	Status      AgencyInvitationStatus
+	ExpiresAt   time.Time
+	RespondedAt *time.Time
+	CreatedAt   time.Time
+}
+
+// IsOpen — приглашение ещё можно принять или отклонить.
+func (i AgencyInvitation) IsOpen(now time.Time) bool {
+	return i.Status == AgencyInvitationPending && now.Before(i.ExpiresAt)
+}
+
+// AgencyDetails — агентство с участниками и действующими приглашениями.
+type AgencyDetails struct {
+	Agency  Agency
+	Members []AgencyMember
+	// Invitations — заполняется только для владельца, руководителя и админа
+	Invitations []AgencyInvitation
+}
+
+// AgencyMemberStats — показатели участника в отчёте по сделкам команды.
+// Лиды и объекты считаются по текущему OwnerUserID, сделки — по продавцу и покупателю.
+type AgencyMemberStats struct {
+	UserID          uuid.UUID
+	Role            AgencyRole
+	LeadsOwned      int32
+	PropertiesOwned int32
+	DealsSold       int32   // завершённые сделки, где участник — продавец
+	SoldVolume      float64 // сумма завершённых продаж
+	DealsBought     int32   // завершённые сделки, где участник — покупатель
+	BoughtVolume    float64 // сумма завершённых покупок
+	OpenDeals       int32   // сделки в статусах PENDING и ACCEPTED
+}
+
+// AgencyDealReport — отчёт по сделкам агентства за период.
+type AgencyDealReport struct {
+	AgencyID uuid.UUID
+	From     *time.Time // nil — без нижней границы
+	To       *time.Time // nil — без верхней границы
+	Members  []AgencyMemberStats
+	// Totals — сумма по участникам, кроме OpenDeals: открытые сделки считаются без повторов,
+	// сделка между двумя участниками входит один раз. UserID и Role не заполняются
+	Totals AgencyMemberStats
+}
+
+// AgencyReassignment — результат передачи лидов и объектов одного участника другому.
+type AgencyReassignment struct {
+	FromUserID uuid.UUID
+	ToUserID   uuid.UUID
+	Leads      int
+	Properties int
+	Deals      int // открытые сделки, в которых сменился продавец
+}
@@ -66,6 +66,20 @@ func DecodePageCursor(token string) (*PageCursor, error) {
 	return &cursor, nil
 }
 
+// KeysetValue значение поля сортировки в текстовом виде для keyset-условия
+// (в SQL приводится к типу колонки). Для created_at берётся LastCreatedAt
+func (c *PageCursor) KeysetValue(orderBy string) string {
+	if orderBy == "created_at" {
+		return FormatCursorTime(c.LastCreatedAt)
+	}
+	return c.LastValue
+}
+
+// FormatCursorTime формат времени в LastValue курсора
+func FormatCursorTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
 // PaginatedResult результат пагинированного запроса
 type PaginatedResult[T any] struct {
 	Items         []T
@@ -43,4 +43,5 @@ type DealFilter struct {
 	Price        *float64 // для обновления цены
 	MinPrice     *float64 // для фильтрации
 	MaxPrice     *float64 // для фильтрации
+	Pagination   *PaginationParams
 }
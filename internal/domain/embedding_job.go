@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmbeddingJob — задача очереди на генерацию embedding лида или объекта.
+type EmbeddingJob struct {
+	ID         uuid.UUID
+	EntityType EmbeddingEntityType
+	EntityID   uuid.UUID
+	Operation  EmbeddingOperation
+	Status     EmbeddingJobStatus
+	// Attempts — сколько раз задача уже бралась в работу
+	Attempts    int
+	MaxAttempts int
+	LastError   *string
+	// RunAt — не раньше какого момента задачу можно брать (backoff между попытками)
+	RunAt     time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// EmbeddingEntityType — тип сущности, для которой строится embedding.
+type EmbeddingEntityType string
+
+const (
+	EmbeddingEntityLead     EmbeddingEntityType = "lead"
+	EmbeddingEntityProperty EmbeddingEntityType = "property"
+)
+
+func (t EmbeddingEntityType) String() string {
+	return string(t)
+}
+
+// EmbeddingOperation — способ получения embedding в ML-сервисе.
+type EmbeddingOperation string
+
+const (
+	EmbeddingOperationEmbed   EmbeddingOperation = "EMBED"   // Первичная генерация (prepare-and-embed)
+	EmbeddingOperationReindex EmbeddingOperation = "REINDEX" // Переиндексация после изменения
+)
+
+func (o EmbeddingOperation) String() string {
+	return string(o)
+}
+
+// EmbeddingJobStatus — статус задачи очереди.
+type EmbeddingJobStatus string
+
+const (
+	EmbeddingJobStatusUnspecified EmbeddingJobStatus = ""
+	EmbeddingJobStatusPending     EmbeddingJobStatus = "PENDING" // Ждёт выполнения (в том числе повторной попытки)
+	EmbeddingJobStatusRunning     EmbeddingJobStatus = "RUNNING" // Взята воркером
+	EmbeddingJobStatusDone        EmbeddingJobStatus = "DONE"    // Embedding обновлён
+	EmbeddingJobStatusDead        EmbeddingJobStatus = "DEAD"    // Попытки исчерпаны
+)
+
+func (s EmbeddingJobStatus) String() string {
+	return string(s)
+}
+
+// EmbeddingState — состояние embedding сущности: есть ли вектор и последняя задача очереди.
+type EmbeddingState struct {
+	HasEmbedding bool
+	// Job — последняя задача; nil, если сущность ни разу не ставилась в очередь
+	Job *EmbeddingJob
+}
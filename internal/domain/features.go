@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// NormalizeFeatures — особенности в виде для сравнения: без пробелов по краям и в нижнем регистре,
+// без пустых и повторов. nil, если список пуст.
+func NormalizeFeatures(features []string) []string {
+	var out []string
+	for _, f := range features {
+		f = strings.ToLower(strings.TrimSpace(f))
+		if f != "" && !slices.Contains(out, f) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// HasFeatures — у объекта есть все перечисленные особенности (без учёта регистра).
+func (p Property) HasFeatures(required []string) bool {
+	have := NormalizeFeatures(p.Features)
+	for _, f := range NormalizeFeatures(required) {
+		if !slices.Contains(have, f) {
+			return false
+		}
+	}
+	return true
+}
+
+// FeatureMatch — доля желательных особенностей, которые есть у объекта (0-1).
+// false, если желательных особенностей нет.
+func FeatureMatch(features, preferred []string) (float64, bool) {
+	preferred = NormalizeFeatures(preferred)
+	if len(preferred) == 0 {
+		return 0, false
+	}
+	have := NormalizeFeatures(features)
+	found := 0
+	for _, f := range preferred {
+		if slices.Contains(have, f) {
+			found++
+		}
+	}
+	return float64(found) / float64(len(preferred)), true
+}
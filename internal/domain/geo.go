@@ -0,0 +1,53 @@
+package domain
+
+import "math"
+
+// earthRadiusKm — радиус Земли как у earth() из earthdistance, чтобы расстояния совпадали с SQL-фильтром.
+const earthRadiusKm = 6378.168
+
+// GeoPoint — точка на карте.
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// GeoFilter — гео-фильтр «в радиусе RadiusKm от Center» (например, «2 км от метро»).
+// Объекты без координат фильтром исключаются.
+type GeoFilter struct {
+	Center   GeoPoint
+	RadiusKm float64
+}
+
+// RadiusMeters — радиус в метрах (единицы earthdistance).
+func (g GeoFilter) RadiusMeters() float64 {
+	return g.RadiusKm * 1000
+}
+
+// Distance — расстояние от центра до объекта в километрах; false, если у объекта нет координат.
+func (g GeoFilter) Distance(p Property) (float64, bool) {
+	if !p.HasCoordinates() {
+		return 0, false
+	}
+	return DistanceKm(g.Center, GeoPoint{Latitude: *p.Latitude, Longitude: *p.Longitude}), true
+}
+
+// Contains — объект находится в пределах радиуса.
+func (g GeoFilter) Contains(p Property) bool {
+	d, ok := g.Distance(p)
+	return ok && d <= g.RadiusKm
+}
+
+// DistanceKm — расстояние по большому кругу (формула гаверсинусов).
+func DistanceKm(a, b GeoPoint) float64 {
+	lat1, lat2 := toRadians(a.Latitude), toRadians(b.Latitude)
+	dLat := lat2 - lat1
+	dLon := toRadians(b.Longitude - a.Longitude)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -11,8 +11,8 @@ type Lead struct {
 	ID          uuid.UUID
 	Title       string
 	Description string
-	// JSON с предпочтениями ("roomNumber": 3, "price": "5000000")
-	Requirement   []byte
+	// Requirement — требования к объекту (хранятся в JSONB)
+	Requirement   LeadRequirement
 	ContactName   string
 	ContactPhone  string
 	ContactEmail  *string
@@ -25,6 +25,8 @@ type Lead struct {
 	CreatedUserID uuid.UUID
 	// Embedding — векторное представление для матчинга (pgvector)
 	Embedding     []float32
+	// ContactsMasked — контакты скрыты политикой видимости (не хранится в БД)
+	ContactsMasked bool
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
@@ -48,13 +50,31 @@ func (s LeadStatus) String() string {
 type LeadFilter struct {
 	Title         *string
 	Description   *string
-	Requirement   *[]byte
+	Requirement   *LeadRequirement
 	City          *string
 	PropertyType  *PropertyType
 	Status        *LeadStatus
 	OwnerUserID   *uuid.UUID
 	CreatedUserID *uuid.UUID
+	// TeamOf — лиды команды пользователя: всех участников его агентства
+	TeamOf        *uuid.UUID
+	// OwnerUserIDs — владелец из списка; сервис заполняет его по TeamOf
+	OwnerUserIDs  []uuid.UUID
 
 	// Пагинация
 	Pagination    *PaginationParams
 }
+
+// MatchedLead — результат обратного матчинга (лид для объекта) с коэффициентом схожести.
+type MatchedLead struct {
+	Lead       Lead
+	Similarity float64 // Косинусная близость (0-1)
+	// Взвешенные scores (те же, что у MatchedProperty)
+	TotalScore       *float64
+	PriceScore       *float64
+	DistrictScore    *float64
+	RoomsScore       *float64
+	AreaScore        *float64
+	SemanticScore    *float64
+	MatchExplanation *string
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClarificationStatus — статус сессии уточнения.
+type ClarificationStatus string
+
+const (
+	// ClarificationStatusPending — вопросы заданы, ответов ещё нет
+	ClarificationStatusPending ClarificationStatus = "pending"
+	// ClarificationStatusAnswered — ответы применены к требованиям лида
+	ClarificationStatusAnswered ClarificationStatus = "answered"
+	// ClarificationStatusSkipped — вопросы остались без ответа: по лиду начата новая сессия
+	ClarificationStatusSkipped ClarificationStatus = "skipped"
+)
+
+// ClarificationQuestion — уточняющий вопрос по лиду.
+type ClarificationQuestion struct {
+	// Field — поле, которое уточняется
+	Field string `json:"field"`
+	// Question — текст вопроса
+	Question string `json:"question"`
+	// QuestionType — тип вопроса (open, choice, range, boolean)
+	QuestionType string `json:"question_type"`
+	// SuggestedOptions — предложенные варианты ответа
+	SuggestedOptions []string `json:"suggested_options,omitempty"`
+	// Importance — важность вопроса (required, recommended, optional)
+	Importance string `json:"importance"`
+}
+
+// ClarificationAnswer — ответ на уточняющий вопрос.
+type ClarificationAnswer struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+// LeadClarification — сессия уточнения лида (таблица lead_clarifications):
+// заданные вопросы, ответы и качество лида до и после уточнения.
+type LeadClarification struct {
+	ID        uuid.UUID
+	LeadID    uuid.UUID
+	Questions []ClarificationQuestion
+	Answers   []ClarificationAnswer
+	// Priority — приоритет уточнения (high, medium, low)
+	Priority string
+	Status   ClarificationStatus
+	// QualityScoreBefore — качество лида при создании сессии (0-1)
+	QualityScoreBefore *float64
+	// QualityScoreAfter — качество лида после применения ответов
+	QualityScoreAfter *float64
+	CreatedAt         time.Time
+	AnsweredAt        *time.Time
+}
@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// LeadRequirementVersion — текущая версия схемы требований лида.
+// Хранится в каждой записи, чтобы будущие изменения схемы можно было мигрировать.
+const LeadRequirementVersion = 1
+
+// LeadRequirement — требования лида к объекту недвижимости.
+type LeadRequirement struct {
+	Version int             `json:"version"`
+	Price   *Range[int64]   `json:"price,omitempty"`
+	Rooms   *Range[int32]   `json:"rooms,omitempty"`
+	Area    *Range[float64] `json:"area,omitempty"`
+	// Districts — районы в порядке предпочтения: первый считается целевым
+	Districts []string `json:"districts,omitempty"`
+	// MustHave — обязательные особенности объекта (балкон, парковка…)
+	MustHave []string `json:"must_have,omitempty"`
+	// NiceToHave — желательные особенности
+	NiceToHave []string `json:"nice_to_have,omitempty"`
+	// VisualPreferences — что должно быть видно на фото: «renovated», «park_view», «балкон»…
+	VisualPreferences []string `json:"visual_preferences,omitempty"`
+}
+
+// Range — диапазон значений; любая граница может отсутствовать.
+type Range[T int32 | int64 | float64] struct {
+	Min *T `json:"min,omitempty"`
+	Max *T `json:"max,omitempty"`
+}
+
+// Target — ориентир для ранжирования: середина диапазона или единственная заданная граница.
+func (r *Range[T]) Target() *T {
+	if r == nil {
+		return nil
+	}
+	switch {
+	case r.Min != nil && r.Max != nil:
+		mid := (*r.Min + *r.Max) / 2
+		return &mid
+	case r.Max != nil:
+		v := *r.Max
+		return &v
+	case r.Min != nil:
+		v := *r.Min
+		return &v
+	}
+	return nil
+}
+
+// IsEmpty — не задана ни одна граница.
+func (r *Range[T]) IsEmpty() bool {
+	return r == nil || (r.Min == nil && r.Max == nil)
+}
+
+func (r *Range[T]) validate(name string) error {
+	if r == nil {
+		return nil
+	}
+	if (r.Min != nil && *r.Min < 0) || (r.Max != nil && *r.Max < 0) {
+		return fmt.Errorf("%s must not be negative", name)
+	}
+	if r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+		return fmt.Errorf("%s.min must not exceed %s.max", name, name)
+	}
+	return nil
+}
+
+// IsEmpty — требования не заданы.
+func (r LeadRequirement) IsEmpty() bool {
+	return r.Price.IsEmpty() && r.Rooms.IsEmpty() && r.Area.IsEmpty() &&
+		len(r.Districts) == 0 && len(r.MustHave) == 0 && len(r.NiceToHave) == 0 &&
+		len(r.VisualPreferences) == 0
+}
+
+// Validate — проверяет диапазоны и списки. Версия должна совпадать с текущей
+// или быть нулевой (проставляется Normalize).
+func (r LeadRequirement) Validate() error {
+	if r.Version != 0 && r.Version != LeadRequirementVersion {
+		return fmt.Errorf("unsupported requirement version %d", r.Version)
+	}
+	if err := r.Price.validate("price"); err != nil {
+		return err
+	}
+	if err := r.Rooms.validate("rooms"); err != nil {
+		return err
+	}
+	if err := r.Area.validate("area"); err != nil {
+		return err
+	}
+	lists := []struct {
+		name   string
+		values []string
+	}{
+		{"districts", r.Districts},
+		{"must_have", r.MustHave},
+		{"nice_to_have", r.NiceToHave},
+		{"visual_preferences", r.VisualPreferences},
+	}
+	for _, list := range lists {
+		for _, v := range list.values {
+			if strings.TrimSpace(v) == "" {
+				return fmt.Errorf("%s must not contain empty values", list.name)
+			}
+		}
+	}
+	return nil
+}
+
+// Normalize — проставляет текущую версию и убирает пустые диапазоны.
+func (r LeadRequirement) Normalize() LeadRequirement {
+	r.Version = LeadRequirementVersion
+	if r.Price.IsEmpty() {
+		r.Price = nil
+	}
+	if r.Rooms.IsEmpty() {
+		r.Rooms = nil
+	}
+	if r.Area.IsEmpty() {
+		r.Area = nil
+	}
+	return r
+}
+
+// SoftCriteria — мягкие критерии ранжирования из требований.
+// Возвращает nil, если требования не содержат ни цены, ни комнат, ни площади, ни района,
+// ни распознанных визуальных предпочтений, ни желательных особенностей.
+func (r LeadRequirement) SoftCriteria() *SoftCriteria {
+	criteria := &SoftCriteria{
+		TargetPrice: r.Price.Target(),
+		TargetRooms: r.Rooms.Target(),
+		TargetArea:  r.Area.Target(),
+	}
+	if len(r.Districts) > 0 {
+		district := r.Districts[0]
+		criteria.TargetDistrict = &district
+		criteria.PreferredDistricts = append([]string(nil), r.Districts[1:]...)
+	}
+	criteria.VisualPreferences = VisualPreferenceVector(r.VisualPreferences)
+	criteria.PreferredFeatures = NormalizeFeatures(r.NiceToHave)
+
+	if criteria.TargetPrice == nil && criteria.TargetRooms == nil && criteria.TargetArea == nil &&
+		criteria.TargetDistrict == nil && criteria.VisualPreferences == nil && criteria.PreferredFeatures == nil {
+		return nil
+	}
+	return criteria
+}
+
+// Map — требования в виде map для запросов к ML и LLM.
+func (r LeadRequirement) Map() map[string]interface{} {
+	if r.IsEmpty() {
+		return nil
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil
+	}
+	return m
+}
+
+// ParseLeadRequirement — разбирает requirement из JSONB; пустое значение — пустые требования.
+func ParseLeadRequirement(data []byte) (LeadRequirement, error) {
+	var r LeadRequirement
+	if len(data) == 0 {
+		return r, nil
+	}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return LeadRequirement{}, err
+	}
+	if r.Version > LeadRequirementVersion {
+		return LeadRequirement{}, fmt.Errorf("unsupported requirement version %d", r.Version)
+	}
+	return r, nil
+}
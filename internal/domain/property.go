@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,15 +19,32 @@ type Property struct {
 	Area          *float64
 	Price         *int64
 	Rooms         *int32
+	// Floor — этаж; TotalFloors — этажность здания
+	Floor         *int32
+	TotalFloors   *int32
+	YearBuilt     *int32
+	// District — район; при ранжировании важнее совпадения по адресу
+	District      *string
+	Latitude      *float64
+	Longitude     *float64
+	// Features — особенности объекта (балкон, парковка, лифт…)
+	Features      []string
 	Status        PropertyStatus
 	OwnerUserID   uuid.UUID
 	CreatedUserID uuid.UUID
 	// Embedding — векторное представление для матчинга (pgvector)
 	Embedding     []float32
+	// VisualFeatures — визуальные признаки по фотографиям (см. VisualFeatureFlags); nil — фото не анализировались
+	VisualFeatures []float32
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
 
+// HasCoordinates — известны ли координаты объекта.
+func (p Property) HasCoordinates() bool {
+	return p.Latitude != nil && p.Longitude != nil
+}
+
 // PropertyType — тип недвижимости.
 type PropertyType string
 
@@ -68,13 +86,27 @@ type PropertyFilter struct {
 	Area          *float64
 	Price         *int64
 	Rooms         *int32
+	Floor         *int32
+	TotalFloors   *int32
+	YearBuilt     *int32
+	District      *string
+	Latitude      *float64
+	Longitude     *float64
+	// Features — новый список особенностей целиком; nil — не менять
+	Features      *[]string
 	MinRooms      *int32
 	MaxRooms      *int32
 	MinPrice      *int64
 	MaxPrice      *int64
+	// Geo — поиск в радиусе от точки; объекты без координат не попадают в выборку
+	Geo           *GeoFilter
 	Status        *PropertyStatus
 	OwnerUserID   *uuid.UUID
 	CreatedUserID *uuid.UUID
+	// TeamOf — объекты команды пользователя: всех участников его агентства
+	TeamOf        *uuid.UUID
+	// OwnerUserIDs — владелец из списка; сервис заполняет его по TeamOf
+	OwnerUserIDs  []uuid.UUID
 
 	// Пагинация
 	Pagination    *PaginationParams
@@ -91,6 +123,13 @@ type MatchedProperty struct {
 	RoomsScore       *float64
 	AreaScore        *float64
 	SemanticScore    *float64
+	// DistanceScore — затухание по расстоянию до центра гео-поиска; DistanceKm — само расстояние
+	DistanceScore    *float64
+	DistanceKm       *float64
+	// VisualScore — соответствие фото визуальным предпочтениям лида; nil, если сравнить не с чем
+	VisualScore      *float64
+	// FeaturesScore — доля желательных особенностей лида, которые есть у объекта; nil, если их нет
+	FeaturesScore    *float64
 	MatchExplanation *string
 }
 
@@ -101,8 +140,20 @@ type MatchWeights struct {
 	Rooms    float64 `json:"rooms"`    // Вес комнат (default: 0.20)
 	Area     float64 `json:"area"`     // Вес площади (default: 0.10)
 	Semantic float64 `json:"semantic"` // Вес семантики (default: 0.15)
+	Distance float64 `json:"distance"` // Вес удалённости от центра гео-поиска (default: 0)
+	Visual   float64 `json:"visual"`   // Вес визуальных предпочтений по фото (default: 0)
+	Features float64 `json:"features"` // Вес желательных особенностей (default: 0)
 }
 
+// DefaultDistanceWeight — вес удалённости, если задан гео-фильтр, а вес не указан.
+const DefaultDistanceWeight = 0.20
+
+// DefaultVisualWeight — вес визуальных признаков, если у лида есть визуальные предпочтения, а вес не указан.
+const DefaultVisualWeight = 0.15
+
+// DefaultFeaturesWeight — вес желательных особенностей, если они есть у лида, а вес не указан.
+const DefaultFeaturesWeight = 0.10
+
 // DefaultWeights возвращает веса по умолчанию.
 func DefaultWeights() MatchWeights {
 	return MatchWeights{
@@ -116,7 +167,7 @@ func DefaultWeights() MatchWeights {
 
 // Normalize нормализует веса чтобы сумма = 1.
 func (w MatchWeights) Normalize() MatchWeights {
-	total := w.Price + w.District + w.Rooms + w.Area + w.Semantic
+	total := w.Price + w.District + w.Rooms + w.Area + w.Semantic + w.Distance + w.Visual + w.Features
 	if total <= 0 {
 		return DefaultWeights()
 	}
@@ -126,6 +177,9 @@ func (w MatchWeights) Normalize() MatchWeights {
 		Rooms:    w.Rooms / total,
 		Area:     w.Area / total,
 		Semantic: w.Semantic / total,
+		Distance: w.Distance / total,
+		Visual:   w.Visual / total,
+		Features: w.Features / total,
 	}
 }
 
@@ -136,6 +190,9 @@ type SoftCriteria struct {
 	TargetRooms        *int32   // Желаемое кол-во комнат
 	TargetArea         *float64 // Желаемая площадь
 	PreferredDistricts []string // Список предпочтительных районов
+	Geo                *GeoFilter // Центр гео-поиска для ранжирования по удалённости
+	VisualPreferences  []float64  // Важные визуальные признаки (см. VisualPreferenceVector)
+	PreferredFeatures  []string   // Желательные особенности объекта (nice-to-have лида)
 }
 
 // HardFilters — жёсткие фильтры для критических полей матчинга.
@@ -151,6 +208,10 @@ type HardFilters struct {
 	// MinPrice / MaxPrice — ценовой диапазон (жёсткий, но с допуском)
 	MinPrice *int64
 	MaxPrice *int64
+	// Geo — радиус от точки; в отличие от остальных фильтров, объекты без координат исключаются
+	Geo *GeoFilter
+	// Features — обязательные особенности (must-have лида); объект должен содержать все
+	Features []string
 }
 
 // DefaultHardFiltersFromLead создаёт HardFilters из данных лида.
@@ -183,6 +244,74 @@ func DefaultHardFiltersFromLead(city *string, propertyType *PropertyType, target
 	return hf
 }
 
+// DefaultHardFiltersFromProperty создаёт HardFilters для обратного матчинга (лиды для объекта).
+// Диапазоны зеркальны DefaultHardFiltersFromLead и ограничивают желаемые значения лида:
+// объект с N комнатами подходит лидам, ищущим N±1, а цена P — лидам с бюджетом от P/1.2 до P/0.8.
+func DefaultHardFiltersFromProperty(p Property) HardFilters {
+	hf := HardFilters{
+		City: p.City,
+	}
+
+	if p.PropertyType != PropertyTypeUnspecified {
+		pt := p.PropertyType
+		hf.PropertyType = &pt
+	}
+
+	// Допуск по комнатам: ±1
+	if p.Rooms != nil {
+		minR := *p.Rooms - 1
+		maxR := *p.Rooms + 1
+		hf.MinRooms = &minR
+		hf.MaxRooms = &maxR
+	}
+
+	// Допуск по цене: цена объекта в пределах ±20% от бюджета лида
+	if p.Price != nil {
+		minP := int64(float64(*p.Price) / 1.2)
+		maxP := int64(float64(*p.Price) / 0.8)
+		hf.MinPrice = &minP
+		hf.MaxPrice = &maxP
+	}
+
+	return hf
+}
+
+// Matches проверяет объект на соответствие жёстким фильтрам.
+// Семантика совпадает с SQL-фильтрами репозитория: пустые поля объекта фильтр не отсекают
+// (кроме гео-фильтра — без координат расстояние не определить).
+func (hf HardFilters) Matches(p Property) bool {
+	if hf.City != nil && *hf.City != "" && p.City != nil && *p.City != "" &&
+		!strings.EqualFold(*p.City, *hf.City) {
+		return false
+	}
+	if hf.PropertyType != nil && p.PropertyType != *hf.PropertyType {
+		return false
+	}
+	if p.Rooms != nil {
+		if hf.MinRooms != nil && *p.Rooms < *hf.MinRooms {
+			return false
+		}
+		if hf.MaxRooms != nil && *p.Rooms > *hf.MaxRooms {
+			return false
+		}
+	}
+	if p.Price != nil {
+		if hf.MinPrice != nil && *p.Price < *hf.MinPrice {
+			return false
+		}
+		if hf.MaxPrice != nil && *p.Price > *hf.MaxPrice {
+			return false
+		}
+	}
+	if hf.Geo != nil && !hf.Geo.Contains(p) {
+		return false
+	}
+	if !p.HasFeatures(hf.Features) {
+		return false
+	}
+	return true
+}
+
 // WeightPreset — пресет весов.
 type WeightPreset struct {
 	ID          string
@@ -199,6 +328,7 @@ func GetWeightPresets() []WeightPreset {
 		{ID: "location_first", Name: "Локация важнее", Description: "Приоритет на район", Weights: MatchWeights{Price: 0.20, District: 0.40, Rooms: 0.15, Area: 0.10, Semantic: 0.15}},
 		{ID: "family", Name: "Для семьи", Description: "Комнаты и площадь", Weights: MatchWeights{Price: 0.20, District: 0.20, Rooms: 0.30, Area: 0.20, Semantic: 0.10}},
 		{ID: "semantic", Name: "Умный поиск", Description: "Приоритет на семантику", Weights: MatchWeights{Price: 0.15, District: 0.15, Rooms: 0.15, Area: 0.10, Semantic: 0.45}},
+		{ID: "visual", Name: "По фото", Description: "Приоритет на состояние и вид по фотографиям", Weights: MatchWeights{Price: 0.20, District: 0.15, Rooms: 0.15, Area: 0.10, Semantic: 0.10, Visual: 0.30}},
 	}
 }
 
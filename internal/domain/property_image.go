@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxPropertyImages — сколько фотографий можно хранить у одного объекта.
+const MaxPropertyImages = 30
+
+// PropertyImage — фотография из галереи объекта недвижимости.
+type PropertyImage struct {
+	ID         uuid.UUID
+	PropertyID uuid.UUID
+	// StoragePath — путь к объекту в бакете MinIO (properties/<property_id>/<image_id>.<ext>)
+	StoragePath string
+	// ImageURL — внешняя ссылка на фотографию, которая не хранится в MinIO (передана на анализ по URL)
+	ImageURL    string
+	FileName    string
+	ContentType string
+	// Position — порядок в галерее, 0 — обложка
+	Position int32
+	// URL — ссылка для клиента: presigned-ссылка на файл в MinIO или ImageURL; в базе не хранится
+	URL string
+	// Analysis — результат CV-анализа; заполняется только при анализе фотографий
+	Analysis  *ImageAnalysis
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// PropertyImageUpload — файл, загружаемый в галерею.
+type PropertyImageUpload struct {
+	FileName string
+	Data     []byte
+}
+
+// ImageFeature — особенность, найденная на фотографии (балкон, панорамные окна…).
+type ImageFeature struct {
+	Name       string  `json:"name"`
+	Confidence float64 `json:"confidence"`
+	// Category — interior, exterior, view, amenity, premium
+	Category string `json:"category"`
+}
+
+// ImageAnalysis — результат CV-анализа одной фотографии.
+type ImageAnalysis struct {
+	DetectedFeatures []ImageFeature
+	RoomType         string
+	// QualityScore — качество отделки (0-1)
+	QualityScore float64
+	ViewType     string
+	// Brightness — освещённость (0-1)
+	Brightness float64
+	Tags       map[string]string
+	// VisualFeatures — 16 визуальных признаков для эмбеддинга
+	VisualFeatures []float64
+	Confidence     float64
+	AnalyzedAt     time.Time
+}
+
+// PropertyVisualSummary — сводный анализ фотографий объекта, хранится в properties.
+type PropertyVisualSummary struct {
+	TotalImages       int
+	AverageQuality    float64
+	DetectedRooms     []string
+	AllFeatures       []ImageFeature
+	ViewTypes         []string
+	OverallAssessment string
+	VisualFeatures    []float64
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken — выданный refresh-токен. Сам токен не хранится, только его хэш.
+type RefreshToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	FamilyID  uuid.UUID // цепочка ротации, начатая одним входом
+	TokenHash string
+	AccessJTI uuid.UUID // jti access-токена, выданного вместе с этим refresh-токеном
+	ExpiresAt time.Time
+	// ReplacedBy — токен, выданный при ротации; повторное предъявление заменённого токена — признак кражи
+	ReplacedBy *uuid.UUID
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// TokenPair — пара токенов, выдаваемая при входе и обновлении.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time // срок действия access-токена
+}
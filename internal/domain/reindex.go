@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultReindexBatchSize — размер пакета для ml.Client.ReindexBatch по умолчанию.
+const DefaultReindexBatchSize = 100
+
+// ReindexOptions — параметры массовой переиндексации.
+type ReindexOptions struct {
+	// BatchSize — сколько сущностей отправляется в ML-сервис одним запросом
+	BatchSize int
+	// Restart — начать заново, игнорируя сохранённый курсор незавершённой переиндексации
+	Restart bool
+}
+
+// ReindexProgress — ход массовой переиндексации. Сохраняется после каждого пакета
+// и служит контрольной точкой для продолжения после сбоя.
+type ReindexProgress struct {
+	EntityType EmbeddingEntityType
+	// Cursor — PageCursor последнего обработанного пакета; пустой — с начала
+	Cursor    string
+	Total     int
+	Processed int
+	Success   int
+	Failed    int
+	// LastID — последняя обработанная сущность (не сохраняется в контрольной точке)
+	LastID uuid.UUID
+	// Done — все сущности обработаны
+	Done      bool
+	StartedAt time.Time
+	UpdatedAt time.Time
+}
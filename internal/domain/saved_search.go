@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultSavedSearchMinScore — порог TotalScore, начиная с которого объект попадает во входящие.
+const DefaultSavedSearchMinScore = 0.6
+
+// SavedSearch — сохранённый поиск брокера.
+// Ищет либо по лиду (LeadID), либо по произвольному фильтру (Filter).
+type SavedSearch struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Name   string
+	// LeadID — поиск по лиду: embedding, город и критерии берутся из лида
+	LeadID *uuid.UUID
+	// Filter — ad-hoc фильтр для поиска без лида (хранится в JSONB)
+	Filter SavedSearchFilter
+	// Weights — веса ранжирования; nil — веса по умолчанию
+	Weights *MatchWeights
+	// MinScore — минимальный TotalScore для записи совпадения во входящие
+	MinScore  float64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SavedSearchFilter — ad-hoc фильтр сохранённого поиска.
+type SavedSearchFilter struct {
+	City         *string       `json:"city,omitempty"`
+	PropertyType *PropertyType `json:"propertyType,omitempty"`
+	MinRooms     *int32        `json:"minRooms,omitempty"`
+	MaxRooms     *int32        `json:"maxRooms,omitempty"`
+	MinPrice     *int64        `json:"minPrice,omitempty"`
+	MaxPrice     *int64        `json:"maxPrice,omitempty"`
+
+	// Желаемые значения для мягкого ранжирования
+	TargetPrice    *int64   `json:"targetPrice,omitempty"`
+	TargetRooms    *int32   `json:"targetRooms,omitempty"`
+	TargetArea     *float64 `json:"targetArea,omitempty"`
+	TargetDistrict *string  `json:"targetDistrict,omitempty"`
+}
+
+// HardFilters — жёсткие фильтры ad-hoc поиска.
+func (f SavedSearchFilter) HardFilters() HardFilters {
+	hf := HardFilters{
+		PropertyType: f.PropertyType,
+		MinRooms:     f.MinRooms,
+		MaxRooms:     f.MaxRooms,
+		MinPrice:     f.MinPrice,
+		MaxPrice:     f.MaxPrice,
+	}
+	if f.City != nil && *f.City != "" {
+		city := NormalizeCity(*f.City)
+		hf.City = &city
+	}
+	return hf
+}
+
+// SoftCriteria — критерии ранжирования ad-hoc поиска; nil, если желаемые значения не заданы.
+func (f SavedSearchFilter) SoftCriteria() *SoftCriteria {
+	if f.TargetPrice == nil && f.TargetRooms == nil && f.TargetArea == nil && f.TargetDistrict == nil {
+		return nil
+	}
+	return &SoftCriteria{
+		TargetPrice:    f.TargetPrice,
+		TargetRooms:    f.TargetRooms,
+		TargetArea:     f.TargetArea,
+		TargetDistrict: f.TargetDistrict,
+	}
+}
+
+// SavedSearchMatch — новый объект, найденный сохранённым поиском (запись во входящих).
+type SavedSearchMatch struct {
+	ID               uuid.UUID
+	SearchID         uuid.UUID
+	PropertyID       uuid.UUID
+	Property         Property
+	Score            float64
+	MatchExplanation *string
+	// SeenAt — когда брокер получил совпадение; nil — не просмотрено
+	SeenAt    *time.Time
+	CreatedAt time.Time
+}
+
+// SavedSearchPending — проиндексированный объект, который ещё не прогнан по сохранённым поискам.
+type SavedSearchPending struct {
+	PropertyID uuid.UUID
+	// IndexedAt — время последней индексации; по нему воркер удаляет только обработанное уведомление
+	IndexedAt time.Time
+}
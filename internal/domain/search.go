@@ -0,0 +1,29 @@
+package domain
+
+// SearchOptions — стратегия поиска для одного запроса. nil-поле — значение
+// из конфигурации сервиса (config.SearchConfig).
+type SearchOptions struct {
+	HybridSearch *bool
+	// VectorWeight / FulltextWeight — веса гибридного поиска; если задан один, второй дополняет его до 1
+	VectorWeight   *float64
+	FulltextWeight *float64
+	UseReranker    *bool
+	// Candidates — сколько кандидатов отбирается для ранжирования и реранкера
+	Candidates     *int
+	DynamicWeights *bool
+}
+
+// EffectiveSearchOptions — стратегия, с которой фактически выполнен поиск.
+// Возвращается клиенту для сравнения стратегий (A/B).
+type EffectiveSearchOptions struct {
+	HybridSearch   bool
+	VectorWeight   float64
+	FulltextWeight float64
+	UseReranker    bool
+	// RerankerApplied — реранкер был доступен и отработал без ошибок
+	RerankerApplied bool
+	Candidates      int
+	DynamicWeights  bool
+	// DynamicWeightsApplied — веса получены анализом лида, а не взяты по умолчанию
+	DynamicWeightsApplied bool
+}
@@ -19,6 +19,19 @@ type User struct {
 	Role         UserRole
 	Status       UserStatus
 	CreatedAt    time.Time
+	// EmailVerifiedAt — когда пользователь подтвердил email; nil — не подтверждён
+	EmailVerifiedAt *time.Time
+}
+
+// Actor — пользователь, от имени которого выполняется операция.
+type Actor struct {
+	UserID uuid.UUID
+	Role   UserRole
+}
+
+// IsAdmin — обладает ли пользователь правами администратора.
+func (a Actor) IsAdmin() bool {
+	return a.Role == UserRoleAdmin
 }
 
 // UserRole — тип роли пользователя.
@@ -58,4 +71,5 @@ type UserFilter struct {
 	AvatarURL  *string
 	Role       *UserRole
 	Status     *UserStatus
+	Pagination *PaginationParams
 }
@@ -0,0 +1,118 @@
+package domain
+
+import "strings"
+
+// VisualFeatureCount — размерность визуальных признаков (properties.visual_features vector(16)).
+const VisualFeatureCount = 16
+
+// Индексы сводных визуальных признаков; признаки с VisualFlagsOffset — флаги VisualFeatureFlags.
+const (
+	VisualQuality     = 0 // качество отделки
+	VisualRoomVariety = 1 // разнообразие помещений на фото
+	VisualPremium     = 2 // премиум-особенности
+	VisualGoodView    = 3 // вид на парк, реку или панорама
+	VisualFlagsOffset = 4
+)
+
+// VisualFeatureFlags — особенности, наличие которых кодируется признаками 4-15 (в этом порядке).
+var VisualFeatureFlags = []string{
+	"balcony", "terrace", "panoramic_windows", "high_ceilings",
+	"modern_kitchen", "master_bedroom", "walk_in_closet", "bathroom_modern",
+	"parking", "security", "gym", "pool",
+}
+
+// visualPreferenceKeys — ключи предпочтений лида, кроме названий флагов.
+var visualPreferenceKeys = map[string]int{
+	"renovated":     VisualQuality,
+	"good_repair":   VisualQuality,
+	"premium":       VisualPremium,
+	"good_view":     VisualGoodView,
+	"park_view":     VisualGoodView,
+	"river_view":    VisualGoodView,
+	"panorama_view": VisualGoodView,
+}
+
+// visualPreferenceStems — русские формулировки предпочтений: подстрока → признак.
+var visualPreferenceStems = []struct {
+	stem string
+	dim  int
+}{
+	{"ремонт", VisualQuality},
+	{"отделк", VisualQuality},
+	{"премиум", VisualPremium},
+	{"элит", VisualPremium},
+	{"вид", VisualGoodView},
+	{"балкон", VisualFlagsOffset + 0},
+	{"террас", VisualFlagsOffset + 1},
+	{"панорамн", VisualFlagsOffset + 2},
+	{"потол", VisualFlagsOffset + 3},
+	{"кухн", VisualFlagsOffset + 4},
+	{"спальн", VisualFlagsOffset + 5},
+	{"гардероб", VisualFlagsOffset + 6},
+	{"ванн", VisualFlagsOffset + 7},
+	{"санузел", VisualFlagsOffset + 7},
+	{"парков", VisualFlagsOffset + 8},
+	{"охран", VisualFlagsOffset + 9},
+	{"консьерж", VisualFlagsOffset + 9},
+	{"фитнес", VisualFlagsOffset + 10},
+	{"спортзал", VisualFlagsOffset + 10},
+	{"бассейн", VisualFlagsOffset + 11},
+}
+
+// VisualPreferenceVector — визуальные предпочтения лида в пространстве visual_features:
+// 1 для важных признаков, 0 для остальных. Понимает ключи («renovated», «park_view», «balcony»)
+// и русские формулировки («свежий ремонт», «вид на парк»). nil, если ни одно предпочтение не распознано.
+func VisualPreferenceVector(prefs []string) []float64 {
+	var vec []float64
+	set := func(dim int) {
+		if vec == nil {
+			vec = make([]float64, VisualFeatureCount)
+		}
+		vec[dim] = 1
+	}
+
+	for _, pref := range prefs {
+		key := strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(strings.TrimSpace(pref)))
+		if dim, ok := visualPreferenceKeys[key]; ok {
+			set(dim)
+			continue
+		}
+		if i := visualFlagIndex(key); i >= 0 {
+			set(VisualFlagsOffset + i)
+			continue
+		}
+		for _, s := range visualPreferenceStems {
+			if strings.Contains(key, s.stem) {
+				set(s.dim)
+			}
+		}
+	}
+	return vec
+}
+
+// VisualMatch — соответствие визуальных признаков объекта предпочтениям (0-1):
+// среднее значение признаков объекта, взвешенное предпочтениями.
+// false, если предпочтений нет или объект ещё не проанализирован.
+func VisualMatch(features []float32, prefs []float64) (float64, bool) {
+	if len(features) != VisualFeatureCount || len(prefs) != VisualFeatureCount {
+		return 0, false
+	}
+	var sum, total float64
+	for i, w := range prefs {
+		sum += w * float64(features[i])
+		total += w
+	}
+	if total <= 0 {
+		return 0, false
+	}
+	return sum / total, true
+}
+
+func visualFlagIndex(name string) int {
+	for i, flag := range VisualFeatureFlags {
+		if flag == name {
+			return i
+		}
+	}
+	return -1
+}
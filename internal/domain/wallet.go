@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WalletAccountKind — вид счёта в кредитном кошельке.
+type WalletAccountKind string
+
+const (
+	WalletAccountAvailable WalletAccountKind = "AVAILABLE" // Свободные кредиты пользователя
+	WalletAccountHeld      WalletAccountKind = "HELD"      // Кредиты, заблокированные под принятые сделки
+	WalletAccountExternal  WalletAccountKind = "EXTERNAL"  // Системный счёт: источник пополнений и получатель выводов
+)
+
+func (k WalletAccountKind) String() string {
+	return string(k)
+}
+
+// WalletAccount — счёт в двойной записи. У пользователя два счёта (AVAILABLE и HELD),
+// системный счёт EXTERNAL один и может уходить в минус.
+type WalletAccount struct {
+	ID        uuid.UUID
+	UserID    *uuid.UUID // nil у системного счёта
+	Kind      WalletAccountKind
+	Balance   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// LedgerTransactionKind — тип операции в журнале.
+type LedgerTransactionKind string
+
+const (
+	LedgerTopUp       LedgerTransactionKind = "TOP_UP"       // Пополнение админом
+	LedgerWithdrawal  LedgerTransactionKind = "WITHDRAWAL"   // Вывод админом
+	LedgerDealHold    LedgerTransactionKind = "DEAL_HOLD"    // Блокировка средств покупателя при принятии сделки
+	LedgerDealRelease LedgerTransactionKind = "DEAL_RELEASE" // Перевод заблокированных средств продавцу при завершении
+	LedgerDealRefund  LedgerTransactionKind = "DEAL_REFUND"  // Возврат заблокированных средств покупателю
+)
+
+func (k LedgerTransactionKind) String() string {
+	return string(k)
+}
+
+// LedgerEntry — проводка по одному счёту. Amount положительный для зачисления и
+// отрицательный для списания; сумма проводок одной операции равна нулю.
+type LedgerEntry struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	AccountID     uuid.UUID
+	Amount        int64
+	BalanceAfter  int64
+}
+
+// LedgerTransaction — операция журнала с проводками.
+type LedgerTransaction struct {
+	ID        uuid.UUID
+	Kind      LedgerTransactionKind
+	DealID    *uuid.UUID // для операций по сделке
+	CreatedBy *uuid.UUID // админ для пополнений и выводов
+	Comment   string
+	CreatedAt time.Time
+	Entries   []LedgerEntry
+}
+
+// IsBalanced — проводки операции в сумме дают ноль.
+func (t LedgerTransaction) IsBalanced() bool {
+	var sum int64
+	for _, e := range t.Entries {
+		sum += e.Amount
+	}
+	return len(t.Entries) > 0 && sum == 0
+}
+
+// WalletBalance — остатки на счетах пользователя.
+type WalletBalance struct {
+	UserID    uuid.UUID
+	Available int64
+	Held      int64
+}
+
+// WalletStatementEntry — строка выписки: проводка по счёту пользователя вместе с операцией.
+type WalletStatementEntry struct {
+	EntryID       uuid.UUID
+	TransactionID uuid.UUID
+	Kind          LedgerTransactionKind
+	AccountKind   WalletAccountKind
+	DealID        *uuid.UUID
+	Comment       string
+	Amount        int64
+	BalanceAfter  int64
+	CreatedAt     time.Time
+}
+
+// WalletStatementFilter — параметры выписки.
+type WalletStatementFilter struct {
+	UserID     uuid.UUID
+	From       *time.Time
+	To         *time.Time
+	Pagination *PaginationParams
+}
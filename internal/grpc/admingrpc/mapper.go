@@ -0,0 +1,23 @@
+package admingrpc
+
+import (
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+)
+
+func reindexProgressToProto(p domain.ReindexProgress) *pb.ReindexProgress {
+	resp := &pb.ReindexProgress{
+		EntityType: p.EntityType.String(),
+		Total:      int32(p.Total),
+		Processed:  int32(p.Processed),
+		Succeeded:  int32(p.Success),
+		Failed:     int32(p.Failed),
+		Done:       p.Done,
+	}
+	if p.LastID != uuid.Nil {
+		resp.CurrentId = p.LastID.String()
+	}
+	return resp
+}
@@ -0,0 +1,73 @@
+package admingrpc
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	pb "lead_exchange/pkg"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReindexAll — пакетная переиндексация лидов и/или объектов с потоковой передачей прогресса.
+// При отключении клиента контекст отменяется, переиндексация останавливается
+// и при следующем вызове продолжается с последней контрольной точки.
+func (s *adminServer) ReindexAll(in *pb.ReindexAllRequest, stream pb.AdminService_ReindexAllServer) error {
+	if err := in.ValidateAll(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	opts := domain.ReindexOptions{
+		BatchSize: int(in.GetBatchSize()),
+		Restart:   in.GetRestart(),
+	}
+
+	// Ошибка отправки означает, что клиент ушёл: останавливаем переиндексацию
+	var sendErr error
+	onProgress := func(progress domain.ReindexProgress) {
+		if sendErr != nil {
+			return
+		}
+		if err := stream.Send(reindexProgressToProto(progress)); err != nil {
+			sendErr = err
+			cancel()
+		}
+	}
+
+	target := in.GetTarget()
+	if target == pb.ReindexTarget_REINDEX_TARGET_LEADS || target == pb.ReindexTarget_REINDEX_TARGET_ALL {
+		if _, err := s.leads.ReindexAllLeads(ctx, opts, onProgress); err != nil {
+			return s.reindexError(ctx, err, sendErr, domain.EmbeddingEntityLead)
+		}
+	}
+	if target == pb.ReindexTarget_REINDEX_TARGET_PROPERTIES || target == pb.ReindexTarget_REINDEX_TARGET_ALL {
+		if _, err := s.properties.ReindexAllProperties(ctx, opts, onProgress); err != nil {
+			return s.reindexError(ctx, err, sendErr, domain.EmbeddingEntityProperty)
+		}
+	}
+
+	if sendErr != nil {
+		return status.Error(codes.Unavailable, "failed to send progress")
+	}
+
+	return nil
+}
+
+// reindexError — перевод ошибки переиндексации в gRPC-статус.
+func (s *adminServer) reindexError(ctx context.Context, err, sendErr error, entityType domain.EmbeddingEntityType) error {
+	log := s.log.With(slog.String("entity_type", entityType.String()))
+
+	if sendErr != nil || errors.Is(err, context.Canceled) || ctx.Err() != nil {
+		log.Warn("reindex cancelled, will resume from last checkpoint", sl.Err(err))
+		return status.Error(codes.Canceled, "reindex cancelled")
+	}
+
+	log.Error("reindex failed", sl.Err(err))
+	return status.Errorf(codes.Internal, "failed to reindex %ss: %v", entityType, err)
+}
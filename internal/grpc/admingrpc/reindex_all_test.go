@@ -0,0 +1,136 @@
+package admingrpc
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+	"log/slog"
+	"os"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockReindexer
+type MockReindexer struct {
+	ReindexFunc func(ctx context.Context, opts domain.ReindexOptions, onProgress func(domain.ReindexProgress)) (domain.ReindexProgress, error)
+	called      bool
+}
+
+func (m *MockReindexer) ReindexAllLeads(ctx context.Context, opts domain.ReindexOptions, onProgress func(domain.ReindexProgress)) (domain.ReindexProgress, error) {
+	return m.reindex(ctx, opts, onProgress)
+}
+
+func (m *MockReindexer) ReindexAllProperties(ctx context.Context, opts domain.ReindexOptions, onProgress func(domain.ReindexProgress)) (domain.ReindexProgress, error) {
+	return m.reindex(ctx, opts, onProgress)
+}
+
+func (m *MockReindexer) reindex(ctx context.Context, opts domain.ReindexOptions, onProgress func(domain.ReindexProgress)) (domain.ReindexProgress, error) {
+	m.called = true
+	if m.ReindexFunc != nil {
+		return m.ReindexFunc(ctx, opts, onProgress)
+	}
+	return domain.ReindexProgress{Done: true}, nil
+}
+
+// mockReindexStream — поток прогресса; SendFunc эмулирует отключение клиента.
+type mockReindexStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	sent     []*pb.ReindexProgress
+	SendFunc func(*pb.ReindexProgress) error
+}
+
+func (m *mockReindexStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockReindexStream) Send(p *pb.ReindexProgress) error {
+	if m.SendFunc != nil {
+		if err := m.SendFunc(p); err != nil {
+			return err
+		}
+	}
+	m.sent = append(m.sent, p)
+	return nil
+}
+
+// batchReindexer отдаёт два пакета и останавливается при отмене контекста.
+func batchReindexer(entityType domain.EmbeddingEntityType) func(ctx context.Context, opts domain.ReindexOptions, onProgress func(domain.ReindexProgress)) (domain.ReindexProgress, error) {
+	return func(ctx context.Context, opts domain.ReindexOptions, onProgress func(domain.ReindexProgress)) (domain.ReindexProgress, error) {
+		var progress domain.ReindexProgress
+		for i := 1; i <= 2; i++ {
+			if err := ctx.Err(); err != nil {
+				return progress, err
+			}
+			progress = domain.ReindexProgress{EntityType: entityType, Total: 2, Processed: i, Success: i, Done: i == 2}
+			onProgress(progress)
+		}
+		return progress, nil
+	}
+}
+
+func TestReindexAll_StreamsProgress(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	leads := &MockReindexer{ReindexFunc: batchReindexer(domain.EmbeddingEntityLead)}
+	properties := &MockReindexer{ReindexFunc: batchReindexer(domain.EmbeddingEntityProperty)}
+	server := &adminServer{log: log, leads: leads, properties: properties}
+
+	stream := &mockReindexStream{ctx: context.Background()}
+	err := server.ReindexAll(&pb.ReindexAllRequest{Target: pb.ReindexTarget_REINDEX_TARGET_ALL}, stream)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(stream.sent) != 4 {
+		t.Fatalf("expected 4 progress messages, got %d", len(stream.sent))
+	}
+	if last := stream.sent[3]; last.EntityType != "property" || !last.Done || last.Succeeded != 2 {
+		t.Errorf("unexpected last progress: %+v", last)
+	}
+}
+
+func TestReindexAll_OnlyRequestedTarget(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	leads := &MockReindexer{}
+	properties := &MockReindexer{}
+	server := &adminServer{log: log, leads: leads, properties: properties}
+
+	err := server.ReindexAll(&pb.ReindexAllRequest{Target: pb.ReindexTarget_REINDEX_TARGET_PROPERTIES}, &mockReindexStream{ctx: context.Background()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if leads.called || !properties.called {
+		t.Errorf("expected only properties to be reindexed (leads=%v, properties=%v)", leads.called, properties.called)
+	}
+
+	err = server.ReindexAll(&pb.ReindexAllRequest{}, &mockReindexStream{ctx: context.Background()})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for unspecified target, got %v", err)
+	}
+}
+
+func TestReindexAll_ClientDisconnectCancelsReindex(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	leads := &MockReindexer{ReindexFunc: batchReindexer(domain.EmbeddingEntityLead)}
+	properties := &MockReindexer{}
+	server := &adminServer{log: log, leads: leads, properties: properties}
+
+	stream := &mockReindexStream{
+		ctx: context.Background(),
+		SendFunc: func(*pb.ReindexProgress) error {
+			return errors.New("transport is closing")
+		},
+	}
+
+	err := server.ReindexAll(&pb.ReindexAllRequest{Target: pb.ReindexTarget_REINDEX_TARGET_ALL}, stream)
+	if status.Code(err) != codes.Canceled {
+		t.Fatalf("expected Canceled, got %v", err)
+	}
+	if properties.called {
+		t.Error("properties must not be reindexed after client disconnected")
+	}
+}
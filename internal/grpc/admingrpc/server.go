@@ -0,0 +1,37 @@
+package admingrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+	"log/slog"
+
+	"google.golang.org/grpc"
+)
+
+// LeadReindexer — пакетная переиндексация лидов.
+type LeadReindexer interface {
+	ReindexAllLeads(ctx context.Context, opts domain.ReindexOptions, onProgress func(domain.ReindexProgress)) (domain.ReindexProgress, error)
+}
+
+// PropertyReindexer — пакетная переиндексация объектов.
+type PropertyReindexer interface {
+	ReindexAllProperties(ctx context.Context, opts domain.ReindexOptions, onProgress func(domain.ReindexProgress)) (domain.ReindexProgress, error)
+}
+
+// adminServer реализует gRPC AdminServiceServer.
+type adminServer struct {
+	pb.UnimplementedAdminServiceServer
+	log        *slog.Logger
+	leads      LeadReindexer
+	properties PropertyReindexer
+}
+
+// RegisterAdminServerGRPC регистрирует AdminServiceServer в gRPC сервере.
+func RegisterAdminServerGRPC(server *grpc.Server, log *slog.Logger, leads LeadReindexer, properties PropertyReindexer) {
+	pb.RegisterAdminServiceServer(server, &adminServer{
+		log:        log,
+		leads:      leads,
+		properties: properties,
+	})
+}
@@ -0,0 +1,34 @@
+package agencygrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateAgency — создание агентства; создатель становится владельцем.
+func (s *agencyServer) CreateAgency(ctx context.Context, in *pb.CreateAgencyRequest) (*pb.AgencyResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	a, err := s.agencyService.CreateAgency(ctx, actor, in.GetName())
+	if err != nil {
+		return nil, agencyError(err, "create agency")
+	}
+
+	owner := domain.AgencyMember{AgencyID: a.ID, UserID: actor.UserID, Role: domain.AgencyRoleOwner, JoinedAt: a.CreatedAt}
+	return &pb.AgencyResponse{
+		Agency:  agencyDomainToProto(a),
+		Members: []*pb.AgencyMember{memberDomainToProto(owner)},
+	}, nil
+}
@@ -0,0 +1,32 @@
+package agencygrpc
+
+import (
+	"errors"
+	"fmt"
+	"lead_exchange/internal/services/agency"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// agencyError — перевод ошибок сервиса агентств в gRPC-статусы.
+func agencyError(err error, action string) error {
+	switch {
+	case errors.Is(err, agency.ErrAgencyNotFound):
+		return status.Error(codes.NotFound, "agency not found")
+	case errors.Is(err, agency.ErrMemberNotFound):
+		return status.Error(codes.NotFound, "agency member not found")
+	case errors.Is(err, agency.ErrInvitationNotFound):
+		return status.Error(codes.NotFound, "invitation not found")
+	case errors.Is(err, agency.ErrAlreadyMember):
+		return status.Error(codes.AlreadyExists, "user already belongs to an agency")
+	case errors.Is(err, agency.ErrInvitationClosed):
+		return status.Error(codes.FailedPrecondition, "invitation is no longer valid")
+	case errors.Is(err, agency.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, agency.ErrInvalidRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("failed to %s: %v", action, err))
+	}
+}
@@ -0,0 +1,46 @@
+package agencygrpc
+
+import (
+	"context"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetAgency — агентство пользователя (или указанное, для админа) с участниками.
+func (s *agencyServer) GetAgency(ctx context.Context, in *pb.GetAgencyRequest) (*pb.AgencyResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	var agencyID *uuid.UUID
+	if in.AgencyId != nil {
+		id, err := uuid.Parse(*in.AgencyId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid agency_id format")
+		}
+		agencyID = &id
+	}
+
+	details, err := s.agencyService.GetAgency(ctx, actor, agencyID)
+	if err != nil {
+		return nil, agencyError(err, "get agency")
+	}
+
+	resp := &pb.AgencyResponse{
+		Agency:      agencyDomainToProto(details.Agency),
+		Invitations: invitationsDomainToProto(details.Invitations),
+	}
+	for _, m := range details.Members {
+		resp.Members = append(resp.Members, memberDomainToProto(m))
+	}
+	return resp, nil
+}
@@ -0,0 +1,117 @@
+package agencygrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// InviteMember — приглашение пользователя в агентство по email.
+func (s *agencyServer) InviteMember(ctx context.Context, in *pb.InviteMemberRequest) (*pb.AgencyInvitationResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	inv, err := s.agencyService.InviteMember(ctx, actor, in.GetEmail(), protoAgencyRoleToDomain(in.GetRole()))
+	if err != nil {
+		return nil, agencyError(err, "invite member")
+	}
+
+	return &pb.AgencyInvitationResponse{Invitation: invitationDomainToProto(inv)}, nil
+}
+
+// ListMyInvitations — действующие приглашения на email текущего пользователя.
+func (s *agencyServer) ListMyInvitations(ctx context.Context, in *pb.ListMyInvitationsRequest) (*pb.ListMyInvitationsResponse, error) {
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	invitations, err := s.agencyService.ListMyInvitations(ctx, actor)
+	if err != nil {
+		return nil, agencyError(err, "list invitations")
+	}
+
+	return &pb.ListMyInvitationsResponse{Invitations: invitationsDomainToProto(invitations)}, nil
+}
+
+// AcceptInvitation — вступление в агентство по приглашению.
+func (s *agencyServer) AcceptInvitation(ctx context.Context, in *pb.AcceptInvitationRequest) (*pb.AgencyMemberResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, id, err := invitationRequest(ctx, in.GetInvitationId())
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.agencyService.AcceptInvitation(ctx, actor, id)
+	if err != nil {
+		return nil, agencyError(err, "accept invitation")
+	}
+
+	return &pb.AgencyMemberResponse{Member: memberDomainToProto(member)}, nil
+}
+
+// DeclineInvitation — отказ от приглашения.
+func (s *agencyServer) DeclineInvitation(ctx context.Context, in *pb.DeclineInvitationRequest) (*emptypb.Empty, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, id, err := invitationRequest(ctx, in.GetInvitationId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.agencyService.DeclineInvitation(ctx, actor, id); err != nil {
+		return nil, agencyError(err, "decline invitation")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RevokeInvitation — отзыв приглашения владельцем или руководителем.
+func (s *agencyServer) RevokeInvitation(ctx context.Context, in *pb.RevokeInvitationRequest) (*emptypb.Empty, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, id, err := invitationRequest(ctx, in.GetInvitationId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.agencyService.RevokeInvitation(ctx, actor, id); err != nil {
+		return nil, agencyError(err, "revoke invitation")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// invitationRequest — пользователь запроса и ID приглашения.
+func invitationRequest(ctx context.Context, invitationID string) (domain.Actor, uuid.UUID, error) {
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return domain.Actor{}, uuid.Nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	id, err := uuid.Parse(invitationID)
+	if err != nil {
+		return domain.Actor{}, uuid.Nil, status.Error(codes.InvalidArgument, "invalid invitation_id format")
+	}
+
+	return actor, id, nil
+}
@@ -0,0 +1,139 @@
+package agencygrpc
+
+import (
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const timeLayout = "2006-01-02T15:04:05Z07:00"
+
+func agencyDomainToProto(a domain.Agency) *pb.Agency {
+	return &pb.Agency{
+		AgencyId:  a.ID.String(),
+		Name:      a.Name,
+		CreatedBy: a.CreatedBy.String(),
+		CreatedAt: a.CreatedAt.Format(timeLayout),
+	}
+}
+
+func memberDomainToProto(m domain.AgencyMember) *pb.AgencyMember {
+	return &pb.AgencyMember{
+		AgencyId: m.AgencyID.String(),
+		UserId:   m.UserID.String(),
+		Role:     agencyRoleDomainToProto(m.Role),
+		JoinedAt: m.JoinedAt.Format(timeLayout),
+	}
+}
+
+func invitationDomainToProto(inv domain.AgencyInvitation) *pb.AgencyInvitation {
+	return &pb.AgencyInvitation{
+		InvitationId: inv.ID.String(),
+		AgencyId:     inv.AgencyID.String(),
+		Email:        inv.Email,
+		Role:         agencyRoleDomainToProto(inv.Role),
+		InvitedBy:    inv.InvitedBy.String(),
+		Status:       invitationStatusDomainToProto(inv.Status),
+		ExpiresAt:    inv.ExpiresAt.Format(timeLayout),
+		CreatedAt:    inv.CreatedAt.Format(timeLayout),
+	}
+}
+
+func invitationsDomainToProto(invitations []domain.AgencyInvitation) []*pb.AgencyInvitation {
+	out := make([]*pb.AgencyInvitation, 0, len(invitations))
+	for _, inv := range invitations {
+		out = append(out, invitationDomainToProto(inv))
+	}
+	return out
+}
+
+func reassignmentDomainToProto(r domain.AgencyReassignment) *pb.AgencyReassignment {
+	return &pb.AgencyReassignment{
+		FromUserId: r.FromUserID.String(),
+		ToUserId:   r.ToUserID.String(),
+		Leads:      int32(r.Leads),
+		Properties: int32(r.Properties),
+		Deals:      int32(r.Deals),
+	}
+}
+
+func memberStatsDomainToProto(s domain.AgencyMemberStats) *pb.AgencyMemberStats {
+	proto := &pb.AgencyMemberStats{
+		Role:            agencyRoleDomainToProto(s.Role),
+		LeadsOwned:      s.LeadsOwned,
+		PropertiesOwned: s.PropertiesOwned,
+		DealsSold:       s.DealsSold,
+		SoldVolume:      s.SoldVolume,
+		DealsBought:     s.DealsBought,
+		BoughtVolume:    s.BoughtVolume,
+		OpenDeals:       s.OpenDeals,
+	}
+	if s.UserID != uuid.Nil {
+		proto.UserId = s.UserID.String()
+	}
+	return proto
+}
+
+func reportDomainToProto(r domain.AgencyDealReport) *pb.TeamDealReportResponse {
+	resp := &pb.TeamDealReportResponse{
+		AgencyId: r.AgencyID.String(),
+		From:     formatOptionalTime(r.From),
+		To:       formatOptionalTime(r.To),
+		Totals:   memberStatsDomainToProto(r.Totals),
+	}
+	for _, m := range r.Members {
+		resp.Members = append(resp.Members, memberStatsDomainToProto(m))
+	}
+	return resp
+}
+
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(timeLayout)
+	return &s
+}
+
+func agencyRoleDomainToProto(r domain.AgencyRole) pb.AgencyRole {
+	switch r {
+	case domain.AgencyRoleOwner:
+		return pb.AgencyRole_AGENCY_ROLE_OWNER
+	case domain.AgencyRoleManager:
+		return pb.AgencyRole_AGENCY_ROLE_MANAGER
+	case domain.AgencyRoleAgent:
+		return pb.AgencyRole_AGENCY_ROLE_AGENT
+	default:
+		return pb.AgencyRole_AGENCY_ROLE_UNSPECIFIED
+	}
+}
+
+func protoAgencyRoleToDomain(r pb.AgencyRole) domain.AgencyRole {
+	switch r {
+	case pb.AgencyRole_AGENCY_ROLE_OWNER:
+		return domain.AgencyRoleOwner
+	case pb.AgencyRole_AGENCY_ROLE_MANAGER:
+		return domain.AgencyRoleManager
+	case pb.AgencyRole_AGENCY_ROLE_AGENT:
+		return domain.AgencyRoleAgent
+	default:
+		return domain.AgencyRoleUnspecified
+	}
+}
+
+func invitationStatusDomainToProto(s domain.AgencyInvitationStatus) pb.AgencyInvitationStatus {
+	switch s {
+	case domain.AgencyInvitationPending:
+		return pb.AgencyInvitationStatus_AGENCY_INVITATION_STATUS_PENDING
+	case domain.AgencyInvitationAccepted:
+		return pb.AgencyInvitationStatus_AGENCY_INVITATION_STATUS_ACCEPTED
+	case domain.AgencyInvitationDeclined:
+		return pb.AgencyInvitationStatus_AGENCY_INVITATION_STATUS_DECLINED
+	case domain.AgencyInvitationRevoked:
+		return pb.AgencyInvitationStatus_AGENCY_INVITATION_STATUS_REVOKED
+	default:
+		return pb.AgencyInvitationStatus_AGENCY_INVITATION_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,100 @@
+package agencygrpc
+
+import (
+	"context"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateMemberRole — смена роли участника владельцем агентства.
+func (s *agencyServer) UpdateMemberRole(ctx context.Context, in *pb.UpdateMemberRoleRequest) (*pb.AgencyMemberResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id format")
+	}
+
+	member, err := s.agencyService.UpdateMemberRole(ctx, actor, userID, protoAgencyRoleToDomain(in.GetRole()))
+	if err != nil {
+		return nil, agencyError(err, "update member role")
+	}
+
+	return &pb.AgencyMemberResponse{Member: memberDomainToProto(member)}, nil
+}
+
+// RemoveMember — исключение участника или выход из агентства, при необходимости с передачей его лидов.
+func (s *agencyServer) RemoveMember(ctx context.Context, in *pb.RemoveMemberRequest) (*pb.RemoveMemberResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id format")
+	}
+
+	var reassignTo *uuid.UUID
+	if in.ReassignToUserId != nil {
+		id, err := uuid.Parse(*in.ReassignToUserId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid reassign_to_user_id format")
+		}
+		reassignTo = &id
+	}
+
+	reassignment, err := s.agencyService.RemoveMember(ctx, actor, userID, reassignTo)
+	if err != nil {
+		return nil, agencyError(err, "remove member")
+	}
+
+	resp := &pb.RemoveMemberResponse{Success: true}
+	if reassignment != nil {
+		resp.Reassignment = reassignmentDomainToProto(*reassignment)
+	}
+	return resp, nil
+}
+
+// ReassignMemberAssets — передача лидов, объектов и открытых сделок участника коллеге.
+func (s *agencyServer) ReassignMemberAssets(ctx context.Context, in *pb.ReassignMemberAssetsRequest) (*pb.ReassignMemberAssetsResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	fromID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id format")
+	}
+	toID, err := uuid.Parse(in.GetToUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid to_user_id format")
+	}
+
+	reassignment, err := s.agencyService.ReassignMemberAssets(ctx, actor, fromID, toID)
+	if err != nil {
+		return nil, agencyError(err, "reassign member assets")
+	}
+
+	return &pb.ReassignMemberAssetsResponse{Reassignment: reassignmentDomainToProto(reassignment)}, nil
+}
@@ -0,0 +1,39 @@
+package agencygrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// AgencyService описывает бизнес-логику агентств.
+type AgencyService interface {
+	CreateAgency(ctx context.Context, actor domain.Actor, name string) (domain.Agency, error)
+	GetAgency(ctx context.Context, actor domain.Actor, agencyID *uuid.UUID) (domain.AgencyDetails, error)
+	InviteMember(ctx context.Context, actor domain.Actor, email string, role domain.AgencyRole) (domain.AgencyInvitation, error)
+	ListMyInvitations(ctx context.Context, actor domain.Actor) ([]domain.AgencyInvitation, error)
+	AcceptInvitation(ctx context.Context, actor domain.Actor, invitationID uuid.UUID) (domain.AgencyMember, error)
+	DeclineInvitation(ctx context.Context, actor domain.Actor, invitationID uuid.UUID) error
+	RevokeInvitation(ctx context.Context, actor domain.Actor, invitationID uuid.UUID) error
+	UpdateMemberRole(ctx context.Context, actor domain.Actor, userID uuid.UUID, role domain.AgencyRole) (domain.AgencyMember, error)
+	RemoveMember(ctx context.Context, actor domain.Actor, userID uuid.UUID, reassignTo *uuid.UUID) (*domain.AgencyReassignment, error)
+	ReassignMemberAssets(ctx context.Context, actor domain.Actor, fromUserID, toUserID uuid.UUID) (domain.AgencyReassignment, error)
+	GetTeamDealReport(ctx context.Context, actor domain.Actor, agencyID *uuid.UUID, from, to *time.Time) (domain.AgencyDealReport, error)
+}
+
+// agencyServer реализует gRPC AgencyServiceServer.
+type agencyServer struct {
+	pb.UnimplementedAgencyServiceServer
+	agencyService AgencyService
+}
+
+// RegisterAgencyServerGRPC регистрирует AgencyServiceServer в gRPC сервере.
+func RegisterAgencyServerGRPC(server *grpc.Server, svc AgencyService) {
+	pb.RegisterAgencyServiceServer(server, &agencyServer{
+		agencyService: svc,
+	})
+}
@@ -0,0 +1,60 @@
+package agencygrpc
+
+import (
+	"context"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetTeamDealReport — отчёт по сделкам агентства за период.
+func (s *agencyServer) GetTeamDealReport(ctx context.Context, in *pb.GetTeamDealReportRequest) (*pb.TeamDealReportResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	var agencyID *uuid.UUID
+	if in.AgencyId != nil {
+		id, err := uuid.Parse(*in.AgencyId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid agency_id format")
+		}
+		agencyID = &id
+	}
+
+	from, err := parseOptionalTime(in.From)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid from: expected RFC 3339")
+	}
+	to, err := parseOptionalTime(in.To)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid to: expected RFC 3339")
+	}
+
+	report, err := s.agencyService.GetTeamDealReport(ctx, actor, agencyID, from, to)
+	if err != nil {
+		return nil, agencyError(err, "build team deal report")
+	}
+
+	return reportDomainToProto(report), nil
+}
+
+func parseOptionalTime(s *string) (*time.Time, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, *s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
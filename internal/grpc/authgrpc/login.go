@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"lead_exchange/internal/domain"
 	"lead_exchange/internal/services/user"
 	pb "lead_exchange/pkg"
 
@@ -19,11 +20,15 @@ func (s *authServer) Login(ctx context.Context, in *pb.LoginRequest) (*pb.AuthRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	_, token, err := s.authService.Login(ctx, in.GetEmail(), in.GetPassword())
+	_, tokens, err := s.authService.Login(ctx, in.GetEmail(), in.GetPassword())
 	if err != nil {
 		switch {
 		case errors.Is(err, user.ErrInvalidCredentials):
 			return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+		case errors.Is(err, user.ErrUserBanned):
+			return nil, status.Error(codes.PermissionDenied, "user is banned")
+		case errors.Is(err, user.ErrEmailNotVerified):
+			return nil, status.Error(codes.FailedPrecondition, "email is not verified")
 		case errors.Is(err, repository.ErrUserNotFound):
 			return nil, status.Error(codes.NotFound, "user not found")
 		default:
@@ -31,5 +36,14 @@ func (s *authServer) Login(ctx context.Context, in *pb.LoginRequest) (*pb.AuthRe
 		}
 	}
 
-	return &pb.AuthResponse{Token: token}, nil
+	return tokenPairToProto(tokens), nil
+}
+
+// tokenPairToProto — пара токенов в ответ авторизации.
+func tokenPairToProto(tokens domain.TokenPair) *pb.AuthResponse {
+	return &pb.AuthResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    tokens.ExpiresAt.Unix(),
+	}
 }
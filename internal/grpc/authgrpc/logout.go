@@ -0,0 +1,29 @@
+package authgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/services/user"
+	pb "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Logout — выход пользователя, отзыв refresh-токена.
+func (s *authServer) Logout(ctx context.Context, in *pb.LogoutRequest) (*emptypb.Empty, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.authService.Logout(ctx, in.GetRefreshToken()); err != nil {
+		if errors.Is(err, user.ErrInvalidRefreshToken) {
+			return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to logout: %v", err))
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,49 @@
+package authgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/services/user"
+	pb "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RequestPasswordReset — запрос письма для сброса пароля.
+func (s *authServer) RequestPasswordReset(ctx context.Context, in *pb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.authService.RequestPasswordReset(ctx, in.GetEmail()); err != nil {
+		return nil, accountMailError(err, "failed to request password reset")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ConfirmPasswordReset — установка нового пароля по токену из письма.
+func (s *authServer) ConfirmPasswordReset(ctx context.Context, in *pb.ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.authService.ConfirmPasswordReset(ctx, in.GetToken(), in.GetNewPassword()); err != nil {
+		return nil, accountMailError(err, "failed to reset password")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// accountMailError — ошибки сброса пароля и подтверждения email в gRPC-статусы.
+func accountMailError(err error, msg string) error {
+	switch {
+	case errors.Is(err, user.ErrInvalidUserToken):
+		return status.Error(codes.InvalidArgument, "invalid or expired token")
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+	}
+}
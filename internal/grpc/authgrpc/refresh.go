@@ -0,0 +1,33 @@
+package authgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/services/user"
+	pb "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Refresh — обмен refresh-токена на новую пару токенов.
+func (s *authServer) Refresh(ctx context.Context, in *pb.RefreshRequest) (*pb.AuthResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tokens, err := s.authService.Refresh(ctx, in.GetRefreshToken())
+	if err != nil {
+		switch {
+		case errors.Is(err, user.ErrInvalidRefreshToken), errors.Is(err, user.ErrRefreshTokenReused):
+			return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+		case errors.Is(err, user.ErrUserBanned):
+			return nil, status.Error(codes.PermissionDenied, "user is banned")
+		default:
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to refresh token: %v", err))
+		}
+	}
+
+	return tokenPairToProto(tokens), nil
+}
@@ -2,6 +2,7 @@ package authgrpc
 
 import (
 	"context"
+	"lead_exchange/internal/domain"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
@@ -11,7 +12,12 @@ import (
 // AuthService описывает бизнес-логику авторизации и регистрации.
 type AuthService interface {
 	Register(ctx context.Context, email, password, firstName, lastName string) (uuid.UUID, error)
-	Login(ctx context.Context, email, password string) (uuid.UUID, string, error)
+	Login(ctx context.Context, email, password string) (uuid.UUID, domain.TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (domain.TokenPair, error)
+	Logout(ctx context.Context, refreshToken string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ConfirmPasswordReset(ctx context.Context, token, newPassword string) error
+	VerifyEmail(ctx context.Context, token string) error
 }
 
 // authServer реализует gRPC AuthServiceServer.
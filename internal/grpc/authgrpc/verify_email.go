@@ -0,0 +1,23 @@
+package authgrpc
+
+import (
+	"context"
+	pb "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// VerifyEmail — подтверждение email по токену из письма.
+func (s *authServer) VerifyEmail(ctx context.Context, in *pb.VerifyEmailRequest) (*emptypb.Empty, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.authService.VerifyEmail(ctx, in.GetToken()); err != nil {
+		return nil, accountMailError(err, "failed to verify email")
+	}
+
+	return &emptypb.Empty{}, nil
+}
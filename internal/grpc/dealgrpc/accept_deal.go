@@ -33,7 +33,7 @@ func (s *dealServer) AcceptDeal(ctx context.Context, in *pb.AcceptDealRequest) (
 
 	deal, err := s.dealService.AcceptDeal(ctx, dealID, userID)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to accept deal: %v", err))
+		return nil, dealStatusError(err, "accept")
 	}
 
 	return &pb.DealResponse{Deal: dealDomainToProto(deal)}, nil
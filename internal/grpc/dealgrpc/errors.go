@@ -0,0 +1,27 @@
+package dealgrpc
+
+import (
+	"errors"
+	"fmt"
+	"lead_exchange/internal/services/deal"
+	"lead_exchange/internal/services/wallet"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dealStatusError преобразует ошибку операции над сделкой в gRPC статус.
+func dealStatusError(err error, action string) error {
+	switch {
+	case errors.Is(err, deal.ErrDealNotFound):
+		return status.Error(codes.NotFound, fmt.Sprintf("deal not found: %v", err))
+	case errors.Is(err, deal.ErrInvalidTransition), errors.Is(err, deal.ErrDealTermsLocked):
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("failed to %s deal: %v", action, err))
+	case errors.Is(err, wallet.ErrInsufficientFunds):
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("failed to %s deal: not enough credits", action))
+	case errors.Is(err, deal.ErrNotDealParticipant), errors.Is(err, deal.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("failed to %s deal: %v", action, err))
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("failed to %s deal: %v", action, err))
+	}
+}
@@ -11,7 +11,7 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// ListDeals — получение списка сделок по фильтру.
+// ListDeals — получение списка сделок по фильтру с пагинацией.
 func (s *dealServer) ListDeals(ctx context.Context, in *pb.ListDealsRequest) (*pb.ListDealsResponse, error) {
 	if err := s.checkUserStatus(ctx); err != nil {
 		return nil, err
@@ -58,15 +58,36 @@ func (s *dealServer) ListDeals(ctx context.Context, in *pb.ListDealsRequest) (*p
 		}
 	}
 
-	deals, err := s.dealService.ListDeals(ctx, filter)
+	// Параметры пагинации
+	pagination := &domain.PaginationParams{}
+	if in.PageSize != nil {
+		pagination.PageSize = *in.PageSize
+	}
+	if in.PageToken != nil {
+		pagination.PageToken = *in.PageToken
+	}
+	if in.OrderBy != nil {
+		pagination.OrderBy = *in.OrderBy
+	}
+	if in.OrderDirection != nil {
+		pagination.OrderDirection = domain.OrderDirection(*in.OrderDirection)
+	}
+	filter.Pagination = pagination
+
+	result, err := s.dealService.ListDeals(ctx, filter)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list deals: %v", err))
 	}
 
-	protoDeals := make([]*pb.Deal, len(deals))
-	for i, deal := range deals {
+	protoDeals := make([]*pb.Deal, len(result.Items))
+	for i, deal := range result.Items {
 		protoDeals[i] = dealDomainToProto(deal)
 	}
 
-	return &pb.ListDealsResponse{Deals: protoDeals}, nil
+	return &pb.ListDealsResponse{
+		Deals:         protoDeals,
+		NextPageToken: result.NextPageToken,
+		HasMore:       result.HasMore,
+		TotalCount:    result.TotalCount,
+	}, nil
 }
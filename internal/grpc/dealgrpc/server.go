@@ -13,9 +13,12 @@ import (
 type DealService interface {
 	CreateDeal(ctx context.Context, deal domain.Deal) (uuid.UUID, error)
 	GetDeal(ctx context.Context, id uuid.UUID) (domain.Deal, error)
-	UpdateDeal(ctx context.Context, id uuid.UUID, update domain.DealFilter) (domain.Deal, error)
-	ListDeals(ctx context.Context, filter domain.DealFilter) ([]domain.Deal, error)
+	UpdateDeal(ctx context.Context, actor domain.Actor, id uuid.UUID, update domain.DealFilter) (domain.Deal, error)
+	ListDeals(ctx context.Context, filter domain.DealFilter) (*domain.PaginatedResult[domain.Deal], error)
 	AcceptDeal(ctx context.Context, dealID uuid.UUID, buyerUserID uuid.UUID) (domain.Deal, error)
+	CompleteDeal(ctx context.Context, dealID uuid.UUID, userID uuid.UUID) (domain.Deal, error)
+	CancelDeal(ctx context.Context, dealID uuid.UUID, userID uuid.UUID) (domain.Deal, error)
+	RejectDeal(ctx context.Context, dealID uuid.UUID, userID uuid.UUID) (domain.Deal, error)
 }
 
 // UserService описывает бизнес-логику работы с пользователями (для проверки статуса).
@@ -18,6 +18,12 @@ func (s *dealServer) UpdateDeal(ctx context.Context, in *pb.UpdateDealRequest) (
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// Смена статуса двигает кредиты и коммитится отдельно от цены; вместе их не принимаем,
+	// чтобы ошибка второго шага не оставила наполовину применённый запрос
+	if in.Status != nil && in.Price != nil {
+		return nil, status.Error(codes.InvalidArgument, "status and price cannot be changed in one request")
+	}
+
 	if err := s.checkUserStatus(ctx); err != nil {
 		return nil, err
 	}
@@ -27,38 +33,62 @@ func (s *dealServer) UpdateDeal(ctx context.Context, in *pb.UpdateDealRequest) (
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid deal_id: %v", err))
 	}
 
-	update := domain.DealFilter{}
-
-	if in.Status != nil {
-		status := protoDealStatusToDomain(*in.Status)
-		update.Status = &status
-	}
-
-	if in.Price != nil {
-		update.Price = in.Price
-	}
-
-	// Проверяем права доступа: только продавец или покупатель могут обновлять сделку
-	userID, ok := middleware.FromContext(ctx)
+	// Права проверяет сервис: переходы статуса — по таблице переходов, цена — продавец или админ
+	actor, ok := middleware.ActorFromContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "user not found in context")
 	}
 
-	// Получаем текущую сделку для проверки прав
-	currentDeal, err := s.dealService.GetDeal(ctx, dealID)
-	if err != nil {
-		return nil, status.Error(codes.NotFound, fmt.Sprintf("deal not found: %v", err))
+	var deal domain.Deal
+	switch {
+	case in.Status != nil:
+		deal, err = s.changeDealStatus(ctx, dealID, actor.UserID, protoDealStatusToDomain(*in.Status))
+		if err != nil {
+			return nil, err
+		}
+	case in.Price != nil:
+		deal, err = s.dealService.UpdateDeal(ctx, actor, dealID, domain.DealFilter{Price: in.Price})
+		if err != nil {
+			return nil, dealStatusError(err, "update")
+		}
+	default:
+		deal, err = s.dealService.GetDeal(ctx, dealID)
+		if err != nil {
+			return nil, dealStatusError(err, "get")
+		}
 	}
 
-	// Проверяем, что пользователь является продавцом или покупателем
-	if currentDeal.SellerUserID != userID && (currentDeal.BuyerUserID == nil || *currentDeal.BuyerUserID != userID) {
-		return nil, status.Error(codes.PermissionDenied, "only seller or buyer can update deal")
+	return &pb.DealResponse{Deal: dealDomainToProto(deal)}, nil
+}
+
+// changeDealStatus вызывает метод сервиса, соответствующий целевому статусу.
+func (s *dealServer) changeDealStatus(ctx context.Context, dealID, userID uuid.UUID, to domain.DealStatus) (domain.Deal, error) {
+	var (
+		deal   domain.Deal
+		err    error
+		action string
+	)
+
+	switch to {
+	case domain.DealStatusAccepted:
+		action = "accept"
+		deal, err = s.dealService.AcceptDeal(ctx, dealID, userID)
+	case domain.DealStatusCompleted:
+		action = "complete"
+		deal, err = s.dealService.CompleteDeal(ctx, dealID, userID)
+	case domain.DealStatusCancelled:
+		action = "cancel"
+		deal, err = s.dealService.CancelDeal(ctx, dealID, userID)
+	case domain.DealStatusRejected:
+		action = "reject"
+		deal, err = s.dealService.RejectDeal(ctx, dealID, userID)
+	default:
+		return domain.Deal{}, status.Error(codes.FailedPrecondition, fmt.Sprintf("cannot move deal to status %q", to))
 	}
 
-	deal, err := s.dealService.UpdateDeal(ctx, dealID, update)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update deal: %v", err))
+		return domain.Deal{}, dealStatusError(err, action)
 	}
 
-	return &pb.DealResponse{Deal: dealDomainToProto(deal)}, nil
+	return deal, nil
 }
@@ -2,9 +2,12 @@ package leadgrpc
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	"lead_exchange/internal/services/clarification"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
@@ -13,13 +16,14 @@ import (
 )
 
 // GetClarificationQuestions — получить уточняющие вопросы для "короткого" лида.
+// Каждый вызов открывает новую сессию уточнения.
 func (s *serverAPI) GetClarificationQuestions(ctx context.Context, in *pb.GetClarificationQuestionsRequest) (*pb.GetClarificationQuestionsResponse, error) {
 	if err := in.ValidateAll(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Проверяем, доступен ли агент уточнения
-	if s.clarificationAgent == nil {
+	// Проверяем, доступен ли сервис уточнения
+	if s.clarificationService == nil {
 		return nil, status.Error(codes.Unavailable, "clarification service is not available")
 	}
 
@@ -28,117 +32,112 @@ func (s *serverAPI) GetClarificationQuestions(ctx context.Context, in *pb.GetCla
 		return nil, status.Error(codes.InvalidArgument, "invalid lead_id format")
 	}
 
-	// Получаем лид
-	lead, err := s.leadService.GetLead(ctx, leadID)
-	if err != nil {
-		return nil, status.Error(codes.NotFound, fmt.Sprintf("lead not found: %v", err))
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	// Анализируем и генерируем вопросы
-	result, err := s.clarificationAgent.AnalyzeAndGenerateQuestions(ctx, lead)
+	// Анализируем лид, генерируем вопросы и сохраняем сессию
+	session, result, err := s.clarificationService.StartClarification(ctx, actor, leadID)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to analyze lead: %v", err))
+		return nil, clarificationError(err, "failed to analyze lead")
 	}
 
 	// Конвертируем в protobuf ответ
-	resp := &pb.GetClarificationQuestionsResponse{
+	return &pb.GetClarificationQuestionsResponse{
 		NeedsClarification: result.NeedsClarification,
+		Questions:          clarificationQuestionsToProto(result.Questions),
 		Priority:           result.Priority,
 		MissingFields:      result.MissingFields,
 		LeadQualityScore:   result.LeadQualityScore,
-	}
-
-	for _, q := range result.Questions {
-		resp.Questions = append(resp.Questions, &pb.ClarificationQuestion{
-			Field:            q.Field,
-			Question:         q.Question,
-			QuestionType:     q.QuestionType,
-			SuggestedOptions: q.SuggestedOptions,
-			Importance:       q.Importance,
-		})
-	}
-
-	return resp, nil
+		ClarificationId:    session.ID.String(),
+	}, nil
 }
 
-// ApplyClarificationAnswers — применить ответы на уточняющие вопросы.
+// ApplyClarificationAnswers — применить ответы на уточняющие вопросы и закрыть сессию уточнения.
 func (s *serverAPI) ApplyClarificationAnswers(ctx context.Context, in *pb.ApplyClarificationAnswersRequest) (*pb.ApplyClarificationAnswersResponse, error) {
 	if err := in.ValidateAll(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	leadID, err := uuid.Parse(in.GetLeadId())
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid lead_id format")
+	if s.clarificationService == nil {
+		return nil, status.Error(codes.Unavailable, "clarification service is not available")
 	}
 
-	// Получаем текущий лид
-	lead, err := s.leadService.GetLead(ctx, leadID)
+	leadID, err := uuid.Parse(in.GetLeadId())
 	if err != nil {
-		return nil, status.Error(codes.NotFound, fmt.Sprintf("lead not found: %v", err))
+		return nil, status.Error(codes.InvalidArgument, "invalid lead_id format")
 	}
 
-	// Парсим текущий requirement
-	var requirement map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		if err := json.Unmarshal(lead.Requirement, &requirement); err != nil {
-			requirement = make(map[string]interface{})
-		}
-	} else {
-		requirement = make(map[string]interface{})
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	// Применяем ответы
+	answers := make([]domain.ClarificationAnswer, 0, len(in.Answers))
 	for _, answer := range in.Answers {
-		field := answer.GetField()
-		value := answer.GetValue()
-
-		// Обрабатываем специальные поля
-		switch field {
-		case "price", "min_price", "max_price":
-			// Пытаемся парсить как число
-			var numValue int64
-			if _, err := fmt.Sscanf(value, "%d", &numValue); err == nil {
-				requirement[field] = numValue
-			} else {
-				requirement[field] = value
-			}
-		case "rooms", "min_rooms", "max_rooms":
-			var numValue int32
-			if _, err := fmt.Sscanf(value, "%d", &numValue); err == nil {
-				requirement[field] = numValue
-			} else {
-				requirement[field] = value
-			}
-		case "area", "min_area", "max_area":
-			var numValue float64
-			if _, err := fmt.Sscanf(value, "%f", &numValue); err == nil {
-				requirement[field] = numValue
-			} else {
-				requirement[field] = value
-			}
-		default:
-			requirement[field] = value
-		}
+		answers = append(answers, domain.ClarificationAnswer{Field: answer.GetField(), Value: answer.GetValue()})
 	}
 
-	// Сериализуем обновлённый requirement
-	newRequirement, err := json.Marshal(requirement)
+	session, updated, err := s.clarificationService.ApplyAnswers(ctx, actor, leadID, answers)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to serialize requirement: %v", err))
+		return nil, clarificationError(err, "failed to apply clarification answers")
 	}
 
-	// TODO: Обновить лид в базе данных
-	// Пока возвращаем успешный результат с новым requirement
-	// В будущем нужно добавить leadService.UpdateLeadRequirement
-
 	return &pb.ApplyClarificationAnswersResponse{
 		Success:        true,
-		NewRequirement: newRequirement,
+		NewRequirement: requirementDomainToProto(updated.Requirement),
 		Message:        fmt.Sprintf("Applied %d clarification answers", len(in.Answers)),
+		Clarification:  clarificationDomainToProto(session),
 	}, nil
 }
 
+// ListClarifications — история уточнений лида.
+func (s *serverAPI) ListClarifications(ctx context.Context, in *pb.ListClarificationsRequest) (*pb.ListClarificationsResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if s.clarificationService == nil {
+		return nil, status.Error(codes.Unavailable, "clarification service is not available")
+	}
+
+	leadID, err := uuid.Parse(in.GetLeadId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid lead_id format")
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	clarifications, err := s.clarificationService.ListClarifications(ctx, actor, leadID)
+	if err != nil {
+		return nil, clarificationError(err, "failed to list clarifications")
+	}
+
+	resp := &pb.ListClarificationsResponse{}
+	for _, c := range clarifications {
+		resp.Clarifications = append(resp.Clarifications, clarificationDomainToProto(c))
+	}
+
+	return resp, nil
+}
+
+// clarificationError — ошибки сервиса уточнения в gRPC-статусы.
+func clarificationError(err error, msg string) error {
+	switch {
+	case errors.Is(err, clarification.ErrLeadNotFound):
+		return status.Error(codes.NotFound, "lead not found")
+	case errors.Is(err, clarification.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "only owner or admin can access lead clarifications")
+	case errors.Is(err, clarification.ErrInvalidAnswer):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+}
+
 // AnalyzeLeadIntent — анализ намерений лида для определения оптимальных весов матчинга.
 func (s *serverAPI) AnalyzeLeadIntent(ctx context.Context, in *pb.AnalyzeLeadIntentRequest) (*pb.AnalyzeLeadIntentResponse, error) {
 	if err := in.ValidateAll(); err != nil {
@@ -175,6 +174,9 @@ func (s *serverAPI) AnalyzeLeadIntent(ctx context.Context, in *pb.AnalyzeLeadInt
 			Rooms:    result.Weights.Rooms,
 			Area:     result.Weights.Area,
 			Semantic: result.Weights.Semantic,
+			Distance: result.Weights.Distance,
+			Visual:   result.Weights.Visual,
+			Features: result.Weights.Features,
 		},
 		LeadType:    result.LeadType,
 		Confidence:  result.Confidence,
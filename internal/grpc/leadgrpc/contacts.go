@@ -0,0 +1,27 @@
+package leadgrpc
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// visibleLeads применяет политику видимости контактов для текущего пользователя.
+func (s *leadServer) visibleLeads(ctx context.Context, leads ...domain.Lead) ([]domain.Lead, error) {
+	viewerID, ok := middleware.FromContext(ctx)
+	if !ok {
+		viewerID = uuid.Nil
+	}
+
+	visible, err := s.leadService.ApplyContactPolicy(ctx, viewerID, leads...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to apply contact policy: %v", err))
+	}
+
+	return visible, nil
+}
@@ -2,10 +2,12 @@ package leadgrpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/samber/lo"
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/middleware"
+	"lead_exchange/internal/services/lead"
 	pb "lead_exchange/pkg"
 
 	"google.golang.org/grpc/codes"
@@ -23,10 +25,10 @@ func (s *leadServer) CreateLead(ctx context.Context, in *pb.CreateLeadRequest) (
 		return nil, status.Error(codes.Unauthenticated, "user not found in context")
 	}
 
-	lead := domain.Lead{
+	newLead := domain.Lead{
 		Title:         in.Title,
 		Description:   in.Description,
-		Requirement:   in.Requirement,
+		Requirement:   requirementProtoToDomain(in.Requirement),
 		ContactName:   in.ContactName,
 		ContactPhone:  in.ContactPhone,
 		ContactEmail:  lo.EmptyableToPtr(in.ContactEmail),
@@ -37,11 +39,15 @@ func (s *leadServer) CreateLead(ctx context.Context, in *pb.CreateLeadRequest) (
 		CreatedUserID: userID,
 	}
 
-	id, err := s.leadService.CreateLead(ctx, lead)
+	id, err := s.leadService.CreateLead(ctx, newLead)
 	if err != nil {
+		if errors.Is(err, lead.ErrInvalidRequirement) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create lead: %v", err))
 	}
 
-	lead.ID = id
-	return &pb.LeadResponse{Lead: leadDomainToProto(lead)}, nil
+	newLead.ID = id
+	newLead.Requirement = newLead.Requirement.Normalize()
+	return &pb.LeadResponse{Lead: leadDomainToProto(newLead)}, nil
 }
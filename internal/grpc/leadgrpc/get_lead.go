@@ -26,5 +26,10 @@ func (s *leadServer) GetLead(ctx context.Context, in *pb.GetLeadRequest) (*pb.Le
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get lead: %v", err))
 	}
 
-	return &pb.LeadResponse{Lead: leadDomainToProto(lead)}, nil
+	visible, err := s.visibleLeads(ctx, lead)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LeadResponse{Lead: leadDomainToProto(visible[0])}, nil
 }
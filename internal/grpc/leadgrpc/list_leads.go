@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
@@ -41,6 +42,13 @@ func (s *leadServer) ListLeads(ctx context.Context, in *pb.ListLeadsRequest) (*p
 			pt := protoPropertyTypeToDomain(*in.Filter.PropertyType)
 			filter.PropertyType = &pt
 		}
+		if in.Filter.GetTeam() {
+			userID, ok := middleware.FromContext(ctx)
+			if !ok {
+				return nil, status.Error(codes.Unauthenticated, "unauthorized")
+			}
+			filter.TeamOf = &userID
+		}
 	}
 
 	// Параметры пагинации
@@ -64,8 +72,17 @@ func (s *leadServer) ListLeads(ctx context.Context, in *pb.ListLeadsRequest) (*p
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list leads: %v", err))
 	}
 
-	resp := &pb.ListLeadsResponse{}
-	for _, l := range result.Items {
+	visible, err := s.visibleLeads(ctx, result.Items...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListLeadsResponse{
+		NextPageToken: result.NextPageToken,
+		HasMore:       result.HasMore,
+		TotalCount:    result.TotalCount,
+	}
+	for _, l := range visible {
 		resp.Leads = append(resp.Leads, leadDomainToProto(l))
 	}
 	return resp, nil
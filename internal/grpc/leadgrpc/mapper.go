@@ -10,20 +10,78 @@ import (
 
 func leadDomainToProto(l domain.Lead) *pb.Lead {
 	return &pb.Lead{
-		LeadId:        l.ID.String(),
-		Title:         l.Title,
-		Description:   l.Description,
-		Requirement:   l.Requirement,
-		ContactName:   l.ContactName,
-		ContactPhone:  l.ContactPhone,
-		ContactEmail:  lo.FromPtr(l.ContactEmail),
-		City:          l.City,
-		PropertyType:  propertyTypeDomainToProto(l.PropertyType),
-		Status:        leadStatusDomainToProto(l.Status),
-		OwnerUserId:   l.OwnerUserID.String(),
-		CreatedUserId: l.CreatedUserID.String(),
-		CreatedAt:     l.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:     l.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		LeadId:         l.ID.String(),
+		Title:          l.Title,
+		Description:    l.Description,
+		Requirement:    requirementDomainToProto(l.Requirement),
+		ContactName:    l.ContactName,
+		ContactPhone:   l.ContactPhone,
+		ContactEmail:   lo.FromPtr(l.ContactEmail),
+		City:           l.City,
+		PropertyType:   propertyTypeDomainToProto(l.PropertyType),
+		Status:         leadStatusDomainToProto(l.Status),
+		ContactsMasked: l.ContactsMasked,
+		OwnerUserId:    l.OwnerUserID.String(),
+		CreatedUserId:  l.CreatedUserID.String(),
+		CreatedAt:      l.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      l.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func requirementDomainToProto(r domain.LeadRequirement) *pb.LeadRequirement {
+	out := &pb.LeadRequirement{
+		Version:           int32(r.Version),
+		Districts:         r.Districts,
+		MustHave:          r.MustHave,
+		NiceToHave:        r.NiceToHave,
+		VisualPreferences: r.VisualPreferences,
+	}
+	if r.Price != nil {
+		out.Price = &pb.Int64Range{Min: r.Price.Min, Max: r.Price.Max}
+	}
+	if r.Rooms != nil {
+		out.Rooms = &pb.Int32Range{Min: r.Rooms.Min, Max: r.Rooms.Max}
+	}
+	if r.Area != nil {
+		out.Area = &pb.DoubleRange{Min: r.Area.Min, Max: r.Area.Max}
+	}
+	return out
+}
+
+func requirementProtoToDomain(r *pb.LeadRequirement) domain.LeadRequirement {
+	if r == nil {
+		return domain.LeadRequirement{}
+	}
+	out := domain.LeadRequirement{
+		Version:           int(r.Version),
+		Districts:         r.Districts,
+		MustHave:          r.MustHave,
+		NiceToHave:        r.NiceToHave,
+		VisualPreferences: r.VisualPreferences,
+	}
+	if r.Price != nil {
+		out.Price = &domain.Range[int64]{Min: r.Price.Min, Max: r.Price.Max}
+	}
+	if r.Rooms != nil {
+		out.Rooms = &domain.Range[int32]{Min: r.Rooms.Min, Max: r.Rooms.Max}
+	}
+	if r.Area != nil {
+		out.Area = &domain.Range[float64]{Min: r.Area.Min, Max: r.Area.Max}
+	}
+	return out
+}
+
+func matchedLeadToProto(m domain.MatchedLead) *pb.MatchedLead {
+	return &pb.MatchedLead{
+		Lead:             leadDomainToProto(m.Lead),
+		Similarity:       m.Similarity,
+		TotalScore:       m.TotalScore,
+		PriceScore:       m.PriceScore,
+		DistrictScore:    m.DistrictScore,
+		RoomsScore:       m.RoomsScore,
+		AreaScore:        m.AreaScore,
+		SemanticScore:    m.SemanticScore,
+		MatchExplanation: m.MatchExplanation,
 	}
 }
 
@@ -91,3 +149,73 @@ func protoPropertyTypeToDomain(t pb.PropertyType) domain.PropertyType {
 func parseUUID(s string) (uuid.UUID, error) {
 	return uuid.Parse(s)
 }
+
+func embeddingStateToProto(state domain.EmbeddingState) *pb.EmbeddingStatusResponse {
+	resp := &pb.EmbeddingStatusResponse{HasEmbedding: state.HasEmbedding}
+	if state.Job == nil {
+		return resp
+	}
+
+	job := state.Job
+	resp.Job = &pb.EmbeddingJob{
+		JobId:       job.ID.String(),
+		Operation:   job.Operation.String(),
+		Status:      embeddingJobStatusDomainToProto(job.Status),
+		Attempts:    int32(job.Attempts),
+		MaxAttempts: int32(job.MaxAttempts),
+		LastError:   job.LastError,
+		RunAt:       job.RunAt.Format("2006-01-02T15:04:05Z07:00"),
+		CreatedAt:   job.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   job.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	return resp
+}
+
+func embeddingJobStatusDomainToProto(s domain.EmbeddingJobStatus) pb.EmbeddingJobStatus {
+	switch s {
+	case domain.EmbeddingJobStatusPending:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_PENDING
+	case domain.EmbeddingJobStatusRunning:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_RUNNING
+	case domain.EmbeddingJobStatusDone:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_DONE
+	case domain.EmbeddingJobStatusDead:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_DEAD
+	default:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_UNSPECIFIED
+	}
+}
+
+func clarificationDomainToProto(c domain.LeadClarification) *pb.LeadClarification {
+	out := &pb.LeadClarification{
+		ClarificationId:    c.ID.String(),
+		LeadId:             c.LeadID.String(),
+		Questions:          clarificationQuestionsToProto(c.Questions),
+		Priority:           c.Priority,
+		Status:             string(c.Status),
+		QualityScoreBefore: c.QualityScoreBefore,
+		QualityScoreAfter:  c.QualityScoreAfter,
+		CreatedAt:          c.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	for _, a := range c.Answers {
+		out.Answers = append(out.Answers, &pb.ClarificationAnswer{Field: a.Field, Value: a.Value})
+	}
+	if c.AnsweredAt != nil {
+		out.AnsweredAt = lo.ToPtr(c.AnsweredAt.Format("2006-01-02T15:04:05Z07:00"))
+	}
+	return out
+}
+
+func clarificationQuestionsToProto(questions []domain.ClarificationQuestion) []*pb.ClarificationQuestion {
+	out := make([]*pb.ClarificationQuestion, 0, len(questions))
+	for _, q := range questions {
+		out = append(out, &pb.ClarificationQuestion{
+			Field:            q.Field,
+			Question:         q.Question,
+			QuestionType:     q.QuestionType,
+			SuggestedOptions: q.SuggestedOptions,
+			Importance:       q.Importance,
+		})
+	}
+	return out
+}
@@ -0,0 +1,75 @@
+package leadgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/services/property"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MatchLeads — поиск подходящих лидов для объекта недвижимости (обратный матчинг).
+func (s *leadServer) MatchLeads(ctx context.Context, in *pb.MatchLeadsRequest) (*pb.MatchLeadsResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if s.leadMatcher == nil {
+		return nil, status.Error(codes.Unimplemented, "lead matching is not configured")
+	}
+
+	propertyID, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
+	}
+
+	filter := domain.LeadFilter{}
+	if in.Filter != nil {
+		if in.Filter.Status != nil {
+			statusStr := protoLeadStatusToDomain(*in.Filter.Status)
+			filter.Status = &statusStr
+		}
+		if in.Filter.PropertyType != nil {
+			pt := protoPropertyTypeToDomain(*in.Filter.PropertyType)
+			filter.PropertyType = &pt
+		}
+		if in.Filter.City != nil {
+			filter.City = in.Filter.City
+		}
+	}
+
+	limit := 10
+	if in.Limit != nil && *in.Limit > 0 {
+		limit = int(*in.Limit)
+	}
+
+	matches, err := s.leadMatcher.MatchLeads(ctx, propertyID, filter, limit)
+	if err != nil {
+		if errors.Is(err, property.ErrPropertyNotFound) {
+			return nil, status.Error(codes.NotFound, "property not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to match leads: %v", err))
+	}
+
+	// Контакты найденных лидов скрываются по общей политике видимости
+	leads := make([]domain.Lead, len(matches))
+	for i, m := range matches {
+		leads[i] = m.Lead
+	}
+	visible, err := s.visibleLeads(ctx, leads...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.MatchLeadsResponse{}
+	for i, m := range matches {
+		m.Lead = visible[i]
+		resp.Matches = append(resp.Matches, matchedLeadToProto(m))
+	}
+	return resp, nil
+}
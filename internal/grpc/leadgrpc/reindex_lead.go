@@ -2,7 +2,9 @@ package leadgrpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"lead_exchange/internal/services/lead"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
@@ -10,6 +12,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// ReindexLead — ставит переиндексацию лида в очередь embedding.
+// Ход выполнения отслеживается через GetLeadEmbeddingStatus.
 func (s *leadServer) ReindexLead(ctx context.Context, req *pb.ReindexLeadRequest) (*pb.ReindexLeadResponse, error) {
 	if req.LeadId == "" {
 		return nil, status.Error(codes.InvalidArgument, "lead_id is required")
@@ -20,14 +24,38 @@ func (s *leadServer) ReindexLead(ctx context.Context, req *pb.ReindexLeadRequest
 		return nil, status.Error(codes.InvalidArgument, "invalid lead_id")
 	}
 
-	err = s.leadService.ReindexLead(ctx, id)
+	err = s.leadService.ScheduleReindex(ctx, id)
 	if err != nil {
+		if errors.Is(err, lead.ErrLeadNotFound) {
+			return nil, status.Error(codes.NotFound, "lead not found")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to reindex lead: %v", err)
 	}
 
 	return &pb.ReindexLeadResponse{
 		Success: true,
-		Message: fmt.Sprintf("Lead %s reindexed successfully", id),
+		Message: fmt.Sprintf("Lead %s queued for reindex", id),
 	}, nil
 }
 
+// GetLeadEmbeddingStatus — есть ли у лида embedding и статус последней задачи очереди.
+func (s *leadServer) GetLeadEmbeddingStatus(ctx context.Context, in *pb.GetLeadEmbeddingStatusRequest) (*pb.EmbeddingStatusResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := uuid.Parse(in.GetLeadId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid lead_id format")
+	}
+
+	state, err := s.leadService.EmbeddingState(ctx, id)
+	if err != nil {
+		if errors.Is(err, lead.ErrLeadNotFound) {
+			return nil, status.Error(codes.NotFound, "lead not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get embedding status: %v", err)
+	}
+
+	return embeddingStateToProto(state), nil
+}
@@ -15,26 +15,41 @@ import (
 type LeadService interface {
 	CreateLead(ctx context.Context, lead domain.Lead) (uuid.UUID, error)
 	GetLead(ctx context.Context, id uuid.UUID) (domain.Lead, error)
-	UpdateLead(ctx context.Context, id uuid.UUID, update domain.LeadFilter) (domain.Lead, error)
+	UpdateLead(ctx context.Context, actor domain.Actor, id uuid.UUID, update domain.LeadFilter) (domain.Lead, error)
 	ListLeads(ctx context.Context, filter domain.LeadFilter) (*domain.PaginatedResult[domain.Lead], error)
-	ReindexLead(ctx context.Context, id uuid.UUID) error
+	ScheduleReindex(ctx context.Context, id uuid.UUID) error
+	EmbeddingState(ctx context.Context, id uuid.UUID) (domain.EmbeddingState, error)
+	ApplyContactPolicy(ctx context.Context, viewerID uuid.UUID, leads ...domain.Lead) ([]domain.Lead, error)
+}
+
+// LeadMatcher — обратный матчинг (лиды для объекта), реализуется сервисом объектов.
+type LeadMatcher interface {
+	MatchLeads(ctx context.Context, propertyID uuid.UUID, filter domain.LeadFilter, limit int) ([]domain.MatchedLead, error)
+}
+
+// ClarificationService — сессии уточнения лидов (вопросы, ответы, история).
+type ClarificationService interface {
+	StartClarification(ctx context.Context, actor domain.Actor, leadID uuid.UUID) (domain.LeadClarification, *clarification.ClarificationResult, error)
+	ApplyAnswers(ctx context.Context, actor domain.Actor, leadID uuid.UUID, answers []domain.ClarificationAnswer) (domain.LeadClarification, domain.Lead, error)
+	ListClarifications(ctx context.Context, actor domain.Actor, leadID uuid.UUID) ([]domain.LeadClarification, error)
 }
 
 // serverAPI реализует gRPC LeadServiceServer с поддержкой AI-функций.
 type serverAPI struct {
 	pb.UnimplementedLeadServiceServer
-	leadService        LeadService
-	clarificationAgent *clarification.Agent
-	weightsAnalyzer    *weights.Analyzer
+	leadService          LeadService
+	clarificationService ClarificationService
+	weightsAnalyzer      *weights.Analyzer
+	leadMatcher          LeadMatcher
 }
 
 // ServerOption — опция для конфигурации сервера.
 type ServerOption func(*serverAPI)
 
-// WithClarificationAgent добавляет агента уточнения.
-func WithClarificationAgent(agent *clarification.Agent) ServerOption {
+// WithClarificationService добавляет сервис уточнения.
+func WithClarificationService(svc ClarificationService) ServerOption {
 	return func(s *serverAPI) {
-		s.clarificationAgent = agent
+		s.clarificationService = svc
 	}
 }
 
@@ -45,6 +60,13 @@ func WithWeightsAnalyzer(analyzer *weights.Analyzer) ServerOption {
 	}
 }
 
+// WithLeadMatcher добавляет обратный матчинг лидов.
+func WithLeadMatcher(matcher LeadMatcher) ServerOption {
+	return func(s *serverAPI) {
+		s.leadMatcher = matcher
+	}
+}
+
 // RegisterLeadServerGRPC регистрирует LeadServiceServer в gRPC сервере.
 func RegisterLeadServerGRPC(server *grpc.Server, svc LeadService, opts ...ServerOption) {
 	s := &serverAPI{
@@ -61,9 +83,6 @@ func RegisterLeadServerGRPC(server *grpc.Server, svc LeadService, opts ...Server
 // Для обратной совместимости (старый leadServer удалён, используем serverAPI)
 type leadServer = serverAPI
 
-// ClarificationAgent — type alias для использования в app.go
-type ClarificationAgent = *clarification.Agent
-
 // WeightsAnalyzer — type alias для использования в app.go
 type WeightsAnalyzer = *weights.Analyzer
 
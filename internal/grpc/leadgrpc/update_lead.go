@@ -2,12 +2,14 @@ package leadgrpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	"lead_exchange/internal/services/lead"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
-	"github.com/samber/lo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -23,13 +25,22 @@ func (s *leadServer) UpdateLead(ctx context.Context, in *pb.UpdateLeadRequest) (
 		return nil, status.Error(codes.InvalidArgument, "invalid lead_id format")
 	}
 
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
 	filter := domain.LeadFilter{
 		Title:       in.Title,
 		Description: in.Description,
-		Requirement: lo.EmptyableToPtr(in.Requirement),
 		City:        in.City,
 	}
 
+	if in.Requirement != nil {
+		requirement := requirementProtoToDomain(in.Requirement)
+		filter.Requirement = &requirement
+	}
+
 	if in.PropertyType != nil {
 		pt := protoPropertyTypeToDomain(*in.PropertyType)
 		filter.PropertyType = &pt
@@ -48,10 +59,23 @@ func (s *leadServer) UpdateLead(ctx context.Context, in *pb.UpdateLeadRequest) (
 		filter.OwnerUserID = &ownerID
 	}
 
-	updated, err := s.leadService.UpdateLead(ctx, id, filter)
+	updated, err := s.leadService.UpdateLead(ctx, actor, id, filter)
 	if err != nil {
+		switch {
+		case errors.Is(err, lead.ErrLeadNotFound):
+			return nil, status.Error(codes.NotFound, "lead not found")
+		case errors.Is(err, lead.ErrPermissionDenied):
+			return nil, status.Error(codes.PermissionDenied, "only owner or admin can update lead")
+		case errors.Is(err, lead.ErrInvalidRequirement):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update lead: %v", err))
 	}
 
-	return &pb.LeadResponse{Lead: leadDomainToProto(updated)}, nil
+	visible, err := s.visibleLeads(ctx, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LeadResponse{Lead: leadDomainToProto(visible[0])}, nil
 }
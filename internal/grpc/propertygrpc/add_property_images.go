@@ -0,0 +1,48 @@
+package propertygrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AddPropertyImages — загрузка фотографий в галерею объекта.
+func (s *propertyServer) AddPropertyImages(ctx context.Context, in *pb.AddPropertyImagesRequest) (*pb.PropertyImagesResponse, error) {
+	if s.imageService == nil {
+		return nil, errImagesUnavailable
+	}
+
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	uploads := make([]domain.PropertyImageUpload, 0, len(in.GetImages()))
+	for _, img := range in.GetImages() {
+		uploads = append(uploads, domain.PropertyImageUpload{
+			FileName: img.GetFileName(),
+			Data:     img.GetFile(),
+		})
+	}
+
+	images, err := s.imageService.AddImages(ctx, actor, id, uploads)
+	if err != nil {
+		return nil, propertyImageError(err, "add property images")
+	}
+
+	return propertyImagesToProto(images), nil
+}
@@ -2,11 +2,13 @@ package propertygrpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"lead_exchange/internal/domain"
-	"lead_exchange/internal/lib/jsonld"
 	"lead_exchange/internal/lib/llm"
+	"lead_exchange/internal/middleware"
+	"lead_exchange/internal/services/property"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
@@ -51,6 +53,7 @@ func (s *serverAPI) MatchPropertiesAdvanced(ctx context.Context, in *pb.MatchPro
 		if in.Filter.City != nil {
 			filter.City = in.Filter.City
 		}
+		filter.Geo = geoFilterProtoToDomain(in.Filter.Geo)
 	}
 
 	limit := 10
@@ -58,13 +61,28 @@ func (s *serverAPI) MatchPropertiesAdvanced(ctx context.Context, in *pb.MatchPro
 		limit = int(*in.Limit)
 	}
 
+	// Стратегия поиска для этого запроса поверх настроек сервера
+	opts := domain.SearchOptions{
+		HybridSearch:   in.UseHybridSearch,
+		VectorWeight:   in.VectorWeight,
+		FulltextWeight: in.FulltextWeight,
+		UseReranker:    in.UseReranker,
+		DynamicWeights: in.UseDynamicWeights,
+	}
+	if in.Candidates != nil {
+		candidates := int(*in.Candidates)
+		opts.Candidates = &candidates
+	}
+
 	// Используем расширенный поиск с поддержкой AI-функций
-	matches, err := s.propertyService.MatchPropertiesAdvanced(ctx, leadID, filter, limit)
+	matches, effective, err := s.propertyService.MatchPropertiesAdvanced(ctx, leadID, filter, limit, opts)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to match properties: %v", err))
 	}
 
-	resp := &pb.MatchPropertiesResponse{}
+	resp := &pb.MatchPropertiesResponse{
+		SearchOptions: searchOptionsToProto(effective),
+	}
 	for _, match := range matches {
 		pbMatch := matchedPropertyToProto(match)
 		resp.Matches = append(resp.Matches, pbMatch)
@@ -84,22 +102,12 @@ func (s *serverAPI) GetPropertyJSONLD(ctx context.Context, in *pb.GetPropertyJSO
 		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
 	}
 
-	// Получаем объект недвижимости
-	property, err := s.propertyService.GetProperty(ctx, propertyID)
-	if err != nil {
-		return nil, status.Error(codes.NotFound, fmt.Sprintf("property not found: %v", err))
-	}
-
-	// Определяем base URL
-	baseURL := "https://api.leadexchange.ru"
-	if in.BaseUrl != nil && *in.BaseUrl != "" {
-		baseURL = *in.BaseUrl
-	}
-
-	// Генерируем JSON-LD
-	generator := jsonld.NewGenerator()
-	jsonldData, err := generator.GeneratePropertyJSONLDBytes(property, baseURL)
+	// Разметка из кэша или сгенерированная заново; пустой base_url — адрес из конфига
+	jsonldData, err := s.propertyService.GetPropertyJSONLD(ctx, propertyID, in.GetBaseUrl())
 	if err != nil {
+		if errors.Is(err, property.ErrPropertyNotFound) {
+			return nil, status.Error(codes.NotFound, "property not found")
+		}
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to generate JSON-LD: %v", err))
 	}
 
@@ -170,10 +178,33 @@ func (s *serverAPI) GenerateListingContent(ctx context.Context, in *pb.GenerateL
 	}, nil
 }
 
-// AnalyzePropertyImages — анализ изображений объекта недвижимости.
-// Пока не реализовано, так как изображения не хранятся.
+// AnalyzePropertyImages — анализ фотографий объекта недвижимости.
+// Анализирует галерею объекта или переданные ссылки и сохраняет результаты.
 func (s *serverAPI) AnalyzePropertyImages(ctx context.Context, in *pb.AnalyzePropertyImagesRequest) (*pb.AnalyzePropertyImagesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "image analysis is not yet available - images are not stored in the system")
+	if s.imageService == nil {
+		return nil, errImagesUnavailable
+	}
+
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	propertyID, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	images, summary, err := s.imageService.AnalyzeImages(ctx, actor, propertyID, in.GetImageUrls())
+	if err != nil {
+		return nil, propertyImageError(err, "analyze property images")
+	}
+
+	return imageAnalysisToProto(images, summary), nil
 }
 
 // stringOrEmpty возвращает строку из optional string или пустую строку.
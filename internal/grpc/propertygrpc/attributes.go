@@ -0,0 +1,24 @@
+package propertygrpc
+
+import (
+	"errors"
+	"fmt"
+	"lead_exchange/internal/services/property"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// propertyWriteError — ошибки создания и изменения объекта в gRPC-статусы.
+func propertyWriteError(err error, msg string) error {
+	switch {
+	case errors.Is(err, property.ErrPropertyNotFound):
+		return status.Error(codes.NotFound, "property not found")
+	case errors.Is(err, property.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "only owner or admin can update property")
+	case errors.Is(err, property.ErrInvalidAttributes):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("%s: %v", msg, err))
+	}
+}
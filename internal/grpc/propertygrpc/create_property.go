@@ -2,7 +2,6 @@ package propertygrpc
 
 import (
 	"context"
-	"fmt"
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/middleware"
 	pb "lead_exchange/pkg"
@@ -42,10 +41,17 @@ func (s *propertyServer) CreateProperty(ctx context.Context, in *pb.CreateProper
 	if in.Rooms != nil {
 		property.Rooms = in.Rooms
 	}
+	property.Floor = in.Floor
+	property.TotalFloors = in.TotalFloors
+	property.YearBuilt = in.YearBuilt
+	property.District = in.District
+	property.Latitude = in.Latitude
+	property.Longitude = in.Longitude
+	property.Features = in.Features
 
 	id, err := s.propertyService.CreateProperty(ctx, property)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create property: %v", err))
+		return nil, propertyWriteError(err, "failed to create property")
 	}
 
 	property.ID = id
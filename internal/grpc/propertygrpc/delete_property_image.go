@@ -0,0 +1,43 @@
+package propertygrpc
+
+import (
+	"context"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeletePropertyImage — удаление фотографии из галереи объекта.
+func (s *propertyServer) DeletePropertyImage(ctx context.Context, in *pb.DeletePropertyImageRequest) (*pb.DeletePropertyImageResponse, error) {
+	if s.imageService == nil {
+		return nil, errImagesUnavailable
+	}
+
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	propertyID, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
+	}
+
+	imageID, err := uuid.Parse(in.GetImageId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid image_id format")
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.imageService.DeleteImage(ctx, actor, propertyID, imageID); err != nil {
+		return nil, propertyImageError(err, "delete property image")
+	}
+
+	return &pb.DeletePropertyImageResponse{Success: true}, nil
+}
@@ -0,0 +1,33 @@
+package propertygrpc
+
+import (
+	"errors"
+	"fmt"
+	"lead_exchange/internal/services/propertyimage"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errImagesUnavailable — галерея не подключена (MinIO выключен).
+var errImagesUnavailable = status.Error(codes.Unavailable, "property images are not available: file storage is disabled")
+
+// propertyImageError — перевод ошибок сервиса фотогалереи в gRPC-статусы.
+func propertyImageError(err error, action string) error {
+	switch {
+	case errors.Is(err, propertyimage.ErrPropertyNotFound):
+		return status.Error(codes.NotFound, "property not found")
+	case errors.Is(err, propertyimage.ErrImageNotFound):
+		return status.Error(codes.NotFound, "property image not found")
+	case errors.Is(err, propertyimage.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "only owner or admin can change property images")
+	case errors.Is(err, propertyimage.ErrInvalidImage):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, propertyimage.ErrTooManyImages), errors.Is(err, propertyimage.ErrNoImages):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, propertyimage.ErrVisionDisabled):
+		return status.Error(codes.Unavailable, "vision service is not available")
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("failed to %s: %v", action, err))
+	}
+}
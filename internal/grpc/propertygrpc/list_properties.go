@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
@@ -13,6 +14,10 @@ import (
 
 // ListProperties — получение списка объектов недвижимости по фильтру с пагинацией.
 func (s *propertyServer) ListProperties(ctx context.Context, in *pb.ListPropertiesRequest) (*pb.ListPropertiesResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	filter := domain.PropertyFilter{}
 
 	if in.Filter != nil {
@@ -53,6 +58,14 @@ func (s *propertyServer) ListProperties(ctx context.Context, in *pb.ListProperti
 		if in.Filter.City != nil {
 			filter.City = in.Filter.City
 		}
+		filter.Geo = geoFilterProtoToDomain(in.Filter.Geo)
+		if in.Filter.GetTeam() {
+			userID, ok := middleware.FromContext(ctx)
+			if !ok {
+				return nil, status.Error(codes.Unauthenticated, "unauthorized")
+			}
+			filter.TeamOf = &userID
+		}
 	}
 
 	// Параметры пагинации
@@ -76,7 +89,11 @@ func (s *propertyServer) ListProperties(ctx context.Context, in *pb.ListProperti
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list properties: %v", err))
 	}
 
-	resp := &pb.ListPropertiesResponse{}
+	resp := &pb.ListPropertiesResponse{
+		NextPageToken: result.NextPageToken,
+		HasMore:       result.HasMore,
+		TotalCount:    result.TotalCount,
+	}
 	for _, p := range result.Items {
 		resp.Properties = append(resp.Properties, propertyDomainToProto(p))
 	}
@@ -0,0 +1,33 @@
+package propertygrpc
+
+import (
+	"context"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPropertyImages — галерея объекта со свежими ссылками на фотографии.
+func (s *propertyServer) ListPropertyImages(ctx context.Context, in *pb.ListPropertyImagesRequest) (*pb.PropertyImagesResponse, error) {
+	if s.imageService == nil {
+		return nil, errImagesUnavailable
+	}
+
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
+	}
+
+	images, err := s.imageService.ListImages(ctx, id)
+	if err != nil {
+		return nil, propertyImageError(err, "list property images")
+	}
+
+	return propertyImagesToProto(images), nil
+}
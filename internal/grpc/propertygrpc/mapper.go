@@ -20,6 +20,13 @@ func propertyDomainToProto(p domain.Property) *pb.Property {
 		CreatedUserId: p.CreatedUserID.String(),
 		CreatedAt:     p.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:     p.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Floor:         p.Floor,
+		TotalFloors:   p.TotalFloors,
+		YearBuilt:     p.YearBuilt,
+		District:      p.District,
+		Latitude:      p.Latitude,
+		Longitude:     p.Longitude,
+		Features:      p.Features,
 	}
 
 	if p.Area != nil {
@@ -118,6 +125,18 @@ func matchedPropertyToProto(m domain.MatchedProperty) *pb.MatchedProperty {
 	if m.AreaScore != nil {
 		result.AreaScore = m.AreaScore
 	}
+	if m.DistanceScore != nil {
+		result.DistanceScore = m.DistanceScore
+	}
+	if m.DistanceKm != nil {
+		result.DistanceKm = m.DistanceKm
+	}
+	if m.VisualScore != nil {
+		result.VisualScore = m.VisualScore
+	}
+	if m.FeaturesScore != nil {
+		result.FeaturesScore = m.FeaturesScore
+	}
 	if m.SemanticScore != nil {
 		result.SemanticScore = m.SemanticScore
 	}
@@ -128,7 +147,123 @@ func matchedPropertyToProto(m domain.MatchedProperty) *pb.MatchedProperty {
 	return result
 }
 
+// geoFilterProtoToDomain — гео-фильтр запроса; nil, если не задан.
+func geoFilterProtoToDomain(g *pb.GeoFilter) *domain.GeoFilter {
+	if g == nil {
+		return nil
+	}
+	return &domain.GeoFilter{
+		Center:   domain.GeoPoint{Latitude: g.Latitude, Longitude: g.Longitude},
+		RadiusKm: g.RadiusKm,
+	}
+}
+
 // parseUUID парсит строку UUID.
 func parseUUID(s string) (uuid.UUID, error) {
 	return uuid.Parse(s)
 }
+
+func embeddingStateToProto(state domain.EmbeddingState) *pb.EmbeddingStatusResponse {
+	resp := &pb.EmbeddingStatusResponse{HasEmbedding: state.HasEmbedding}
+	if state.Job == nil {
+		return resp
+	}
+
+	job := state.Job
+	resp.Job = &pb.EmbeddingJob{
+		JobId:       job.ID.String(),
+		Operation:   job.Operation.String(),
+		Status:      embeddingJobStatusDomainToProto(job.Status),
+		Attempts:    int32(job.Attempts),
+		MaxAttempts: int32(job.MaxAttempts),
+		LastError:   job.LastError,
+		RunAt:       job.RunAt.Format("2006-01-02T15:04:05Z07:00"),
+		CreatedAt:   job.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   job.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	return resp
+}
+
+func embeddingJobStatusDomainToProto(s domain.EmbeddingJobStatus) pb.EmbeddingJobStatus {
+	switch s {
+	case domain.EmbeddingJobStatusPending:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_PENDING
+	case domain.EmbeddingJobStatusRunning:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_RUNNING
+	case domain.EmbeddingJobStatusDone:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_DONE
+	case domain.EmbeddingJobStatusDead:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_DEAD
+	default:
+		return pb.EmbeddingJobStatus_EMBEDDING_JOB_STATUS_UNSPECIFIED
+	}
+}
+
+func searchOptionsToProto(o domain.EffectiveSearchOptions) *pb.SearchOptions {
+	return &pb.SearchOptions{
+		HybridSearch:          o.HybridSearch,
+		VectorWeight:          o.VectorWeight,
+		FulltextWeight:        o.FulltextWeight,
+		UseReranker:           o.UseReranker,
+		RerankerApplied:       o.RerankerApplied,
+		Candidates:            int32(o.Candidates),
+		UseDynamicWeights:     o.DynamicWeights,
+		DynamicWeightsApplied: o.DynamicWeightsApplied,
+	}
+}
+
+func propertyImagesToProto(images []domain.PropertyImage) *pb.PropertyImagesResponse {
+	resp := &pb.PropertyImagesResponse{Images: make([]*pb.PropertyImage, 0, len(images))}
+	for _, img := range images {
+		resp.Images = append(resp.Images, &pb.PropertyImage{
+			ImageId:     img.ID.String(),
+			PropertyId:  img.PropertyID.String(),
+			Url:         img.URL,
+			FileName:    img.FileName,
+			ContentType: img.ContentType,
+			Position:    img.Position,
+			CreatedAt:   img.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+	return resp
+}
+
+func imageAnalysisToProto(images []domain.PropertyImage, summary domain.PropertyVisualSummary) *pb.AnalyzePropertyImagesResponse {
+	resp := &pb.AnalyzePropertyImagesResponse{
+		TotalImages:       int32(summary.TotalImages),
+		AverageQuality:    summary.AverageQuality,
+		DetectedRooms:     summary.DetectedRooms,
+		AllFeatures:       imageFeaturesToProto(summary.AllFeatures),
+		ViewTypes:         summary.ViewTypes,
+		OverallAssessment: summary.OverallAssessment,
+	}
+	for _, img := range images {
+		if img.Analysis == nil {
+			continue
+		}
+		a := img.Analysis
+		result := &pb.ImageAnalysisResult{
+			ImageId:          img.ID.String(),
+			ImageUrl:         img.URL,
+			DetectedFeatures: imageFeaturesToProto(a.DetectedFeatures),
+			QualityScore:     a.QualityScore,
+			Brightness:       a.Brightness,
+		}
+		if a.RoomType != "" {
+			result.RoomType = &a.RoomType
+		}
+		if a.ViewType != "" {
+			result.ViewType = &a.ViewType
+		}
+		resp.ImageResults = append(resp.ImageResults, result)
+	}
+	return resp
+}
+
+func imageFeaturesToProto(features []domain.ImageFeature) []*pb.ImageFeature {
+	result := make([]*pb.ImageFeature, 0, len(features))
+	for _, f := range features {
+		result = append(result, &pb.ImageFeature{Name: f.Name, Confidence: f.Confidence, Category: f.Category})
+	}
+	return result
+}
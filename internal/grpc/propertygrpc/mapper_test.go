@@ -247,3 +247,33 @@ func TestParseUUID(t *testing.T) {
 	}
 }
 
+
+func TestPropertyDomainToProto_StructuredAttributes(t *testing.T) {
+	floor, totalFloors, yearBuilt := int32(5), int32(17), int32(2015)
+	district := "Хамовники"
+	lat, lon := 55.7298, 37.5726
+
+	proto := propertyDomainToProto(domain.Property{
+		ID:          uuid.New(),
+		Floor:       &floor,
+		TotalFloors: &totalFloors,
+		YearBuilt:   &yearBuilt,
+		District:    &district,
+		Latitude:    &lat,
+		Longitude:   &lon,
+		Features:    []string{"балкон", "парковка"},
+	})
+
+	if proto.GetFloor() != 5 || proto.GetTotalFloors() != 17 || proto.GetYearBuilt() != 2015 {
+		t.Errorf("unexpected building attributes: floor=%v total=%v year=%v", proto.Floor, proto.TotalFloors, proto.YearBuilt)
+	}
+	if proto.GetDistrict() != "Хамовники" {
+		t.Errorf("expected District 'Хамовники', got %v", proto.District)
+	}
+	if proto.GetLatitude() != lat || proto.GetLongitude() != lon {
+		t.Errorf("expected coordinates %v,%v, got %v,%v", lat, lon, proto.Latitude, proto.Longitude)
+	}
+	if len(proto.Features) != 2 || proto.Features[1] != "парковка" {
+		t.Errorf("expected features to be mapped, got %v", proto.Features)
+	}
+}
@@ -2,7 +2,9 @@ package propertygrpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"lead_exchange/internal/services/property"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
@@ -10,6 +12,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// ReindexProperty — ставит переиндексацию объекта в очередь embedding.
+// Ход выполнения отслеживается через GetPropertyEmbeddingStatus.
 func (s *propertyServer) ReindexProperty(ctx context.Context, req *pb.ReindexPropertyRequest) (*pb.ReindexPropertyResponse, error) {
 	if req.PropertyId == "" {
 		return nil, status.Error(codes.InvalidArgument, "property_id is required")
@@ -20,14 +24,38 @@ func (s *propertyServer) ReindexProperty(ctx context.Context, req *pb.ReindexPro
 		return nil, status.Error(codes.InvalidArgument, "invalid property_id")
 	}
 
-	err = s.propertyService.ReindexProperty(ctx, id)
+	err = s.propertyService.ScheduleReindex(ctx, id)
 	if err != nil {
+		if errors.Is(err, property.ErrPropertyNotFound) {
+			return nil, status.Error(codes.NotFound, "property not found")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to reindex property: %v", err)
 	}
 
 	return &pb.ReindexPropertyResponse{
 		Success: true,
-		Message: fmt.Sprintf("Property %s reindexed successfully", id),
+		Message: fmt.Sprintf("Property %s queued for reindex", id),
 	}, nil
 }
 
+// GetPropertyEmbeddingStatus — есть ли у объекта embedding и статус последней задачи очереди.
+func (s *propertyServer) GetPropertyEmbeddingStatus(ctx context.Context, in *pb.GetPropertyEmbeddingStatusRequest) (*pb.EmbeddingStatusResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
+	}
+
+	state, err := s.propertyService.EmbeddingState(ctx, id)
+	if err != nil {
+		if errors.Is(err, property.ErrPropertyNotFound) {
+			return nil, status.Error(codes.NotFound, "property not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get embedding status: %v", err)
+	}
+
+	return embeddingStateToProto(state), nil
+}
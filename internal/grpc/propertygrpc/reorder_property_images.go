@@ -0,0 +1,48 @@
+package propertygrpc
+
+import (
+	"context"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReorderPropertyImages — изменение порядка фотографий в галерее объекта.
+func (s *propertyServer) ReorderPropertyImages(ctx context.Context, in *pb.ReorderPropertyImagesRequest) (*pb.PropertyImagesResponse, error) {
+	if s.imageService == nil {
+		return nil, errImagesUnavailable
+	}
+
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	propertyID, err := uuid.Parse(in.GetPropertyId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
+	}
+
+	imageIDs := make([]uuid.UUID, 0, len(in.GetImageIds()))
+	for _, raw := range in.GetImageIds() {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid image_id format")
+		}
+		imageIDs = append(imageIDs, id)
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	images, err := s.imageService.ReorderImages(ctx, actor, propertyID, imageIDs)
+	if err != nil {
+		return nil, propertyImageError(err, "reorder property images")
+	}
+
+	return propertyImagesToProto(images), nil
+}
@@ -21,12 +21,23 @@ type VisionClient = vision.Client
 type PropertyService interface {
 	CreateProperty(ctx context.Context, property domain.Property) (uuid.UUID, error)
 	GetProperty(ctx context.Context, id uuid.UUID) (domain.Property, error)
-	UpdateProperty(ctx context.Context, id uuid.UUID, update domain.PropertyFilter) (domain.Property, error)
+	UpdateProperty(ctx context.Context, actor domain.Actor, id uuid.UUID, update domain.PropertyFilter) (domain.Property, error)
 	ListProperties(ctx context.Context, filter domain.PropertyFilter) (*domain.PaginatedResult[domain.Property], error)
 	MatchProperties(ctx context.Context, leadID uuid.UUID, filter domain.PropertyFilter, limit int) ([]domain.MatchedProperty, error)
 	MatchPropertiesWeighted(ctx context.Context, leadID uuid.UUID, filter domain.PropertyFilter, limit int, weights *domain.MatchWeights, criteria *domain.SoftCriteria, useWeightedRanking bool) ([]domain.MatchedProperty, error)
-	MatchPropertiesAdvanced(ctx context.Context, leadID uuid.UUID, filter domain.PropertyFilter, limit int) ([]domain.MatchedProperty, error)
-	ReindexProperty(ctx context.Context, id uuid.UUID) error
+	MatchPropertiesAdvanced(ctx context.Context, leadID uuid.UUID, filter domain.PropertyFilter, limit int, opts domain.SearchOptions) ([]domain.MatchedProperty, domain.EffectiveSearchOptions, error)
+	ScheduleReindex(ctx context.Context, id uuid.UUID) error
+	EmbeddingState(ctx context.Context, id uuid.UUID) (domain.EmbeddingState, error)
+	GetPropertyJSONLD(ctx context.Context, id uuid.UUID, baseURL string) ([]byte, error)
+}
+
+// PropertyImageService описывает работу с фотогалереей объекта.
+type PropertyImageService interface {
+	AddImages(ctx context.Context, actor domain.Actor, propertyID uuid.UUID, uploads []domain.PropertyImageUpload) ([]domain.PropertyImage, error)
+	ListImages(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error)
+	DeleteImage(ctx context.Context, actor domain.Actor, propertyID, imageID uuid.UUID) error
+	ReorderImages(ctx context.Context, actor domain.Actor, propertyID uuid.UUID, imageIDs []uuid.UUID) ([]domain.PropertyImage, error)
+	AnalyzeImages(ctx context.Context, actor domain.Actor, propertyID uuid.UUID, imageURLs []string) ([]domain.PropertyImage, domain.PropertyVisualSummary, error)
 }
 
 // serverAPI реализует gRPC PropertyServiceServer с поддержкой AI-функций.
@@ -35,6 +46,7 @@ type serverAPI struct {
 	propertyService PropertyService
 	llmClient       llm.Client
 	visionClient    vision.Client
+	imageService    PropertyImageService
 }
 
 // ServerOption — опция для конфигурации сервера.
@@ -54,6 +66,13 @@ func WithVisionClient(client vision.Client) ServerOption {
 	}
 }
 
+// WithImageService добавляет фотогалерею (требует MinIO).
+func WithImageService(svc PropertyImageService) ServerOption {
+	return func(s *serverAPI) {
+		s.imageService = svc
+	}
+}
+
 // RegisterPropertyServerGRPC регистрирует PropertyServiceServer в gRPC сервере.
 func RegisterPropertyServerGRPC(server *grpc.Server, svc PropertyService, opts ...ServerOption) {
 	s := &serverAPI{
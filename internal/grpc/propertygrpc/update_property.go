@@ -2,8 +2,8 @@ package propertygrpc
 
 import (
 	"context"
-	"fmt"
 	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
 	pb "lead_exchange/pkg"
 
 	"github.com/google/uuid"
@@ -22,6 +22,11 @@ func (s *propertyServer) UpdateProperty(ctx context.Context, in *pb.UpdateProper
 		return nil, status.Error(codes.InvalidArgument, "invalid property_id format")
 	}
 
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
 	filter := domain.PropertyFilter{
 		Title:       in.Title,
 		Description: in.Description,
@@ -46,6 +51,17 @@ func (s *propertyServer) UpdateProperty(ctx context.Context, in *pb.UpdateProper
 		filter.Rooms = in.Rooms
 	}
 
+	filter.Floor = in.Floor
+	filter.TotalFloors = in.TotalFloors
+	filter.YearBuilt = in.YearBuilt
+	filter.District = in.District
+	filter.Latitude = in.Latitude
+	filter.Longitude = in.Longitude
+	if in.Features != nil {
+		features := append([]string{}, in.Features.Values...)
+		filter.Features = &features
+	}
+
 	if in.Status != nil {
 		statusStr := protoPropertyStatusToDomain(*in.Status)
 		filter.Status = &statusStr
@@ -59,9 +75,9 @@ func (s *propertyServer) UpdateProperty(ctx context.Context, in *pb.UpdateProper
 		filter.OwnerUserID = &ownerID
 	}
 
-	updated, err := s.propertyService.UpdateProperty(ctx, id, filter)
+	updated, err := s.propertyService.UpdateProperty(ctx, actor, id, filter)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update property: %v", err))
+		return nil, propertyWriteError(err, "failed to update property")
 	}
 
 	return &pb.PropertyResponse{Property: propertyDomainToProto(updated)}, nil
@@ -0,0 +1,58 @@
+package savedsearchgrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateSavedSearch — сохранение поиска по лиду или по фильтру.
+func (s *savedSearchServer) CreateSavedSearch(ctx context.Context, in *pb.CreateSavedSearchRequest) (*pb.SavedSearchResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	search := domain.SavedSearch{
+		Name:     in.GetName(),
+		Filter:   savedSearchFilterProtoToDomain(in.GetFilter()),
+		MinScore: in.GetMinScore(),
+	}
+
+	if in.LeadId != nil {
+		leadID, err := uuid.Parse(*in.LeadId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid lead_id format")
+		}
+		search.LeadID = &leadID
+	}
+
+	if in.Weights != nil {
+		search.Weights = &domain.MatchWeights{
+			Price:    in.Weights.Price,
+			District: in.Weights.District,
+			Rooms:    in.Weights.Rooms,
+			Area:     in.Weights.Area,
+			Semantic: in.Weights.Semantic,
+			Distance: in.Weights.Distance,
+			Visual:   in.Weights.Visual,
+			Features: in.Weights.Features,
+		}
+	}
+
+	created, err := s.savedSearchService.CreateSavedSearch(ctx, actor, search)
+	if err != nil {
+		return nil, savedSearchError(err, "create saved search")
+	}
+
+	return &pb.SavedSearchResponse{SavedSearch: savedSearchDomainToProto(created)}, nil
+}
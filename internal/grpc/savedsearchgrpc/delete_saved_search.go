@@ -0,0 +1,34 @@
+package savedsearchgrpc
+
+import (
+	"context"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeleteSavedSearch — удаление сохранённого поиска вместе с его входящими.
+func (s *savedSearchServer) DeleteSavedSearch(ctx context.Context, in *pb.DeleteSavedSearchRequest) (*pb.DeleteSavedSearchResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := uuid.Parse(in.GetSearchId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid search_id format")
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.savedSearchService.DeleteSavedSearch(ctx, actor, id); err != nil {
+		return nil, savedSearchError(err, "delete saved search")
+	}
+
+	return &pb.DeleteSavedSearchResponse{Success: true}, nil
+}
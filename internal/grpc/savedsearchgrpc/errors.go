@@ -0,0 +1,24 @@
+package savedsearchgrpc
+
+import (
+	"errors"
+	"fmt"
+	"lead_exchange/internal/services/savedsearch"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// savedSearchError — перевод ошибок сервиса сохранённых поисков в gRPC-статусы.
+func savedSearchError(err error, action string) error {
+	switch {
+	case errors.Is(err, savedsearch.ErrSavedSearchNotFound):
+		return status.Error(codes.NotFound, "saved search not found")
+	case errors.Is(err, savedsearch.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, savedsearch.ErrInvalidSavedSearch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("failed to %s: %v", action, err))
+	}
+}
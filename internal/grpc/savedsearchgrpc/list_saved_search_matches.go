@@ -0,0 +1,49 @@
+package savedsearchgrpc
+
+import (
+	"context"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListSavedSearchMatches — новые объекты, найденные сохранёнными поисками пользователя.
+// По умолчанию выданные совпадения отмечаются просмотренными и больше не возвращаются.
+func (s *savedSearchServer) ListSavedSearchMatches(ctx context.Context, in *pb.ListSavedSearchMatchesRequest) (*pb.ListSavedSearchMatchesResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	var searchID *uuid.UUID
+	if in.SearchId != nil {
+		id, err := uuid.Parse(*in.SearchId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid search_id format")
+		}
+		searchID = &id
+	}
+
+	markSeen := true
+	if in.MarkSeen != nil {
+		markSeen = *in.MarkSeen
+	}
+
+	matches, err := s.savedSearchService.ListSavedSearchMatches(ctx, actor, searchID, int(in.GetLimit()), markSeen)
+	if err != nil {
+		return nil, savedSearchError(err, "list saved search matches")
+	}
+
+	resp := &pb.ListSavedSearchMatchesResponse{}
+	for _, m := range matches {
+		resp.Matches = append(resp.Matches, savedSearchMatchDomainToProto(m))
+	}
+	return resp, nil
+}
@@ -0,0 +1,29 @@
+package savedsearchgrpc
+
+import (
+	"context"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListSavedSearches — сохранённые поиски текущего пользователя.
+func (s *savedSearchServer) ListSavedSearches(ctx context.Context, _ *pb.ListSavedSearchesRequest) (*pb.ListSavedSearchesResponse, error) {
+	userID, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	searches, err := s.savedSearchService.ListSavedSearches(ctx, userID)
+	if err != nil {
+		return nil, savedSearchError(err, "list saved searches")
+	}
+
+	resp := &pb.ListSavedSearchesResponse{}
+	for _, search := range searches {
+		resp.SavedSearches = append(resp.SavedSearches, savedSearchDomainToProto(search))
+	}
+	return resp, nil
+}
@@ -0,0 +1,165 @@
+package savedsearchgrpc
+
+import (
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+)
+
+func savedSearchDomainToProto(s domain.SavedSearch) *pb.SavedSearch {
+	proto := &pb.SavedSearch{
+		SearchId:  s.ID.String(),
+		UserId:    s.UserID.String(),
+		Name:      s.Name,
+		Filter:    savedSearchFilterDomainToProto(s.Filter),
+		MinScore:  s.MinScore,
+		CreatedAt: s.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: s.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if s.LeadID != nil {
+		leadID := s.LeadID.String()
+		proto.LeadId = &leadID
+	}
+	if s.Weights != nil {
+		proto.Weights = &pb.MatchWeights{
+			Price:    s.Weights.Price,
+			District: s.Weights.District,
+			Rooms:    s.Weights.Rooms,
+			Area:     s.Weights.Area,
+			Semantic: s.Weights.Semantic,
+			Distance: s.Weights.Distance,
+			Visual:   s.Weights.Visual,
+			Features: s.Weights.Features,
+		}
+	}
+
+	return proto
+}
+
+func savedSearchFilterDomainToProto(f domain.SavedSearchFilter) *pb.SavedSearchFilter {
+	proto := &pb.SavedSearchFilter{
+		City:           f.City,
+		MinRooms:       f.MinRooms,
+		MaxRooms:       f.MaxRooms,
+		MinPrice:       f.MinPrice,
+		MaxPrice:       f.MaxPrice,
+		TargetPrice:    f.TargetPrice,
+		TargetRooms:    f.TargetRooms,
+		TargetArea:     f.TargetArea,
+		TargetDistrict: f.TargetDistrict,
+	}
+
+	if f.PropertyType != nil {
+		pt := propertyTypeDomainToProto(*f.PropertyType)
+		proto.PropertyType = &pt
+	}
+
+	return proto
+}
+
+func savedSearchFilterProtoToDomain(f *pb.SavedSearchFilter) domain.SavedSearchFilter {
+	if f == nil {
+		return domain.SavedSearchFilter{}
+	}
+
+	filter := domain.SavedSearchFilter{
+		City:           f.City,
+		MinRooms:       f.MinRooms,
+		MaxRooms:       f.MaxRooms,
+		MinPrice:       f.MinPrice,
+		MaxPrice:       f.MaxPrice,
+		TargetPrice:    f.TargetPrice,
+		TargetRooms:    f.TargetRooms,
+		TargetArea:     f.TargetArea,
+		TargetDistrict: f.TargetDistrict,
+	}
+
+	if f.PropertyType != nil {
+		pt := protoPropertyTypeToDomain(*f.PropertyType)
+		filter.PropertyType = &pt
+	}
+
+	return filter
+}
+
+func savedSearchMatchDomainToProto(m domain.SavedSearchMatch) *pb.SavedSearchMatch {
+	return &pb.SavedSearchMatch{
+		MatchId:          m.ID.String(),
+		SearchId:         m.SearchID.String(),
+		Property:         propertyDomainToProto(m.Property),
+		Score:            m.Score,
+		MatchExplanation: m.MatchExplanation,
+		CreatedAt:        m.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func propertyDomainToProto(p domain.Property) *pb.Property {
+	return &pb.Property{
+		PropertyId:    p.ID.String(),
+		Title:         p.Title,
+		Description:   p.Description,
+		Address:       p.Address,
+		City:          p.City,
+		PropertyType:  propertyTypeDomainToProto(p.PropertyType),
+		Status:        propertyStatusDomainToProto(p.Status),
+		OwnerUserId:   p.OwnerUserID.String(),
+		CreatedUserId: p.CreatedUserID.String(),
+		Area:          p.Area,
+		Price:         p.Price,
+		Rooms:         p.Rooms,
+		Floor:         p.Floor,
+		TotalFloors:   p.TotalFloors,
+		YearBuilt:     p.YearBuilt,
+		District:      p.District,
+		Latitude:      p.Latitude,
+		Longitude:     p.Longitude,
+		Features:      p.Features,
+		CreatedAt:     p.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     p.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func propertyTypeDomainToProto(t domain.PropertyType) pb.PropertyType {
+	switch t {
+	case domain.PropertyTypeApartment:
+		return pb.PropertyType_PROPERTY_TYPE_APARTMENT
+	case domain.PropertyTypeHouse:
+		return pb.PropertyType_PROPERTY_TYPE_HOUSE
+	case domain.PropertyTypeCommercial:
+		return pb.PropertyType_PROPERTY_TYPE_COMMERCIAL
+	case domain.PropertyTypeLand:
+		return pb.PropertyType_PROPERTY_TYPE_LAND
+	default:
+		return pb.PropertyType_PROPERTY_TYPE_UNSPECIFIED
+	}
+}
+
+func protoPropertyTypeToDomain(t pb.PropertyType) domain.PropertyType {
+	switch t {
+	case pb.PropertyType_PROPERTY_TYPE_APARTMENT:
+		return domain.PropertyTypeApartment
+	case pb.PropertyType_PROPERTY_TYPE_HOUSE:
+		return domain.PropertyTypeHouse
+	case pb.PropertyType_PROPERTY_TYPE_COMMERCIAL:
+		return domain.PropertyTypeCommercial
+	case pb.PropertyType_PROPERTY_TYPE_LAND:
+		return domain.PropertyTypeLand
+	default:
+		return domain.PropertyTypeUnspecified
+	}
+}
+
+func propertyStatusDomainToProto(s domain.PropertyStatus) pb.PropertyStatus {
+	switch s {
+	case domain.PropertyStatusNew:
+		return pb.PropertyStatus_PROPERTY_STATUS_NEW
+	case domain.PropertyStatusPublished:
+		return pb.PropertyStatus_PROPERTY_STATUS_PUBLISHED
+	case domain.PropertyStatusSold:
+		return pb.PropertyStatus_PROPERTY_STATUS_SOLD
+	case domain.PropertyStatusDeleted:
+		return pb.PropertyStatus_PROPERTY_STATUS_DELETED
+	default:
+		return pb.PropertyStatus_PROPERTY_STATUS_UNSPECIFIED
+	}
+}
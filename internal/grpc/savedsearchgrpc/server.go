@@ -0,0 +1,31 @@
+package savedsearchgrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// SavedSearchService описывает бизнес-логику сохранённых поисков.
+type SavedSearchService interface {
+	CreateSavedSearch(ctx context.Context, actor domain.Actor, search domain.SavedSearch) (domain.SavedSearch, error)
+	ListSavedSearches(ctx context.Context, userID uuid.UUID) ([]domain.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, actor domain.Actor, id uuid.UUID) error
+	ListSavedSearchMatches(ctx context.Context, actor domain.Actor, searchID *uuid.UUID, limit int, markSeen bool) ([]domain.SavedSearchMatch, error)
+}
+
+// savedSearchServer реализует gRPC SavedSearchServiceServer.
+type savedSearchServer struct {
+	pb.UnimplementedSavedSearchServiceServer
+	savedSearchService SavedSearchService
+}
+
+// RegisterSavedSearchServerGRPC регистрирует SavedSearchServiceServer в gRPC сервере.
+func RegisterSavedSearchServerGRPC(server *grpc.Server, svc SavedSearchService) {
+	pb.RegisterSavedSearchServiceServer(server, &savedSearchServer{
+		savedSearchService: svc,
+	})
+}
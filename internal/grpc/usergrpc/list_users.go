@@ -11,7 +11,7 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// ListUsers — получение списка пользователей.
+// ListUsers — получение списка пользователей с пагинацией.
 func (s *userServer) ListUsers(ctx context.Context, in *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
 	// Проверяем, что пользователь аутентифицирован
 	_, ok := middleware.FromContext(ctx)
@@ -37,19 +37,38 @@ func (s *userServer) ListUsers(ctx context.Context, in *pb.ListUsersRequest) (*p
 		}
 	}
 
+	// Параметры пагинации
+	pagination := &domain.PaginationParams{}
+	if in.PageSize != nil {
+		pagination.PageSize = *in.PageSize
+	}
+	if in.PageToken != nil {
+		pagination.PageToken = *in.PageToken
+	}
+	if in.OrderBy != nil {
+		pagination.OrderBy = *in.OrderBy
+	}
+	if in.OrderDirection != nil {
+		pagination.OrderDirection = domain.OrderDirection(*in.OrderDirection)
+	}
+	filter.Pagination = pagination
+
 	// Получаем список пользователей
-	users, err := s.userService.ListUsers(ctx, filter)
+	result, err := s.userService.ListUsers(ctx, filter)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list users: %v", err))
 	}
 
 	// Преобразуем в proto
-	protoUsers := make([]*pb.UserProfile, 0, len(users))
-	for _, user := range users {
+	protoUsers := make([]*pb.UserProfile, 0, len(result.Items))
+	for _, user := range result.Items {
 		protoUsers = append(protoUsers, userDomainToProto(user))
 	}
 
 	return &pb.ListUsersResponse{
-		Users: protoUsers,
+		Users:         protoUsers,
+		NextPageToken: result.NextPageToken,
+		HasMore:       result.HasMore,
+		TotalCount:    result.TotalCount,
 	}, nil
 }
@@ -8,15 +8,16 @@ import (
 // userDomainToProto — преобразует доменную сущность пользователя в protobuf-модель.
 func userDomainToProto(u domain.User) *pb.UserProfile {
 	return &pb.UserProfile{
-		Id:         u.ID.String(),
-		Email:      u.Email,
-		FirstName:  u.FirstName,
-		LastName:   u.LastName,
-		Phone:      u.Phone,
-		AgencyName: u.AgencyName,
-		AvatarUrl:  u.AvatarURL,
-		Role:       userTypeDomainToProto(u.Role),
-		Status:     userStatusDomainToProto(u.Status),
+		Id:            u.ID.String(),
+		Email:         u.Email,
+		FirstName:     u.FirstName,
+		LastName:      u.LastName,
+		Phone:         u.Phone,
+		AgencyName:    u.AgencyName,
+		AvatarUrl:     u.AvatarURL,
+		Role:          userTypeDomainToProto(u.Role),
+		Status:        userStatusDomainToProto(u.Status),
+		EmailVerified: u.EmailVerifiedAt != nil,
 	}
 }
 
@@ -0,0 +1,62 @@
+package walletgrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TopUp — зачисление кредитов пользователю (админ).
+func (s *walletServer) TopUp(ctx context.Context, in *pb.TopUpRequest) (*pb.WalletTransactionResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return s.adjust(ctx, in.UserId, "top up wallet", func(actor domain.Actor, userID uuid.UUID) (domain.LedgerTransaction, error) {
+		return s.walletService.TopUp(ctx, actor, userID, in.Amount, in.Comment)
+	})
+}
+
+// Withdraw — списание свободных кредитов пользователя (админ).
+func (s *walletServer) Withdraw(ctx context.Context, in *pb.WithdrawRequest) (*pb.WalletTransactionResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return s.adjust(ctx, in.UserId, "withdraw from wallet", func(actor domain.Actor, userID uuid.UUID) (domain.LedgerTransaction, error) {
+		return s.walletService.Withdraw(ctx, actor, userID, in.Amount, in.Comment)
+	})
+}
+
+// adjust — общая часть TopUp и Withdraw: проводка и остатки после неё.
+func (s *walletServer) adjust(ctx context.Context, rawUserID, action string,
+	post func(actor domain.Actor, userID uuid.UUID) (domain.LedgerTransaction, error),
+) (*pb.WalletTransactionResponse, error) {
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id format")
+	}
+
+	t, err := post(actor, userID)
+	if err != nil {
+		return nil, walletError(err, action)
+	}
+
+	balance, err := s.walletService.GetBalance(ctx, actor, userID)
+	if err != nil {
+		return nil, walletError(err, "get balance")
+	}
+
+	return &pb.WalletTransactionResponse{
+		Transaction: transactionDomainToProto(t),
+		Balance:     balanceDomainToProto(balance),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package walletgrpc
+
+import (
+	"errors"
+	"fmt"
+	"lead_exchange/internal/services/wallet"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// walletError — перевод ошибок сервиса кошелька в gRPC-статусы.
+func walletError(err error, action string) error {
+	switch {
+	case errors.Is(err, wallet.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, wallet.ErrInsufficientFunds):
+		return status.Error(codes.FailedPrecondition, "not enough credits")
+	case errors.Is(err, wallet.ErrInvalidAmount):
+		return status.Error(codes.InvalidArgument, "amount must be positive")
+	case errors.Is(err, wallet.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "permission denied")
+	default:
+		return status.Error(codes.Internal, fmt.Sprintf("failed to %s: %v", action, err))
+	}
+}
@@ -0,0 +1,47 @@
+package walletgrpc
+
+import (
+	"context"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBalance — остатки кошелька текущего пользователя (или указанного, для админа).
+func (s *walletServer) GetBalance(ctx context.Context, in *pb.GetBalanceRequest) (*pb.WalletBalanceResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	userID, err := walletOwner(in.UserId, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := s.walletService.GetBalance(ctx, actor, userID)
+	if err != nil {
+		return nil, walletError(err, "get balance")
+	}
+
+	return &pb.WalletBalanceResponse{Balance: balanceDomainToProto(balance)}, nil
+}
+
+// walletOwner — чей кошелёк запрошен: указанный user_id или текущий пользователь.
+func walletOwner(requested *string, current uuid.UUID) (uuid.UUID, error) {
+	if requested == nil {
+		return current, nil
+	}
+	id, err := uuid.Parse(*requested)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid user_id format")
+	}
+	return id, nil
+}
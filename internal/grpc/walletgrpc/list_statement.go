@@ -0,0 +1,70 @@
+package walletgrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/middleware"
+	pb "lead_exchange/pkg"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListStatement — выписка по кошельку с пагинацией.
+func (s *walletServer) ListStatement(ctx context.Context, in *pb.ListStatementRequest) (*pb.ListStatementResponse, error) {
+	if err := in.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, ok := middleware.ActorFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	userID, err := walletOwner(in.UserId, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := domain.WalletStatementFilter{
+		UserID: userID,
+		Pagination: &domain.PaginationParams{
+			PageSize:  in.GetPageSize(),
+			PageToken: in.GetPageToken(),
+		},
+	}
+	if filter.From, err = parseOptionalTime(in.From); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid from: expected RFC 3339")
+	}
+	if filter.To, err = parseOptionalTime(in.To); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid to: expected RFC 3339")
+	}
+
+	result, err := s.walletService.ListStatement(ctx, actor, filter)
+	if err != nil {
+		return nil, walletError(err, "list statement")
+	}
+
+	resp := &pb.ListStatementResponse{
+		Entries:       make([]*pb.StatementEntry, 0, len(result.Items)),
+		NextPageToken: result.NextPageToken,
+		HasMore:       result.HasMore,
+		TotalCount:    result.TotalCount,
+	}
+	for _, e := range result.Items {
+		resp.Entries = append(resp.Entries, statementEntryDomainToProto(e))
+	}
+	return resp, nil
+}
+
+func parseOptionalTime(s *string) (*time.Time, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, *s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
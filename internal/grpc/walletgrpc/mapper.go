@@ -0,0 +1,81 @@
+package walletgrpc
+
+import (
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+)
+
+const timeLayout = "2006-01-02T15:04:05Z07:00"
+
+func balanceDomainToProto(b domain.WalletBalance) *pb.WalletBalance {
+	return &pb.WalletBalance{
+		UserId:    b.UserID.String(),
+		Available: b.Available,
+		Held:      b.Held,
+	}
+}
+
+func statementEntryDomainToProto(e domain.WalletStatementEntry) *pb.StatementEntry {
+	proto := &pb.StatementEntry{
+		EntryId:       e.EntryID.String(),
+		TransactionId: e.TransactionID.String(),
+		Kind:          transactionKindDomainToProto(e.Kind),
+		Account:       accountKindDomainToProto(e.AccountKind),
+		Comment:       e.Comment,
+		Amount:        e.Amount,
+		BalanceAfter:  e.BalanceAfter,
+		CreatedAt:     e.CreatedAt.Format(timeLayout),
+	}
+	if e.DealID != nil {
+		dealID := e.DealID.String()
+		proto.DealId = &dealID
+	}
+	return proto
+}
+
+// transactionDomainToProto — пополнение или вывод; сумма берётся из зачисляющей проводки.
+func transactionDomainToProto(t domain.LedgerTransaction) *pb.LedgerTransaction {
+	proto := &pb.LedgerTransaction{
+		TransactionId: t.ID.String(),
+		Kind:          transactionKindDomainToProto(t.Kind),
+		Comment:       t.Comment,
+		CreatedAt:     t.CreatedAt.Format(timeLayout),
+	}
+	if t.CreatedBy != nil {
+		proto.CreatedBy = t.CreatedBy.String()
+	}
+	for _, e := range t.Entries {
+		if e.Amount > 0 {
+			proto.Amount = e.Amount
+		}
+	}
+	return proto
+}
+
+func transactionKindDomainToProto(k domain.LedgerTransactionKind) pb.LedgerTransactionKind {
+	switch k {
+	case domain.LedgerTopUp:
+		return pb.LedgerTransactionKind_LEDGER_TRANSACTION_KIND_TOP_UP
+	case domain.LedgerWithdrawal:
+		return pb.LedgerTransactionKind_LEDGER_TRANSACTION_KIND_WITHDRAWAL
+	case domain.LedgerDealHold:
+		return pb.LedgerTransactionKind_LEDGER_TRANSACTION_KIND_DEAL_HOLD
+	case domain.LedgerDealRelease:
+		return pb.LedgerTransactionKind_LEDGER_TRANSACTION_KIND_DEAL_RELEASE
+	case domain.LedgerDealRefund:
+		return pb.LedgerTransactionKind_LEDGER_TRANSACTION_KIND_DEAL_REFUND
+	default:
+		return pb.LedgerTransactionKind_LEDGER_TRANSACTION_KIND_UNSPECIFIED
+	}
+}
+
+func accountKindDomainToProto(k domain.WalletAccountKind) pb.WalletAccountKind {
+	switch k {
+	case domain.WalletAccountAvailable:
+		return pb.WalletAccountKind_WALLET_ACCOUNT_KIND_AVAILABLE
+	case domain.WalletAccountHeld:
+		return pb.WalletAccountKind_WALLET_ACCOUNT_KIND_HELD
+	default:
+		return pb.WalletAccountKind_WALLET_ACCOUNT_KIND_UNSPECIFIED
+	}
+}
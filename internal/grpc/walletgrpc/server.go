@@ -0,0 +1,31 @@
+package walletgrpc
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// WalletService описывает бизнес-логику кредитного кошелька.
+type WalletService interface {
+	GetBalance(ctx context.Context, actor domain.Actor, userID uuid.UUID) (domain.WalletBalance, error)
+	ListStatement(ctx context.Context, actor domain.Actor, filter domain.WalletStatementFilter) (*domain.PaginatedResult[domain.WalletStatementEntry], error)
+	TopUp(ctx context.Context, actor domain.Actor, userID uuid.UUID, amount int64, comment string) (domain.LedgerTransaction, error)
+	Withdraw(ctx context.Context, actor domain.Actor, userID uuid.UUID, amount int64, comment string) (domain.LedgerTransaction, error)
+}
+
+// walletServer реализует gRPC WalletServiceServer.
+type walletServer struct {
+	pb.UnimplementedWalletServiceServer
+	walletService WalletService
+}
+
+// RegisterWalletServerGRPC регистрирует WalletServiceServer в gRPC сервере.
+func RegisterWalletServerGRPC(server *grpc.Server, svc WalletService) {
+	pb.RegisterWalletServiceServer(server, &walletServer{
+		walletService: svc,
+	})
+}
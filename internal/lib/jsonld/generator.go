@@ -44,6 +44,9 @@ type RealEstateListing struct {
 	// Изображения
 	Image           []string         `json:"image,omitempty"`
 
+	// Особенности объекта (балкон, парковка…)
+	AmenityFeature  []LocationFeatureSpecification `json:"amenityFeature,omitempty"`
+
 	// Дополнительные свойства
 	AdditionalProperty []PropertyValue `json:"additionalProperty,omitempty"`
 }
@@ -80,6 +83,13 @@ type QuantitativeValue struct {
 	UnitText string  `json:"unitText,omitempty"`
 }
 
+// LocationFeatureSpecification — особенность объекта по schema.org.
+type LocationFeatureSpecification struct {
+	Type  string `json:"@type"`
+	Name  string `json:"name"`
+	Value bool   `json:"value"`
+}
+
 // PropertyValue — дополнительное свойство.
 type PropertyValue struct {
 	Type  string      `json:"@type"`
@@ -138,12 +148,41 @@ func (g *Generator) GeneratePropertyJSONLD(property domain.Property, baseURL str
 	// Тип недвижимости (текстовый)
 	listing.PropertyType = g.mapPropertyTypeText(property.PropertyType)
 
+	// Координаты
+	if property.HasCoordinates() {
+		g.SetGeoCoordinates(listing, *property.Latitude, *property.Longitude)
+	}
+
+	// Характеристики без отдельного поля в schema.org
+	if property.District != nil && *property.District != "" {
+		g.AddAdditionalProperties(listing, map[string]interface{}{"district": *property.District})
+	}
+	if property.TotalFloors != nil {
+		g.AddAdditionalProperties(listing, map[string]interface{}{"numberOfFloors": *property.TotalFloors})
+	}
+
+	for _, feature := range property.Features {
+		listing.AmenityFeature = append(listing.AmenityFeature, LocationFeatureSpecification{
+			Type:  "LocationFeatureSpecification",
+			Name:  feature,
+			Value: true,
+		})
+	}
+
 	return listing, nil
 }
 
+// document — JSON-LD документ объекта: для квартир Apartment с этажом и годом постройки.
+func (g *Generator) document(property domain.Property, baseURL string) (interface{}, error) {
+	if property.PropertyType == domain.PropertyTypeApartment {
+		return g.GenerateApartmentJSONLD(property, baseURL, nil, nil, property.Floor, property.YearBuilt)
+	}
+	return g.GeneratePropertyJSONLD(property, baseURL)
+}
+
 // GeneratePropertyJSONLDString генерирует JSON-LD строку.
 func (g *Generator) GeneratePropertyJSONLDString(property domain.Property, baseURL string) (string, error) {
-	listing, err := g.GeneratePropertyJSONLD(property, baseURL)
+	listing, err := g.document(property, baseURL)
 	if err != nil {
 		return "", err
 	}
@@ -158,7 +197,7 @@ func (g *Generator) GeneratePropertyJSONLDString(property domain.Property, baseU
 
 // GeneratePropertyJSONLDBytes генерирует JSON-LD в байтах.
 func (g *Generator) GeneratePropertyJSONLDBytes(property domain.Property, baseURL string) ([]byte, error) {
-	listing, err := g.GeneratePropertyJSONLD(property, baseURL)
+	listing, err := g.document(property, baseURL)
 	if err != nil {
 		return nil, err
 	}
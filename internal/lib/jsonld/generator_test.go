@@ -0,0 +1,62 @@
+package jsonld
+
+import (
+	"encoding/json"
+	"lead_exchange/internal/domain"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestGeneratePropertyJSONLDBytes_StructuredAttributes(t *testing.T) {
+	floor, totalFloors, yearBuilt := int32(5), int32(17), int32(2015)
+	district := "Хамовники"
+	lat, lon := 55.7298, 37.5726
+
+	data, err := NewGenerator().GeneratePropertyJSONLDBytes(domain.Property{
+		ID:           uuid.New(),
+		Title:        "Двушка у парка",
+		PropertyType: domain.PropertyTypeApartment,
+		Floor:        &floor,
+		TotalFloors:  &totalFloors,
+		YearBuilt:    &yearBuilt,
+		District:     &district,
+		Latitude:     &lat,
+		Longitude:    &lon,
+		Features:     []string{"балкон"},
+	}, "https://example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var doc Apartment
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("invalid JSON-LD: %v", err)
+	}
+
+	if doc.Type != "Apartment" {
+		t.Errorf("expected @type Apartment, got %q", doc.Type)
+	}
+	if doc.FloorLevel == nil || *doc.FloorLevel != 5 || doc.YearBuilt == nil || *doc.YearBuilt != 2015 {
+		t.Errorf("expected floor and year built, got floor=%v year=%v", doc.FloorLevel, doc.YearBuilt)
+	}
+	if doc.Geo == nil || doc.Geo.Latitude != lat || doc.Geo.Longitude != lon {
+		t.Errorf("expected geo coordinates, got %+v", doc.Geo)
+	}
+	if len(doc.AmenityFeature) != 1 || doc.AmenityFeature[0].Name != "балкон" {
+		t.Errorf("expected amenity feature, got %+v", doc.AmenityFeature)
+	}
+	if len(doc.AdditionalProperty) != 2 {
+		t.Errorf("expected district and number of floors, got %+v", doc.AdditionalProperty)
+	}
+}
+
+func TestGeneratePropertyJSONLD_NoCoordinates(t *testing.T) {
+	listing, err := NewGenerator().GeneratePropertyJSONLD(domain.Property{ID: uuid.New(), PropertyType: domain.PropertyTypeHouse}, "https://example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if listing.Geo != nil {
+		t.Errorf("expected no geo without coordinates, got %+v", listing.Geo)
+	}
+}
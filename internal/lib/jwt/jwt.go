@@ -1,18 +1,25 @@
 package jwt
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"lead_exchange/internal/domain"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 // NewToken creates new JWT token for given user and app.
-func NewToken(user domain.User, secret string, duration time.Duration) (string, error) {
+// jti identifies the token so that it can be revoked before it expires.
+func NewToken(user domain.User, jti uuid.UUID, secret string, duration time.Duration) (string, error) {
 	token := jwt.New(jwt.SigningMethodHS256)
 
 	claims := token.Claims.(jwt.MapClaims)
 	claims["uid"] = user.ID.String()
+	claims["jti"] = jti.String()
 	claims["exp"] = time.Now().Add(duration).Unix()
 
 	tokenString, err := token.SignedString([]byte(secret))
@@ -22,3 +29,18 @@ func NewToken(user domain.User, secret string, duration time.Duration) (string,
 
 	return tokenString, nil
 }
+
+// NewOpaqueToken generates opaque random token (refresh token, password reset or email verification token).
+func NewOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashOpaqueToken returns hash under which opaque token is stored.
+func HashOpaqueToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
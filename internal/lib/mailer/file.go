@@ -0,0 +1,65 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/config"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// fileMailer сохраняет письма в .eml файлы вместо отправки — для локальной разработки.
+type fileMailer struct {
+	dir  string
+	from string
+	log  *slog.Logger
+}
+
+func newFileMailer(cfg config.MailerConfig, log *slog.Logger) *fileMailer {
+	return &fileMailer{dir: cfg.FileDir, from: cfg.From, log: log}
+}
+
+// Send записывает письмо в файл <время>_<получатель>.eml.
+func (m *fileMailer) Send(ctx context.Context, msg Message) error {
+	const op = "mailer.File.Send"
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	name := fmt.Sprintf("%s_%s.eml", time.Now().Format("20060102T150405.000000000"), sanitizeFileName(msg.To))
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, buildMessage(m.from, msg), 0o644); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	m.log.Info("mail saved to file", slog.String("to", msg.To), slog.String("subject", msg.Subject), slog.String("path", path))
+	return nil
+}
+
+// sanitizeFileName — адрес получателя без символов, недопустимых в имени файла.
+func sanitizeFileName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == ':' || r == ' ' {
+			return '_'
+		}
+		return r
+	}, s)
+}
+
+// logMailer только пишет письма в лог — для локальной разработки и тестов.
+type logMailer struct {
+	log *slog.Logger
+}
+
+func (m *logMailer) Send(ctx context.Context, msg Message) error {
+	m.log.Info("mail is not sent, MAILER_DRIVER=log",
+		slog.String("to", msg.To),
+		slog.String("subject", msg.Subject),
+		slog.String("body", msg.Body),
+	)
+	return nil
+}
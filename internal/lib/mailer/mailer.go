@@ -0,0 +1,44 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/config"
+	"log/slog"
+)
+
+// Mailer — отправка писем пользователям.
+type Mailer interface {
+	// Send отправляет письмо.
+	Send(ctx context.Context, msg Message) error
+}
+
+// Message — текстовое письмо одному получателю.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+const (
+	DriverSMTP = "smtp"
+	DriverFile = "file"
+	DriverLog  = "log"
+)
+
+// New создаёт Mailer по MAILER_DRIVER. file и log предназначены для локальной разработки.
+func New(cfg config.MailerConfig, log *slog.Logger) (Mailer, error) {
+	switch cfg.Driver {
+	case DriverSMTP:
+		if cfg.SMTPHost == "" {
+			return nil, fmt.Errorf("mailer: SMTP_HOST is required for smtp driver")
+		}
+		return newSMTPMailer(cfg), nil
+	case DriverFile:
+		return newFileMailer(cfg, log), nil
+	case DriverLog, "":
+		return &logMailer{log: log}, nil
+	default:
+		return nil, fmt.Errorf("mailer: unknown driver %q", cfg.Driver)
+	}
+}
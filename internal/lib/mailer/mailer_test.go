@@ -0,0 +1,56 @@
+package mailer
+
+import (
+	"context"
+	"lead_exchange/internal/config"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNew_Drivers(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	if _, err := New(config.MailerConfig{Driver: DriverSMTP}, log); err == nil {
+		t.Error("expected error for smtp driver without host")
+	}
+	if _, err := New(config.MailerConfig{Driver: "pigeon"}, log); err == nil {
+		t.Error("expected error for unknown driver")
+	}
+	if m, err := New(config.MailerConfig{Driver: DriverSMTP, SMTPHost: "smtp.example.ru", SMTPPort: 587}, log); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	} else if m.(*smtpMailer).addr != "smtp.example.ru:587" {
+		t.Errorf("unexpected smtp address %s", m.(*smtpMailer).addr)
+	}
+}
+
+func TestFileMailer_Send(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	dir := t.TempDir()
+
+	m, err := New(config.MailerConfig{Driver: DriverFile, FileDir: dir, From: "noreply@example.ru"}, log)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	msg := Message{To: "broker@example.ru", Subject: "Сброс пароля", Body: "Ссылка: https://example.ru/reset-password?token=abc"}
+	if err := m.Send(context.Background(), msg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("expected 1 mail file, got %d (err=%v)", len(files), err)
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatalf("failed to read mail: %v", err)
+	}
+	for _, want := range []string{"To: broker@example.ru", "From: noreply@example.ru", "Subject: =?utf-8?q?", msg.Body} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("expected mail to contain %q, got:\n%s", want, data)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"lead_exchange/internal/config"
 	"lead_exchange/internal/domain"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -14,6 +15,11 @@ type Client interface {
 	InitMinio(MinioConfig config.MinioConfig) error              // Метод для инициализации подключения к Minio
 	CreateOne(file domain.FileDataType) (string, error)          // Метод для создания одного объекта в бакете Minio
 	CreateMany(map[string]domain.FileDataType) ([]string, error) // Метод для создания нескольких объектов в бакете Minio
+
+	PutObject(ctx context.Context, objectName string, data []byte, contentType string) error   // Загрузка объекта под заданным именем
+	PresignedURL(ctx context.Context, objectName string, expiry time.Duration) (string, error) // Временная ссылка на чтение объекта
+	RemoveObject(ctx context.Context, objectName string) error                                 // Удаление объекта
+	GetObject(ctx context.Context, objectName string) ([]byte, error)                          // Чтение объекта целиком
 }
 
 // minioClient реализация интерфейса MinioClient
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"lead_exchange/internal/domain"
 	"sync"
 	"time"
@@ -87,3 +88,46 @@ func (m *minioClient) CreateMany(data map[string]domain.FileDataType) ([]string,
 
 	return urls, nil
 }
+
+// PutObject загружает объект под заданным именем (путь внутри бакета, например properties/<id>/<file>).
+func (m *minioClient) PutObject(ctx context.Context, objectName string, data []byte, contentType string) error {
+	_, err := m.mc.PutObject(ctx, m.minioConfig.BucketName, objectName, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("ошибка при создании объекта %s: %w", objectName, err)
+	}
+	return nil
+}
+
+// PresignedURL возвращает временную ссылку на чтение объекта.
+func (m *minioClient) PresignedURL(ctx context.Context, objectName string, expiry time.Duration) (string, error) {
+	url, err := m.mc.PresignedGetObject(ctx, m.minioConfig.BucketName, objectName, expiry, nil)
+	if err != nil {
+		return "", fmt.Errorf("ошибка при создании URL для объекта %s: %w", objectName, err)
+	}
+	return url.String(), nil
+}
+
+// RemoveObject удаляет объект из бакета. Отсутствующий объект ошибкой не считается.
+func (m *minioClient) RemoveObject(ctx context.Context, objectName string) error {
+	if err := m.mc.RemoveObject(ctx, m.minioConfig.BucketName, objectName, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("ошибка при удалении объекта %s: %w", objectName, err)
+	}
+	return nil
+}
+
+// GetObject читает объект из бакета целиком (фотографии объектов ограничены 5 МБ).
+func (m *minioClient) GetObject(ctx context.Context, objectName string) ([]byte, error) {
+	obj, err := m.mc.GetObject(ctx, m.minioConfig.BucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при чтении объекта %s: %w", objectName, err)
+	}
+	defer obj.Close()
+
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при чтении объекта %s: %w", objectName, err)
+	}
+	return data, nil
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
 	"log/slog"
 )
 
@@ -138,7 +139,7 @@ func (c *client) AnalyzeImages(ctx context.Context, images [][]byte) (*PropertyI
 		analyses = append(analyses, analysis)
 	}
 
-	return c.aggregateAnalyses(analyses), nil
+	return Aggregate(analyses), nil
 }
 
 // AnalyzeImageURL анализирует изображение по URL.
@@ -212,8 +213,8 @@ func (c *client) sendRequest(ctx context.Context, endpoint string, req visionReq
 	return &result, nil
 }
 
-// aggregateAnalyses агрегирует результаты анализа нескольких изображений.
-func (c *client) aggregateAnalyses(analyses []*ImageAnalysis) *PropertyImageAnalysis {
+// Aggregate агрегирует результаты анализа нескольких изображений объекта.
+func Aggregate(analyses []*ImageAnalysis) *PropertyImageAnalysis {
 	if len(analyses) == 0 {
 		return &PropertyImageAnalysis{}
 	}
@@ -265,16 +266,16 @@ func (c *client) aggregateAnalyses(analyses []*ImageAnalysis) *PropertyImageAnal
 	}
 
 	// Генерируем общую оценку
-	result.OverallAssessment = c.generateAssessment(result)
+	result.OverallAssessment = generateAssessment(result)
 
 	// Генерируем embedding features
-	result.EmbeddingFeatures = c.generateEmbeddingFeatures(result)
+	result.EmbeddingFeatures = generateEmbeddingFeatures(result)
 
 	return result
 }
 
 // generateAssessment генерирует текстовую оценку объекта.
-func (c *client) generateAssessment(analysis *PropertyImageAnalysis) string {
+func generateAssessment(analysis *PropertyImageAnalysis) string {
 	var parts []string
 
 	if analysis.AverageQuality >= 0.8 {
@@ -319,17 +320,17 @@ func (c *client) generateAssessment(analysis *PropertyImageAnalysis) string {
 }
 
 // generateEmbeddingFeatures генерирует числовые признаки для эмбеддинга.
-func (c *client) generateEmbeddingFeatures(analysis *PropertyImageAnalysis) []float64 {
-	// Генерируем вектор из 16 признаков
-	features := make([]float64, 16)
+func generateEmbeddingFeatures(analysis *PropertyImageAnalysis) []float64 {
+	// Вектор из 16 признаков; раскладка общая с предпочтениями лидов (domain.VisualPreferenceVector)
+	features := make([]float64, domain.VisualFeatureCount)
 
 	// 0: Quality score
-	features[0] = analysis.AverageQuality
+	features[domain.VisualQuality] = analysis.AverageQuality
 
 	// 1: Number of rooms (normalized)
-	features[1] = float64(len(analysis.DetectedRooms)) / 10.0
-	if features[1] > 1.0 {
-		features[1] = 1.0
+	features[domain.VisualRoomVariety] = float64(len(analysis.DetectedRooms)) / 10.0
+	if features[domain.VisualRoomVariety] > 1.0 {
+		features[domain.VisualRoomVariety] = 1.0
 	}
 
 	// 2: Number of premium features (normalized)
@@ -339,34 +340,28 @@ func (c *client) generateEmbeddingFeatures(analysis *PropertyImageAnalysis) []fl
 			premiumCount++
 		}
 	}
-	features[2] = float64(premiumCount) / 5.0
-	if features[2] > 1.0 {
-		features[2] = 1.0
+	features[domain.VisualPremium] = float64(premiumCount) / 5.0
+	if features[domain.VisualPremium] > 1.0 {
+		features[domain.VisualPremium] = 1.0
 	}
 
 	// 3: Has good view
 	for _, v := range analysis.ViewTypes {
 		if v == "park" || v == "river" || v == "panorama" {
-			features[3] = 1.0
+			features[domain.VisualGoodView] = 1.0
 			break
 		}
 	}
 
 	// 4-15: Feature presence flags
-	featureNames := []string{
-		"balcony", "terrace", "panoramic_windows", "high_ceilings",
-		"modern_kitchen", "master_bedroom", "walk_in_closet", "bathroom_modern",
-		"parking", "security", "gym", "pool",
-	}
-
 	featureSet := make(map[string]bool)
 	for _, f := range analysis.AllFeatures {
 		featureSet[f.Name] = true
 	}
 
-	for i, name := range featureNames {
+	for i, name := range domain.VisualFeatureFlags {
 		if featureSet[name] {
-			features[4+i] = 1.0
+			features[domain.VisualFlagsOffset+i] = 1.0
 		}
 	}
 
@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UserLookup — источник актуальной роли пользователя.
+type UserLookup interface {
+	GetProfile(ctx context.Context, userID uuid.UUID) (domain.User, error)
+}
+
+// MethodPolicy — правило доступа к gRPC-методу.
+type MethodPolicy struct {
+	// Roles — роли, которым разрешён вызов
+	Roles []domain.UserRole
+	// Applies — если задано, правило действует только для запросов, на которых вернула true
+	Applies func(req interface{}) bool
+}
+
+// DefaultPolicies — правила доступа к административным методам.
+func DefaultPolicies() map[string]MethodPolicy {
+	adminOnly := MethodPolicy{Roles: []domain.UserRole{domain.UserRoleAdmin}}
+
+	return map[string]MethodPolicy{
+		"/leadexchange.v1.UserService/UpdateUserStatus":    adminOnly,
+		"/leadexchange.v1.UserService/ListUsers":           adminOnly,
+		"/leadexchange.v1.LeadService/ReindexLead":         adminOnly,
+		"/leadexchange.v1.PropertyService/ReindexProperty": adminOnly,
+		"/leadexchange.v1.AdminService/ReindexAll":         adminOnly,
+		"/leadexchange.v1.WalletService/TopUp":             adminOnly,
+		"/leadexchange.v1.WalletService/Withdraw":          adminOnly,
+		// Удалять лиды (переводить в DELETED) может только админ
+		"/leadexchange.v1.LeadService/UpdateLead": {
+			Roles: []domain.UserRole{domain.UserRoleAdmin},
+			Applies: func(req interface{}) bool {
+				r, ok := req.(*pb.UpdateLeadRequest)
+				return ok && r.Status != nil && *r.Status == pb.LeadStatus_LEAD_STATUS_DELETED
+			},
+		},
+	}
+}
+
+const roleKey ctxKey = "userRole"
+
+// RoleFromContext — роль текущего пользователя, определённая JWTUnaryInterceptor
+// при проверке отзыва токена или AuthzUnaryInterceptor.
+func RoleFromContext(ctx context.Context) domain.UserRole {
+	role, _ := ctx.Value(roleKey).(domain.UserRole)
+	return role
+}
+
+// ActorFromContext — пользователь и его роль для проверок доступа в сервисах.
+func ActorFromContext(ctx context.Context) (domain.Actor, bool) {
+	userID, ok := FromContext(ctx)
+	if !ok {
+		return domain.Actor{}, false
+	}
+	return domain.Actor{UserID: userID, Role: RoleFromContext(ctx)}, true
+}
+
+// AuthzUnaryInterceptor проверяет роль пользователя по политикам методов.
+// Должен стоять в цепочке после JWTUnaryInterceptor. Роль берётся из контекста, куда её
+// положила проверка отзыва токена, а если её там нет (dev-токены, DISABLE_AUTH) — читается
+// из репозитория пользователей. В обоих случаях это актуальная роль из БД, поэтому понижение
+// прав вступает в силу сразу, без перевыпуска токена.
+// Для остальных методов роль кладётся в контекст для проверок владения в сервисах.
+func AuthzUnaryInterceptor(users UserLookup, policies map[string]MethodPolicy) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		policy, guarded := policies[info.FullMethod]
+		if guarded && policy.Applies != nil && !policy.Applies(req) {
+			guarded = false
+		}
+
+		ctx, err := authorize(ctx, users, info.FullMethod, policy, guarded)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthzStreamInterceptor — то же, что AuthzUnaryInterceptor, для server-streaming методов.
+// Запрос на момент проверки ещё не прочитан, поэтому политика с Applies применяется всегда.
+func AuthzStreamInterceptor(users UserLookup, policies map[string]MethodPolicy) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		policy, guarded := policies[info.FullMethod]
+
+		ctx, err := authorize(ss.Context(), users, info.FullMethod, policy, guarded)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorize кладёт роль пользователя в контекст и, если метод защищён, проверяет её по политике.
+func authorize(ctx context.Context, users UserLookup, method string, policy MethodPolicy, guarded bool) (context.Context, error) {
+	userID, ok := FromContext(ctx)
+	if !ok {
+		if guarded {
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+		return ctx, nil
+	}
+
+	role, ok := ctx.Value(roleKey).(domain.UserRole)
+	if !ok {
+		user, err := users.GetProfile(ctx, userID)
+		if err != nil {
+			if guarded {
+				slog.Warn("failed to resolve user role", "userID", userID, "method", method, "error", err)
+				return nil, status.Error(codes.PermissionDenied, "permission denied")
+			}
+			return ctx, nil
+		}
+		role = user.Role
+		ctx = context.WithValue(ctx, roleKey, role)
+	}
+
+	if !guarded {
+		return ctx, nil
+	}
+
+	for _, allowed := range policy.Roles {
+		if role == allowed {
+			return ctx, nil
+		}
+	}
+
+	slog.Warn("access denied", "userID", userID, "role", role, "method", method)
+	return nil, status.Error(codes.PermissionDenied, "permission denied")
+}
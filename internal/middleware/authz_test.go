@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	pb "lead_exchange/pkg"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockUserLookup
+type MockUserLookup struct {
+	GetProfileFunc func(ctx context.Context, userID uuid.UUID) (domain.User, error)
+}
+
+func (m *MockUserLookup) GetProfile(ctx context.Context, userID uuid.UUID) (domain.User, error) {
+	if m.GetProfileFunc != nil {
+		return m.GetProfileFunc(ctx, userID)
+	}
+	return domain.User{}, nil
+}
+
+func TestAuthzUnaryInterceptor(t *testing.T) {
+	adminID := uuid.New()
+	userID := uuid.New()
+
+	users := &MockUserLookup{
+		GetProfileFunc: func(ctx context.Context, id uuid.UUID) (domain.User, error) {
+			switch id {
+			case adminID:
+				return domain.User{ID: id, Role: domain.UserRoleAdmin}, nil
+			case userID:
+				return domain.User{ID: id, Role: domain.UserRoleUser}, nil
+			}
+			return domain.User{}, errors.New("user not found")
+		},
+	}
+	interceptor := AuthzUnaryInterceptor(users, DefaultPolicies())
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	deleted := pb.LeadStatus_LEAD_STATUS_DELETED
+	published := pb.LeadStatus_LEAD_STATUS_PUBLISHED
+
+	tests := []struct {
+		name     string
+		method   string
+		req      interface{}
+		userID   *uuid.UUID
+		wantCode codes.Code
+	}{
+		{"admin bans user", "/leadexchange.v1.UserService/UpdateUserStatus", &pb.UpdateUserStatusRequest{}, &adminID, codes.OK},
+		{"user cannot ban", "/leadexchange.v1.UserService/UpdateUserStatus", &pb.UpdateUserStatusRequest{}, &userID, codes.PermissionDenied},
+		{"user cannot list users", "/leadexchange.v1.UserService/ListUsers", &pb.ListUsersRequest{}, &userID, codes.PermissionDenied},
+		{"user cannot reindex property", "/leadexchange.v1.PropertyService/ReindexProperty", &pb.ReindexPropertyRequest{}, &userID, codes.PermissionDenied},
+		{"user cannot delete lead", "/leadexchange.v1.LeadService/UpdateLead", &pb.UpdateLeadRequest{Status: &deleted}, &userID, codes.PermissionDenied},
+		{"user publishes lead", "/leadexchange.v1.LeadService/UpdateLead", &pb.UpdateLeadRequest{Status: &published}, &userID, codes.OK},
+		{"user cannot top up wallet", "/leadexchange.v1.WalletService/TopUp", &pb.TopUpRequest{}, &userID, codes.PermissionDenied},
+		{"admin tops up wallet", "/leadexchange.v1.WalletService/TopUp", &pb.TopUpRequest{}, &adminID, codes.OK},
+		{"unguarded method", "/leadexchange.v1.LeadService/GetLead", &pb.GetLeadRequest{}, &userID, codes.OK},
+		{"unknown user", "/leadexchange.v1.UserService/ListUsers", &pb.ListUsersRequest{}, lo.ToPtr(uuid.New()), codes.PermissionDenied},
+		{"no user in context", "/leadexchange.v1.UserService/ListUsers", &pb.ListUsersRequest{}, nil, codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.userID != nil {
+				ctx = context.WithValue(ctx, userIDKey, *tt.userID)
+			}
+
+			_, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("expected code %s, got %s (%v)", tt.wantCode, code, err)
+			}
+		})
+	}
+}
+
+func TestAuthzUnaryInterceptor_PutsRoleIntoContext(t *testing.T) {
+	adminID := uuid.New()
+	users := &MockUserLookup{
+		GetProfileFunc: func(ctx context.Context, id uuid.UUID) (domain.User, error) {
+			return domain.User{ID: id, Role: domain.UserRoleAdmin}, nil
+		},
+	}
+	interceptor := AuthzUnaryInterceptor(users, DefaultPolicies())
+
+	ctx := context.WithValue(context.Background(), userIDKey, adminID)
+	info := &grpc.UnaryServerInfo{FullMethod: "/leadexchange.v1.PropertyService/UpdateProperty"}
+
+	_, err := interceptor(ctx, &pb.UpdatePropertyRequest{}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		actor, ok := ActorFromContext(ctx)
+		if !ok || actor.UserID != adminID || !actor.IsAdmin() {
+			t.Errorf("expected admin actor in context, got %+v", actor)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestAuthzUnaryInterceptor_UsesRoleFromContext(t *testing.T) {
+	userID := uuid.New()
+	users := &MockUserLookup{
+		GetProfileFunc: func(ctx context.Context, id uuid.UUID) (domain.User, error) {
+			t.Error("expected role from context without user lookup")
+			return domain.User{}, errors.New("unexpected lookup")
+		},
+	}
+	interceptor := AuthzUnaryInterceptor(users, DefaultPolicies())
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/leadexchange.v1.UserService/ListUsers"}
+
+	ctx := context.WithValue(context.Background(), userIDKey, userID)
+
+	adminCtx := context.WithValue(ctx, roleKey, domain.UserRoleAdmin)
+	if _, err := interceptor(adminCtx, &pb.ListUsersRequest{}, info, handler); err != nil {
+		t.Errorf("expected admin to pass, got %v", err)
+	}
+
+	userCtx := context.WithValue(ctx, roleKey, domain.UserRoleUser)
+	if _, err := interceptor(userCtx, &pb.ListUsersRequest{}, info, handler); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied, got %v", err)
+	}
+}
+
+// mockServerStream — ServerStream с заданным контекстом.
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (m *mockServerStream) Context() context.Context {
+	return m.ctx
+}
+
+func TestAuthzStreamInterceptor(t *testing.T) {
+	adminID := uuid.New()
+	userID := uuid.New()
+
+	users := &MockUserLookup{
+		GetProfileFunc: func(ctx context.Context, id uuid.UUID) (domain.User, error) {
+			if id == adminID {
+				return domain.User{ID: id, Role: domain.UserRoleAdmin}, nil
+			}
+			return domain.User{ID: id, Role: domain.UserRoleUser}, nil
+		},
+	}
+	interceptor := AuthzStreamInterceptor(users, DefaultPolicies())
+	info := &grpc.StreamServerInfo{FullMethod: "/leadexchange.v1.AdminService/ReindexAll", IsServerStream: true}
+
+	tests := []struct {
+		name     string
+		userID   *uuid.UUID
+		wantCode codes.Code
+	}{
+		{"admin reindexes", &adminID, codes.OK},
+		{"user cannot reindex", &userID, codes.PermissionDenied},
+		{"no user in context", nil, codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.userID != nil {
+				ctx = context.WithValue(ctx, userIDKey, *tt.userID)
+			}
+
+			err := interceptor(nil, &mockServerStream{ctx: ctx}, info, func(srv interface{}, stream grpc.ServerStream) error {
+				// Роль доступна обработчику через контекст стрима
+				if actor, ok := ActorFromContext(stream.Context()); !ok || !actor.IsAdmin() {
+					t.Errorf("expected admin actor in stream context, got %+v", actor)
+				}
+				return nil
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("expected code %s, got %s (%v)", tt.wantCode, code, err)
+			}
+		})
+	}
+}
@@ -3,15 +3,33 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"lead_exchange/internal/domain"
 	"log/slog"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+// TokenRevocationChecker — проверка, что access-токен не отозван до истечения срока
+// (пользователь заблокирован или вышел из сессии). Заодно возвращает текущую роль
+// пользователя, чтобы AuthzUnaryInterceptor не читал её повторно.
+type TokenRevocationChecker interface {
+	CheckAccessToken(ctx context.Context, userID uuid.UUID, jti uuid.UUID) (role domain.UserRole, revoked bool, err error)
+}
+
+// DevIdentityResolver — пользователь для отладочного токена dev:<email> или dev:<роль>.
+type DevIdentityResolver interface {
+	ResolveDevIdentity(ctx context.Context, identity string) (uuid.UUID, error)
+}
+
+// devTokenPrefix — префикс отладочных токенов: Bearer dev:admin@m.c, Bearer dev:admin.
+const devTokenPrefix = "dev:"
+
 type ctxKey string
 
 const userIDKey ctxKey = "userID"
@@ -21,32 +39,82 @@ func FromContext(ctx context.Context) (uuid.UUID, bool) {
 	return id, ok
 }
 
-func JWTUnaryInterceptor(secret string, disableAuth bool) grpc.UnaryServerInterceptor {
+// JWTUnaryInterceptor проверяет токен и кладёт userID в контекст.
+// revocation может быть nil — тогда токен действителен до exp.
+// devIdentities включает отладочные токены dev:...; передаётся только при ENV=local, иначе nil.
+func JWTUnaryInterceptor(secret string, disableAuth bool, revocation TokenRevocationChecker, devIdentities DevIdentityResolver) grpc.UnaryServerInterceptor {
+	authenticate := jwtAuthenticator(secret, disableAuth, revocation, devIdentities)
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, err := authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// JWTStreamInterceptor — то же, что JWTUnaryInterceptor, для server-streaming методов.
+func JWTStreamInterceptor(secret string, disableAuth bool, revocation TokenRevocationChecker, devIdentities DevIdentityResolver) grpc.StreamServerInterceptor {
+	authenticate := jwtAuthenticator(secret, disableAuth, revocation, devIdentities)
+
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// contextStream — ServerStream с контекстом, дополненным интерсептором.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// jwtAuthenticator проверяет токен из metadata и кладёт userID в контекст.
+func jwtAuthenticator(secret string, disableAuth bool, revocation TokenRevocationChecker, devIdentities DevIdentityResolver) func(ctx context.Context, method string) (context.Context, error) {
 	// Список методов, для которых токен не нужен
 	whitelist := map[string]struct{}{
 		"/leadexchange.v1.AuthService/Login":       {},
 		"/leadexchange.v1.AuthService/Register":    {},
 		"/leadexchange.v1.AuthService/HealthCheck": {},
+		// Refresh и Logout авторизуются refresh-токеном: access-токен к этому моменту может истечь
+		"/leadexchange.v1.AuthService/Refresh": {},
+		"/leadexchange.v1.AuthService/Logout":  {},
+		// Сброс пароля и подтверждение email авторизуются токеном из письма
+		"/leadexchange.v1.AuthService/RequestPasswordReset": {},
+		"/leadexchange.v1.AuthService/ConfirmPasswordReset": {},
+		"/leadexchange.v1.AuthService/VerifyEmail":          {},
 	}
 
-	// Тестовый user ID для использования когда auth отключен
+	// Тестовый user ID для использования когда auth отключен (DISABLE_AUTH, только при ENV=local)
 	testUserID := uuid.MustParse("8c6f9c70-9312-4f17-94b0-2a2b9230f5d1")
 
-	return func(
-		ctx context.Context,
-		req interface{},
-		info *grpc.UnaryServerInfo,
-		handler grpc.UnaryHandler,
-	) (interface{}, error) {
+	return func(ctx context.Context, method string) (context.Context, error) {
 		// Если auth отключен, используем тестовый user ID
 		if disableAuth {
-			slog.Warn("Auth disabled, using test user ID", "userID", testUserID, "method", info.FullMethod)
-			ctx = context.WithValue(ctx, userIDKey, testUserID)
-			return handler(ctx, req)
+			slog.Warn("Auth disabled, using test user ID", "userID", testUserID, "method", method)
+			return context.WithValue(ctx, userIDKey, testUserID), nil
 		}
 
-		if _, ok := whitelist[info.FullMethod]; ok {
-			return handler(ctx, req)
+		if _, ok := whitelist[method]; ok {
+			return ctx, nil
 		}
 
 		md, ok := metadata.FromIncomingContext(ctx)
@@ -66,10 +134,20 @@ func JWTUnaryInterceptor(secret string, disableAuth bool) grpc.UnaryServerInterc
 
 		tokenString := parts[1]
 
-		if tokenString == "test" {
-			slog.Warn("Using test token, falling back to test user ID", "userID", testUserID, "method", info.FullMethod)
-			ctx = context.WithValue(ctx, userIDKey, testUserID)
-			return handler(ctx, req)
+		if identity, ok := strings.CutPrefix(tokenString, devTokenPrefix); ok {
+			if devIdentities == nil {
+				return nil, fmt.Errorf("dev identities are disabled")
+			}
+
+			uid, err := devIdentities.ResolveDevIdentity(ctx, identity)
+			if err != nil {
+				slog.Warn("Failed to resolve dev identity", "identity", identity, "method", method, "error", err)
+				return nil, fmt.Errorf("invalid dev identity %q: %v", identity, err)
+			}
+
+			// Каждый вход без пароля логируется
+			slog.Warn("Using dev identity", "identity", identity, "userID", uid, "method", method)
+			return context.WithValue(ctx, userIDKey, uid), nil
 		}
 
 		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
@@ -98,10 +176,30 @@ func JWTUnaryInterceptor(secret string, disableAuth bool) grpc.UnaryServerInterc
 			return nil, fmt.Errorf("invalid uid in token")
 		}
 
-		slog.Debug("JWT auth successful", "userID", uid, "method", info.FullMethod)
+		if revocation != nil {
+			// Токены, выпущенные до появления jti, проверяются только по статусу пользователя
+			var jti uuid.UUID
+			if jtiStr, ok := claims["jti"].(string); ok {
+				if jti, err = uuid.Parse(jtiStr); err != nil {
+					return nil, fmt.Errorf("invalid jti in token")
+				}
+			}
+
+			role, revoked, err := revocation.CheckAccessToken(ctx, uid, jti)
+			if err != nil {
+				slog.Warn("failed to check token revocation", "userID", uid, "method", method, "error", err)
+				return nil, status.Error(codes.Unauthenticated, "unauthorized")
+			}
+			if revoked {
+				slog.Warn("revoked token rejected", "userID", uid, "method", method)
+				return nil, status.Error(codes.Unauthenticated, "token revoked")
+			}
+			ctx = context.WithValue(ctx, roleKey, role)
+		}
+
+		slog.Debug("JWT auth successful", "userID", uid, "method", method)
 
 		// Передаём userID в контекст
-		ctx = context.WithValue(ctx, userIDKey, uid)
-		return handler(ctx, req)
+		return context.WithValue(ctx, userIDKey, uid), nil
 	}
 }
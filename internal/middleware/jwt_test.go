@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/jwt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MockRevocationChecker — отозванные jti, заблокированные пользователи и роли.
+type MockRevocationChecker struct {
+	bannedUsers map[uuid.UUID]bool
+	revokedJTI  map[uuid.UUID]bool
+	roles       map[uuid.UUID]domain.UserRole
+}
+
+func (m *MockRevocationChecker) CheckAccessToken(ctx context.Context, userID uuid.UUID, jti uuid.UUID) (domain.UserRole, bool, error) {
+	return m.roles[userID], m.bannedUsers[userID] || m.revokedJTI[jti], nil
+}
+
+func TestJWTUnaryInterceptor_Revocation(t *testing.T) {
+	const secret = "secret"
+	activeID := uuid.New()
+	bannedID := uuid.New()
+	revokedJTI := uuid.New()
+
+	checker := &MockRevocationChecker{
+		bannedUsers: map[uuid.UUID]bool{bannedID: true},
+		revokedJTI:  map[uuid.UUID]bool{revokedJTI: true},
+		roles:       map[uuid.UUID]domain.UserRole{activeID: domain.UserRoleAdmin},
+	}
+	interceptor := JWTUnaryInterceptor(secret, false, checker, nil)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		if role := RoleFromContext(ctx); role != domain.UserRoleAdmin {
+			t.Errorf("expected role from revocation check in context, got %q", role)
+		}
+		return "ok", nil
+	}
+
+	tests := []struct {
+		name     string
+		userID   uuid.UUID
+		jti      uuid.UUID
+		wantCode codes.Code
+	}{
+		{"active user", activeID, uuid.New(), codes.OK},
+		{"banned user", bannedID, uuid.New(), codes.Unauthenticated},
+		{"revoked session", activeID, revokedJTI, codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := jwt.NewToken(domain.User{ID: tt.userID}, tt.jti, secret, time.Hour)
+			if err != nil {
+				t.Fatalf("failed to create token: %v", err)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+			_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/leadexchange.v1.LeadService/GetLead"}, handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("expected code %s, got %s (%v)", tt.wantCode, code, err)
+			}
+		})
+	}
+}
+
+// MockDevIdentityResolver — отладочные личности по email.
+type MockDevIdentityResolver struct {
+	users map[string]uuid.UUID
+}
+
+func (m *MockDevIdentityResolver) ResolveDevIdentity(ctx context.Context, identity string) (uuid.UUID, error) {
+	id, ok := m.users[identity]
+	if !ok {
+		return uuid.Nil, errors.New("unknown dev identity")
+	}
+	return id, nil
+}
+
+func TestJWTUnaryInterceptor_DevIdentities(t *testing.T) {
+	adminID := uuid.New()
+	resolver := &MockDevIdentityResolver{users: map[string]uuid.UUID{"admin@m.c": adminID}}
+
+	call := func(interceptor grpc.UnaryServerInterceptor, token string) (uuid.UUID, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		var got uuid.UUID
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/leadexchange.v1.LeadService/GetLead"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				got, _ = FromContext(ctx)
+				return "ok", nil
+			})
+		return got, err
+	}
+
+	enabled := JWTUnaryInterceptor("secret", false, nil, resolver)
+	if got, err := call(enabled, "dev:admin@m.c"); err != nil || got != adminID {
+		t.Errorf("expected dev identity to resolve to %s, got %s (%v)", adminID, got, err)
+	}
+	if _, err := call(enabled, "dev:nobody@m.c"); err == nil {
+		t.Error("expected unknown dev identity to be rejected")
+	}
+
+	disabled := JWTUnaryInterceptor("secret", false, nil, nil)
+	if _, err := call(disabled, "dev:admin@m.c"); err == nil {
+		t.Error("expected dev identity to be rejected when dev auth is disabled")
+	}
+	if _, err := call(disabled, "test"); err == nil {
+		t.Error("expected legacy test token to be rejected")
+	}
+}
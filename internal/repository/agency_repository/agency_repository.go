@@ -0,0 +1,410 @@
+package agency_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AgencyRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewAgencyRepository(db *pgxpool.Pool, log *slog.Logger) *AgencyRepository {
+	return &AgencyRepository{db: db, log: log}
+}
+
+// conn — соединение с учётом транзакции из контекста.
+func (r *AgencyRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
+// CreateAgency — создаёт агентство.
+func (r *AgencyRepository) CreateAgency(ctx context.Context, a domain.Agency) (uuid.UUID, error) {
+	const op = "AgencyRepository.CreateAgency"
+
+	var id uuid.UUID
+	err := r.conn(ctx).QueryRow(ctx,
+		`INSERT INTO agencies (name, created_by) VALUES ($1, $2) RETURNING agency_id`,
+		a.Name, a.CreatedBy,
+	).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// GetByID — агентство по ID.
+func (r *AgencyRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Agency, error) {
+	const op = "AgencyRepository.GetByID"
+
+	var a domain.Agency
+	err := r.conn(ctx).QueryRow(ctx,
+		`SELECT agency_id, name, created_by, created_at FROM agencies WHERE agency_id = $1`,
+		id,
+	).Scan(&a.ID, &a.Name, &a.CreatedBy, &a.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Agency{}, fmt.Errorf("%s: %w", op, repository.ErrAgencyNotFound)
+		}
+		return domain.Agency{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return a, nil
+}
+
+// AddMember — добавляет участника. Если пользователь уже состоит в агентстве, возвращает ErrAgencyMemberExists.
+func (r *AgencyRepository) AddMember(ctx context.Context, m domain.AgencyMember) error {
+	const op = "AgencyRepository.AddMember"
+
+	_, err := r.conn(ctx).Exec(ctx,
+		`INSERT INTO agency_members (agency_id, user_id, role) VALUES ($1, $2, $3)`,
+		m.AgencyID, m.UserID, m.Role.String(),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, repository.ErrAgencyMemberExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetMemberByUser — членство пользователя (пользователь состоит не более чем в одном агентстве).
+func (r *AgencyRepository) GetMemberByUser(ctx context.Context, userID uuid.UUID) (domain.AgencyMember, error) {
+	const op = "AgencyRepository.GetMemberByUser"
+
+	m, err := scanMember(r.conn(ctx).QueryRow(ctx,
+		`SELECT agency_id, user_id, role, joined_at FROM agency_members WHERE user_id = $1`,
+		userID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.AgencyMember{}, fmt.Errorf("%s: %w", op, repository.ErrAgencyMemberNotFound)
+		}
+		return domain.AgencyMember{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return m, nil
+}
+
+// ListMembers — участники агентства в порядке вступления.
+func (r *AgencyRepository) ListMembers(ctx context.Context, agencyID uuid.UUID) ([]domain.AgencyMember, error) {
+	const op = "AgencyRepository.ListMembers"
+
+	rows, err := r.conn(ctx).Query(ctx,
+		`SELECT agency_id, user_id, role, joined_at FROM agency_members WHERE agency_id = $1 ORDER BY joined_at`,
+		agencyID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var members []domain.AgencyMember
+	for rows.Next() {
+		m, err := scanMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		members = append(members, m)
+	}
+
+	return members, rows.Err()
+}
+
+// UpdateMemberRole — меняет роль участника.
+func (r *AgencyRepository) UpdateMemberRole(ctx context.Context, agencyID, userID uuid.UUID, role domain.AgencyRole) error {
+	const op = "AgencyRepository.UpdateMemberRole"
+
+	tag, err := r.conn(ctx).Exec(ctx,
+		`UPDATE agency_members SET role = $3 WHERE agency_id = $1 AND user_id = $2`,
+		agencyID, userID, role.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrAgencyMemberNotFound)
+	}
+
+	return nil
+}
+
+// RemoveMember — исключает участника из агентства.
+func (r *AgencyRepository) RemoveMember(ctx context.Context, agencyID, userID uuid.UUID) error {
+	const op = "AgencyRepository.RemoveMember"
+
+	tag, err := r.conn(ctx).Exec(ctx,
+		`DELETE FROM agency_members WHERE agency_id = $1 AND user_id = $2`,
+		agencyID, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrAgencyMemberNotFound)
+	}
+
+	return nil
+}
+
+const invitationColumns = `
+	invitation_id, agency_id, email, role, invited_by, status, expires_at, responded_at, created_at
+`
+
+// CreateInvitation — сохраняет приглашение.
+func (r *AgencyRepository) CreateInvitation(ctx context.Context, inv domain.AgencyInvitation) (uuid.UUID, error) {
+	const op = "AgencyRepository.CreateInvitation"
+
+	query := `
+		INSERT INTO agency_invitations (agency_id, email, role, invited_by, status, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING invitation_id
+	`
+
+	var id uuid.UUID
+	err := r.conn(ctx).QueryRow(ctx, query,
+		inv.AgencyID,
+		inv.Email,
+		inv.Role.String(),
+		inv.InvitedBy,
+		inv.Status.String(),
+		inv.ExpiresAt,
+	).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// GetInvitationForUpdate — приглашение по ID. Строка блокируется до конца транзакции,
+// чтобы на приглашение не ответили дважды.
+func (r *AgencyRepository) GetInvitationForUpdate(ctx context.Context, id uuid.UUID) (domain.AgencyInvitation, error) {
+	const op = "AgencyRepository.GetInvitationForUpdate"
+
+	inv, err := scanInvitation(r.conn(ctx).QueryRow(ctx,
+		`SELECT `+invitationColumns+` FROM agency_invitations WHERE invitation_id = $1 FOR UPDATE`,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.AgencyInvitation{}, fmt.Errorf("%s: %w", op, repository.ErrAgencyInvitationNotFound)
+		}
+		return domain.AgencyInvitation{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return inv, nil
+}
+
+// ListPendingByEmail — действующие приглашения на email, новые первыми.
+func (r *AgencyRepository) ListPendingByEmail(ctx context.Context, email string) ([]domain.AgencyInvitation, error) {
+	const op = "AgencyRepository.ListPendingByEmail"
+
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM agency_invitations
+		WHERE LOWER(email) = LOWER($1) AND status = 'PENDING' AND expires_at > NOW()
+		ORDER BY created_at DESC
+	`
+
+	invitations, err := r.listInvitations(ctx, query, email)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return invitations, nil
+}
+
+// ListPendingByAgency — действующие приглашения агентства, новые первыми.
+func (r *AgencyRepository) ListPendingByAgency(ctx context.Context, agencyID uuid.UUID) ([]domain.AgencyInvitation, error) {
+	const op = "AgencyRepository.ListPendingByAgency"
+
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM agency_invitations
+		WHERE agency_id = $1 AND status = 'PENDING' AND expires_at > NOW()
+		ORDER BY created_at DESC
+	`
+
+	invitations, err := r.listInvitations(ctx, query, agencyID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return invitations, nil
+}
+
+// SetInvitationStatus — закрывает приглашение ответом (принято, отклонено, отозвано).
+func (r *AgencyRepository) SetInvitationStatus(ctx context.Context, id uuid.UUID, status domain.AgencyInvitationStatus) error {
+	const op = "AgencyRepository.SetInvitationStatus"
+
+	tag, err := r.conn(ctx).Exec(ctx,
+		`UPDATE agency_invitations SET status = $2, responded_at = NOW() WHERE invitation_id = $1 AND status = 'PENDING'`,
+		id, status.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrAgencyInvitationNotFound)
+	}
+
+	return nil
+}
+
+// TeamDealReport — показатели каждого участника агентства.
+// Лиды и объекты считаются по owner_user_id (без удалённых), сделки — по продавцу и покупателю;
+// период from/to ограничивает сделки по дате последнего изменения.
+func (r *AgencyRepository) TeamDealReport(ctx context.Context, agencyID uuid.UUID, from, to *time.Time) ([]domain.AgencyMemberStats, error) {
+	const op = "AgencyRepository.TeamDealReport"
+
+	query := `
+		SELECT
+			m.user_id,
+			m.role,
+			(SELECT COUNT(*) FROM leads l WHERE l.owner_user_id = m.user_id AND l.status <> 'DELETED'),
+			(SELECT COUNT(*) FROM properties p WHERE p.owner_user_id = m.user_id AND p.status <> 'DELETED'),
+			COUNT(d.deal_id) FILTER (WHERE d.seller_user_id = m.user_id AND d.status = 'COMPLETED'),
+			COALESCE(SUM(d.price) FILTER (WHERE d.seller_user_id = m.user_id AND d.status = 'COMPLETED'), 0)::float8,
+			COUNT(d.deal_id) FILTER (WHERE d.buyer_user_id = m.user_id AND d.status = 'COMPLETED'),
+			COALESCE(SUM(d.price) FILTER (WHERE d.buyer_user_id = m.user_id AND d.status = 'COMPLETED'), 0)::float8,
+			COUNT(d.deal_id) FILTER (WHERE d.status IN ('PENDING', 'ACCEPTED'))
+		FROM agency_members m
+		LEFT JOIN deals d
+			ON (d.seller_user_id = m.user_id OR d.buyer_user_id = m.user_id)
+			AND ($2::timestamptz IS NULL OR d.updated_at >= $2)
+			AND ($3::timestamptz IS NULL OR d.updated_at < $3)
+		WHERE m.agency_id = $1
+		GROUP BY m.user_id, m.role, m.joined_at
+		ORDER BY m.joined_at
+	`
+
+	rows, err := r.conn(ctx).Query(ctx, query, agencyID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var stats []domain.AgencyMemberStats
+	for rows.Next() {
+		var s domain.AgencyMemberStats
+		var role string
+		if err := rows.Scan(
+			&s.UserID,
+			&role,
+			&s.LeadsOwned,
+			&s.PropertiesOwned,
+			&s.DealsSold,
+			&s.SoldVolume,
+			&s.DealsBought,
+			&s.BoughtVolume,
+			&s.OpenDeals,
+		); err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		s.Role = domain.AgencyRole(role)
+		stats = append(stats, s)
+	}
+
+	return stats, rows.Err()
+}
+
+// CountTeamOpenDeals — открытые сделки (PENDING и ACCEPTED), в которых продавец или покупатель
+// состоит в агентстве. Сделка между двумя участниками считается один раз; период — как в TeamDealReport.
+func (r *AgencyRepository) CountTeamOpenDeals(ctx context.Context, agencyID uuid.UUID, from, to *time.Time) (int32, error) {
+	const op = "AgencyRepository.CountTeamOpenDeals"
+
+	query := `
+		SELECT COUNT(*)
+		FROM deals d
+		WHERE d.status IN ('PENDING', 'ACCEPTED')
+			AND EXISTS (
+				SELECT 1 FROM agency_members m
+				WHERE m.agency_id = $1 AND m.user_id IN (d.seller_user_id, d.buyer_user_id)
+			)
+			AND ($2::timestamptz IS NULL OR d.updated_at >= $2)
+			AND ($3::timestamptz IS NULL OR d.updated_at < $3)
+	`
+
+	var count int32
+	if err := r.conn(ctx).QueryRow(ctx, query, agencyID, from, to).Scan(&count); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+// listInvitations — выборка приглашений по готовому запросу.
+func (r *AgencyRepository) listInvitations(ctx context.Context, query string, args ...any) ([]domain.AgencyInvitation, error) {
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invitations []domain.AgencyInvitation
+	for rows.Next() {
+		inv, err := scanInvitation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+		invitations = append(invitations, inv)
+	}
+
+	return invitations, rows.Err()
+}
+
+// scanMember — читает строку agency_members в доменную модель.
+func scanMember(row pgx.Row) (domain.AgencyMember, error) {
+	var m domain.AgencyMember
+	var role string
+	if err := row.Scan(&m.AgencyID, &m.UserID, &role, &m.JoinedAt); err != nil {
+		return domain.AgencyMember{}, err
+	}
+	m.Role = domain.AgencyRole(role)
+	return m, nil
+}
+
+// scanInvitation — читает строку agency_invitations в доменную модель.
+func scanInvitation(row pgx.Row) (domain.AgencyInvitation, error) {
+	var inv domain.AgencyInvitation
+	var role, status string
+	if err := row.Scan(
+		&inv.ID,
+		&inv.AgencyID,
+		&inv.Email,
+		&role,
+		&inv.InvitedBy,
+		&status,
+		&inv.ExpiresAt,
+		&inv.RespondedAt,
+		&inv.CreatedAt,
+	); err != nil {
+		return domain.AgencyInvitation{}, err
+	}
+	inv.Role = domain.AgencyRole(role)
+	inv.Status = domain.AgencyInvitationStatus(status)
+	return inv, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -7,6 +7,7 @@ import (
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/repository"
 	"log/slog"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -23,6 +24,11 @@ func NewDealRepository(db *pgxpool.Pool, log *slog.Logger) *DealRepository {
 	return &DealRepository{db: db, log: log}
 }
 
+// conn — соединение с учётом транзакции из контекста.
+func (r *DealRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
 // CreateDeal — создаёт новую сделку.
 func (r *DealRepository) CreateDeal(ctx context.Context, deal domain.Deal) (uuid.UUID, error) {
 	const op = "DealRepository.CreateDeal"
@@ -37,7 +43,7 @@ func (r *DealRepository) CreateDeal(ctx context.Context, deal domain.Deal) (uuid
 	`
 
 	var id uuid.UUID
-	err := r.db.QueryRow(ctx, query,
+	err := r.conn(ctx).QueryRow(ctx, query,
 		deal.LeadID,
 		deal.SellerUserID,
 		deal.BuyerUserID,
@@ -65,7 +71,43 @@ func (r *DealRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Deal
 
 	var d domain.Deal
 	var buyerUserID *uuid.UUID
-	err := r.db.QueryRow(ctx, query, id).Scan(
+	err := r.conn(ctx).QueryRow(ctx, query, id).Scan(
+		&d.ID,
+		&d.LeadID,
+		&d.SellerUserID,
+		&buyerUserID,
+		&d.Price,
+		&d.Status,
+		&d.CreatedAt,
+		&d.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Deal{}, fmt.Errorf("%s: %w", op, repository.ErrDealNotFound)
+		}
+		return domain.Deal{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	d.BuyerUserID = buyerUserID
+	return d, nil
+}
+
+// GetByIDForUpdate — получает сделку по ID с блокировкой строки до конца транзакции.
+func (r *DealRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (domain.Deal, error) {
+	const op = "DealRepository.GetByIDForUpdate"
+
+	query := `
+		SELECT
+			deal_id, lead_id, seller_user_id, buyer_user_id,
+			price, status, created_at, updated_at
+		FROM deals
+		WHERE deal_id = $1
+		FOR UPDATE
+	`
+
+	var d domain.Deal
+	var buyerUserID *uuid.UUID
+	err := r.conn(ctx).QueryRow(ctx, query, id).Scan(
 		&d.ID,
 		&d.LeadID,
 		&d.SellerUserID,
@@ -119,7 +161,7 @@ func (r *DealRepository) UpdateDeal(ctx context.Context, dealID uuid.UUID, updat
 	query := fmt.Sprintf(`UPDATE deals SET %s WHERE deal_id = $%d`, strings.Join(setClauses, ", "), paramCount)
 	params = append(params, dealID)
 
-	tag, err := r.db.Exec(ctx, query, params...)
+	tag, err := r.conn(ctx).Exec(ctx, query, params...)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -131,56 +173,185 @@ func (r *DealRepository) UpdateDeal(ctx context.Context, dealID uuid.UUID, updat
 	return nil
 }
 
-// ListDeals — возвращает сделки по фильтру.
-func (r *DealRepository) ListDeals(ctx context.Context, filter domain.DealFilter) ([]domain.Deal, error) {
+// ReassignSeller — передаёт открытые (PENDING, ACCEPTED) сделки продавца from пользователю to.
+// Сделки, где to уже покупатель, не трогаются: продавец и покупатель не могут совпадать.
+// Возвращает число сделок.
+func (r *DealRepository) ReassignSeller(ctx context.Context, from, to uuid.UUID) (int, error) {
+	const op = "DealRepository.ReassignSeller"
+
+	tag, err := r.conn(ctx).Exec(ctx,
+		`UPDATE deals SET seller_user_id = $2, updated_at = NOW()
+		WHERE seller_user_id = $1 AND status IN ('PENDING', 'ACCEPTED') AND buyer_user_id IS DISTINCT FROM $2`,
+		from, to,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// PurchasedLeadIDs — лиды из leadIDs, купленные пользователем по завершённым сделкам.
+func (r *DealRepository) PurchasedLeadIDs(ctx context.Context, buyerID uuid.UUID, leadIDs []uuid.UUID) ([]uuid.UUID, error) {
+	const op = "DealRepository.PurchasedLeadIDs"
+
+	if len(leadIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.conn(ctx).Query(ctx,
+		`SELECT DISTINCT lead_id FROM deals
+		WHERE buyer_user_id = $1 AND status = 'COMPLETED' AND lead_id = ANY($2)`,
+		buyerID, leadIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// dealSortColumns — допустимые поля сортировки ListDeals: SQL-выражение и тип значения курсора.
+var dealSortColumns = map[string]repository.SortColumn{
+	"created_at": {Expr: "created_at", Cast: "timestamptz"},
+	"updated_at": {Expr: "updated_at", Cast: "timestamptz"},
+	"price":      {Expr: "price", Cast: "integer"},
+}
+
+// dealSortValue — значение поля сортировки последней записи страницы для курсора.
+func dealSortValue(d domain.Deal, orderBy string) string {
+	switch orderBy {
+	case "updated_at":
+		return domain.FormatCursorTime(d.UpdatedAt)
+	case "price":
+		return strconv.FormatInt(int64(d.Price), 10)
+	}
+	return ""
+}
+
+// ListDeals — возвращает сделки по фильтру с пагинацией.
+func (r *DealRepository) ListDeals(ctx context.Context, filter domain.DealFilter) (*domain.PaginatedResult[domain.Deal], error) {
 	const op = "DealRepository.ListDeals"
 
-	query := `
-		SELECT
-			deal_id, lead_id, seller_user_id, buyer_user_id,
-			price, status, created_at, updated_at
-		FROM deals
-	`
-	whereClauses := []string{}
-	params := []interface{}{}
+	// Нормализуем параметры пагинации
+	pageSize := int(domain.DefaultPageSize)
+	var cursor *domain.PageCursor
+	orderBy := "created_at"
+	orderDir := domain.OrderDesc
+
+	if filter.Pagination != nil {
+		pageSize = int(domain.NormalizePageSize(filter.Pagination.PageSize))
+		orderDir = domain.NormalizeOrderDirection(string(filter.Pagination.OrderDirection))
+
+		if _, ok := dealSortColumns[filter.Pagination.OrderBy]; ok {
+			orderBy = filter.Pagination.OrderBy
+		}
+
+		if filter.Pagination.PageToken != "" {
+			var err error
+			cursor, err = domain.DecodePageCursor(filter.Pagination.PageToken)
+			if err != nil {
+				r.log.Warn("failed to decode page cursor, starting from beginning", "error", err)
+				cursor = nil
+			}
+		}
+	}
+
+	// Базовые WHERE условия (без cursor)
+	baseWhereClauses := []string{}
+	baseParams := []interface{}{}
 	paramCount := 1
 
 	if filter.LeadID != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("lead_id = $%d", paramCount))
-		params = append(params, *filter.LeadID)
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("lead_id = $%d", paramCount))
+		baseParams = append(baseParams, *filter.LeadID)
 		paramCount++
 	}
 	if filter.SellerUserID != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("seller_user_id = $%d", paramCount))
-		params = append(params, *filter.SellerUserID)
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("seller_user_id = $%d", paramCount))
+		baseParams = append(baseParams, *filter.SellerUserID)
 		paramCount++
 	}
 	if filter.BuyerUserID != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("buyer_user_id = $%d", paramCount))
-		params = append(params, *filter.BuyerUserID)
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("buyer_user_id = $%d", paramCount))
+		baseParams = append(baseParams, *filter.BuyerUserID)
 		paramCount++
 	}
 	if filter.Status != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("status = $%d", paramCount))
-		params = append(params, (*filter.Status).String())
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("status = $%d", paramCount))
+		baseParams = append(baseParams, (*filter.Status).String())
 		paramCount++
 	}
 	if filter.MinPrice != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("price >= $%d", paramCount))
-		params = append(params, *filter.MinPrice)
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("price >= $%d", paramCount))
+		baseParams = append(baseParams, *filter.MinPrice)
 		paramCount++
 	}
 	if filter.MaxPrice != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("price <= $%d", paramCount))
-		params = append(params, *filter.MaxPrice)
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("price <= $%d", paramCount))
+		baseParams = append(baseParams, *filter.MaxPrice)
 		paramCount++
 	}
 
+	// Получаем total count
+	countQuery := "SELECT COUNT(*) FROM deals"
+	if len(baseWhereClauses) > 0 {
+		countQuery += " WHERE " + strings.Join(baseWhereClauses, " AND ")
+	}
+
+	var totalCount int32
+	if err := r.conn(ctx).QueryRow(ctx, countQuery, baseParams...).Scan(&totalCount); err != nil {
+		return nil, fmt.Errorf("%s: count failed: %w", op, err)
+	}
+
+	whereClauses := append([]string{}, baseWhereClauses...)
+	params := append([]interface{}{}, baseParams...)
+
+	// Keyset pagination: (поле сортировки, deal_id) < или > в зависимости от направления
+	sortCol := dealSortColumns[orderBy]
+	if cursor != nil {
+		cmp := "<"
+		if orderDir == domain.OrderAsc {
+			cmp = ">"
+		}
+		whereClauses = append(whereClauses,
+			fmt.Sprintf("(%s, deal_id) %s ($%d::text::%s, $%d)", sortCol.Expr, cmp, paramCount, sortCol.Cast, paramCount+1))
+		params = append(params, cursor.KeysetValue(orderBy), cursor.LastID)
+		paramCount += 2
+	}
+
+	query := `
+		SELECT
+			deal_id, lead_id, seller_user_id, buyer_user_id,
+			price, status, created_at, updated_at
+		FROM deals
+	`
 	if len(whereClauses) > 0 {
 		query += " WHERE " + strings.Join(whereClauses, " AND ")
 	}
 
-	rows, err := r.db.Query(ctx, query, params...)
+	dirStr := "DESC"
+	if orderDir == domain.OrderAsc {
+		dirStr = "ASC"
+	}
+	query += fmt.Sprintf(" ORDER BY %s %s, deal_id %s", sortCol.Expr, dirStr, dirStr)
+
+	// LIMIT +1 для определения has_more
+	query += fmt.Sprintf(" LIMIT $%d", paramCount)
+	params = append(params, pageSize+1)
+
+	rows, err := r.conn(ctx).Query(ctx, query, params...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -206,5 +377,30 @@ func (r *DealRepository) ListDeals(ctx context.Context, filter domain.DealFilter
 		deals = append(deals, d)
 	}
 
-	return deals, rows.Err()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	hasMore := len(deals) > pageSize
+	if hasMore {
+		deals = deals[:pageSize]
+	}
+
+	var nextPageToken string
+	if hasMore && len(deals) > 0 {
+		last := deals[len(deals)-1]
+		nextCursor := &domain.PageCursor{
+			LastID:        last.ID,
+			LastCreatedAt: last.CreatedAt,
+			LastValue:     dealSortValue(last, orderBy),
+		}
+		nextPageToken = nextCursor.Encode()
+	}
+
+	return &domain.PaginatedResult[domain.Deal]{
+		Items:         deals,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+		HasMore:       hasMore,
+	}, nil
 }
@@ -0,0 +1,197 @@
+package embedding_job_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type EmbeddingJobRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewEmbeddingJobRepository(db *pgxpool.Pool, log *slog.Logger) *EmbeddingJobRepository {
+	return &EmbeddingJobRepository{db: db, log: log}
+}
+
+// conn — соединение с учётом транзакции из контекста.
+func (r *EmbeddingJobRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
+const jobColumns = `
+	job_id, entity_type, entity_id, operation, status,
+	attempts, max_attempts, last_error, run_at, created_at, updated_at
+`
+
+// Enqueue — ставит задачу в очередь. Если по сущности уже есть ожидающая задача,
+// новая не создаётся: ожидающая задача переносится на «сейчас» и получает новый бюджет попыток.
+// Выполняется в транзакции из контекста, поэтому задача не теряется при создании сущности.
+func (r *EmbeddingJobRepository) Enqueue(ctx context.Context, job domain.EmbeddingJob) (uuid.UUID, error) {
+	const op = "EmbeddingJobRepository.Enqueue"
+
+	query := `
+		WITH existing AS (
+			UPDATE embedding_jobs
+			SET run_at = LEAST(run_at, NOW()), attempts = 0, updated_at = NOW()
+			WHERE entity_type = $1 AND entity_id = $2 AND status = 'PENDING'
+			RETURNING job_id
+		), inserted AS (
+			INSERT INTO embedding_jobs (entity_type, entity_id, operation, max_attempts)
+			SELECT $1, $2, $3, $4
+			WHERE NOT EXISTS (SELECT 1 FROM existing)
+			RETURNING job_id
+		)
+		SELECT job_id FROM existing
+		UNION ALL
+		SELECT job_id FROM inserted
+		LIMIT 1
+	`
+
+	var id uuid.UUID
+	err := r.conn(ctx).QueryRow(ctx, query,
+		job.EntityType.String(),
+		job.EntityID,
+		job.Operation.String(),
+		job.MaxAttempts,
+	).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// ClaimNext — берёт в работу следующую готовую задачу. Задачи, зависшие в RUNNING
+// дольше staleAfter (воркер упал), берутся повторно. Параллельные воркеры не блокируют друг друга.
+func (r *EmbeddingJobRepository) ClaimNext(ctx context.Context, staleAfter time.Duration) (domain.EmbeddingJob, error) {
+	const op = "EmbeddingJobRepository.ClaimNext"
+
+	query := `
+		UPDATE embedding_jobs
+		SET status = 'RUNNING', attempts = attempts + 1, locked_at = NOW(), updated_at = NOW()
+		WHERE job_id = (
+			SELECT job_id FROM embedding_jobs
+			WHERE (status = 'PENDING' AND run_at <= NOW())
+			   OR (status = 'RUNNING' AND locked_at < NOW() - make_interval(secs => $1))
+			ORDER BY run_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + jobColumns
+
+	job, err := scanJob(r.conn(ctx).QueryRow(ctx, query, staleAfter.Seconds()))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.EmbeddingJob{}, fmt.Errorf("%s: %w", op, repository.ErrEmbeddingJobNotFound)
+		}
+		return domain.EmbeddingJob{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return job, nil
+}
+
+// MarkDone — задача выполнена.
+func (r *EmbeddingJobRepository) MarkDone(ctx context.Context, jobID uuid.UUID) error {
+	const op = "EmbeddingJobRepository.MarkDone"
+
+	query := `
+		UPDATE embedding_jobs
+		SET status = 'DONE', last_error = NULL, locked_at = NULL, updated_at = NOW()
+		WHERE job_id = $1
+	`
+	return r.exec(ctx, op, query, jobID)
+}
+
+// MarkRetry — возвращает задачу в очередь с отложенным запуском после ошибки.
+func (r *EmbeddingJobRepository) MarkRetry(ctx context.Context, jobID uuid.UUID, lastError string, runAt time.Time) error {
+	const op = "EmbeddingJobRepository.MarkRetry"
+
+	query := `
+		UPDATE embedding_jobs
+		SET status = 'PENDING', last_error = $2, run_at = $3, locked_at = NULL, updated_at = NOW()
+		WHERE job_id = $1
+	`
+	return r.exec(ctx, op, query, jobID, lastError, runAt)
+}
+
+// MarkDead — попытки исчерпаны, задача остаётся в таблице для разбора.
+func (r *EmbeddingJobRepository) MarkDead(ctx context.Context, jobID uuid.UUID, lastError string) error {
+	const op = "EmbeddingJobRepository.MarkDead"
+
+	query := `
+		UPDATE embedding_jobs
+		SET status = 'DEAD', last_error = $2, locked_at = NULL, updated_at = NOW()
+		WHERE job_id = $1
+	`
+	return r.exec(ctx, op, query, jobID, lastError)
+}
+
+// GetLatestByEntity — последняя задача по сущности.
+func (r *EmbeddingJobRepository) GetLatestByEntity(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID) (domain.EmbeddingJob, error) {
+	const op = "EmbeddingJobRepository.GetLatestByEntity"
+
+	query := `
+		SELECT ` + jobColumns + `
+		FROM embedding_jobs
+		WHERE entity_type = $1 AND entity_id = $2
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+
+	job, err := scanJob(r.conn(ctx).QueryRow(ctx, query, entityType.String(), entityID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.EmbeddingJob{}, fmt.Errorf("%s: %w", op, repository.ErrEmbeddingJobNotFound)
+		}
+		return domain.EmbeddingJob{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return job, nil
+}
+
+func (r *EmbeddingJobRepository) exec(ctx context.Context, op, query string, args ...any) error {
+	tag, err := r.conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrEmbeddingJobNotFound)
+	}
+	return nil
+}
+
+// scanJob — читает строку embedding_jobs в доменную модель.
+func scanJob(row pgx.Row) (domain.EmbeddingJob, error) {
+	var job domain.EmbeddingJob
+	var entityType, operation, status string
+	if err := row.Scan(
+		&job.ID,
+		&entityType,
+		&job.EntityID,
+		&operation,
+		&status,
+		&job.Attempts,
+		&job.MaxAttempts,
+		&job.LastError,
+		&job.RunAt,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	); err != nil {
+		return domain.EmbeddingJob{}, err
+	}
+
+	job.EntityType = domain.EmbeddingEntityType(entityType)
+	job.Operation = domain.EmbeddingOperation(operation)
+	job.Status = domain.EmbeddingJobStatus(status)
+	return job, nil
+}
@@ -3,10 +3,26 @@ package repository
 import "errors"
 
 var (
-	ErrUserExists       = errors.New("user already exists")
-	ErrUserNotFound     = errors.New("user not found")
-	ErrLeadNotFound     = errors.New("lead not found")
-	ErrDealNotFound     = errors.New("deal not found")
-	ErrPropertyNotFound = errors.New("property not found")
-	ErrNoFieldsToUpdate = errors.New("no fields to update")
+	ErrUserExists                = errors.New("user already exists")
+	ErrUserNotFound              = errors.New("user not found")
+	ErrLeadNotFound              = errors.New("lead not found")
+	ErrDealNotFound              = errors.New("deal not found")
+	ErrPropertyNotFound          = errors.New("property not found")
+	ErrSavedSearchNotFound       = errors.New("saved search not found")
+	ErrPropertyImageNotFound     = errors.New("property image not found")
+	ErrEmbeddingJobNotFound      = errors.New("embedding job not found")
+	ErrReindexCheckpointNotFound = errors.New("reindex checkpoint not found")
+	ErrClarificationNotFound     = errors.New("clarification not found")
+	ErrJSONLDNotCached           = errors.New("json-ld is not cached")
+	ErrRefreshTokenNotFound      = errors.New("refresh token not found")
+	ErrUserTokenNotFound         = errors.New("user token not found")
+	ErrAgencyNotFound            = errors.New("agency not found")
+	ErrAgencyMemberNotFound      = errors.New("agency member not found")
+	ErrAgencyMemberExists        = errors.New("user already belongs to an agency")
+	ErrAgencyInvitationNotFound  = errors.New("agency invitation not found")
+	ErrWalletAccountNotFound     = errors.New("wallet account not found")
+	ErrLedgerTransactionNotFound = errors.New("ledger transaction not found")
+	ErrLedgerTransactionExists   = errors.New("ledger transaction already posted")
+	ErrInsufficientFunds         = errors.New("insufficient funds")
+	ErrNoFieldsToUpdate          = errors.New("no fields to update")
 )
@@ -0,0 +1,208 @@
+package lead_clarification_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type LeadClarificationRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewLeadClarificationRepository(db *pgxpool.Pool, log *slog.Logger) *LeadClarificationRepository {
+	return &LeadClarificationRepository{db: db, log: log}
+}
+
+// conn — соединение с учётом транзакции из контекста.
+func (r *LeadClarificationRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
+const clarificationColumns = `
+	clarification_id, lead_id, questions, answers, COALESCE(priority, 'medium'), COALESCE(status, 'pending'),
+	quality_score_before, quality_score_after, created_at, answered_at
+`
+
+// CreateClarification — сохраняет сессию уточнения.
+func (r *LeadClarificationRepository) CreateClarification(ctx context.Context, c domain.LeadClarification) (uuid.UUID, error) {
+	const op = "LeadClarificationRepository.CreateClarification"
+
+	questionsJSON, err := json.Marshal(nonNil(c.Questions))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: marshal questions: %w", op, err)
+	}
+	answersJSON, err := json.Marshal(nonNil(c.Answers))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: marshal answers: %w", op, err)
+	}
+
+	query := `
+		INSERT INTO lead_clarifications (
+			lead_id, questions, answers, priority, status,
+			quality_score_before, quality_score_after, answered_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING clarification_id
+	`
+
+	var id uuid.UUID
+	err = r.conn(ctx).QueryRow(ctx, query,
+		c.LeadID,
+		questionsJSON,
+		answersJSON,
+		c.Priority,
+		string(c.Status),
+		c.QualityScoreBefore,
+		c.QualityScoreAfter,
+		c.AnsweredAt,
+	).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// GetPendingByLead — последняя сессия лида, ожидающая ответов. Строка блокируется до конца транзакции,
+// чтобы одна сессия не закрылась дважды.
+func (r *LeadClarificationRepository) GetPendingByLead(ctx context.Context, leadID uuid.UUID) (domain.LeadClarification, error) {
+	const op = "LeadClarificationRepository.GetPendingByLead"
+
+	query := `
+		SELECT ` + clarificationColumns + `
+		FROM lead_clarifications
+		WHERE lead_id = $1 AND status = 'pending'
+		ORDER BY created_at DESC
+		LIMIT 1
+		FOR UPDATE
+	`
+
+	c, err := scanClarification(r.conn(ctx).QueryRow(ctx, query, leadID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.LeadClarification{}, fmt.Errorf("%s: %w", op, repository.ErrClarificationNotFound)
+		}
+		return domain.LeadClarification{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return c, nil
+}
+
+// SkipPending — помечает неотвеченные сессии лида пропущенными.
+func (r *LeadClarificationRepository) SkipPending(ctx context.Context, leadID uuid.UUID) error {
+	const op = "LeadClarificationRepository.SkipPending"
+
+	_, err := r.conn(ctx).Exec(ctx,
+		`UPDATE lead_clarifications SET status = 'skipped' WHERE lead_id = $1 AND status = 'pending'`,
+		leadID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// AnswerClarification — закрывает сессию ответами и качеством лида после уточнения.
+func (r *LeadClarificationRepository) AnswerClarification(ctx context.Context, id uuid.UUID, answers []domain.ClarificationAnswer, qualityAfter float64) error {
+	const op = "LeadClarificationRepository.AnswerClarification"
+
+	answersJSON, err := json.Marshal(nonNil(answers))
+	if err != nil {
+		return fmt.Errorf("%s: marshal answers: %w", op, err)
+	}
+
+	query := `
+		UPDATE lead_clarifications
+		SET answers = $2, quality_score_after = $3, status = 'answered', answered_at = NOW()
+		WHERE clarification_id = $1 AND status = 'pending'
+	`
+
+	tag, err := r.conn(ctx).Exec(ctx, query, id, answersJSON, qualityAfter)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrClarificationNotFound)
+	}
+
+	return nil
+}
+
+// ListByLead — история уточнений лида, новые первыми.
+func (r *LeadClarificationRepository) ListByLead(ctx context.Context, leadID uuid.UUID) ([]domain.LeadClarification, error) {
+	const op = "LeadClarificationRepository.ListByLead"
+
+	query := `SELECT ` + clarificationColumns + ` FROM lead_clarifications WHERE lead_id = $1 ORDER BY created_at DESC`
+
+	rows, err := r.conn(ctx).Query(ctx, query, leadID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var clarifications []domain.LeadClarification
+	for rows.Next() {
+		c, err := scanClarification(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		clarifications = append(clarifications, c)
+	}
+
+	return clarifications, rows.Err()
+}
+
+// scanClarification — читает строку lead_clarifications в доменную модель.
+func scanClarification(row pgx.Row) (domain.LeadClarification, error) {
+	var c domain.LeadClarification
+	var status string
+	var questionsJSON, answersJSON []byte
+	if err := row.Scan(
+		&c.ID,
+		&c.LeadID,
+		&questionsJSON,
+		&answersJSON,
+		&c.Priority,
+		&status,
+		&c.QualityScoreBefore,
+		&c.QualityScoreAfter,
+		&c.CreatedAt,
+		&c.AnsweredAt,
+	); err != nil {
+		return domain.LeadClarification{}, err
+	}
+	c.Status = domain.ClarificationStatus(status)
+
+	if len(questionsJSON) > 0 {
+		if err := json.Unmarshal(questionsJSON, &c.Questions); err != nil {
+			return domain.LeadClarification{}, fmt.Errorf("unmarshal questions: %w", err)
+		}
+	}
+	if len(answersJSON) > 0 {
+		if err := json.Unmarshal(answersJSON, &c.Answers); err != nil {
+			return domain.LeadClarification{}, fmt.Errorf("unmarshal answers: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+// nonNil — пустой список вместо nil, чтобы в JSONB писался [], а не null.
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
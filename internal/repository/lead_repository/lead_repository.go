@@ -24,6 +24,11 @@ func NewLeadRepository(db *pgxpool.Pool, log *slog.Logger) *LeadRepository {
 	return &LeadRepository{db: db, log: log}
 }
 
+// conn — соединение с учётом транзакции из контекста.
+func (r *LeadRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
 // CreateLead — создаёт нового лида.
 func (r *LeadRepository) CreateLead(ctx context.Context, lead domain.Lead) (uuid.UUID, error) {
 	const op = "LeadRepository.CreateLead"
@@ -32,14 +37,14 @@ func (r *LeadRepository) CreateLead(ctx context.Context, lead domain.Lead) (uuid
 		INSERT INTO leads (
 			title, description, requirement,
 			contact_name, contact_phone, contact_email,
-			city, status, owner_user_id, created_user_id
+			city, property_type, status, owner_user_id, created_user_id
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), $9, $10, $11)
 		RETURNING lead_id
 	`
 
 	var id uuid.UUID
-	err := r.db.QueryRow(ctx, query,
+	err := r.conn(ctx).QueryRow(ctx, query,
 		lead.Title,
 		lead.Description,
 		lead.Requirement,
@@ -47,6 +52,7 @@ func (r *LeadRepository) CreateLead(ctx context.Context, lead domain.Lead) (uuid
 		lead.ContactPhone,
 		lead.ContactEmail,
 		lead.City,
+		lead.PropertyType.String(),
 		lead.Status.String(),
 		lead.OwnerUserID,
 		lead.CreatedUserID,
@@ -66,23 +72,25 @@ func (r *LeadRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Lead
 		SELECT
 			lead_id, title, description, requirement,
 			contact_name, contact_phone, contact_email,
-			city, status, owner_user_id, created_user_id,
+			city, COALESCE(property_type, ''), status, owner_user_id, created_user_id,
 			embedding::text, created_at, updated_at
 		FROM leads
 		WHERE lead_id = $1
 	`
 
 	var l domain.Lead
+	var requirement []byte
 	var embeddingStr *string
-	err := r.db.QueryRow(ctx, query, id).Scan(
+	err := r.conn(ctx).QueryRow(ctx, query, id).Scan(
 		&l.ID,
 		&l.Title,
 		&l.Description,
-		&l.Requirement,
+		&requirement,
 		&l.ContactName,
 		&l.ContactPhone,
 		&l.ContactEmail,
 		&l.City,
+		&l.PropertyType,
 		&l.Status,
 		&l.OwnerUserID,
 		&l.CreatedUserID,
@@ -96,6 +104,9 @@ func (r *LeadRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Lead
 		}
 		return domain.Lead{}, fmt.Errorf("%s: %w", op, err)
 	}
+	if l.Requirement, err = domain.ParseLeadRequirement(requirement); err != nil {
+		return domain.Lead{}, fmt.Errorf("%s: invalid requirement: %w", op, err)
+	}
 
 	// Конвертируем embedding из строки
 	if embeddingStr != nil && *embeddingStr != "" {
@@ -138,6 +149,11 @@ func (r *LeadRepository) UpdateLead(ctx context.Context, leadID uuid.UUID, updat
 		params = append(params, *update.City)
 		paramCount++
 	}
+	if update.PropertyType != nil {
+		setClauses = append(setClauses, fmt.Sprintf("property_type = NULLIF($%d, '')", paramCount))
+		params = append(params, (*update.PropertyType).String())
+		paramCount++
+	}
 	if update.Status != nil {
 		setClauses = append(setClauses, fmt.Sprintf("status = $%d", paramCount))
 		params = append(params, (*update.Status).String())
@@ -158,7 +174,7 @@ func (r *LeadRepository) UpdateLead(ctx context.Context, leadID uuid.UUID, updat
 	query := fmt.Sprintf(`UPDATE leads SET %s WHERE lead_id = $%d`, strings.Join(setClauses, ", "), paramCount)
 	params = append(params, leadID)
 
-	tag, err := r.db.Exec(ctx, query, params...)
+	tag, err := r.conn(ctx).Exec(ctx, query, params...)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -170,6 +186,39 @@ func (r *LeadRepository) UpdateLead(ctx context.Context, leadID uuid.UUID, updat
 	return nil
 }
 
+// ReassignOwner — передаёт все неудалённые лиды владельца from пользователю to. Возвращает число лидов.
+func (r *LeadRepository) ReassignOwner(ctx context.Context, from, to uuid.UUID) (int, error) {
+	const op = "LeadRepository.ReassignOwner"
+
+	tag, err := r.conn(ctx).Exec(ctx,
+		`UPDATE leads SET owner_user_id = $2, updated_at = NOW() WHERE owner_user_id = $1 AND status <> 'DELETED'`,
+		from, to,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// leadSortColumns — допустимые поля сортировки ListLeads: SQL-выражение и тип значения курсора.
+var leadSortColumns = map[string]repository.SortColumn{
+	"created_at": {Expr: "created_at", Cast: "timestamptz"},
+	"updated_at": {Expr: "updated_at", Cast: "timestamptz"},
+	"title":      {Expr: "title", Cast: "text"},
+}
+
+// leadSortValue — значение поля сортировки последней записи страницы для курсора.
+func leadSortValue(l domain.Lead, orderBy string) string {
+	switch orderBy {
+	case "updated_at":
+		return domain.FormatCursorTime(l.UpdatedAt)
+	case "title":
+		return l.Title
+	}
+	return ""
+}
+
 // ListLeads — возвращает лидов по фильтру с пагинацией.
 func (r *LeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter) (*domain.PaginatedResult[domain.Lead], error) {
 	const op = "LeadRepository.ListLeads"
@@ -185,8 +234,7 @@ func (r *LeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter
 		orderDir = domain.NormalizeOrderDirection(string(filter.Pagination.OrderDirection))
 
 		// Валидация и установка поля сортировки
-		switch filter.Pagination.OrderBy {
-		case "created_at", "updated_at", "title":
+		if _, ok := leadSortColumns[filter.Pagination.OrderBy]; ok {
 			orderBy = filter.Pagination.OrderBy
 		}
 
@@ -216,6 +264,11 @@ func (r *LeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter
 		baseParams = append(baseParams, *filter.OwnerUserID)
 		paramCount++
 	}
+	if filter.OwnerUserIDs != nil {
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("owner_user_id = ANY($%d)", paramCount))
+		baseParams = append(baseParams, filter.OwnerUserIDs)
+		paramCount++
+	}
 	if filter.CreatedUserID != nil {
 		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("created_user_id = $%d", paramCount))
 		baseParams = append(baseParams, *filter.CreatedUserID)
@@ -226,6 +279,11 @@ func (r *LeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter
 		baseParams = append(baseParams, *filter.City)
 		paramCount++
 	}
+	if filter.PropertyType != nil {
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("property_type = $%d", paramCount))
+		baseParams = append(baseParams, (*filter.PropertyType).String())
+		paramCount++
+	}
 
 	// Получаем total count
 	countQuery := "SELECT COUNT(*) FROM leads"
@@ -234,7 +292,7 @@ func (r *LeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter
 	}
 
 	var totalCount int32
-	err := r.db.QueryRow(ctx, countQuery, baseParams...).Scan(&totalCount)
+	err := r.conn(ctx).QueryRow(ctx, countQuery, baseParams...).Scan(&totalCount)
 	if err != nil {
 		return nil, fmt.Errorf("%s: count failed: %w", op, err)
 	}
@@ -244,16 +302,17 @@ func (r *LeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter
 	params := append([]interface{}{}, baseParams...)
 
 	// Применяем cursor-based пагинацию
+	sortCol := leadSortColumns[orderBy]
 	if cursor != nil {
-		// Keyset pagination: (created_at, lead_id) < или > в зависимости от направления
+		// Keyset pagination: (поле сортировки, lead_id) < или > в зависимости от направления
 		if orderDir == domain.OrderDesc {
 			whereClauses = append(whereClauses,
-				fmt.Sprintf("(%s, lead_id) < ($%d, $%d)", orderBy, paramCount, paramCount+1))
+				fmt.Sprintf("(%s, lead_id) < ($%d::text::%s, $%d)", sortCol.Expr, paramCount, sortCol.Cast, paramCount+1))
 		} else {
 			whereClauses = append(whereClauses,
-				fmt.Sprintf("(%s, lead_id) > ($%d, $%d)", orderBy, paramCount, paramCount+1))
+				fmt.Sprintf("(%s, lead_id) > ($%d::text::%s, $%d)", sortCol.Expr, paramCount, sortCol.Cast, paramCount+1))
 		}
-		params = append(params, cursor.LastCreatedAt, cursor.LastID)
+		params = append(params, cursor.KeysetValue(orderBy), cursor.LastID)
 		paramCount += 2
 	}
 
@@ -262,7 +321,7 @@ func (r *LeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter
 		SELECT
 			lead_id, title, description, requirement,
 			contact_name, contact_phone, contact_email,
-			city, status, owner_user_id, created_user_id,
+			city, COALESCE(property_type, ''), status, owner_user_id, created_user_id,
 			created_at, updated_at
 		FROM leads
 	`
@@ -275,13 +334,13 @@ func (r *LeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter
 	if orderDir == domain.OrderAsc {
 		dirStr = "ASC"
 	}
-	query += fmt.Sprintf(" ORDER BY %s %s, lead_id %s", orderBy, dirStr, dirStr)
+	query += fmt.Sprintf(" ORDER BY %s %s, lead_id %s", sortCol.Expr, dirStr, dirStr)
 
 	// LIMIT +1 для определения has_more
 	query += fmt.Sprintf(" LIMIT $%d", paramCount)
 	params = append(params, pageSize+1)
 
-	rows, err := r.db.Query(ctx, query, params...)
+	rows, err := r.conn(ctx).Query(ctx, query, params...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -290,15 +349,17 @@ func (r *LeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter
 	var leads []domain.Lead
 	for rows.Next() {
 		var l domain.Lead
+		var requirement []byte
 		if err := rows.Scan(
 			&l.ID,
 			&l.Title,
 			&l.Description,
-			&l.Requirement,
+			&requirement,
 			&l.ContactName,
 			&l.ContactPhone,
 			&l.ContactEmail,
 			&l.City,
+			&l.PropertyType,
 			&l.Status,
 			&l.OwnerUserID,
 			&l.CreatedUserID,
@@ -307,6 +368,7 @@ func (r *LeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter
 		); err != nil {
 			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
 		}
+		l.Requirement = r.listedRequirement(l.ID, requirement)
 		leads = append(leads, l)
 	}
 
@@ -327,6 +389,7 @@ func (r *LeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter
 		nextCursor := &domain.PageCursor{
 			LastID:        lastLead.ID,
 			LastCreatedAt: lastLead.CreatedAt,
+			LastValue:     leadSortValue(lastLead, orderBy),
 		}
 		nextPageToken = nextCursor.Encode()
 	}
@@ -350,7 +413,7 @@ func (r *LeadRepository) UpdateEmbedding(ctx context.Context, leadID uuid.UUID,
 	`
 
 	embeddingStr := repository.VectorToString(embedding)
-	tag, err := r.db.Exec(ctx, query, embeddingStr, leadID)
+	tag, err := r.conn(ctx).Exec(ctx, query, embeddingStr, leadID)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -362,6 +425,199 @@ func (r *LeadRepository) UpdateEmbedding(ctx context.Context, leadID uuid.UUID,
 	return nil
 }
 
+// UpdateEmbeddings обновляет embedding пакета записей одним запросом.
+// Возвращает количество обновлённых строк (удалённые за время переиндексации записи пропускаются).
+func (r *LeadRepository) UpdateEmbeddings(ctx context.Context, embeddings map[uuid.UUID][]float32) (int, error) {
+	const op = "LeadRepository.UpdateEmbeddings"
+
+	if len(embeddings) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]string, 0, len(embeddings))
+	vectors := make([]string, 0, len(embeddings))
+	for id, embedding := range embeddings {
+		ids = append(ids, id.String())
+		vectors = append(vectors, repository.VectorToString(embedding))
+	}
+
+	query := `
+		UPDATE leads AS t
+		SET embedding = v.embedding::vector, updated_at = NOW()
+		FROM unnest($1::uuid[], $2::text[]) AS v(id, embedding)
+		WHERE t.lead_id = v.id
+	`
+
+	tag, err := r.conn(ctx).Exec(ctx, query, ids, vectors)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// requirementBound — SQL-выражение границы диапазона из requirement; нечисловое значение считается неуказанным.
+func requirementBound(field, bound string) string {
+	return fmt.Sprintf("(CASE WHEN jsonb_typeof(requirement->'%[1]s'->'%[2]s') = 'number' THEN (requirement->'%[1]s'->>'%[2]s')::numeric END)", field, bound)
+}
+
+// MatchLeadsWithHardFilters находит лидов, близких к объекту по embedding (обратный матчинг).
+// Жёсткие фильтры применяются к диапазонам лида из requirement: диапазон лида должен
+// пересекаться с диапазоном фильтра, лиды без указанной границы не отсекаются.
+func (r *LeadRepository) MatchLeadsWithHardFilters(
+	ctx context.Context,
+	propertyEmbedding []float32,
+	filter domain.LeadFilter,
+	hardFilters *domain.HardFilters,
+	limit int,
+) ([]domain.MatchedLead, error) {
+	const op = "LeadRepository.MatchLeadsWithHardFilters"
+
+	embeddingStr := repository.VectorToString(propertyEmbedding)
+
+	query := `
+		SELECT
+			lead_id, title, description, requirement,
+			contact_name, contact_phone, contact_email,
+			city, COALESCE(property_type, ''), status, owner_user_id, created_user_id,
+			created_at, updated_at,
+			1 - (embedding <=> $1::vector) as similarity
+		FROM leads
+		WHERE embedding IS NOT NULL
+	`
+
+	whereClauses := []string{}
+	params := []interface{}{embeddingStr}
+	paramCount := 2
+
+	// Границы диапазонов requirement (domain.LeadRequirement)
+	reqRoomsMin, reqRoomsMax := requirementBound("rooms", "min"), requirementBound("rooms", "max")
+	reqPriceMin, reqPriceMax := requirementBound("price", "min"), requirementBound("price", "max")
+
+	// ===== ЖЁСТКИЕ ФИЛЬТРЫ (критические поля) =====
+	if hardFilters != nil {
+		// Город — совпадение (case-insensitive) ИЛИ city пустой/NULL (для старых записей)
+		if hardFilters.City != nil && *hardFilters.City != "" {
+			whereClauses = append(whereClauses, fmt.Sprintf("(LOWER(city) = LOWER($%d) OR city IS NULL OR city = '')", paramCount))
+			params = append(params, *hardFilters.City)
+			paramCount++
+		}
+		// Тип недвижимости — совпадение ИЛИ тип у лида не указан
+		if hardFilters.PropertyType != nil {
+			whereClauses = append(whereClauses, fmt.Sprintf("(property_type = $%d OR property_type IS NULL)", paramCount))
+			params = append(params, (*hardFilters.PropertyType).String())
+			paramCount++
+		}
+		// Желаемое количество комнат — диапазоны пересекаются
+		if hardFilters.MinRooms != nil {
+			whereClauses = append(whereClauses, fmt.Sprintf("(%s >= $%d OR %s IS NULL)", reqRoomsMax, paramCount, reqRoomsMax))
+			params = append(params, *hardFilters.MinRooms)
+			paramCount++
+		}
+		if hardFilters.MaxRooms != nil {
+			whereClauses = append(whereClauses, fmt.Sprintf("(%s <= $%d OR %s IS NULL)", reqRoomsMin, paramCount, reqRoomsMin))
+			params = append(params, *hardFilters.MaxRooms)
+			paramCount++
+		}
+		// Бюджет лида — диапазоны пересекаются
+		if hardFilters.MinPrice != nil {
+			whereClauses = append(whereClauses, fmt.Sprintf("(%s >= $%d OR %s IS NULL)", reqPriceMax, paramCount, reqPriceMax))
+			params = append(params, *hardFilters.MinPrice)
+			paramCount++
+		}
+		if hardFilters.MaxPrice != nil {
+			whereClauses = append(whereClauses, fmt.Sprintf("(%s <= $%d OR %s IS NULL)", reqPriceMin, paramCount, reqPriceMin))
+			params = append(params, *hardFilters.MaxPrice)
+			paramCount++
+		}
+	}
+
+	// ===== МЯГКИЕ ФИЛЬТРЫ (из LeadFilter) =====
+	if filter.Status != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("status = $%d", paramCount))
+		params = append(params, (*filter.Status).String())
+		paramCount++
+	}
+	if filter.City != nil && (hardFilters == nil || hardFilters.City == nil) {
+		whereClauses = append(whereClauses, fmt.Sprintf("LOWER(city) = LOWER($%d)", paramCount))
+		params = append(params, *filter.City)
+		paramCount++
+	}
+	if filter.PropertyType != nil && (hardFilters == nil || hardFilters.PropertyType == nil) {
+		whereClauses = append(whereClauses, fmt.Sprintf("property_type = $%d", paramCount))
+		params = append(params, (*filter.PropertyType).String())
+		paramCount++
+	}
+	if filter.OwnerUserID != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("owner_user_id = $%d", paramCount))
+		params = append(params, *filter.OwnerUserID)
+		paramCount++
+	}
+
+	if len(whereClauses) > 0 {
+		query += " AND " + strings.Join(whereClauses, " AND ")
+	}
+
+	query += fmt.Sprintf(" ORDER BY embedding <=> $1::vector LIMIT $%d", paramCount)
+	params = append(params, limit)
+
+	rows, err := r.conn(ctx).Query(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var matches []domain.MatchedLead
+	for rows.Next() {
+		var l domain.Lead
+		var requirement []byte
+		var similarity float64
+
+		if err := rows.Scan(
+			&l.ID,
+			&l.Title,
+			&l.Description,
+			&requirement,
+			&l.ContactName,
+			&l.ContactPhone,
+			&l.ContactEmail,
+			&l.City,
+			&l.PropertyType,
+			&l.Status,
+			&l.OwnerUserID,
+			&l.CreatedUserID,
+			&l.CreatedAt,
+			&l.UpdatedAt,
+			&similarity,
+		); err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		l.Requirement = r.listedRequirement(l.ID, requirement)
+
+		matches = append(matches, domain.MatchedLead{
+			Lead:       l,
+			Similarity: similarity,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	return matches, nil
+}
+
+// listedRequirement — requirement лида для списков и матчинга. Одна запись с неразбираемыми
+// требованиями не должна ломать выдачу остальным: она логируется и получает пустые требования.
+func (r *LeadRepository) listedRequirement(leadID uuid.UUID, data []byte) domain.LeadRequirement {
+	requirement, err := domain.ParseLeadRequirement(data)
+	if err != nil {
+		r.log.Warn("failed to parse lead requirement", "lead_id", leadID.String(), "error", err)
+		return domain.LeadRequirement{}
+	}
+	return requirement
+}
+
 func isUniqueViolation(err error) bool {
 	var pgErr *pgconn.PgError
 	return errors.As(err, &pgErr) && pgErr.Code == "23505"
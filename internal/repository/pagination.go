@@ -0,0 +1,9 @@
+package repository
+
+// SortColumn — поле сортировки для keyset-пагинации.
+type SortColumn struct {
+	// Expr — SQL-выражение в ORDER BY и keyset-условии
+	Expr string
+	// Cast — тип Postgres, к которому приводится текстовое значение курсора
+	Cast string
+}
@@ -0,0 +1,279 @@
+package property_image_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PropertyImageRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewPropertyImageRepository(db *pgxpool.Pool, log *slog.Logger) *PropertyImageRepository {
+	return &PropertyImageRepository{db: db, log: log}
+}
+
+// conn — соединение с учётом транзакции из контекста.
+func (r *PropertyImageRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
+const imageColumns = `
+	image_id, property_id, COALESCE(storage_path, ''), COALESCE(image_url, ''),
+	COALESCE(file_name, ''), COALESCE(content_type, ''), position, created_at, updated_at
+`
+
+// CountImagesForUpdate — число фотографий в галерее объекта. Строка объекта блокируется до конца
+// транзакции, поэтому параллельные загрузки в одну галерею проверяют лимит по очереди.
+func (r *PropertyImageRepository) CountImagesForUpdate(ctx context.Context, propertyID uuid.UUID) (int, error) {
+	const op = "PropertyImageRepository.CountImagesForUpdate"
+
+	var locked uuid.UUID
+	err := r.conn(ctx).QueryRow(ctx, `SELECT property_id FROM properties WHERE property_id = $1 FOR UPDATE`, propertyID).Scan(&locked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", op, repository.ErrPropertyNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var count int
+	if err := r.conn(ctx).QueryRow(ctx, `SELECT COUNT(*) FROM property_images WHERE property_id = $1`, propertyID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+// AddImages — записывает фотографии в конец галереи объекта в переданном порядке.
+func (r *PropertyImageRepository) AddImages(ctx context.Context, propertyID uuid.UUID, images []domain.PropertyImage) error {
+	const op = "PropertyImageRepository.AddImages"
+
+	if len(images) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(images))
+	paths := make([]string, len(images))
+	urls := make([]string, len(images))
+	names := make([]string, len(images))
+	contentTypes := make([]string, len(images))
+	for i, img := range images {
+		ids[i] = img.ID
+		paths[i] = img.StoragePath
+		urls[i] = img.ImageURL
+		names[i] = img.FileName
+		contentTypes[i] = img.ContentType
+	}
+
+	query := `
+		INSERT INTO property_images (image_id, property_id, storage_path, image_url, file_name, content_type, position)
+		SELECT i.image_id, $1, NULLIF(i.storage_path, ''), NULLIF(i.image_url, ''),
+			NULLIF(i.file_name, ''), NULLIF(i.content_type, ''), last.position + i.ord
+		FROM unnest($2::uuid[], $3::text[], $4::text[], $5::text[], $6::text[])
+			WITH ORDINALITY AS i(image_id, storage_path, image_url, file_name, content_type, ord),
+			(SELECT COALESCE(MAX(position), -1) AS position FROM property_images WHERE property_id = $1) AS last
+	`
+
+	if _, err := r.conn(ctx).Exec(ctx, query, propertyID, ids, paths, urls, names, contentTypes); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListImages — галерея объекта в порядке отображения.
+func (r *PropertyImageRepository) ListImages(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error) {
+	const op = "PropertyImageRepository.ListImages"
+
+	query := `SELECT ` + imageColumns + ` FROM property_images WHERE property_id = $1 ORDER BY position, created_at`
+
+	rows, err := r.conn(ctx).Query(ctx, query, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var images []domain.PropertyImage
+	for rows.Next() {
+		img, err := scanImage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		images = append(images, img)
+	}
+
+	return images, rows.Err()
+}
+
+// GetImage — фотография по ID.
+func (r *PropertyImageRepository) GetImage(ctx context.Context, imageID uuid.UUID) (domain.PropertyImage, error) {
+	const op = "PropertyImageRepository.GetImage"
+
+	query := `SELECT ` + imageColumns + ` FROM property_images WHERE image_id = $1`
+
+	img, err := scanImage(r.conn(ctx).QueryRow(ctx, query, imageID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PropertyImage{}, fmt.Errorf("%s: %w", op, repository.ErrPropertyImageNotFound)
+		}
+		return domain.PropertyImage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return img, nil
+}
+
+// DeleteImage — удаляет запись о фотографии.
+func (r *PropertyImageRepository) DeleteImage(ctx context.Context, imageID uuid.UUID) error {
+	const op = "PropertyImageRepository.DeleteImage"
+
+	tag, err := r.conn(ctx).Exec(ctx, `DELETE FROM property_images WHERE image_id = $1`, imageID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrPropertyImageNotFound)
+	}
+
+	return nil
+}
+
+// ReorderImages — выставляет позиции фотографий объекта по порядку imageIDs.
+func (r *PropertyImageRepository) ReorderImages(ctx context.Context, propertyID uuid.UUID, imageIDs []uuid.UUID) error {
+	const op = "PropertyImageRepository.ReorderImages"
+
+	query := `
+		UPDATE property_images p
+		SET position = o.ord - 1, updated_at = NOW()
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS o(image_id, ord)
+		WHERE p.image_id = o.image_id AND p.property_id = $1
+	`
+
+	if _, err := r.conn(ctx).Exec(ctx, query, propertyID, imageIDs); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SaveImageAnalysis — сохраняет результат CV-анализа фотографии.
+func (r *PropertyImageRepository) SaveImageAnalysis(ctx context.Context, imageID uuid.UUID, a domain.ImageAnalysis) error {
+	const op = "PropertyImageRepository.SaveImageAnalysis"
+
+	features := a.DetectedFeatures
+	if features == nil {
+		features = []domain.ImageFeature{}
+	}
+	featuresJSON, err := json.Marshal(features)
+	if err != nil {
+		return fmt.Errorf("%s: marshal features: %w", op, err)
+	}
+	tags := a.Tags
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	tagsJSON, err := json.Marshal(tags)
+	if err != nil {
+		return fmt.Errorf("%s: marshal tags: %w", op, err)
+	}
+
+	query := `
+		UPDATE property_images
+		SET detected_features = $2, room_type = NULLIF($3, ''), quality_score = $4,
+			view_type = NULLIF($5, ''), brightness = $6, tags = $7,
+			visual_features = $8::vector, analysis_confidence = $9, analyzed_at = $10,
+			updated_at = NOW()
+		WHERE image_id = $1
+	`
+
+	tag, err := r.conn(ctx).Exec(ctx, query,
+		imageID,
+		featuresJSON,
+		a.RoomType,
+		a.QualityScore,
+		a.ViewType,
+		a.Brightness,
+		tagsJSON,
+		visualVector(a.VisualFeatures),
+		a.Confidence,
+		a.AnalyzedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrPropertyImageNotFound)
+	}
+
+	return nil
+}
+
+// SaveVisualSummary — записывает сводный анализ фотографий в properties.
+// updated_at объекта не меняется: данные объекта не редактировались.
+func (r *PropertyImageRepository) SaveVisualSummary(ctx context.Context, propertyID uuid.UUID, summary domain.PropertyVisualSummary) error {
+	const op = "PropertyImageRepository.SaveVisualSummary"
+
+	query := `
+		UPDATE properties
+		SET visual_features = $2::vector, visual_assessment = $3, average_quality_score = $4
+		WHERE property_id = $1
+	`
+
+	tag, err := r.conn(ctx).Exec(ctx, query,
+		propertyID,
+		visualVector(summary.VisualFeatures),
+		summary.OverallAssessment,
+		summary.AverageQuality,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrPropertyNotFound)
+	}
+
+	return nil
+}
+
+// visualVector — визуальные признаки в формате pgvector; nil, если признаков нет.
+func visualVector(features []float64) *string {
+	if len(features) == 0 {
+		return nil
+	}
+	vec := make([]float32, len(features))
+	for i, f := range features {
+		vec[i] = float32(f)
+	}
+	s := repository.VectorToString(vec)
+	return &s
+}
+
+// scanImage — читает строку property_images в доменную модель.
+func scanImage(row pgx.Row) (domain.PropertyImage, error) {
+	var img domain.PropertyImage
+	err := row.Scan(
+		&img.ID,
+		&img.PropertyID,
+		&img.StoragePath,
+		&img.ImageURL,
+		&img.FileName,
+		&img.ContentType,
+		&img.Position,
+		&img.CreatedAt,
+		&img.UpdatedAt,
+	)
+	return img, err
+}
@@ -0,0 +1,82 @@
+package property_jsonld_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PropertyJSONLDRepository — кэш JSON-LD разметки объектов (property_jsonld_cache).
+type PropertyJSONLDRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewPropertyJSONLDRepository(db *pgxpool.Pool, log *slog.Logger) *PropertyJSONLDRepository {
+	return &PropertyJSONLDRepository{db: db, log: log}
+}
+
+// conn — соединение с учётом транзакции из контекста.
+func (r *PropertyJSONLDRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
+// GetJSONLD — неистёкшая разметка объекта для base_url.
+func (r *PropertyJSONLDRepository) GetJSONLD(ctx context.Context, propertyID uuid.UUID, baseURL string) ([]byte, error) {
+	const op = "PropertyJSONLDRepository.GetJSONLD"
+
+	query := `
+		SELECT jsonld_data
+		FROM property_jsonld_cache
+		WHERE property_id = $1 AND base_url = $2
+		  AND (expires_at IS NULL OR expires_at > NOW())
+	`
+
+	var data []byte
+	if err := r.conn(ctx).QueryRow(ctx, query, propertyID, baseURL).Scan(&data); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repository.ErrJSONLDNotCached)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return data, nil
+}
+
+// SaveJSONLD — сохраняет разметку объекта для base_url на ttl, заменяя прежнюю.
+func (r *PropertyJSONLDRepository) SaveJSONLD(ctx context.Context, propertyID uuid.UUID, baseURL string, data []byte, ttl time.Duration) error {
+	const op = "PropertyJSONLDRepository.SaveJSONLD"
+
+	query := `
+		INSERT INTO property_jsonld_cache (property_id, base_url, jsonld_data, generated_at, expires_at)
+		VALUES ($1, $2, $3, NOW(), NOW() + make_interval(secs => $4))
+		ON CONFLICT (property_id, base_url) DO UPDATE
+		SET jsonld_data = EXCLUDED.jsonld_data,
+		    generated_at = EXCLUDED.generated_at,
+		    expires_at = EXCLUDED.expires_at
+	`
+
+	if _, err := r.conn(ctx).Exec(ctx, query, propertyID, baseURL, data, ttl.Seconds()); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// InvalidateJSONLD — удаляет разметку объекта для всех base_url.
+func (r *PropertyJSONLDRepository) InvalidateJSONLD(ctx context.Context, propertyID uuid.UUID) error {
+	const op = "PropertyJSONLDRepository.InvalidateJSONLD"
+
+	if _, err := r.conn(ctx).Exec(ctx, `DELETE FROM property_jsonld_cache WHERE property_id = $1`, propertyID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
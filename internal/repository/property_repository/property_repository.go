@@ -7,12 +7,14 @@ import (
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/repository"
 	"log/slog"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/lo"
 )
 
 type PropertyRepository struct {
@@ -24,6 +26,11 @@ func NewPropertyRepository(db *pgxpool.Pool, log *slog.Logger) *PropertyReposito
 	return &PropertyRepository{db: db, log: log}
 }
 
+// conn — соединение с учётом транзакции из контекста.
+func (r *PropertyRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
 // CreateProperty — создаёт новый объект недвижимости.
 func (r *PropertyRepository) CreateProperty(ctx context.Context, property domain.Property) (uuid.UUID, error) {
 	const op = "PropertyRepository.CreateProperty"
@@ -32,14 +39,15 @@ func (r *PropertyRepository) CreateProperty(ctx context.Context, property domain
 		INSERT INTO properties (
 			title, description, address, city, property_type,
 			area, price, rooms,
+			floor, total_floors, year_built, district, latitude, longitude, features,
 			status, owner_user_id, created_user_id
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 		RETURNING property_id
 	`
 
 	var id uuid.UUID
-	err := r.db.QueryRow(ctx, query,
+	err := r.conn(ctx).QueryRow(ctx, query,
 		property.Title,
 		property.Description,
 		property.Address,
@@ -48,6 +56,13 @@ func (r *PropertyRepository) CreateProperty(ctx context.Context, property domain
 		property.Area,
 		property.Price,
 		property.Rooms,
+		property.Floor,
+		property.TotalFloors,
+		property.YearBuilt,
+		property.District,
+		property.Latitude,
+		property.Longitude,
+		lo.Ternary(property.Features == nil, []string{}, property.Features),
 		property.Status.String(),
 		property.OwnerUserID,
 		property.CreatedUserID,
@@ -67,8 +82,9 @@ func (r *PropertyRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.
 		SELECT
 			property_id, title, description, address, city, property_type,
 			area, price, rooms,
+			floor, total_floors, year_built, district, latitude, longitude, features,
 			status, owner_user_id, created_user_id,
-			embedding::text, created_at, updated_at
+			embedding::text, visual_features::text, created_at, updated_at
 		FROM properties
 		WHERE property_id = $1
 	`
@@ -76,8 +92,8 @@ func (r *PropertyRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.
 	var p domain.Property
 	var propertyTypeStr string
 	var statusStr string
-	var embeddingStr *string
-	err := r.db.QueryRow(ctx, query, id).Scan(
+	var embeddingStr, visualStr *string
+	err := r.conn(ctx).QueryRow(ctx, query, id).Scan(
 		&p.ID,
 		&p.Title,
 		&p.Description,
@@ -87,10 +103,18 @@ func (r *PropertyRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.
 		&p.Area,
 		&p.Price,
 		&p.Rooms,
+		&p.Floor,
+		&p.TotalFloors,
+		&p.YearBuilt,
+		&p.District,
+		&p.Latitude,
+		&p.Longitude,
+		&p.Features,
 		&statusStr,
 		&p.OwnerUserID,
 		&p.CreatedUserID,
 		&embeddingStr,
+		&visualStr,
 		&p.CreatedAt,
 		&p.UpdatedAt,
 	)
@@ -113,6 +137,7 @@ func (r *PropertyRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.
 			p.Embedding = vec
 		}
 	}
+	p.VisualFeatures = r.parseVisualFeatures(visualStr)
 
 	return p, nil
 }
@@ -165,6 +190,41 @@ func (r *PropertyRepository) UpdateProperty(ctx context.Context, propertyID uuid
 		params = append(params, *update.Rooms)
 		paramCount++
 	}
+	if update.Floor != nil {
+		setClauses = append(setClauses, fmt.Sprintf("floor = $%d", paramCount))
+		params = append(params, *update.Floor)
+		paramCount++
+	}
+	if update.TotalFloors != nil {
+		setClauses = append(setClauses, fmt.Sprintf("total_floors = $%d", paramCount))
+		params = append(params, *update.TotalFloors)
+		paramCount++
+	}
+	if update.YearBuilt != nil {
+		setClauses = append(setClauses, fmt.Sprintf("year_built = $%d", paramCount))
+		params = append(params, *update.YearBuilt)
+		paramCount++
+	}
+	if update.District != nil {
+		setClauses = append(setClauses, fmt.Sprintf("district = $%d", paramCount))
+		params = append(params, *update.District)
+		paramCount++
+	}
+	if update.Latitude != nil {
+		setClauses = append(setClauses, fmt.Sprintf("latitude = $%d", paramCount))
+		params = append(params, *update.Latitude)
+		paramCount++
+	}
+	if update.Longitude != nil {
+		setClauses = append(setClauses, fmt.Sprintf("longitude = $%d", paramCount))
+		params = append(params, *update.Longitude)
+		paramCount++
+	}
+	if update.Features != nil {
+		setClauses = append(setClauses, fmt.Sprintf("features = $%d", paramCount))
+		params = append(params, *update.Features)
+		paramCount++
+	}
 	if update.Status != nil {
 		setClauses = append(setClauses, fmt.Sprintf("status = $%d", paramCount))
 		params = append(params, (*update.Status).String())
@@ -185,7 +245,7 @@ func (r *PropertyRepository) UpdateProperty(ctx context.Context, propertyID uuid
 	query := fmt.Sprintf(`UPDATE properties SET %s WHERE property_id = $%d`, strings.Join(setClauses, ", "), paramCount)
 	params = append(params, propertyID)
 
-	tag, err := r.db.Exec(ctx, query, params...)
+	tag, err := r.conn(ctx).Exec(ctx, query, params...)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -197,6 +257,42 @@ func (r *PropertyRepository) UpdateProperty(ctx context.Context, propertyID uuid
 	return nil
 }
 
+// ReassignOwner — передаёт все неудалённые объекты владельца from пользователю to. Возвращает число объектов.
+func (r *PropertyRepository) ReassignOwner(ctx context.Context, from, to uuid.UUID) (int, error) {
+	const op = "PropertyRepository.ReassignOwner"
+
+	tag, err := r.conn(ctx).Exec(ctx,
+		`UPDATE properties SET owner_user_id = $2, updated_at = NOW() WHERE owner_user_id = $1 AND status <> 'DELETED'`,
+		from, to,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// propertySortColumns — допустимые поля сортировки ListProperties: SQL-выражение и тип значения курсора.
+var propertySortColumns = map[string]repository.SortColumn{
+	"created_at": {Expr: "created_at", Cast: "timestamptz"},
+	"updated_at": {Expr: "updated_at", Cast: "timestamptz"},
+	"title":      {Expr: "title", Cast: "text"},
+	"price":      {Expr: "COALESCE(price, 0)", Cast: "bigint"},
+}
+
+// propertySortValue — значение поля сортировки последней записи страницы для курсора.
+func propertySortValue(p domain.Property, orderBy string) string {
+	switch orderBy {
+	case "updated_at":
+		return domain.FormatCursorTime(p.UpdatedAt)
+	case "title":
+		return p.Title
+	case "price":
+		return strconv.FormatInt(lo.FromPtr(p.Price), 10)
+	}
+	return ""
+}
+
 // ListProperties — возвращает объекты недвижимости по фильтру с пагинацией.
 func (r *PropertyRepository) ListProperties(ctx context.Context, filter domain.PropertyFilter) (*domain.PaginatedResult[domain.Property], error) {
 	const op = "PropertyRepository.ListProperties"
@@ -212,8 +308,7 @@ func (r *PropertyRepository) ListProperties(ctx context.Context, filter domain.P
 		orderDir = domain.NormalizeOrderDirection(string(filter.Pagination.OrderDirection))
 
 		// Валидация и установка поля сортировки
-		switch filter.Pagination.OrderBy {
-		case "created_at", "updated_at", "title", "price":
+		if _, ok := propertySortColumns[filter.Pagination.OrderBy]; ok {
 			orderBy = filter.Pagination.OrderBy
 		}
 
@@ -243,6 +338,11 @@ func (r *PropertyRepository) ListProperties(ctx context.Context, filter domain.P
 		baseParams = append(baseParams, *filter.OwnerUserID)
 		paramCount++
 	}
+	if filter.OwnerUserIDs != nil {
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("owner_user_id = ANY($%d)", paramCount))
+		baseParams = append(baseParams, filter.OwnerUserIDs)
+		paramCount++
+	}
 	if filter.CreatedUserID != nil {
 		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("created_user_id = $%d", paramCount))
 		baseParams = append(baseParams, *filter.CreatedUserID)
@@ -278,6 +378,12 @@ func (r *PropertyRepository) ListProperties(ctx context.Context, filter domain.P
 		baseParams = append(baseParams, *filter.City)
 		paramCount++
 	}
+	if filter.Geo != nil {
+		clause, args := geoCondition(*filter.Geo, paramCount)
+		baseWhereClauses = append(baseWhereClauses, clause)
+		baseParams = append(baseParams, args...)
+		paramCount += len(args)
+	}
 
 	// Получаем total count
 	countQuery := "SELECT COUNT(*) FROM properties"
@@ -286,7 +392,7 @@ func (r *PropertyRepository) ListProperties(ctx context.Context, filter domain.P
 	}
 
 	var totalCount int32
-	err := r.db.QueryRow(ctx, countQuery, baseParams...).Scan(&totalCount)
+	err := r.conn(ctx).QueryRow(ctx, countQuery, baseParams...).Scan(&totalCount)
 	if err != nil {
 		return nil, fmt.Errorf("%s: count failed: %w", op, err)
 	}
@@ -296,15 +402,17 @@ func (r *PropertyRepository) ListProperties(ctx context.Context, filter domain.P
 	params := append([]interface{}{}, baseParams...)
 
 	// Применяем cursor-based пагинацию
+	sortCol := propertySortColumns[orderBy]
 	if cursor != nil {
+		// Keyset pagination: (поле сортировки, property_id) < или > в зависимости от направления
 		if orderDir == domain.OrderDesc {
 			whereClauses = append(whereClauses,
-				fmt.Sprintf("(%s, property_id) < ($%d, $%d)", orderBy, paramCount, paramCount+1))
+				fmt.Sprintf("(%s, property_id) < ($%d::text::%s, $%d)", sortCol.Expr, paramCount, sortCol.Cast, paramCount+1))
 		} else {
 			whereClauses = append(whereClauses,
-				fmt.Sprintf("(%s, property_id) > ($%d, $%d)", orderBy, paramCount, paramCount+1))
+				fmt.Sprintf("(%s, property_id) > ($%d::text::%s, $%d)", sortCol.Expr, paramCount, sortCol.Cast, paramCount+1))
 		}
-		params = append(params, cursor.LastCreatedAt, cursor.LastID)
+		params = append(params, cursor.KeysetValue(orderBy), cursor.LastID)
 		paramCount += 2
 	}
 
@@ -313,6 +421,7 @@ func (r *PropertyRepository) ListProperties(ctx context.Context, filter domain.P
 		SELECT
 			property_id, title, description, address, city, property_type,
 			area, price, rooms,
+			floor, total_floors, year_built, district, latitude, longitude, features,
 			status, owner_user_id, created_user_id,
 			created_at, updated_at
 		FROM properties
@@ -326,13 +435,13 @@ func (r *PropertyRepository) ListProperties(ctx context.Context, filter domain.P
 	if orderDir == domain.OrderAsc {
 		dirStr = "ASC"
 	}
-	query += fmt.Sprintf(" ORDER BY %s %s, property_id %s", orderBy, dirStr, dirStr)
+	query += fmt.Sprintf(" ORDER BY %s %s, property_id %s", sortCol.Expr, dirStr, dirStr)
 
 	// LIMIT +1 для определения has_more
 	query += fmt.Sprintf(" LIMIT $%d", paramCount)
 	params = append(params, pageSize+1)
 
-	rows, err := r.db.Query(ctx, query, params...)
+	rows, err := r.conn(ctx).Query(ctx, query, params...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -353,6 +462,13 @@ func (r *PropertyRepository) ListProperties(ctx context.Context, filter domain.P
 			&p.Area,
 			&p.Price,
 			&p.Rooms,
+			&p.Floor,
+			&p.TotalFloors,
+			&p.YearBuilt,
+			&p.District,
+			&p.Latitude,
+			&p.Longitude,
+			&p.Features,
 			&statusStr,
 			&p.OwnerUserID,
 			&p.CreatedUserID,
@@ -383,6 +499,7 @@ func (r *PropertyRepository) ListProperties(ctx context.Context, filter domain.P
 		nextCursor := &domain.PageCursor{
 			LastID:        lastProp.ID,
 			LastCreatedAt: lastProp.CreatedAt,
+			LastValue:     propertySortValue(lastProp, orderBy),
 		}
 		nextPageToken = nextCursor.Encode()
 	}
@@ -406,7 +523,7 @@ func (r *PropertyRepository) UpdateEmbedding(ctx context.Context, propertyID uui
 	`
 
 	embeddingStr := repository.VectorToString(embedding)
-	tag, err := r.db.Exec(ctx, query, embeddingStr, propertyID)
+	tag, err := r.conn(ctx).Exec(ctx, query, embeddingStr, propertyID)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -418,6 +535,37 @@ func (r *PropertyRepository) UpdateEmbedding(ctx context.Context, propertyID uui
 	return nil
 }
 
+// UpdateEmbeddings обновляет embedding пакета записей одним запросом.
+// Возвращает количество обновлённых строк (удалённые за время переиндексации записи пропускаются).
+func (r *PropertyRepository) UpdateEmbeddings(ctx context.Context, embeddings map[uuid.UUID][]float32) (int, error) {
+	const op = "PropertyRepository.UpdateEmbeddings"
+
+	if len(embeddings) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]string, 0, len(embeddings))
+	vectors := make([]string, 0, len(embeddings))
+	for id, embedding := range embeddings {
+		ids = append(ids, id.String())
+		vectors = append(vectors, repository.VectorToString(embedding))
+	}
+
+	query := `
+		UPDATE properties AS t
+		SET embedding = v.embedding::vector, updated_at = NOW()
+		FROM unnest($1::uuid[], $2::text[]) AS v(id, embedding)
+		WHERE t.property_id = v.id
+	`
+
+	tag, err := r.conn(ctx).Exec(ctx, query, ids, vectors)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
 // MatchProperties находит подходящие объекты недвижимости для лида по косинусному расстоянию.
 func (r *PropertyRepository) MatchProperties(ctx context.Context, leadEmbedding []float32, filter domain.PropertyFilter, limit int) ([]domain.MatchedProperty, error) {
 	return r.MatchPropertiesWithHardFilters(ctx, leadEmbedding, filter, nil, limit)
@@ -440,8 +588,9 @@ func (r *PropertyRepository) MatchPropertiesWithHardFilters(
 		SELECT
 			property_id, title, description, address, city, property_type,
 			area, price, rooms,
+			floor, total_floors, year_built, district, latitude, longitude, features,
 			status, owner_user_id, created_user_id,
-			embedding::text, created_at, updated_at,
+			embedding::text, visual_features::text, created_at, updated_at,
 			1 - (embedding <=> $1::vector) as similarity
 		FROM properties
 		WHERE embedding IS NOT NULL
@@ -487,6 +636,19 @@ func (r *PropertyRepository) MatchPropertiesWithHardFilters(
 			params = append(params, *hardFilters.MaxPrice)
 			paramCount++
 		}
+		// Радиус от точки
+		if hardFilters.Geo != nil {
+			clause, args := geoCondition(*hardFilters.Geo, paramCount)
+			whereClauses = append(whereClauses, clause)
+			params = append(params, args...)
+			paramCount += len(args)
+		}
+		// Обязательные особенности
+		if len(hardFilters.Features) > 0 {
+			whereClauses = append(whereClauses, featuresCondition(paramCount))
+			params = append(params, hardFilters.Features)
+			paramCount++
+		}
 	}
 
 	// ===== МЯГКИЕ ФИЛЬТРЫ (из PropertyFilter) =====
@@ -525,6 +687,12 @@ func (r *PropertyRepository) MatchPropertiesWithHardFilters(
 		params = append(params, *filter.MaxRooms)
 		paramCount++
 	}
+	if filter.Geo != nil && (hardFilters == nil || hardFilters.Geo == nil) {
+		clause, args := geoCondition(*filter.Geo, paramCount)
+		whereClauses = append(whereClauses, clause)
+		params = append(params, args...)
+		paramCount += len(args)
+	}
 
 	if len(whereClauses) > 0 {
 		query += " AND " + strings.Join(whereClauses, " AND ")
@@ -534,7 +702,7 @@ func (r *PropertyRepository) MatchPropertiesWithHardFilters(
 	query = fmt.Sprintf(query, paramCount)
 	params = append(params, limit)
 
-	rows, err := r.db.Query(ctx, query, params...)
+	rows, err := r.conn(ctx).Query(ctx, query, params...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -545,7 +713,7 @@ func (r *PropertyRepository) MatchPropertiesWithHardFilters(
 		var p domain.Property
 		var propertyTypeStr string
 		var statusStr string
-		var embeddingStr *string
+		var embeddingStr, visualStr *string
 		var similarity float64
 
 		if err := rows.Scan(
@@ -558,10 +726,18 @@ func (r *PropertyRepository) MatchPropertiesWithHardFilters(
 			&p.Area,
 			&p.Price,
 			&p.Rooms,
+			&p.Floor,
+			&p.TotalFloors,
+			&p.YearBuilt,
+			&p.District,
+			&p.Latitude,
+			&p.Longitude,
+			&p.Features,
 			&statusStr,
 			&p.OwnerUserID,
 			&p.CreatedUserID,
 			&embeddingStr,
+			&visualStr,
 			&p.CreatedAt,
 			&p.UpdatedAt,
 			&similarity,
@@ -580,6 +756,7 @@ func (r *PropertyRepository) MatchPropertiesWithHardFilters(
 				p.Embedding = vec
 			}
 		}
+		p.VisualFeatures = r.parseVisualFeatures(visualStr)
 
 		matches = append(matches, domain.MatchedProperty{
 			Property:   p,
@@ -590,6 +767,39 @@ func (r *PropertyRepository) MatchPropertiesWithHardFilters(
 	return matches, rows.Err()
 }
 
+// parseVisualFeatures — визуальные признаки из строки pgvector; nil, если фото не анализировались.
+func (r *PropertyRepository) parseVisualFeatures(s *string) []float32 {
+	if s == nil || *s == "" {
+		return nil
+	}
+	vec, err := repository.StringToVector(*s)
+	if err != nil {
+		r.log.Warn("failed to parse visual features", "error", err)
+		return nil
+	}
+	return vec
+}
+
+// geoCondition — условие «в радиусе» для earthdistance: earth_box отбирает кандидатов
+// по GiST-индексу idx_properties_location, earth_distance отсекает углы куба.
+// Занимает три параметра начиная с paramCount: широта, долгота, радиус в метрах.
+func geoCondition(g domain.GeoFilter, paramCount int) (string, []interface{}) {
+	center := fmt.Sprintf("ll_to_earth($%d, $%d)", paramCount, paramCount+1)
+	clause := fmt.Sprintf(
+		"(latitude IS NOT NULL AND longitude IS NOT NULL"+
+			" AND earth_box(%[1]s, $%[2]d) @> ll_to_earth(latitude, longitude)"+
+			" AND earth_distance(%[1]s, ll_to_earth(latitude, longitude)) <= $%[2]d)",
+		center, paramCount+2,
+	)
+	return clause, []interface{}{g.Center.Latitude, g.Center.Longitude, g.RadiusMeters()}
+}
+
+// featuresCondition — объект содержит все особенности из параметра paramCount (text[]).
+// Сравнение без учёта регистра; особенности в параметре уже нормализованы (domain.NormalizeFeatures).
+func featuresCondition(paramCount int) string {
+	return fmt.Sprintf("ARRAY(SELECT LOWER(TRIM(f)) FROM unnest(features) AS f) @> $%d::text[]", paramCount)
+}
+
 func isUniqueViolation(err error) bool {
 	var pgErr *pgconn.PgError
 	return errors.As(err, &pgErr) && pgErr.Code == "23505"
@@ -663,15 +873,16 @@ func (r *PropertyRepository) HybridSearch(ctx context.Context, params HybridSear
 		SELECT
 			p.property_id, p.title, p.description, p.address, p.city, p.property_type,
 			p.area, p.price, p.rooms,
+			p.floor, p.total_floors, p.year_built, p.district, p.latitude, p.longitude, p.features,
 			p.status, p.owner_user_id, p.created_user_id,
-			p.embedding::text, p.created_at, p.updated_at,
+			p.embedding::text, p.visual_features::text, p.created_at, p.updated_at,
 			c.rrf_score,
 			c.vector_similarity,
 			c.fts_score
 		FROM combined c
 		JOIN properties p ON p.property_id = c.property_id
 		ORDER BY c.rrf_score DESC
-		LIMIT $6
+		LIMIT $%d
 	`
 
 	// Собираем WHERE условия для CTE
@@ -711,22 +922,71 @@ func (r *PropertyRepository) HybridSearch(ctx context.Context, params HybridSear
 			params_list = append(params_list, *params.HardFilters.MaxPrice)
 			paramCount++
 		}
+		if params.HardFilters.Geo != nil {
+			clause, args := geoCondition(*params.HardFilters.Geo, paramCount)
+			whereClauses = append(whereClauses, "AND "+clause)
+			params_list = append(params_list, args...)
+			paramCount += len(args)
+		}
+		if len(params.HardFilters.Features) > 0 {
+			whereClauses = append(whereClauses, "AND "+featuresCondition(paramCount))
+			params_list = append(params_list, params.HardFilters.Features)
+			paramCount++
+		}
 	}
 
-	// Мягкие фильтры
-	if params.Filter.Status != nil {
+	// Мягкие фильтры (из PropertyFilter), если поле не задано жёстким фильтром
+	hf := params.HardFilters
+	filter := params.Filter
+	if filter.Status != nil {
 		whereClauses = append(whereClauses, fmt.Sprintf("AND status = $%d", paramCount))
-		params_list = append(params_list, (*params.Filter.Status).String())
+		params_list = append(params_list, (*filter.Status).String())
+		paramCount++
+	}
+	if filter.City != nil && (hf == nil || hf.City == nil) {
+		whereClauses = append(whereClauses, fmt.Sprintf("AND LOWER(city) = LOWER($%d)", paramCount))
+		params_list = append(params_list, *filter.City)
+		paramCount++
+	}
+	if filter.MinPrice != nil && (hf == nil || hf.MinPrice == nil) {
+		whereClauses = append(whereClauses, fmt.Sprintf("AND price >= $%d", paramCount))
+		params_list = append(params_list, *filter.MinPrice)
 		paramCount++
 	}
+	if filter.MaxPrice != nil && (hf == nil || hf.MaxPrice == nil) {
+		whereClauses = append(whereClauses, fmt.Sprintf("AND price <= $%d", paramCount))
+		params_list = append(params_list, *filter.MaxPrice)
+		paramCount++
+	}
+	if filter.PropertyType != nil && (hf == nil || hf.PropertyType == nil) {
+		whereClauses = append(whereClauses, fmt.Sprintf("AND property_type = $%d", paramCount))
+		params_list = append(params_list, (*filter.PropertyType).String())
+		paramCount++
+	}
+	if filter.MinRooms != nil && (hf == nil || hf.MinRooms == nil) {
+		whereClauses = append(whereClauses, fmt.Sprintf("AND rooms >= $%d", paramCount))
+		params_list = append(params_list, *filter.MinRooms)
+		paramCount++
+	}
+	if filter.MaxRooms != nil && (hf == nil || hf.MaxRooms == nil) {
+		whereClauses = append(whereClauses, fmt.Sprintf("AND rooms <= $%d", paramCount))
+		params_list = append(params_list, *filter.MaxRooms)
+		paramCount++
+	}
+	if filter.Geo != nil && (hf == nil || hf.Geo == nil) {
+		clause, args := geoCondition(*filter.Geo, paramCount)
+		whereClauses = append(whereClauses, "AND "+clause)
+		params_list = append(params_list, args...)
+		paramCount += len(args)
+	}
 
 	whereStr := strings.Join(whereClauses, " ")
 	params_list = append(params_list, params.Limit)
 
-	// Форматируем запрос с WHERE условиями
-	query = fmt.Sprintf(query, whereStr, whereStr)
+	// Форматируем запрос с WHERE условиями; LIMIT идёт последним параметром
+	query = fmt.Sprintf(query, whereStr, whereStr, paramCount)
 
-	rows, err := r.db.Query(ctx, query, params_list...)
+	rows, err := r.conn(ctx).Query(ctx, query, params_list...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -737,7 +997,7 @@ func (r *PropertyRepository) HybridSearch(ctx context.Context, params HybridSear
 		var p domain.Property
 		var propertyTypeStr string
 		var statusStr string
-		var embeddingStr *string
+		var embeddingStr, visualStr *string
 		var rrfScore float64
 		var vectorSimilarity float64
 		var ftsScore float64
@@ -752,10 +1012,18 @@ func (r *PropertyRepository) HybridSearch(ctx context.Context, params HybridSear
 			&p.Area,
 			&p.Price,
 			&p.Rooms,
+			&p.Floor,
+			&p.TotalFloors,
+			&p.YearBuilt,
+			&p.District,
+			&p.Latitude,
+			&p.Longitude,
+			&p.Features,
 			&statusStr,
 			&p.OwnerUserID,
 			&p.CreatedUserID,
 			&embeddingStr,
+			&visualStr,
 			&p.CreatedAt,
 			&p.UpdatedAt,
 			&rrfScore,
@@ -776,6 +1044,7 @@ func (r *PropertyRepository) HybridSearch(ctx context.Context, params HybridSear
 				p.Embedding = vec
 			}
 		}
+		p.VisualFeatures = r.parseVisualFeatures(visualStr)
 
 		matches = append(matches, domain.MatchedProperty{
 			Property:   p,
@@ -794,6 +1063,7 @@ func (r *PropertyRepository) FulltextSearch(ctx context.Context, query string, f
 		SELECT
 			property_id, title, description, address, city, property_type,
 			area, price, rooms,
+			floor, total_floors, year_built, district, latitude, longitude, features,
 			status, owner_user_id, created_user_id,
 			created_at, updated_at,
 			ts_rank(search_vector, plainto_tsquery('russian', $1)) as rank
@@ -823,7 +1093,7 @@ func (r *PropertyRepository) FulltextSearch(ctx context.Context, query string, f
 	sqlQuery += fmt.Sprintf(" ORDER BY rank DESC LIMIT $%d", paramCount)
 	params = append(params, limit)
 
-	rows, err := r.db.Query(ctx, sqlQuery, params...)
+	rows, err := r.conn(ctx).Query(ctx, sqlQuery, params...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -846,6 +1116,13 @@ func (r *PropertyRepository) FulltextSearch(ctx context.Context, query string, f
 			&p.Area,
 			&p.Price,
 			&p.Rooms,
+			&p.Floor,
+			&p.TotalFloors,
+			&p.YearBuilt,
+			&p.District,
+			&p.Latitude,
+			&p.Longitude,
+			&p.Features,
 			&statusStr,
 			&p.OwnerUserID,
 			&p.CreatedUserID,
@@ -0,0 +1,147 @@
+package refresh_token_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RefreshTokenRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewRefreshTokenRepository(db *pgxpool.Pool, log *slog.Logger) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db, log: log}
+}
+
+// conn — соединение с учётом транзакции из контекста.
+func (r *RefreshTokenRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
+// CreateRefreshToken — сохраняет выданный refresh-токен.
+func (r *RefreshTokenRepository) CreateRefreshToken(ctx context.Context, t domain.RefreshToken) (uuid.UUID, error) {
+	const op = "RefreshTokenRepository.CreateRefreshToken"
+
+	query := `
+		INSERT INTO refresh_tokens (user_id, family_id, token_hash, access_jti, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING token_id
+	`
+
+	var id uuid.UUID
+	err := r.conn(ctx).QueryRow(ctx, query, t.UserID, t.FamilyID, t.TokenHash, t.AccessJTI, t.ExpiresAt).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// GetByHash — refresh-токен по хэшу. Строка блокируется до конца транзакции,
+// чтобы один токен не обменяли дважды параллельными запросами.
+func (r *RefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (domain.RefreshToken, error) {
+	const op = "RefreshTokenRepository.GetByHash"
+
+	query := `
+		SELECT token_id, user_id, family_id, token_hash, access_jti, expires_at, replaced_by, revoked_at, created_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+		FOR UPDATE
+	`
+
+	var t domain.RefreshToken
+	err := r.conn(ctx).QueryRow(ctx, query, tokenHash).Scan(
+		&t.ID,
+		&t.UserID,
+		&t.FamilyID,
+		&t.TokenHash,
+		&t.AccessJTI,
+		&t.ExpiresAt,
+		&t.ReplacedBy,
+		&t.RevokedAt,
+		&t.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.RefreshToken{}, fmt.Errorf("%s: %w", op, repository.ErrRefreshTokenNotFound)
+		}
+		return domain.RefreshToken{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return t, nil
+}
+
+// MarkRotated — помечает токен заменённым новым токеном той же цепочки.
+func (r *RefreshTokenRepository) MarkRotated(ctx context.Context, id, replacedBy uuid.UUID) error {
+	const op = "RefreshTokenRepository.MarkRotated"
+
+	tag, err := r.conn(ctx).Exec(ctx,
+		`UPDATE refresh_tokens SET replaced_by = $2 WHERE token_id = $1 AND replaced_by IS NULL AND revoked_at IS NULL`,
+		id, replacedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrRefreshTokenNotFound)
+	}
+
+	return nil
+}
+
+// RevokeFamily — отзывает все токены цепочки ротации.
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	const op = "RefreshTokenRepository.RevokeFamily"
+
+	_, err := r.conn(ctx).Exec(ctx,
+		`UPDATE refresh_tokens SET revoked_at = NOW() WHERE family_id = $1 AND revoked_at IS NULL`,
+		familyID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RevokeUserTokens — отзывает все токены пользователя (например, при блокировке).
+func (r *RefreshTokenRepository) RevokeUserTokens(ctx context.Context, userID uuid.UUID) error {
+	const op = "RefreshTokenRepository.RevokeUserTokens"
+
+	_, err := r.conn(ctx).Exec(ctx,
+		`UPDATE refresh_tokens SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`,
+		userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// IsAccessTokenRevoked — отозван ли refresh-токен, выданный вместе с access-токеном jti.
+// Ротация access-токен не отзывает: он доживает до своего exp.
+func (r *RefreshTokenRepository) IsAccessTokenRevoked(ctx context.Context, jti uuid.UUID) (bool, error) {
+	const op = "RefreshTokenRepository.IsAccessTokenRevoked"
+
+	var revoked bool
+	err := r.conn(ctx).QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM refresh_tokens WHERE access_jti = $1 AND revoked_at IS NOT NULL)`,
+		jti,
+	).Scan(&revoked)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,97 @@
+package reindex_checkpoint_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReindexCheckpointRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewReindexCheckpointRepository(db *pgxpool.Pool, log *slog.Logger) *ReindexCheckpointRepository {
+	return &ReindexCheckpointRepository{db: db, log: log}
+}
+
+// conn — соединение с учётом транзакции из контекста.
+func (r *ReindexCheckpointRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
+// GetCheckpoint — последняя контрольная точка переиндексации по типу сущности.
+func (r *ReindexCheckpointRepository) GetCheckpoint(ctx context.Context, entityType domain.EmbeddingEntityType) (domain.ReindexProgress, error) {
+	const op = "ReindexCheckpointRepository.GetCheckpoint"
+
+	query := `
+		SELECT entity_type, cursor, total, processed, success, failed, done, started_at, updated_at
+		FROM reindex_checkpoints
+		WHERE entity_type = $1
+	`
+
+	var p domain.ReindexProgress
+	var et string
+	err := r.conn(ctx).QueryRow(ctx, query, entityType.String()).Scan(
+		&et,
+		&p.Cursor,
+		&p.Total,
+		&p.Processed,
+		&p.Success,
+		&p.Failed,
+		&p.Done,
+		&p.StartedAt,
+		&p.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ReindexProgress{}, fmt.Errorf("%s: %w", op, repository.ErrReindexCheckpointNotFound)
+		}
+		return domain.ReindexProgress{}, fmt.Errorf("%s: %w", op, err)
+	}
+	p.EntityType = domain.EmbeddingEntityType(et)
+
+	return p, nil
+}
+
+// SaveCheckpoint — сохраняет ход переиндексации (upsert по типу сущности).
+// Вызывается в транзакции записи embedding пакета, поэтому курсор не опережает данные.
+func (r *ReindexCheckpointRepository) SaveCheckpoint(ctx context.Context, progress domain.ReindexProgress) error {
+	const op = "ReindexCheckpointRepository.SaveCheckpoint"
+
+	query := `
+		INSERT INTO reindex_checkpoints (entity_type, cursor, total, processed, success, failed, done, started_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		ON CONFLICT (entity_type) DO UPDATE SET
+			cursor = EXCLUDED.cursor,
+			total = EXCLUDED.total,
+			processed = EXCLUDED.processed,
+			success = EXCLUDED.success,
+			failed = EXCLUDED.failed,
+			done = EXCLUDED.done,
+			started_at = EXCLUDED.started_at,
+			updated_at = NOW()
+	`
+
+	_, err := r.conn(ctx).Exec(ctx, query,
+		progress.EntityType.String(),
+		progress.Cursor,
+		progress.Total,
+		progress.Processed,
+		progress.Success,
+		progress.Failed,
+		progress.Done,
+		progress.StartedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
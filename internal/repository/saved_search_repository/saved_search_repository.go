@@ -0,0 +1,346 @@
+package saved_search_repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SavedSearchRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewSavedSearchRepository(db *pgxpool.Pool, log *slog.Logger) *SavedSearchRepository {
+	return &SavedSearchRepository{db: db, log: log}
+}
+
+// conn — соединение с учётом транзакции из контекста.
+func (r *SavedSearchRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
+const savedSearchColumns = `
+	search_id, user_id, name, lead_id, filter, weights, min_score, created_at, updated_at
+`
+
+// CreateSavedSearch — сохраняет новый поиск.
+func (r *SavedSearchRepository) CreateSavedSearch(ctx context.Context, s domain.SavedSearch) (uuid.UUID, error) {
+	const op = "SavedSearchRepository.CreateSavedSearch"
+
+	filterJSON, err := json.Marshal(s.Filter)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: marshal filter: %w", op, err)
+	}
+
+	var weightsJSON []byte
+	if s.Weights != nil {
+		weightsJSON, err = json.Marshal(s.Weights)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("%s: marshal weights: %w", op, err)
+		}
+	}
+
+	query := `
+		INSERT INTO saved_searches (user_id, name, lead_id, filter, weights, min_score)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING search_id
+	`
+
+	var id uuid.UUID
+	err = r.conn(ctx).QueryRow(ctx, query,
+		s.UserID,
+		s.Name,
+		s.LeadID,
+		filterJSON,
+		weightsJSON,
+		s.MinScore,
+	).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// GetByID — получает сохранённый поиск по ID.
+func (r *SavedSearchRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.SavedSearch, error) {
+	const op = "SavedSearchRepository.GetByID"
+
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE search_id = $1`
+
+	s, err := scanSavedSearch(r.conn(ctx).QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.SavedSearch{}, fmt.Errorf("%s: %w", op, repository.ErrSavedSearchNotFound)
+		}
+		return domain.SavedSearch{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s, nil
+}
+
+// ListSavedSearches — возвращает сохранённые поиски пользователя; userID == nil — все поиски.
+func (r *SavedSearchRepository) ListSavedSearches(ctx context.Context, userID *uuid.UUID) ([]domain.SavedSearch, error) {
+	const op = "SavedSearchRepository.ListSavedSearches"
+
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches`
+	params := []interface{}{}
+	if userID != nil {
+		query += ` WHERE user_id = $1`
+		params = append(params, *userID)
+	}
+	query += ` ORDER BY created_at DESC`
+
+	rows, err := r.conn(ctx).Query(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var searches []domain.SavedSearch
+	for rows.Next() {
+		s, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		searches = append(searches, s)
+	}
+
+	return searches, rows.Err()
+}
+
+// DeleteSavedSearch — удаляет сохранённый поиск вместе с его входящими.
+func (r *SavedSearchRepository) DeleteSavedSearch(ctx context.Context, id uuid.UUID) error {
+	const op = "SavedSearchRepository.DeleteSavedSearch"
+
+	tag, err := r.conn(ctx).Exec(ctx, `DELETE FROM saved_searches WHERE search_id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrSavedSearchNotFound)
+	}
+
+	return nil
+}
+
+// AddMatch — записывает совпадение во входящие. Повторное совпадение по тому же объекту
+// игнорируется; возвращает true, если запись добавлена.
+func (r *SavedSearchRepository) AddMatch(ctx context.Context, m domain.SavedSearchMatch) (bool, error) {
+	const op = "SavedSearchRepository.AddMatch"
+
+	query := `
+		INSERT INTO saved_search_matches (search_id, property_id, score, match_explanation)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (search_id, property_id) DO NOTHING
+	`
+
+	tag, err := r.conn(ctx).Exec(ctx, query, m.SearchID, m.PropertyID, m.Score, m.MatchExplanation)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListUnseenMatches — непросмотренные совпадения поисков пользователя, от новых к старым.
+// searchID ограничивает выборку одним поиском.
+func (r *SavedSearchRepository) ListUnseenMatches(ctx context.Context, userID uuid.UUID, searchID *uuid.UUID, limit int) ([]domain.SavedSearchMatch, error) {
+	const op = "SavedSearchRepository.ListUnseenMatches"
+
+	query := `
+		SELECT
+			m.match_id, m.search_id, m.property_id, m.score, m.match_explanation,
+			m.seen_at, m.created_at,
+			p.title, p.description, p.address, p.city, p.property_type,
+			p.area, p.price, p.rooms,
+			p.floor, p.total_floors, p.year_built, p.district, p.latitude, p.longitude, p.features,
+			p.status, p.owner_user_id, p.created_user_id,
+			p.created_at, p.updated_at
+		FROM saved_search_matches m
+		JOIN saved_searches s ON s.search_id = m.search_id
+		JOIN properties p ON p.property_id = m.property_id
+	`
+	whereClauses := []string{"s.user_id = $1", "m.seen_at IS NULL"}
+	params := []interface{}{userID}
+	paramCount := 2
+
+	if searchID != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("m.search_id = $%d", paramCount))
+		params = append(params, *searchID)
+		paramCount++
+	}
+
+	query += " WHERE " + strings.Join(whereClauses, " AND ")
+	query += fmt.Sprintf(" ORDER BY m.created_at DESC LIMIT $%d", paramCount)
+	params = append(params, limit)
+
+	rows, err := r.conn(ctx).Query(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var matches []domain.SavedSearchMatch
+	for rows.Next() {
+		var m domain.SavedSearchMatch
+		var propertyTypeStr string
+		var statusStr string
+		if err := rows.Scan(
+			&m.ID,
+			&m.SearchID,
+			&m.PropertyID,
+			&m.Score,
+			&m.MatchExplanation,
+			&m.SeenAt,
+			&m.CreatedAt,
+			&m.Property.Title,
+			&m.Property.Description,
+			&m.Property.Address,
+			&m.Property.City,
+			&propertyTypeStr,
+			&m.Property.Area,
+			&m.Property.Price,
+			&m.Property.Rooms,
+			&m.Property.Floor,
+			&m.Property.TotalFloors,
+			&m.Property.YearBuilt,
+			&m.Property.District,
+			&m.Property.Latitude,
+			&m.Property.Longitude,
+			&m.Property.Features,
+			&statusStr,
+			&m.Property.OwnerUserID,
+			&m.Property.CreatedUserID,
+			&m.Property.CreatedAt,
+			&m.Property.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		m.Property.ID = m.PropertyID
+		m.Property.PropertyType = domain.PropertyType(propertyTypeStr)
+		m.Property.Status = domain.PropertyStatus(statusStr)
+		matches = append(matches, m)
+	}
+
+	return matches, rows.Err()
+}
+
+// MarkMatchesSeen — отмечает совпадения просмотренными.
+func (r *SavedSearchRepository) MarkMatchesSeen(ctx context.Context, matchIDs []uuid.UUID) error {
+	const op = "SavedSearchRepository.MarkMatchesSeen"
+
+	if len(matchIDs) == 0 {
+		return nil
+	}
+
+	query := `UPDATE saved_search_matches SET seen_at = NOW() WHERE match_id = ANY($1) AND seen_at IS NULL`
+	if _, err := r.conn(ctx).Exec(ctx, query, matchIDs); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// AddPending — ставит объекты в очередь сохранённых поисков. Объект, уже стоящий в очереди,
+// получает новое время индексации; удалённые объекты пропускаются.
+func (r *SavedSearchRepository) AddPending(ctx context.Context, propertyIDs []uuid.UUID) error {
+	const op = "SavedSearchRepository.AddPending"
+
+	if len(propertyIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO saved_search_pending (property_id)
+		SELECT property_id FROM properties WHERE property_id = ANY($1)
+		ON CONFLICT (property_id) DO UPDATE SET indexed_at = NOW()
+	`
+	if _, err := r.conn(ctx).Exec(ctx, query, propertyIDs); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListPending — объекты из очереди сохранённых поисков, от давно проиндексированных к новым.
+func (r *SavedSearchRepository) ListPending(ctx context.Context, limit int) ([]domain.SavedSearchPending, error) {
+	const op = "SavedSearchRepository.ListPending"
+
+	query := `SELECT property_id, indexed_at FROM saved_search_pending ORDER BY indexed_at LIMIT $1`
+
+	rows, err := r.conn(ctx).Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var pending []domain.SavedSearchPending
+	for rows.Next() {
+		var p domain.SavedSearchPending
+		if err := rows.Scan(&p.PropertyID, &p.IndexedAt); err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		pending = append(pending, p)
+	}
+
+	return pending, rows.Err()
+}
+
+// RemovePending — убирает обработанный объект из очереди. Если объект успели проиндексировать
+// заново (indexed_at изменился), запись остаётся для следующего прохода.
+func (r *SavedSearchRepository) RemovePending(ctx context.Context, p domain.SavedSearchPending) error {
+	const op = "SavedSearchRepository.RemovePending"
+
+	query := `DELETE FROM saved_search_pending WHERE property_id = $1 AND indexed_at = $2`
+	if _, err := r.conn(ctx).Exec(ctx, query, p.PropertyID, p.IndexedAt); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// scanSavedSearch — читает строку saved_searches в доменную модель.
+func scanSavedSearch(row pgx.Row) (domain.SavedSearch, error) {
+	var s domain.SavedSearch
+	var filterJSON, weightsJSON []byte
+	if err := row.Scan(
+		&s.ID,
+		&s.UserID,
+		&s.Name,
+		&s.LeadID,
+		&filterJSON,
+		&weightsJSON,
+		&s.MinScore,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	); err != nil {
+		return domain.SavedSearch{}, err
+	}
+
+	if len(filterJSON) > 0 {
+		if err := json.Unmarshal(filterJSON, &s.Filter); err != nil {
+			return domain.SavedSearch{}, fmt.Errorf("unmarshal filter: %w", err)
+		}
+	}
+	if len(weightsJSON) > 0 {
+		var w domain.MatchWeights
+		if err := json.Unmarshal(weightsJSON, &w); err != nil {
+			return domain.SavedSearch{}, fmt.Errorf("unmarshal weights: %w", err)
+		}
+		s.Weights = &w
+	}
+
+	return s, nil
+}
@@ -24,6 +24,11 @@ func NewUserRepository(db *pgxpool.Pool, log *slog.Logger) *UserRepository {
 	return &UserRepository{db: db, log: log}
 }
 
+// conn — соединение с учётом транзакции из контекста.
+func (r *UserRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
 // CreateUser — создаёт нового пользователя.
 func (r *UserRepository) CreateUser(ctx context.Context, email, firstName, lastName string, passwordHash []byte) (uuid.UUID, error) {
 	const op = "UserRepository.CreateUser"
@@ -53,7 +58,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.User
 	query := `
 		SELECT 
 			user_id, email, password_hash, first_name, last_name,
-			phone, agency_name, avatar_url, role, status, created_at
+			phone, agency_name, avatar_url, role, status, created_at, email_verified_at
 		FROM users
 		WHERE user_id = $1
 	`
@@ -71,6 +76,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.User
 		&u.Role,
 		&u.Status,
 		&u.CreatedAt,
+		&u.EmailVerifiedAt,
 	)
 
 	if err != nil {
@@ -90,7 +96,7 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (domain.U
 	query := `
 		SELECT 
 			user_id, email, password_hash, first_name, last_name,
-			phone, agency_name, avatar_url, role, status, created_at
+			phone, agency_name, avatar_url, role, status, created_at, email_verified_at
 		FROM users
 		WHERE email = $1
 	`
@@ -108,6 +114,7 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (domain.U
 		&u.Role,
 		&u.Status,
 		&u.CreatedAt,
+		&u.EmailVerifiedAt,
 	)
 
 	if err != nil {
@@ -191,60 +198,163 @@ func (r *UserRepository) UpdateUser(ctx context.Context, userID uuid.UUID, updat
 	return nil
 }
 
-// ListUsers — возвращает всех пользователей с опциональным фильтром по роли.
-func (r *UserRepository) ListUsers(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+// UpdatePassword — заменяет хэш пароля пользователя.
+func (r *UserRepository) UpdatePassword(ctx context.Context, userID uuid.UUID, passwordHash []byte) error {
+	const op = "UserRepository.UpdatePassword"
+
+	tag, err := r.conn(ctx).Exec(ctx, `UPDATE users SET password_hash = $2 WHERE user_id = $1`, userID, string(passwordHash))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// MarkEmailVerified — отмечает email пользователя подтверждённым; повторное подтверждение дату не меняет.
+func (r *UserRepository) MarkEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	const op = "UserRepository.MarkEmailVerified"
+
+	tag, err := r.conn(ctx).Exec(ctx,
+		`UPDATE users SET email_verified_at = COALESCE(email_verified_at, NOW()) WHERE user_id = $1`,
+		userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// userSortColumns — допустимые поля сортировки ListUsers: SQL-выражение и тип значения курсора.
+var userSortColumns = map[string]repository.SortColumn{
+	"created_at": {Expr: "created_at", Cast: "timestamptz"},
+	"email":      {Expr: "email", Cast: "text"},
+}
+
+// ListUsers — возвращает пользователей по фильтру с пагинацией.
+func (r *UserRepository) ListUsers(ctx context.Context, filter domain.UserFilter) (*domain.PaginatedResult[domain.User], error) {
 	const op = "UserRepository.ListUsers"
 
-	query := `
-		SELECT 
-			user_id, email, password_hash, first_name, last_name,
-			phone, agency_name, avatar_url, role, status, created_at
-		FROM users
-	`
-	params := []interface{}{}
-	whereClauses := []string{}
+	// Нормализуем параметры пагинации
+	pageSize := int(domain.DefaultPageSize)
+	var cursor *domain.PageCursor
+	orderBy := "created_at"
+	orderDir := domain.OrderDesc
+
+	if filter.Pagination != nil {
+		pageSize = int(domain.NormalizePageSize(filter.Pagination.PageSize))
+		orderDir = domain.NormalizeOrderDirection(string(filter.Pagination.OrderDirection))
+
+		if _, ok := userSortColumns[filter.Pagination.OrderBy]; ok {
+			orderBy = filter.Pagination.OrderBy
+		}
+
+		if filter.Pagination.PageToken != "" {
+			var err error
+			cursor, err = domain.DecodePageCursor(filter.Pagination.PageToken)
+			if err != nil {
+				r.log.Warn("failed to decode page cursor, starting from beginning", "error", err)
+				cursor = nil
+			}
+		}
+	}
+
+	// Базовые WHERE условия (без cursor)
+	baseWhereClauses := []string{}
+	baseParams := []interface{}{}
 	paramCount := 1
 
 	if filter.Email != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("email = $%d", paramCount))
-		params = append(params, *filter.Email)
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("email = $%d", paramCount))
+		baseParams = append(baseParams, *filter.Email)
 		paramCount++
 	}
 	if filter.FirstName != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("first_name = $%d", paramCount))
-		params = append(params, *filter.FirstName)
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("first_name = $%d", paramCount))
+		baseParams = append(baseParams, *filter.FirstName)
 		paramCount++
 	}
 	if filter.LastName != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("last_name = $%d", paramCount))
-		params = append(params, *filter.LastName)
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("last_name = $%d", paramCount))
+		baseParams = append(baseParams, *filter.LastName)
 		paramCount++
 	}
 	if filter.Phone != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("phone = $%d", paramCount))
-		params = append(params, *filter.Phone)
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("phone = $%d", paramCount))
+		baseParams = append(baseParams, *filter.Phone)
 		paramCount++
 	}
 	if filter.AgencyName != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("agency_name = $%d", paramCount))
-		params = append(params, *filter.AgencyName)
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("agency_name = $%d", paramCount))
+		baseParams = append(baseParams, *filter.AgencyName)
 		paramCount++
 	}
 	if filter.Role != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("role = $%d", paramCount))
-		params = append(params, *filter.Role)
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("role = $%d", paramCount))
+		baseParams = append(baseParams, *filter.Role)
 		paramCount++
 	}
 	if filter.Status != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("status = $%d", paramCount))
-		params = append(params, *filter.Status)
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("status = $%d", paramCount))
+		baseParams = append(baseParams, *filter.Status)
 		paramCount++
 	}
 
+	// Получаем total count
+	countQuery := "SELECT COUNT(*) FROM users"
+	if len(baseWhereClauses) > 0 {
+		countQuery += " WHERE " + strings.Join(baseWhereClauses, " AND ")
+	}
+
+	var totalCount int32
+	if err := r.db.QueryRow(ctx, countQuery, baseParams...).Scan(&totalCount); err != nil {
+		return nil, fmt.Errorf("%s: count failed: %w", op, err)
+	}
+
+	whereClauses := append([]string{}, baseWhereClauses...)
+	params := append([]interface{}{}, baseParams...)
+
+	// Keyset pagination: (поле сортировки, user_id) < или > в зависимости от направления
+	sortCol := userSortColumns[orderBy]
+	if cursor != nil {
+		cmp := "<"
+		if orderDir == domain.OrderAsc {
+			cmp = ">"
+		}
+		whereClauses = append(whereClauses,
+			fmt.Sprintf("(%s, user_id) %s ($%d::text::%s, $%d)", sortCol.Expr, cmp, paramCount, sortCol.Cast, paramCount+1))
+		params = append(params, cursor.KeysetValue(orderBy), cursor.LastID)
+		paramCount += 2
+	}
+
+	query := `
+		SELECT
+			user_id, email, password_hash, first_name, last_name,
+			phone, agency_name, avatar_url, role, status, created_at, email_verified_at
+		FROM users
+	`
 	if len(whereClauses) > 0 {
 		query += " WHERE " + strings.Join(whereClauses, " AND ")
 	}
 
+	dirStr := "DESC"
+	if orderDir == domain.OrderAsc {
+		dirStr = "ASC"
+	}
+	query += fmt.Sprintf(" ORDER BY %s %s, user_id %s", sortCol.Expr, dirStr, dirStr)
+
+	// LIMIT +1 для определения has_more
+	query += fmt.Sprintf(" LIMIT $%d", paramCount)
+	params = append(params, pageSize+1)
+
 	rows, err := r.db.Query(ctx, query, params...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: query failed: %w", op, err)
@@ -266,6 +376,7 @@ func (r *UserRepository) ListUsers(ctx context.Context, filter domain.UserFilter
 			&u.Role,
 			&u.Status,
 			&u.CreatedAt,
+			&u.EmailVerifiedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
@@ -273,7 +384,34 @@ func (r *UserRepository) ListUsers(ctx context.Context, filter domain.UserFilter
 		users = append(users, u)
 	}
 
-	return users, rows.Err()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	hasMore := len(users) > pageSize
+	if hasMore {
+		users = users[:pageSize]
+	}
+
+	var nextPageToken string
+	if hasMore && len(users) > 0 {
+		last := users[len(users)-1]
+		nextCursor := &domain.PageCursor{
+			LastID:        last.ID,
+			LastCreatedAt: last.CreatedAt,
+		}
+		if orderBy == "email" {
+			nextCursor.LastValue = last.Email
+		}
+		nextPageToken = nextCursor.Encode()
+	}
+
+	return &domain.PaginatedResult[domain.User]{
+		Items:         users,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+		HasMore:       hasMore,
+	}, nil
 }
 
 func isUniqueViolation(err error) bool {
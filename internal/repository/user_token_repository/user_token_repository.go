@@ -0,0 +1,112 @@
+package user_token_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UserTokenRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewUserTokenRepository(db *pgxpool.Pool, log *slog.Logger) *UserTokenRepository {
+	return &UserTokenRepository{db: db, log: log}
+}
+
+// conn — соединение с учётом транзакции из контекста.
+func (r *UserTokenRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
+// CreateUserToken — сохраняет одноразовый токен.
+func (r *UserTokenRepository) CreateUserToken(ctx context.Context, t domain.UserToken) (uuid.UUID, error) {
+	const op = "UserTokenRepository.CreateUserToken"
+
+	query := `
+		INSERT INTO user_tokens (user_id, purpose, token_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING token_id
+	`
+
+	var id uuid.UUID
+	err := r.conn(ctx).QueryRow(ctx, query, t.UserID, string(t.Purpose), t.TokenHash, t.ExpiresAt).Scan(&id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+// GetByHash — токен по хэшу. Строка блокируется до конца транзакции, чтобы токен не использовали дважды.
+func (r *UserTokenRepository) GetByHash(ctx context.Context, tokenHash string) (domain.UserToken, error) {
+	const op = "UserTokenRepository.GetByHash"
+
+	query := `
+		SELECT token_id, user_id, purpose, token_hash, expires_at, used_at, created_at
+		FROM user_tokens
+		WHERE token_hash = $1
+		FOR UPDATE
+	`
+
+	var t domain.UserToken
+	var purpose string
+	err := r.conn(ctx).QueryRow(ctx, query, tokenHash).Scan(
+		&t.ID,
+		&t.UserID,
+		&purpose,
+		&t.TokenHash,
+		&t.ExpiresAt,
+		&t.UsedAt,
+		&t.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.UserToken{}, fmt.Errorf("%s: %w", op, repository.ErrUserTokenNotFound)
+		}
+		return domain.UserToken{}, fmt.Errorf("%s: %w", op, err)
+	}
+	t.Purpose = domain.UserTokenPurpose(purpose)
+
+	return t, nil
+}
+
+// MarkUsed — помечает токен использованным.
+func (r *UserTokenRepository) MarkUsed(ctx context.Context, id uuid.UUID) error {
+	const op = "UserTokenRepository.MarkUsed"
+
+	tag, err := r.conn(ctx).Exec(ctx, `UPDATE user_tokens SET used_at = NOW() WHERE token_id = $1 AND used_at IS NULL`, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, repository.ErrUserTokenNotFound)
+	}
+
+	return nil
+}
+
+// InvalidateUserTokens — гасит неиспользованные токены пользователя с данным назначением,
+// чтобы действовала только последняя отправленная ссылка.
+func (r *UserTokenRepository) InvalidateUserTokens(ctx context.Context, userID uuid.UUID, purpose domain.UserTokenPurpose) error {
+	const op = "UserTokenRepository.InvalidateUserTokens"
+
+	_, err := r.conn(ctx).Exec(ctx,
+		`UPDATE user_tokens SET used_at = NOW() WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL`,
+		userID, string(purpose),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
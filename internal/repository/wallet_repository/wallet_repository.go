@@ -0,0 +1,346 @@
+package wallet_repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WalletRepository struct {
+	db  *pgxpool.Pool
+	log *slog.Logger
+}
+
+func NewWalletRepository(db *pgxpool.Pool, log *slog.Logger) *WalletRepository {
+	return &WalletRepository{db: db, log: log}
+}
+
+// conn — соединение с учётом транзакции из контекста.
+func (r *WalletRepository) conn(ctx context.Context) repository.DBTX {
+	return repository.Conn(ctx, r.db)
+}
+
+const accountColumns = `account_id, user_id, kind, balance, created_at, updated_at`
+
+func scanAccount(row pgx.Row) (domain.WalletAccount, error) {
+	var a domain.WalletAccount
+	var kind string
+	if err := row.Scan(&a.ID, &a.UserID, &kind, &a.Balance, &a.CreatedAt, &a.UpdatedAt); err != nil {
+		return domain.WalletAccount{}, err
+	}
+	a.Kind = domain.WalletAccountKind(kind)
+	return a, nil
+}
+
+// GetOrCreateAccount — счёт пользователя указанного вида; создаётся при первом обращении.
+func (r *WalletRepository) GetOrCreateAccount(ctx context.Context, userID uuid.UUID, kind domain.WalletAccountKind) (domain.WalletAccount, error) {
+	const op = "WalletRepository.GetOrCreateAccount"
+
+	_, err := r.conn(ctx).Exec(ctx, `
+		INSERT INTO wallet_accounts (user_id, kind) VALUES ($1, $2)
+		ON CONFLICT (user_id, kind) WHERE user_id IS NOT NULL DO NOTHING`,
+		userID, kind.String(),
+	)
+	if err != nil {
+		return domain.WalletAccount{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	a, err := scanAccount(r.conn(ctx).QueryRow(ctx,
+		`SELECT `+accountColumns+` FROM wallet_accounts WHERE user_id = $1 AND kind = $2`,
+		userID, kind.String(),
+	))
+	if err != nil {
+		return domain.WalletAccount{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return a, nil
+}
+
+// GetSystemAccount — системный счёт указанного вида.
+func (r *WalletRepository) GetSystemAccount(ctx context.Context, kind domain.WalletAccountKind) (domain.WalletAccount, error) {
+	const op = "WalletRepository.GetSystemAccount"
+
+	a, err := scanAccount(r.conn(ctx).QueryRow(ctx,
+		`SELECT `+accountColumns+` FROM wallet_accounts WHERE user_id IS NULL AND kind = $1`,
+		kind.String(),
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.WalletAccount{}, fmt.Errorf("%s: %w", op, repository.ErrWalletAccountNotFound)
+		}
+		return domain.WalletAccount{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return a, nil
+}
+
+// ListAccountsByUser — существующие счета пользователя.
+func (r *WalletRepository) ListAccountsByUser(ctx context.Context, userID uuid.UUID) ([]domain.WalletAccount, error) {
+	const op = "WalletRepository.ListAccountsByUser"
+
+	rows, err := r.conn(ctx).Query(ctx,
+		`SELECT `+accountColumns+` FROM wallet_accounts WHERE user_id = $1 ORDER BY kind`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var accounts []domain.WalletAccount
+	for rows.Next() {
+		a, err := scanAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		accounts = append(accounts, a)
+	}
+
+	return accounts, rows.Err()
+}
+
+// PostTransaction — проводит операцию: блокирует счета, проверяет остатки, записывает операцию
+// и проводки и обновляет балансы. Должна вызываться внутри транзакции БД.
+// Если счёт пользователя уходит в минус, возвращает ErrInsufficientFunds; повторная операция
+// того же типа по сделке — ErrLedgerTransactionExists.
+func (r *WalletRepository) PostTransaction(ctx context.Context, t domain.LedgerTransaction) (domain.LedgerTransaction, error) {
+	const op = "WalletRepository.PostTransaction"
+
+	if !t.IsBalanced() {
+		return domain.LedgerTransaction{}, fmt.Errorf("%s: entries are not balanced", op)
+	}
+
+	deltas := make(map[uuid.UUID]int64, len(t.Entries))
+	ids := make([]uuid.UUID, 0, len(t.Entries))
+	for _, e := range t.Entries {
+		if _, ok := deltas[e.AccountID]; !ok {
+			ids = append(ids, e.AccountID)
+		}
+		deltas[e.AccountID] += e.Amount
+	}
+	// Счета блокируются в порядке account_id, чтобы параллельные операции не взаимоблокировались
+	rows, err := r.conn(ctx).Query(ctx,
+		`SELECT `+accountColumns+` FROM wallet_accounts WHERE account_id = ANY($1) ORDER BY account_id FOR UPDATE`,
+		ids,
+	)
+	if err != nil {
+		return domain.LedgerTransaction{}, fmt.Errorf("%s: lock accounts: %w", op, err)
+	}
+	balances := make(map[uuid.UUID]int64, len(ids))
+	for rows.Next() {
+		a, err := scanAccount(rows)
+		if err != nil {
+			rows.Close()
+			return domain.LedgerTransaction{}, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		if a.Kind != domain.WalletAccountExternal && a.Balance+deltas[a.ID] < 0 {
+			rows.Close()
+			return domain.LedgerTransaction{}, fmt.Errorf("%s: account %s: %w", op, a.ID, repository.ErrInsufficientFunds)
+		}
+		balances[a.ID] = a.Balance
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return domain.LedgerTransaction{}, fmt.Errorf("%s: lock accounts: %w", op, err)
+	}
+	if len(balances) != len(ids) {
+		return domain.LedgerTransaction{}, fmt.Errorf("%s: %w", op, repository.ErrWalletAccountNotFound)
+	}
+
+	err = r.conn(ctx).QueryRow(ctx, `
+		INSERT INTO ledger_transactions (kind, deal_id, created_by, comment)
+		VALUES ($1, $2, $3, $4)
+		RETURNING transaction_id, created_at`,
+		t.Kind.String(), t.DealID, t.CreatedBy, t.Comment,
+	).Scan(&t.ID, &t.CreatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.LedgerTransaction{}, fmt.Errorf("%s: %w", op, repository.ErrLedgerTransactionExists)
+		}
+		return domain.LedgerTransaction{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for i := range t.Entries {
+		e := &t.Entries[i]
+		balances[e.AccountID] += e.Amount
+		e.TransactionID = t.ID
+		e.BalanceAfter = balances[e.AccountID]
+
+		err := r.conn(ctx).QueryRow(ctx, `
+			INSERT INTO ledger_entries (transaction_id, account_id, amount, balance_after, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING entry_id`,
+			t.ID, e.AccountID, e.Amount, e.BalanceAfter, t.CreatedAt,
+		).Scan(&e.ID)
+		if err != nil {
+			return domain.LedgerTransaction{}, fmt.Errorf("%s: insert entry: %w", op, err)
+		}
+	}
+
+	for _, id := range ids {
+		_, err := r.conn(ctx).Exec(ctx,
+			`UPDATE wallet_accounts SET balance = $2, updated_at = NOW() WHERE account_id = $1`,
+			id, balances[id],
+		)
+		if err != nil {
+			return domain.LedgerTransaction{}, fmt.Errorf("%s: update balance: %w", op, err)
+		}
+	}
+
+	return t, nil
+}
+
+// GetDealTransaction — операция указанного типа по сделке вместе с проводками.
+func (r *WalletRepository) GetDealTransaction(ctx context.Context, dealID uuid.UUID, kind domain.LedgerTransactionKind) (domain.LedgerTransaction, error) {
+	const op = "WalletRepository.GetDealTransaction"
+
+	var t domain.LedgerTransaction
+	var txKind string
+	err := r.conn(ctx).QueryRow(ctx, `
+		SELECT transaction_id, kind, deal_id, created_by, comment, created_at
+		FROM ledger_transactions
+		WHERE deal_id = $1 AND kind = $2`,
+		dealID, kind.String(),
+	).Scan(&t.ID, &txKind, &t.DealID, &t.CreatedBy, &t.Comment, &t.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.LedgerTransaction{}, fmt.Errorf("%s: %w", op, repository.ErrLedgerTransactionNotFound)
+		}
+		return domain.LedgerTransaction{}, fmt.Errorf("%s: %w", op, err)
+	}
+	t.Kind = domain.LedgerTransactionKind(txKind)
+
+	rows, err := r.conn(ctx).Query(ctx, `
+		SELECT entry_id, transaction_id, account_id, amount, balance_after
+		FROM ledger_entries
+		WHERE transaction_id = $1
+		ORDER BY amount`,
+		t.ID,
+	)
+	if err != nil {
+		return domain.LedgerTransaction{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e domain.LedgerEntry
+		if err := rows.Scan(&e.ID, &e.TransactionID, &e.AccountID, &e.Amount, &e.BalanceAfter); err != nil {
+			return domain.LedgerTransaction{}, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		t.Entries = append(t.Entries, e)
+	}
+
+	return t, rows.Err()
+}
+
+// ListStatement — проводки по счетам пользователя, от новых к старым, с keyset-пагинацией
+// по (created_at, entry_id).
+func (r *WalletRepository) ListStatement(ctx context.Context, filter domain.WalletStatementFilter) (*domain.PaginatedResult[domain.WalletStatementEntry], error) {
+	const op = "WalletRepository.ListStatement"
+
+	pageSize := int(domain.DefaultPageSize)
+	var cursor *domain.PageCursor
+	if filter.Pagination != nil {
+		pageSize = int(domain.NormalizePageSize(filter.Pagination.PageSize))
+		if filter.Pagination.PageToken != "" {
+			var err error
+			cursor, err = domain.DecodePageCursor(filter.Pagination.PageToken)
+			if err != nil {
+				r.log.Warn("failed to decode page cursor, starting from beginning", "error", err)
+				cursor = nil
+			}
+		}
+	}
+
+	baseWhereClauses := []string{"a.user_id = $1"}
+	baseParams := []interface{}{filter.UserID}
+	paramCount := 2
+
+	if filter.From != nil {
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("e.created_at >= $%d", paramCount))
+		baseParams = append(baseParams, *filter.From)
+		paramCount++
+	}
+	if filter.To != nil {
+		baseWhereClauses = append(baseWhereClauses, fmt.Sprintf("e.created_at < $%d", paramCount))
+		baseParams = append(baseParams, *filter.To)
+		paramCount++
+	}
+
+	from := `
+		FROM ledger_entries e
+		JOIN wallet_accounts a ON a.account_id = e.account_id
+		JOIN ledger_transactions t ON t.transaction_id = e.transaction_id
+	`
+
+	var totalCount int32
+	countQuery := "SELECT COUNT(*)" + from + " WHERE " + strings.Join(baseWhereClauses, " AND ")
+	if err := r.conn(ctx).QueryRow(ctx, countQuery, baseParams...).Scan(&totalCount); err != nil {
+		return nil, fmt.Errorf("%s: count failed: %w", op, err)
+	}
+
+	whereClauses := append([]string{}, baseWhereClauses...)
+	params := append([]interface{}{}, baseParams...)
+	if cursor != nil {
+		whereClauses = append(whereClauses,
+			fmt.Sprintf("(e.created_at, e.entry_id) < ($%d, $%d)", paramCount, paramCount+1))
+		params = append(params, cursor.LastCreatedAt, cursor.LastID)
+		paramCount += 2
+	}
+
+	query := `
+		SELECT e.entry_id, e.transaction_id, t.kind, a.kind, t.deal_id, t.comment,
+		       e.amount, e.balance_after, e.created_at
+	` + from + " WHERE " + strings.Join(whereClauses, " AND ") +
+		fmt.Sprintf(" ORDER BY e.created_at DESC, e.entry_id DESC LIMIT $%d", paramCount)
+	params = append(params, pageSize+1)
+
+	rows, err := r.conn(ctx).Query(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var items []domain.WalletStatementEntry
+	for rows.Next() {
+		var s domain.WalletStatementEntry
+		var txKind, accountKind string
+		if err := rows.Scan(
+			&s.EntryID, &s.TransactionID, &txKind, &accountKind, &s.DealID, &s.Comment,
+			&s.Amount, &s.BalanceAfter, &s.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: scan failed: %w", op, err)
+		}
+		s.Kind = domain.LedgerTransactionKind(txKind)
+		s.AccountKind = domain.WalletAccountKind(accountKind)
+		items = append(items, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := &domain.PaginatedResult[domain.WalletStatementEntry]{TotalCount: totalCount}
+	if len(items) > pageSize {
+		items = items[:pageSize]
+		last := items[len(items)-1]
+		result.HasMore = true
+		result.NextPageToken = (&domain.PageCursor{LastID: last.EntryID, LastCreatedAt: last.CreatedAt}).Encode()
+	}
+	result.Items = items
+
+	return result, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -0,0 +1,636 @@
+package agency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository хранит агентства, участников и приглашения.
+type Repository interface {
+	CreateAgency(ctx context.Context, a domain.Agency) (uuid.UUID, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Agency, error)
+	AddMember(ctx context.Context, m domain.AgencyMember) error
+	GetMemberByUser(ctx context.Context, userID uuid.UUID) (domain.AgencyMember, error)
+	ListMembers(ctx context.Context, agencyID uuid.UUID) ([]domain.AgencyMember, error)
+	UpdateMemberRole(ctx context.Context, agencyID, userID uuid.UUID, role domain.AgencyRole) error
+	RemoveMember(ctx context.Context, agencyID, userID uuid.UUID) error
+	CreateInvitation(ctx context.Context, inv domain.AgencyInvitation) (uuid.UUID, error)
+	GetInvitationForUpdate(ctx context.Context, id uuid.UUID) (domain.AgencyInvitation, error)
+	ListPendingByEmail(ctx context.Context, email string) ([]domain.AgencyInvitation, error)
+	ListPendingByAgency(ctx context.Context, agencyID uuid.UUID) ([]domain.AgencyInvitation, error)
+	SetInvitationStatus(ctx context.Context, id uuid.UUID, status domain.AgencyInvitationStatus) error
+	TeamDealReport(ctx context.Context, agencyID uuid.UUID, from, to *time.Time) ([]domain.AgencyMemberStats, error)
+	CountTeamOpenDeals(ctx context.Context, agencyID uuid.UUID, from, to *time.Time) (int32, error)
+}
+
+// UserRepository нужен, чтобы сверять email приглашения с пользователем.
+type UserRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (domain.User, error)
+	GetByEmail(ctx context.Context, email string) (domain.User, error)
+}
+
+// OwnerReassigner — массовая передача лидов или объектов другому владельцу.
+type OwnerReassigner interface {
+	ReassignOwner(ctx context.Context, from, to uuid.UUID) (int, error)
+}
+
+// DealReassigner — передача открытых сделок другому продавцу.
+type DealReassigner interface {
+	ReassignSeller(ctx context.Context, from, to uuid.UUID) (int, error)
+}
+
+// TxManager — запуск операций в одной транзакции.
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Service — агентства: состав и роли команды, приглашения, перераспределение лидов и отчёт по сделкам.
+type Service struct {
+	log        *slog.Logger
+	repo       Repository
+	users      UserRepository
+	leads      OwnerReassigner
+	properties OwnerReassigner
+	deals      DealReassigner
+	txManager  TxManager
+}
+
+var (
+	ErrAgencyNotFound     = errors.New("agency not found")
+	ErrMemberNotFound     = errors.New("agency member not found")
+	ErrAlreadyMember      = errors.New("user already belongs to an agency")
+	ErrInvitationNotFound = errors.New("invitation not found")
+	// ErrInvitationClosed — на приглашение уже ответили, его отозвали или оно истекло.
+	ErrInvitationClosed = errors.New("invitation is no longer valid")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrInvalidRequest   = errors.New("invalid agency request")
+)
+
+func New(
+	log *slog.Logger,
+	repo Repository,
+	users UserRepository,
+	leads OwnerReassigner,
+	properties OwnerReassigner,
+	deals DealReassigner,
+	txManager TxManager,
+) *Service {
+	return &Service{
+		log:        log,
+		repo:       repo,
+		users:      users,
+		leads:      leads,
+		properties: properties,
+		deals:      deals,
+		txManager:  txManager,
+	}
+}
+
+// CreateAgency — создаёт агентство; создатель становится его владельцем.
+func (s *Service) CreateAgency(ctx context.Context, actor domain.Actor, name string) (domain.Agency, error) {
+	const op = "agency.Service.CreateAgency"
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.Agency{}, fmt.Errorf("%s: name is required: %w", op, ErrInvalidRequest)
+	}
+
+	var id uuid.UUID
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		id, err = s.repo.CreateAgency(ctx, domain.Agency{Name: name, CreatedBy: actor.UserID})
+		if err != nil {
+			return err
+		}
+		return s.repo.AddMember(ctx, domain.AgencyMember{AgencyID: id, UserID: actor.UserID, Role: domain.AgencyRoleOwner})
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrAgencyMemberExists) {
+			return domain.Agency{}, fmt.Errorf("%s: %w", op, ErrAlreadyMember)
+		}
+		s.log.Error("failed to create agency", sl.Err(err))
+		return domain.Agency{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	created, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Agency{}, fmt.Errorf("%s: failed to fetch created agency: %w", op, err)
+	}
+
+	s.log.Info("agency created",
+		slog.String("agency_id", id.String()),
+		slog.String("owner_id", actor.UserID.String()),
+	)
+
+	return created, nil
+}
+
+// GetAgency — агентство с участниками. agencyID == nil — агентство самого пользователя.
+// Чужое агентство доступно только админу; приглашения видят владелец, руководитель и админ.
+func (s *Service) GetAgency(ctx context.Context, actor domain.Actor, agencyID *uuid.UUID) (domain.AgencyDetails, error) {
+	const op = "agency.Service.GetAgency"
+
+	id, role, err := s.resolveAgency(ctx, actor, agencyID)
+	if err != nil {
+		return domain.AgencyDetails{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	a, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrAgencyNotFound) {
+			return domain.AgencyDetails{}, fmt.Errorf("%s: %w", op, ErrAgencyNotFound)
+		}
+		return domain.AgencyDetails{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	members, err := s.repo.ListMembers(ctx, id)
+	if err != nil {
+		return domain.AgencyDetails{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	details := domain.AgencyDetails{Agency: a, Members: members}
+	if role.CanManage() {
+		details.Invitations, err = s.repo.ListPendingByAgency(ctx, id)
+		if err != nil {
+			return domain.AgencyDetails{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return details, nil
+}
+
+// InviteMember — приглашает пользователя по email в агентство приглашающего.
+// Приглашать могут владелец и руководитель; руководитель — только агентов. Владельца пригласить нельзя.
+func (s *Service) InviteMember(ctx context.Context, actor domain.Actor, email string, role domain.AgencyRole) (domain.AgencyInvitation, error) {
+	const op = "agency.Service.InviteMember"
+
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return domain.AgencyInvitation{}, fmt.Errorf("%s: email is required: %w", op, ErrInvalidRequest)
+	}
+	if role != domain.AgencyRoleManager && role != domain.AgencyRoleAgent {
+		return domain.AgencyInvitation{}, fmt.Errorf("%s: role must be MANAGER or AGENT: %w", op, ErrInvalidRequest)
+	}
+
+	inviter, err := s.member(ctx, actor.UserID)
+	if err != nil {
+		return domain.AgencyInvitation{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !inviter.Role.CanManage() || (role == domain.AgencyRoleManager && inviter.Role != domain.AgencyRoleOwner) {
+		return domain.AgencyInvitation{}, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	// Уже состоящего в агентстве пользователя приглашать бессмысленно: принять приглашение он не сможет
+	if invitee, err := s.users.GetByEmail(ctx, email); err == nil {
+		if _, err := s.repo.GetMemberByUser(ctx, invitee.ID); err == nil {
+			return domain.AgencyInvitation{}, fmt.Errorf("%s: %w", op, ErrAlreadyMember)
+		} else if !errors.Is(err, repository.ErrAgencyMemberNotFound) {
+			return domain.AgencyInvitation{}, fmt.Errorf("%s: %w", op, err)
+		}
+	} else if !errors.Is(err, repository.ErrUserNotFound) {
+		return domain.AgencyInvitation{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	inv := domain.AgencyInvitation{
+		AgencyID:  inviter.AgencyID,
+		Email:     email,
+		Role:      role,
+		InvitedBy: actor.UserID,
+		Status:    domain.AgencyInvitationPending,
+		ExpiresAt: time.Now().Add(domain.AgencyInvitationTTL),
+		CreatedAt: time.Now(),
+	}
+	inv.ID, err = s.repo.CreateInvitation(ctx, inv)
+	if err != nil {
+		s.log.Error("failed to create agency invitation", sl.Err(err))
+		return domain.AgencyInvitation{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log.Info("agency invitation created",
+		slog.String("agency_id", inv.AgencyID.String()),
+		slog.String("invitation_id", inv.ID.String()),
+		slog.String("role", role.String()),
+	)
+
+	return inv, nil
+}
+
+// ListMyInvitations — действующие приглашения на email пользователя.
+func (s *Service) ListMyInvitations(ctx context.Context, actor domain.Actor) ([]domain.AgencyInvitation, error) {
+	const op = "agency.Service.ListMyInvitations"
+
+	user, err := s.users.GetByID(ctx, actor.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	invitations, err := s.repo.ListPendingByEmail(ctx, user.Email)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return invitations, nil
+}
+
+// AcceptInvitation — вступление в агентство по приглашению. Принять можно только приглашение на свой email
+// и только не состоя в другом агентстве.
+func (s *Service) AcceptInvitation(ctx context.Context, actor domain.Actor, invitationID uuid.UUID) (domain.AgencyMember, error) {
+	const op = "agency.Service.AcceptInvitation"
+
+	var member domain.AgencyMember
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		inv, err := s.invitationFor(ctx, actor, invitationID)
+		if err != nil {
+			return err
+		}
+
+		member = domain.AgencyMember{AgencyID: inv.AgencyID, UserID: actor.UserID, Role: inv.Role, JoinedAt: time.Now()}
+		if err := s.repo.AddMember(ctx, member); err != nil {
+			if errors.Is(err, repository.ErrAgencyMemberExists) {
+				return ErrAlreadyMember
+			}
+			return err
+		}
+		return s.repo.SetInvitationStatus(ctx, inv.ID, domain.AgencyInvitationAccepted)
+	})
+	if err != nil {
+		return domain.AgencyMember{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log.Info("agency invitation accepted",
+		slog.String("agency_id", member.AgencyID.String()),
+		slog.String("user_id", actor.UserID.String()),
+	)
+
+	return member, nil
+}
+
+// DeclineInvitation — отказ от приглашения на свой email.
+func (s *Service) DeclineInvitation(ctx context.Context, actor domain.Actor, invitationID uuid.UUID) error {
+	const op = "agency.Service.DeclineInvitation"
+
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		inv, err := s.invitationFor(ctx, actor, invitationID)
+		if err != nil {
+			return err
+		}
+		return s.repo.SetInvitationStatus(ctx, inv.ID, domain.AgencyInvitationDeclined)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RevokeInvitation — отзыв приглашения владельцем или руководителем агентства.
+func (s *Service) RevokeInvitation(ctx context.Context, actor domain.Actor, invitationID uuid.UUID) error {
+	const op = "agency.Service.RevokeInvitation"
+
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		inv, err := s.repo.GetInvitationForUpdate(ctx, invitationID)
+		if err != nil {
+			if errors.Is(err, repository.ErrAgencyInvitationNotFound) {
+				return ErrInvitationNotFound
+			}
+			return err
+		}
+
+		role, err := s.roleIn(ctx, actor, inv.AgencyID)
+		if err != nil {
+			return err
+		}
+		if !role.CanManage() {
+			return ErrPermissionDenied
+		}
+		if !inv.IsOpen(time.Now()) {
+			return ErrInvitationClosed
+		}
+		return s.repo.SetInvitationStatus(ctx, inv.ID, domain.AgencyInvitationRevoked)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// UpdateMemberRole — смена роли участника (MANAGER или AGENT). Доступна владельцу агентства и админу.
+func (s *Service) UpdateMemberRole(ctx context.Context, actor domain.Actor, userID uuid.UUID, role domain.AgencyRole) (domain.AgencyMember, error) {
+	const op = "agency.Service.UpdateMemberRole"
+
+	if role != domain.AgencyRoleManager && role != domain.AgencyRoleAgent {
+		return domain.AgencyMember{}, fmt.Errorf("%s: role must be MANAGER or AGENT: %w", op, ErrInvalidRequest)
+	}
+
+	target, err := s.member(ctx, userID)
+	if err != nil {
+		return domain.AgencyMember{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	actorRole, err := s.roleIn(ctx, actor, target.AgencyID)
+	if err != nil {
+		return domain.AgencyMember{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if actorRole != domain.AgencyRoleOwner {
+		return domain.AgencyMember{}, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+	if target.Role == domain.AgencyRoleOwner {
+		return domain.AgencyMember{}, fmt.Errorf("%s: owner role cannot be changed: %w", op, ErrInvalidRequest)
+	}
+
+	if err := s.repo.UpdateMemberRole(ctx, target.AgencyID, userID, role); err != nil {
+		if errors.Is(err, repository.ErrAgencyMemberNotFound) {
+			return domain.AgencyMember{}, fmt.Errorf("%s: %w", op, ErrMemberNotFound)
+		}
+		return domain.AgencyMember{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	target.Role = role
+	return target, nil
+}
+
+// RemoveMember — исключение участника из агентства или выход из него.
+// Выйти может любой участник, кроме владельца. Владелец исключает любого, руководитель — только агентов.
+// Если задан reassignTo, лиды, объекты и открытые сделки уходящего сначала передаются этому участнику.
+func (s *Service) RemoveMember(ctx context.Context, actor domain.Actor, userID uuid.UUID, reassignTo *uuid.UUID) (*domain.AgencyReassignment, error) {
+	const op = "agency.Service.RemoveMember"
+
+	target, err := s.member(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if target.Role == domain.AgencyRoleOwner {
+		return nil, fmt.Errorf("%s: owner cannot leave the agency: %w", op, ErrInvalidRequest)
+	}
+
+	if actor.UserID != userID || reassignTo != nil {
+		actorRole, err := s.roleIn(ctx, actor, target.AgencyID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if !canManageMember(actorRole, target.Role) {
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		}
+	}
+
+	var reassignment *domain.AgencyReassignment
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if reassignTo != nil {
+			r, err := s.reassign(ctx, target, *reassignTo)
+			if err != nil {
+				return err
+			}
+			reassignment = &r
+		}
+		if err := s.repo.RemoveMember(ctx, target.AgencyID, userID); err != nil {
+			if errors.Is(err, repository.ErrAgencyMemberNotFound) {
+				return ErrMemberNotFound
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log.Info("agency member removed",
+		slog.String("agency_id", target.AgencyID.String()),
+		slog.String("user_id", userID.String()),
+		slog.String("removed_by", actor.UserID.String()),
+	)
+
+	return reassignment, nil
+}
+
+// ReassignMemberAssets — передаёт лиды, объекты и открытые сделки участника другому участнику того же агентства.
+// Доступно владельцу, руководителю (для агентов) и админу.
+func (s *Service) ReassignMemberAssets(ctx context.Context, actor domain.Actor, fromUserID, toUserID uuid.UUID) (domain.AgencyReassignment, error) {
+	const op = "agency.Service.ReassignMemberAssets"
+
+	from, err := s.member(ctx, fromUserID)
+	if err != nil {
+		return domain.AgencyReassignment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	actorRole, err := s.roleIn(ctx, actor, from.AgencyID)
+	if err != nil {
+		return domain.AgencyReassignment{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !canManageMember(actorRole, from.Role) {
+		return domain.AgencyReassignment{}, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	var result domain.AgencyReassignment
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		result, err = s.reassign(ctx, from, toUserID)
+		return err
+	})
+	if err != nil {
+		return domain.AgencyReassignment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// GetTeamDealReport — отчёт по сделкам агентства за период. agencyID == nil — агентство самого пользователя.
+// Доступен владельцу, руководителю и админу.
+func (s *Service) GetTeamDealReport(ctx context.Context, actor domain.Actor, agencyID *uuid.UUID, from, to *time.Time) (domain.AgencyDealReport, error) {
+	const op = "agency.Service.GetTeamDealReport"
+
+	if from != nil && to != nil && !from.Before(*to) {
+		return domain.AgencyDealReport{}, fmt.Errorf("%s: from must be before to: %w", op, ErrInvalidRequest)
+	}
+
+	id, role, err := s.resolveAgency(ctx, actor, agencyID)
+	if err != nil {
+		return domain.AgencyDealReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !role.CanManage() {
+		return domain.AgencyDealReport{}, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	stats, err := s.repo.TeamDealReport(ctx, id, from, to)
+	if err != nil {
+		return domain.AgencyDealReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Открытая сделка между двумя участниками есть у обоих, поэтому итог берётся из отдельного подсчёта
+	openDeals, err := s.repo.CountTeamOpenDeals(ctx, id, from, to)
+	if err != nil {
+		return domain.AgencyDealReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	report := domain.AgencyDealReport{AgencyID: id, From: from, To: to, Members: stats}
+	for _, m := range stats {
+		report.Totals.LeadsOwned += m.LeadsOwned
+		report.Totals.PropertiesOwned += m.PropertiesOwned
+		report.Totals.DealsSold += m.DealsSold
+		report.Totals.SoldVolume += m.SoldVolume
+		report.Totals.DealsBought += m.DealsBought
+		report.Totals.BoughtVolume += m.BoughtVolume
+	}
+	report.Totals.OpenDeals = openDeals
+
+	return report, nil
+}
+
+// TeamMembers — пользователи, чьи лиды и объекты видны userID как командные: участники его агентства.
+// Пользователь вне агентства — команда из одного себя.
+func (s *Service) TeamMembers(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	const op = "agency.Service.TeamMembers"
+
+	m, err := s.repo.GetMemberByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrAgencyMemberNotFound) {
+			return []uuid.UUID{userID}, nil
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	members, err := s.repo.ListMembers(ctx, m.AgencyID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.UserID)
+	}
+
+	return ids, nil
+}
+
+// reassign — передача активов участника from участнику toUserID того же агентства; вызывается в транзакции.
+func (s *Service) reassign(ctx context.Context, from domain.AgencyMember, toUserID uuid.UUID) (domain.AgencyReassignment, error) {
+	if from.UserID == toUserID {
+		return domain.AgencyReassignment{}, fmt.Errorf("cannot reassign to the same member: %w", ErrInvalidRequest)
+	}
+
+	to, err := s.member(ctx, toUserID)
+	if err != nil {
+		return domain.AgencyReassignment{}, err
+	}
+	if to.AgencyID != from.AgencyID {
+		return domain.AgencyReassignment{}, fmt.Errorf("target user is not a member of the agency: %w", ErrInvalidRequest)
+	}
+
+	result := domain.AgencyReassignment{FromUserID: from.UserID, ToUserID: toUserID}
+	if result.Leads, err = s.leads.ReassignOwner(ctx, from.UserID, toUserID); err != nil {
+		return domain.AgencyReassignment{}, err
+	}
+	if result.Properties, err = s.properties.ReassignOwner(ctx, from.UserID, toUserID); err != nil {
+		return domain.AgencyReassignment{}, err
+	}
+	if result.Deals, err = s.deals.ReassignSeller(ctx, from.UserID, toUserID); err != nil {
+		return domain.AgencyReassignment{}, err
+	}
+
+	s.log.Info("agency member assets reassigned",
+		slog.String("agency_id", from.AgencyID.String()),
+		slog.String("from_user_id", from.UserID.String()),
+		slog.String("to_user_id", toUserID.String()),
+		slog.Int("leads", result.Leads),
+		slog.Int("properties", result.Properties),
+		slog.Int("deals", result.Deals),
+	)
+
+	return result, nil
+}
+
+// invitationFor — открытое приглашение на email пользователя, заблокированное до конца транзакции.
+// Чужое приглашение возвращается как ErrInvitationNotFound, чтобы не раскрывать его существование.
+func (s *Service) invitationFor(ctx context.Context, actor domain.Actor, invitationID uuid.UUID) (domain.AgencyInvitation, error) {
+	inv, err := s.repo.GetInvitationForUpdate(ctx, invitationID)
+	if err != nil {
+		if errors.Is(err, repository.ErrAgencyInvitationNotFound) {
+			return domain.AgencyInvitation{}, ErrInvitationNotFound
+		}
+		return domain.AgencyInvitation{}, err
+	}
+
+	user, err := s.users.GetByID(ctx, actor.UserID)
+	if err != nil {
+		return domain.AgencyInvitation{}, err
+	}
+	if !strings.EqualFold(user.Email, inv.Email) {
+		return domain.AgencyInvitation{}, ErrInvitationNotFound
+	}
+	if !inv.IsOpen(time.Now()) {
+		return domain.AgencyInvitation{}, ErrInvitationClosed
+	}
+
+	return inv, nil
+}
+
+// member — членство пользователя; отсутствие возвращается как ErrMemberNotFound.
+func (s *Service) member(ctx context.Context, userID uuid.UUID) (domain.AgencyMember, error) {
+	m, err := s.repo.GetMemberByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrAgencyMemberNotFound) {
+			return domain.AgencyMember{}, ErrMemberNotFound
+		}
+		return domain.AgencyMember{}, err
+	}
+	return m, nil
+}
+
+// roleIn — роль пользователя в агентстве. Админ платформы действует с правами владельца,
+// пользователь из другого агентства или без агентства получает ErrPermissionDenied.
+func (s *Service) roleIn(ctx context.Context, actor domain.Actor, agencyID uuid.UUID) (domain.AgencyRole, error) {
+	if actor.IsAdmin() {
+		return domain.AgencyRoleOwner, nil
+	}
+
+	m, err := s.member(ctx, actor.UserID)
+	if err != nil {
+		if errors.Is(err, ErrMemberNotFound) {
+			return domain.AgencyRoleUnspecified, ErrPermissionDenied
+		}
+		return domain.AgencyRoleUnspecified, err
+	}
+	if m.AgencyID != agencyID {
+		return domain.AgencyRoleUnspecified, ErrPermissionDenied
+	}
+
+	return m.Role, nil
+}
+
+// resolveAgency — агентство запроса и роль в нём пользователя. Без agencyID берётся агентство пользователя.
+func (s *Service) resolveAgency(ctx context.Context, actor domain.Actor, agencyID *uuid.UUID) (uuid.UUID, domain.AgencyRole, error) {
+	if agencyID != nil {
+		role, err := s.roleIn(ctx, actor, *agencyID)
+		if err != nil {
+			return uuid.Nil, domain.AgencyRoleUnspecified, err
+		}
+		return *agencyID, role, nil
+	}
+
+	m, err := s.member(ctx, actor.UserID)
+	if err != nil {
+		return uuid.Nil, domain.AgencyRoleUnspecified, err
+	}
+
+	return m.AgencyID, m.Role, nil
+}
+
+// canManageMember — может ли участник с ролью actor исключать участника с ролью target и передавать его лиды.
+func canManageMember(actor, target domain.AgencyRole) bool {
+	switch actor {
+	case domain.AgencyRoleOwner:
+		return true
+	case domain.AgencyRoleManager:
+		return target == domain.AgencyRoleAgent
+	}
+	return false
+}
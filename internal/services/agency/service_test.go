@@ -0,0 +1,445 @@
+package agency
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MockRepository — агентства, участники и приглашения в памяти.
+type MockRepository struct {
+	agencies    map[uuid.UUID]domain.Agency
+	members     map[uuid.UUID]domain.AgencyMember // по user_id
+	invitations map[uuid.UUID]domain.AgencyInvitation
+	reportFrom  *time.Time
+}
+
+func NewMockRepository() *MockRepository {
+	return &MockRepository{
+		agencies:    make(map[uuid.UUID]domain.Agency),
+		members:     make(map[uuid.UUID]domain.AgencyMember),
+		invitations: make(map[uuid.UUID]domain.AgencyInvitation),
+	}
+}
+
+func (m *MockRepository) CreateAgency(ctx context.Context, a domain.Agency) (uuid.UUID, error) {
+	a.ID = uuid.New()
+	a.CreatedAt = time.Now()
+	m.agencies[a.ID] = a
+	return a.ID, nil
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Agency, error) {
+	a, ok := m.agencies[id]
+	if !ok {
+		return domain.Agency{}, repository.ErrAgencyNotFound
+	}
+	return a, nil
+}
+
+func (m *MockRepository) AddMember(ctx context.Context, member domain.AgencyMember) error {
+	if _, ok := m.members[member.UserID]; ok {
+		return repository.ErrAgencyMemberExists
+	}
+	m.members[member.UserID] = member
+	return nil
+}
+
+func (m *MockRepository) GetMemberByUser(ctx context.Context, userID uuid.UUID) (domain.AgencyMember, error) {
+	member, ok := m.members[userID]
+	if !ok {
+		return domain.AgencyMember{}, repository.ErrAgencyMemberNotFound
+	}
+	return member, nil
+}
+
+func (m *MockRepository) ListMembers(ctx context.Context, agencyID uuid.UUID) ([]domain.AgencyMember, error) {
+	var out []domain.AgencyMember
+	for _, member := range m.members {
+		if member.AgencyID == agencyID {
+			out = append(out, member)
+		}
+	}
+	return out, nil
+}
+
+func (m *MockRepository) UpdateMemberRole(ctx context.Context, agencyID, userID uuid.UUID, role domain.AgencyRole) error {
+	member, ok := m.members[userID]
+	if !ok || member.AgencyID != agencyID {
+		return repository.ErrAgencyMemberNotFound
+	}
+	member.Role = role
+	m.members[userID] = member
+	return nil
+}
+
+func (m *MockRepository) RemoveMember(ctx context.Context, agencyID, userID uuid.UUID) error {
+	member, ok := m.members[userID]
+	if !ok || member.AgencyID != agencyID {
+		return repository.ErrAgencyMemberNotFound
+	}
+	delete(m.members, userID)
+	return nil
+}
+
+func (m *MockRepository) CreateInvitation(ctx context.Context, inv domain.AgencyInvitation) (uuid.UUID, error) {
+	inv.ID = uuid.New()
+	m.invitations[inv.ID] = inv
+	return inv.ID, nil
+}
+
+func (m *MockRepository) GetInvitationForUpdate(ctx context.Context, id uuid.UUID) (domain.AgencyInvitation, error) {
+	inv, ok := m.invitations[id]
+	if !ok {
+		return domain.AgencyInvitation{}, repository.ErrAgencyInvitationNotFound
+	}
+	return inv, nil
+}
+
+func (m *MockRepository) ListPendingByEmail(ctx context.Context, email string) ([]domain.AgencyInvitation, error) {
+	var out []domain.AgencyInvitation
+	for _, inv := range m.invitations {
+		if strings.EqualFold(inv.Email, email) && inv.IsOpen(time.Now()) {
+			out = append(out, inv)
+		}
+	}
+	return out, nil
+}
+
+func (m *MockRepository) ListPendingByAgency(ctx context.Context, agencyID uuid.UUID) ([]domain.AgencyInvitation, error) {
+	var out []domain.AgencyInvitation
+	for _, inv := range m.invitations {
+		if inv.AgencyID == agencyID && inv.IsOpen(time.Now()) {
+			out = append(out, inv)
+		}
+	}
+	return out, nil
+}
+
+func (m *MockRepository) SetInvitationStatus(ctx context.Context, id uuid.UUID, status domain.AgencyInvitationStatus) error {
+	inv, ok := m.invitations[id]
+	if !ok || inv.Status != domain.AgencyInvitationPending {
+		return repository.ErrAgencyInvitationNotFound
+	}
+	inv.Status = status
+	m.invitations[id] = inv
+	return nil
+}
+
+func (m *MockRepository) TeamDealReport(ctx context.Context, agencyID uuid.UUID, from, to *time.Time) ([]domain.AgencyMemberStats, error) {
+	m.reportFrom = from
+	var out []domain.AgencyMemberStats
+	for _, member := range m.members {
+		if member.AgencyID == agencyID {
+			out = append(out, domain.AgencyMemberStats{UserID: member.UserID, Role: member.Role, LeadsOwned: 2, DealsSold: 1, SoldVolume: 1000, OpenDeals: 1})
+		}
+	}
+	return out, nil
+}
+
+// CountTeamOpenDeals — у каждого участника одна открытая сделка, и это одна сделка между ними.
+func (m *MockRepository) CountTeamOpenDeals(ctx context.Context, agencyID uuid.UUID, from, to *time.Time) (int32, error) {
+	return 1, nil
+}
+
+// MockUserRepository — пользователи в памяти.
+type MockUserRepository struct {
+	users map[uuid.UUID]domain.User
+}
+
+func (m *MockUserRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.User, error) {
+	u, ok := m.users[id]
+	if !ok {
+		return domain.User{}, repository.ErrUserNotFound
+	}
+	return u, nil
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (domain.User, error) {
+	for _, u := range m.users {
+		if strings.EqualFold(u.Email, email) {
+			return u, nil
+		}
+	}
+	return domain.User{}, repository.ErrUserNotFound
+}
+
+// MockOwners — владельцы лидов или объектов в памяти.
+type MockOwners struct {
+	owners map[uuid.UUID]uuid.UUID // сущность -> владелец
+}
+
+func (m *MockOwners) ReassignOwner(ctx context.Context, from, to uuid.UUID) (int, error) {
+	n := 0
+	for id, owner := range m.owners {
+		if owner == from {
+			m.owners[id] = to
+			n++
+		}
+	}
+	return n, nil
+}
+
+// MockDeals — считает вызовы передачи сделок.
+type MockDeals struct {
+	calls int
+}
+
+func (m *MockDeals) ReassignSeller(ctx context.Context, from, to uuid.UUID) (int, error) {
+	m.calls++
+	return 1, nil
+}
+
+// MockTxManager выполняет функцию без транзакции.
+type MockTxManager struct{}
+
+func (m *MockTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// addUser — регистрирует пользователя с ролью USER.
+func addUser(users *MockUserRepository, email string) domain.Actor {
+	id := uuid.New()
+	users.users[id] = domain.User{ID: id, Email: email, Role: domain.UserRoleUser}
+	return domain.Actor{UserID: id, Role: domain.UserRoleUser}
+}
+
+// join — приглашает пользователя от имени inviter и принимает приглашение.
+func join(t *testing.T, svc *Service, users *MockUserRepository, inviter, invitee domain.Actor, role domain.AgencyRole) {
+	t.Helper()
+	inv, err := svc.InviteMember(context.Background(), inviter, users.users[invitee.UserID].Email, role)
+	if err != nil {
+		t.Fatalf("invite: unexpected error: %v", err)
+	}
+	if _, err := svc.AcceptInvitation(context.Background(), invitee, inv.ID); err != nil {
+		t.Fatalf("accept: unexpected error: %v", err)
+	}
+}
+
+func TestService_InvitationFlow(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewMockRepository()
+	users := &MockUserRepository{users: make(map[uuid.UUID]domain.User)}
+	svc := New(log, repo, users, &MockOwners{owners: make(map[uuid.UUID]uuid.UUID)}, &MockOwners{owners: make(map[uuid.UUID]uuid.UUID)}, &MockDeals{}, &MockTxManager{})
+	ctx := context.Background()
+	owner := addUser(users, "owner@m.c")
+	agent := addUser(users, "Agent@M.c")
+
+	created, err := svc.CreateAgency(ctx, owner, "  Домклик  ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.Name != "Домклик" || repo.members[owner.UserID].Role != domain.AgencyRoleOwner {
+		t.Fatalf("expected creator to become owner of %q, got %+v", created.Name, repo.members[owner.UserID])
+	}
+
+	inv, err := svc.InviteMember(ctx, owner, " agent@m.c ", domain.AgencyRoleAgent)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Приглашение на чужой email не раскрывается
+	outsider := addUser(users, "outsider@m.c")
+	if _, err := svc.AcceptInvitation(ctx, outsider, inv.ID); !errors.Is(err, ErrInvitationNotFound) {
+		t.Fatalf("expected ErrInvitationNotFound for another user, got %v", err)
+	}
+
+	mine, err := svc.ListMyInvitations(ctx, agent)
+	if err != nil || len(mine) != 1 {
+		t.Fatalf("expected 1 invitation for agent, got %d (err=%v)", len(mine), err)
+	}
+
+	member, err := svc.AcceptInvitation(ctx, agent, inv.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if member.AgencyID != created.ID || member.Role != domain.AgencyRoleAgent {
+		t.Errorf("expected agent in agency %s, got %+v", created.ID, member)
+	}
+	if _, err := svc.AcceptInvitation(ctx, agent, inv.ID); !errors.Is(err, ErrInvitationClosed) {
+		t.Errorf("expected ErrInvitationClosed on second accept, got %v", err)
+	}
+
+	// Участника агентства повторно не приглашают
+	if _, err := svc.InviteMember(ctx, owner, "agent@m.c", domain.AgencyRoleAgent); !errors.Is(err, ErrAlreadyMember) {
+		t.Errorf("expected ErrAlreadyMember, got %v", err)
+	}
+
+	// Агенты не приглашают
+	if _, err := svc.InviteMember(ctx, agent, "new@m.c", domain.AgencyRoleAgent); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied for agent, got %v", err)
+	}
+
+	details, err := svc.GetAgency(ctx, agent, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(details.Members) != 2 || details.Invitations != nil {
+		t.Errorf("expected 2 members and no invitations for agent, got %d members, %d invitations", len(details.Members), len(details.Invitations))
+	}
+}
+
+func TestService_InviteMember_Roles(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	users := &MockUserRepository{users: make(map[uuid.UUID]domain.User)}
+	svc := New(log, NewMockRepository(), users, &MockOwners{owners: make(map[uuid.UUID]uuid.UUID)}, &MockOwners{owners: make(map[uuid.UUID]uuid.UUID)}, &MockDeals{}, &MockTxManager{})
+	ctx := context.Background()
+	owner := addUser(users, "owner@m.c")
+	manager := addUser(users, "manager@m.c")
+	if _, err := svc.CreateAgency(ctx, owner, "Агентство"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	join(t, svc, users, owner, manager, domain.AgencyRoleManager)
+
+	tests := []struct {
+		name    string
+		actor   domain.Actor
+		role    domain.AgencyRole
+		wantErr error
+	}{
+		{name: "manager invites agent", actor: manager, role: domain.AgencyRoleAgent},
+		{name: "manager cannot invite manager", actor: manager, role: domain.AgencyRoleManager, wantErr: ErrPermissionDenied},
+		{name: "owner invites manager", actor: owner, role: domain.AgencyRoleManager},
+		{name: "owner cannot be invited", actor: owner, role: domain.AgencyRoleOwner, wantErr: ErrInvalidRequest},
+		{name: "user without agency", actor: addUser(users, "solo@m.c"), role: domain.AgencyRoleAgent, wantErr: ErrMemberNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.InviteMember(ctx, tt.actor, uuid.NewString()+"@m.c", tt.role)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestService_RemoveMember_ReassignsLeads(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewMockRepository()
+	users := &MockUserRepository{users: make(map[uuid.UUID]domain.User)}
+	leads := &MockOwners{owners: make(map[uuid.UUID]uuid.UUID)}
+	svc := New(log, repo, users, leads, &MockOwners{owners: make(map[uuid.UUID]uuid.UUID)}, &MockDeals{}, &MockTxManager{})
+	ctx := context.Background()
+	owner := addUser(users, "owner@m.c")
+	manager := addUser(users, "manager@m.c")
+	leaving := addUser(users, "leaving@m.c")
+	colleague := addUser(users, "colleague@m.c")
+	if _, err := svc.CreateAgency(ctx, owner, "Агентство"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	join(t, svc, users, owner, manager, domain.AgencyRoleManager)
+	join(t, svc, users, owner, leaving, domain.AgencyRoleAgent)
+	join(t, svc, users, owner, colleague, domain.AgencyRoleAgent)
+
+	leadID := uuid.New()
+	leads.owners[leadID] = leaving.UserID
+
+	// Агент не перераспределяет чужие лиды
+	if _, err := svc.ReassignMemberAssets(ctx, colleague, leaving.UserID, colleague.UserID); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied for agent, got %v", err)
+	}
+	// Передать можно только участнику того же агентства
+	if _, err := svc.ReassignMemberAssets(ctx, manager, leaving.UserID, addUser(users, "solo@m.c").UserID); !errors.Is(err, ErrMemberNotFound) {
+		t.Fatalf("expected ErrMemberNotFound for outsider, got %v", err)
+	}
+
+	reassignment, err := svc.RemoveMember(ctx, manager, leaving.UserID, &colleague.UserID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reassignment == nil || reassignment.Leads != 1 || reassignment.Deals != 1 {
+		t.Fatalf("expected 1 lead and 1 deal to be reassigned, got %+v", reassignment)
+	}
+	if leads.owners[leadID] != colleague.UserID {
+		t.Errorf("expected lead to move to colleague, owner is %s", leads.owners[leadID])
+	}
+	if _, ok := repo.members[leaving.UserID]; ok {
+		t.Error("expected departing agent to be removed")
+	}
+
+	// Руководитель не исключает руководителей, владелец не выходит из агентства
+	if _, err := svc.RemoveMember(ctx, manager, owner.UserID, nil); !errors.Is(err, ErrInvalidRequest) {
+		t.Errorf("expected ErrInvalidRequest for owner removal, got %v", err)
+	}
+	if _, err := svc.RemoveMember(ctx, colleague, manager.UserID, nil); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied for agent removing manager, got %v", err)
+	}
+	// Агент может выйти сам
+	if _, err := svc.RemoveMember(ctx, colleague, colleague.UserID, nil); err != nil {
+		t.Errorf("expected agent to leave, got %v", err)
+	}
+}
+
+func TestService_GetTeamDealReport(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewMockRepository()
+	users := &MockUserRepository{users: make(map[uuid.UUID]domain.User)}
+	svc := New(log, repo, users, &MockOwners{owners: make(map[uuid.UUID]uuid.UUID)}, &MockOwners{owners: make(map[uuid.UUID]uuid.UUID)}, &MockDeals{}, &MockTxManager{})
+	ctx := context.Background()
+	owner := addUser(users, "owner@m.c")
+	agent := addUser(users, "agent@m.c")
+	created, err := svc.CreateAgency(ctx, owner, "Агентство")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	join(t, svc, users, owner, agent, domain.AgencyRoleAgent)
+
+	from := time.Now().Add(-24 * time.Hour)
+	report, err := svc.GetTeamDealReport(ctx, owner, nil, &from, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Members) != 2 || report.Totals.LeadsOwned != 4 || report.Totals.SoldVolume != 2000 {
+		t.Errorf("expected totals over 2 members, got %+v", report.Totals)
+	}
+	if report.Totals.OpenDeals != 1 {
+		t.Errorf("expected a deal between members to be counted once, got %d", report.Totals.OpenDeals)
+	}
+	if repo.reportFrom == nil || !repo.reportFrom.Equal(from) {
+		t.Errorf("expected period to be passed to repository, got %v", repo.reportFrom)
+	}
+
+	if _, err := svc.GetTeamDealReport(ctx, agent, nil, nil, nil); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied for agent, got %v", err)
+	}
+	admin := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleAdmin}
+	if _, err := svc.GetTeamDealReport(ctx, admin, &created.ID, nil, nil); err != nil {
+		t.Errorf("expected admin to read any agency report, got %v", err)
+	}
+	if _, err := svc.GetTeamDealReport(ctx, owner, nil, &from, &from); !errors.Is(err, ErrInvalidRequest) {
+		t.Errorf("expected ErrInvalidRequest for empty period, got %v", err)
+	}
+}
+
+func TestService_TeamMembers(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	users := &MockUserRepository{users: make(map[uuid.UUID]domain.User)}
+	svc := New(log, NewMockRepository(), users, &MockOwners{owners: make(map[uuid.UUID]uuid.UUID)}, &MockOwners{owners: make(map[uuid.UUID]uuid.UUID)}, &MockDeals{}, &MockTxManager{})
+	ctx := context.Background()
+	owner := addUser(users, "owner@m.c")
+	agent := addUser(users, "agent@m.c")
+	if _, err := svc.CreateAgency(ctx, owner, "Агентство"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	join(t, svc, users, owner, agent, domain.AgencyRoleAgent)
+
+	members, err := svc.TeamMembers(ctx, agent.UserID)
+	if err != nil || len(members) != 2 {
+		t.Fatalf("expected 2 team members, got %v (err=%v)", members, err)
+	}
+
+	solo := uuid.New()
+	members, err = svc.TeamMembers(ctx, solo)
+	if err != nil || len(members) != 1 || members[0] != solo {
+		t.Errorf("expected user without agency to be a team of one, got %v (err=%v)", members, err)
+	}
+}
@@ -2,13 +2,14 @@ package clarification
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/lib/llm"
 	"lead_exchange/internal/services/weights"
+
+	"github.com/samber/lo"
 )
 
 // Agent — AI-агент для генерации уточняющих вопросов.
@@ -42,18 +43,7 @@ type ClarificationResult struct {
 }
 
 // Question — уточняющий вопрос.
-type Question struct {
-	// Field — поле, которое уточняется
-	Field string `json:"field"`
-	// Question — текст вопроса
-	Question string `json:"question"`
-	// QuestionType — тип вопроса (open, choice, range, boolean)
-	QuestionType string `json:"question_type"`
-	// SuggestedOptions — предложенные варианты ответа
-	SuggestedOptions []string `json:"suggested_options,omitempty"`
-	// Importance — важность вопроса (required, recommended, optional)
-	Importance string `json:"importance"`
-}
+type Question = domain.ClarificationQuestion
 
 // AnalyzeAndGenerateQuestions анализирует лид и генерирует уточняющие вопросы.
 func (a *Agent) AnalyzeAndGenerateQuestions(ctx context.Context, lead domain.Lead) (*ClarificationResult, error) {
@@ -103,6 +93,11 @@ func (a *Agent) AnalyzeAndGenerateQuestions(ctx context.Context, lead domain.Lea
 	return result, nil
 }
 
+// LeadQuality — оценка качества лида (0-1) по заполненности требований и описания.
+func (a *Agent) LeadQuality(lead domain.Lead) float64 {
+	return a.calculateLeadQuality(lead, a.weightsAnalyzer.GetMissingFields(lead))
+}
+
 // calculateLeadQuality вычисляет оценку качества лида (0-1).
 func (a *Agent) calculateLeadQuality(lead domain.Lead, missingFields []string) float64 {
 	score := 1.0
@@ -163,15 +158,10 @@ func (a *Agent) determinePriority(missingFields []string, qualityScore float64)
 func (a *Agent) generateQuestionsWithLLM(ctx context.Context, lead domain.Lead, missingFields []string) ([]Question, error) {
 	const op = "clarification.Agent.generateQuestionsWithLLM"
 
-	var reqMap map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		json.Unmarshal(lead.Requirement, &reqMap)
-	}
-
 	req := llm.ClarificationRequest{
 		Title:         lead.Title,
 		Description:   lead.Description,
-		Requirement:   reqMap,
+		Requirement:   lead.Requirement.Map(),
 		MissingFields: missingFields,
 	}
 
@@ -288,60 +278,55 @@ func (a *Agent) generateFallbackQuestions(missingFields []string) []Question {
 }
 
 // ApplyClarificationAnswers применяет ответы на уточняющие вопросы к лиду.
-// Возвращает обновлённый requirement JSON.
-func (a *Agent) ApplyClarificationAnswers(lead domain.Lead, answers map[string]interface{}) (json.RawMessage, error) {
+// Возвращает обновлённые требования; поля вне схемы требований (например, city) пропускаются.
+func (a *Agent) ApplyClarificationAnswers(lead domain.Lead, answers map[string]interface{}) (domain.LeadRequirement, error) {
 	const op = "clarification.Agent.ApplyClarificationAnswers"
 
-	var reqMap map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		if err := json.Unmarshal(lead.Requirement, &reqMap); err != nil {
-			reqMap = make(map[string]interface{})
-		}
-	} else {
-		reqMap = make(map[string]interface{})
-	}
+	req := lead.Requirement
 
-	// Применяем ответы
+	// Применяем ответы; одиночное значение задаёт обе границы диапазона
 	for field, value := range answers {
 		switch field {
 		case "price":
 			// Парсим бюджет из текстового ответа
 			if priceStr, ok := value.(string); ok {
 				if price := a.parsePriceRange(priceStr); price > 0 {
-					reqMap["price"] = price
+					req.Price = &domain.Range[int64]{Min: &price, Max: &price}
 				}
-			} else if price, ok := value.(float64); ok {
-				reqMap["price"] = int64(price)
+			} else if p, ok := value.(float64); ok {
+				price := int64(p)
+				req.Price = &domain.Range[int64]{Min: &price, Max: &price}
 			}
 		case "roomNumber":
 			// Парсим количество комнат
 			if roomsStr, ok := value.(string); ok {
 				if rooms := a.parseRooms(roomsStr); rooms > 0 {
-					reqMap["roomNumber"] = rooms
+					req.Rooms = &domain.Range[int32]{Min: &rooms, Max: &rooms}
 				}
-			} else if rooms, ok := value.(float64); ok {
-				reqMap["roomNumber"] = int32(rooms)
+			} else if r, ok := value.(float64); ok {
+				rooms := int32(r)
+				req.Rooms = &domain.Range[int32]{Min: &rooms, Max: &rooms}
 			}
 		case "area":
 			// Парсим площадь
 			if areaStr, ok := value.(string); ok {
 				if area := a.parseArea(areaStr); area > 0 {
-					reqMap["area"] = area
+					req.Area = &domain.Range[float64]{Min: &area, Max: &area}
 				}
 			} else if area, ok := value.(float64); ok {
-				reqMap["area"] = area
+				req.Area = &domain.Range[float64]{Min: &area, Max: &area}
 			}
 		case "district":
 			if district, ok := value.(string); ok && district != "" && district != "Любой" {
-				reqMap["district"] = district
+				req.Districts = append([]string{district}, lo.Without(req.Districts, district)...)
 			}
-		default:
-			// Другие поля добавляем как есть
-			reqMap[field] = value
 		}
 	}
 
-	return json.Marshal(reqMap)
+	if err := req.Validate(); err != nil {
+		return domain.LeadRequirement{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return req.Normalize(), nil
 }
 
 // parsePriceRange парсит бюджет из текстового ответа.
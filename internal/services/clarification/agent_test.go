@@ -2,7 +2,6 @@ package clarification
 
 import (
 	"context"
-	"encoding/json"
 	"lead_exchange/internal/config"
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/lib/llm"
@@ -94,19 +93,19 @@ func TestAgent_AnalyzeCompleteLead_NoClarification(t *testing.T) {
 	agent := NewAgent(log, llmClient, weightsAnalyzer)
 
 	// Полный лид со всеми деталями
-	requirement := map[string]interface{}{
-		"price":      float64(15000000),
-		"district":   "Центральный",
-		"roomNumber": float64(3),
-		"area":       float64(80),
+	price, rooms, area := int64(15000000), int32(3), float64(80)
+	requirement := domain.LeadRequirement{
+		Price:     &domain.Range[int64]{Max: &price},
+		Rooms:     &domain.Range[int32]{Min: &rooms, Max: &rooms},
+		Area:      &domain.Range[float64]{Min: &area},
+		Districts: []string{"Центральный"},
 	}
-	reqJSON, _ := json.Marshal(requirement)
 
 	lead := domain.Lead{
 		ID:          uuid.New(),
 		Title:       "Ищу 3-комнатную квартиру в центре",
 		Description: "Семья из 4 человек, нужна квартира около 80 кв.м. Бюджет до 15 млн. Важно чтобы был балкон и парковка.",
-		Requirement: reqJSON,
+		Requirement: requirement,
 	}
 
 	result, err := agent.AnalyzeAndGenerateQuestions(context.Background(), lead)
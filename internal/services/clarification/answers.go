@@ -0,0 +1,91 @@
+package clarification
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"lead_exchange/internal/domain"
+
+	"github.com/samber/lo"
+)
+
+// ApplyAnswers — применяет ответы на уточняющие вопросы к требованиям лида.
+// Возвращает нормализованную копию требований; исходные не изменяются.
+func ApplyAnswers(req domain.LeadRequirement, answers []domain.ClarificationAnswer) (domain.LeadRequirement, error) {
+	for _, answer := range answers {
+		if err := applyRequirementAnswer(&req, answer.Field, answer.Value); err != nil {
+			return domain.LeadRequirement{}, err
+		}
+	}
+	if err := req.Validate(); err != nil {
+		return domain.LeadRequirement{}, fmt.Errorf("invalid requirement: %w", err)
+	}
+	return req.Normalize(), nil
+}
+
+// applyRequirementAnswer — применяет ответ на уточняющий вопрос к требованиям лида.
+// Одиночное значение (price, rooms, area) задаёт обе границы диапазона, min_*/max_* — одну.
+func applyRequirementAnswer(req *domain.LeadRequirement, field, value string) error {
+	value = strings.TrimSpace(value)
+
+	switch field {
+	case "price", "min_price", "max_price":
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s must be an integer", field)
+		}
+		req.Price = setRangeBound(req.Price, field, v)
+	case "rooms", "roomNumber", "min_rooms", "max_rooms":
+		v, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("%s must be an integer", field)
+		}
+		req.Rooms = setRangeBound(req.Rooms, field, int32(v))
+	case "area", "min_area", "max_area":
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%s must be a number", field)
+		}
+		req.Area = setRangeBound(req.Area, field, v)
+	case "district":
+		// Новый район становится целевым, прежние остаются предпочтительными
+		districts := lo.Without(req.Districts, value)
+		req.Districts = append([]string{value}, districts...)
+	case "must_have":
+		req.MustHave = lo.Uniq(append(req.MustHave, splitList(value)...))
+	case "nice_to_have":
+		req.NiceToHave = lo.Uniq(append(req.NiceToHave, splitList(value)...))
+	default:
+		return fmt.Errorf("field %q is not part of lead requirement", field)
+	}
+	return nil
+}
+
+// setRangeBound — выставляет границу диапазона по имени поля ответа.
+func setRangeBound[T int32 | int64 | float64](r *domain.Range[T], field string, v T) *domain.Range[T] {
+	var out domain.Range[T]
+	if r != nil {
+		out = *r
+	}
+	switch {
+	case strings.HasPrefix(field, "min_"):
+		out.Min = &v
+	case strings.HasPrefix(field, "max_"):
+		out.Max = &v
+	default:
+		out.Min, out.Max = &v, &v
+	}
+	return &out
+}
+
+// splitList — разбирает список через запятую, пропуская пустые элементы.
+func splitList(value string) []string {
+	var out []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
@@ -0,0 +1,211 @@
+package clarification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/lead"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository хранит сессии уточнения (lead_clarifications).
+type Repository interface {
+	CreateClarification(ctx context.Context, c domain.LeadClarification) (uuid.UUID, error)
+	GetPendingByLead(ctx context.Context, leadID uuid.UUID) (domain.LeadClarification, error)
+	SkipPending(ctx context.Context, leadID uuid.UUID) error
+	AnswerClarification(ctx context.Context, id uuid.UUID, answers []domain.ClarificationAnswer, qualityAfter float64) error
+	ListByLead(ctx context.Context, leadID uuid.UUID) ([]domain.LeadClarification, error)
+}
+
+// LeadService нужен для чтения лида и сохранения уточнённых требований.
+type LeadService interface {
+	GetLead(ctx context.Context, id uuid.UUID) (domain.Lead, error)
+	UpdateLead(ctx context.Context, actor domain.Actor, id uuid.UUID, update domain.LeadFilter) (domain.Lead, error)
+}
+
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Service — сессии уточнения лидов: вопросы агента, ответы и качество лида до и после.
+type Service struct {
+	log         *slog.Logger
+	agent       *Agent
+	repo        Repository
+	leadService LeadService
+	txManager   TxManager
+}
+
+var (
+	ErrLeadNotFound     = errors.New("lead not found")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrInvalidAnswer    = errors.New("invalid clarification answer")
+)
+
+func New(log *slog.Logger, agent *Agent, repo Repository, leadService LeadService, txManager TxManager) *Service {
+	return &Service{
+		log:         log,
+		agent:       agent,
+		repo:        repo,
+		leadService: leadService,
+		txManager:   txManager,
+	}
+}
+
+// StartClarification — анализирует лид, генерирует вопросы и открывает сессию уточнения.
+// Неотвеченные сессии лида при этом считаются пропущенными. Доступно владельцу лида и админу.
+func (s *Service) StartClarification(ctx context.Context, actor domain.Actor, leadID uuid.UUID) (domain.LeadClarification, *ClarificationResult, error) {
+	const op = "clarification.Service.StartClarification"
+
+	l, err := s.getLead(ctx, leadID)
+	if err != nil {
+		return domain.LeadClarification{}, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !actor.IsAdmin() && l.OwnerUserID != actor.UserID {
+		return domain.LeadClarification{}, nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	result, err := s.agent.AnalyzeAndGenerateQuestions(ctx, l)
+	if err != nil {
+		return domain.LeadClarification{}, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	session := domain.LeadClarification{
+		LeadID:             leadID,
+		Questions:          result.Questions,
+		Priority:           result.Priority,
+		Status:             domain.ClarificationStatusPending,
+		QualityScoreBefore: &result.LeadQualityScore,
+		CreatedAt:          time.Now(),
+	}
+
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.SkipPending(ctx, leadID); err != nil {
+			return err
+		}
+		session.ID, err = s.repo.CreateClarification(ctx, session)
+		return err
+	})
+	if err != nil {
+		return domain.LeadClarification{}, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log.Info("clarification session started",
+		slog.String("lead_id", leadID.String()),
+		slog.String("clarification_id", session.ID.String()),
+		slog.Int("questions_count", len(session.Questions)),
+	)
+
+	return session, result, nil
+}
+
+// ApplyAnswers — применяет ответы к требованиям лида и закрывает открытую сессию уточнения.
+// Если открытой сессии нет (вопросы не запрашивались), ответы записываются новой закрытой сессией.
+func (s *Service) ApplyAnswers(ctx context.Context, actor domain.Actor, leadID uuid.UUID, answers []domain.ClarificationAnswer) (domain.LeadClarification, domain.Lead, error) {
+	const op = "clarification.Service.ApplyAnswers"
+
+	l, err := s.getLead(ctx, leadID)
+	if err != nil {
+		return domain.LeadClarification{}, domain.Lead{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	requirement, err := ApplyAnswers(l.Requirement, answers)
+	if err != nil {
+		return domain.LeadClarification{}, domain.Lead{}, fmt.Errorf("%s: %w: %v", op, ErrInvalidAnswer, err)
+	}
+
+	var session domain.LeadClarification
+	var updated domain.Lead
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		session, err = s.repo.GetPendingByLead(ctx, leadID)
+		hasSession := err == nil
+		if err != nil && !errors.Is(err, repository.ErrClarificationNotFound) {
+			return err
+		}
+
+		updated, err = s.leadService.UpdateLead(ctx, actor, leadID, domain.LeadFilter{Requirement: &requirement})
+		if err != nil {
+			return mapLeadError(err)
+		}
+
+		quality := s.agent.LeadQuality(updated)
+		now := time.Now()
+		if !hasSession {
+			before := s.agent.LeadQuality(l)
+			session = domain.LeadClarification{
+				LeadID:             leadID,
+				Priority:           "low",
+				QualityScoreBefore: &before,
+				CreatedAt:          now,
+			}
+		}
+		session.Answers = answers
+		session.Status = domain.ClarificationStatusAnswered
+		session.QualityScoreAfter = &quality
+		session.AnsweredAt = &now
+
+		if hasSession {
+			return s.repo.AnswerClarification(ctx, session.ID, answers, quality)
+		}
+		session.ID, err = s.repo.CreateClarification(ctx, session)
+		return err
+	})
+	if err != nil {
+		return domain.LeadClarification{}, domain.Lead{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log.Info("clarification answers applied",
+		slog.String("lead_id", leadID.String()),
+		slog.String("clarification_id", session.ID.String()),
+		slog.Int("answers_count", len(answers)),
+	)
+
+	return session, updated, nil
+}
+
+// ListClarifications — история уточнений лида, новые первыми. Доступна владельцу лида и админу.
+func (s *Service) ListClarifications(ctx context.Context, actor domain.Actor, leadID uuid.UUID) ([]domain.LeadClarification, error) {
+	const op = "clarification.Service.ListClarifications"
+
+	l, err := s.getLead(ctx, leadID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !actor.IsAdmin() && l.OwnerUserID != actor.UserID {
+		return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	clarifications, err := s.repo.ListByLead(ctx, leadID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return clarifications, nil
+}
+
+// getLead — лид по ID; отсутствие лида возвращается как ErrLeadNotFound.
+func (s *Service) getLead(ctx context.Context, leadID uuid.UUID) (domain.Lead, error) {
+	l, err := s.leadService.GetLead(ctx, leadID)
+	if err != nil {
+		return domain.Lead{}, mapLeadError(err)
+	}
+	return l, nil
+}
+
+// mapLeadError — ошибки сервиса лидов в ошибки уточнения.
+func mapLeadError(err error) error {
+	switch {
+	case errors.Is(err, lead.ErrLeadNotFound):
+		return ErrLeadNotFound
+	case errors.Is(err, lead.ErrPermissionDenied):
+		return ErrPermissionDenied
+	case errors.Is(err, lead.ErrInvalidRequirement):
+		return fmt.Errorf("%w: %v", ErrInvalidAnswer, err)
+	}
+	return err
+}
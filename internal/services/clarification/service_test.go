@@ -0,0 +1,273 @@
+package clarification
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/lead"
+	"lead_exchange/internal/services/weights"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// MockRepository — сессии уточнения в памяти.
+type MockRepository struct {
+	sessions []*domain.LeadClarification
+}
+
+func (m *MockRepository) CreateClarification(ctx context.Context, c domain.LeadClarification) (uuid.UUID, error) {
+	c.ID = uuid.New()
+	m.sessions = append(m.sessions, &c)
+	return c.ID, nil
+}
+
+func (m *MockRepository) GetPendingByLead(ctx context.Context, leadID uuid.UUID) (domain.LeadClarification, error) {
+	for i := len(m.sessions) - 1; i >= 0; i-- {
+		if s := m.sessions[i]; s.LeadID == leadID && s.Status == domain.ClarificationStatusPending {
+			return *s, nil
+		}
+	}
+	return domain.LeadClarification{}, repository.ErrClarificationNotFound
+}
+
+func (m *MockRepository) SkipPending(ctx context.Context, leadID uuid.UUID) error {
+	for _, s := range m.sessions {
+		if s.LeadID == leadID && s.Status == domain.ClarificationStatusPending {
+			s.Status = domain.ClarificationStatusSkipped
+		}
+	}
+	return nil
+}
+
+func (m *MockRepository) AnswerClarification(ctx context.Context, id uuid.UUID, answers []domain.ClarificationAnswer, qualityAfter float64) error {
+	for _, s := range m.sessions {
+		if s.ID == id && s.Status == domain.ClarificationStatusPending {
+			s.Answers = answers
+			s.QualityScoreAfter = &qualityAfter
+			s.Status = domain.ClarificationStatusAnswered
+			return nil
+		}
+	}
+	return repository.ErrClarificationNotFound
+}
+
+func (m *MockRepository) ListByLead(ctx context.Context, leadID uuid.UUID) ([]domain.LeadClarification, error) {
+	var out []domain.LeadClarification
+	for _, s := range m.sessions {
+		if s.LeadID == leadID {
+			out = append(out, *s)
+		}
+	}
+	return out, nil
+}
+
+// MockLeadService — один лид в памяти; обновлять его может только владелец или админ.
+type MockLeadService struct {
+	lead domain.Lead
+}
+
+func (m *MockLeadService) GetLead(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+	if id != m.lead.ID {
+		return domain.Lead{}, lead.ErrLeadNotFound
+	}
+	return m.lead, nil
+}
+
+func (m *MockLeadService) UpdateLead(ctx context.Context, actor domain.Actor, id uuid.UUID, update domain.LeadFilter) (domain.Lead, error) {
+	if id != m.lead.ID {
+		return domain.Lead{}, lead.ErrLeadNotFound
+	}
+	if !actor.IsAdmin() && actor.UserID != m.lead.OwnerUserID {
+		return domain.Lead{}, lead.ErrPermissionDenied
+	}
+	if update.Requirement != nil {
+		m.lead.Requirement = *update.Requirement
+	}
+	return m.lead, nil
+}
+
+// MockTxManager выполняет функцию без транзакции.
+type MockTxManager struct{}
+
+func (m *MockTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newTestService(l domain.Lead) (*Service, *MockRepository, *MockLeadService) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	llmClient := &MockLLMClient{IsEnabledValue: false}
+	agent := NewAgent(log, llmClient, weights.NewAnalyzer(log, llmClient, config.SearchConfig{}))
+	repo := &MockRepository{}
+	leadService := &MockLeadService{lead: l}
+	return New(log, agent, repo, leadService, &MockTxManager{}), repo, leadService
+}
+
+func shortLead() domain.Lead {
+	return domain.Lead{ID: uuid.New(), OwnerUserID: uuid.New(), Title: "Квартира", Description: "хочу купить"}
+}
+
+// ownerOf — владелец лида как участник запроса.
+func ownerOf(l domain.Lead) domain.Actor {
+	return domain.Actor{UserID: l.OwnerUserID, Role: domain.UserRoleUser}
+}
+
+func TestService_StartClarification(t *testing.T) {
+	l := shortLead()
+	svc, repo, _ := newTestService(l)
+
+	first, _, err := svc.StartClarification(context.Background(), ownerOf(l), l.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, result, err := svc.StartClarification(context.Background(), ownerOf(l), l.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(second.Questions) == 0 || len(second.Questions) != len(result.Questions) {
+		t.Errorf("expected questions to be stored in session, got %d", len(second.Questions))
+	}
+	if second.QualityScoreBefore == nil || *second.QualityScoreBefore != result.LeadQualityScore {
+		t.Errorf("expected quality before %.2f, got %v", result.LeadQualityScore, second.QualityScoreBefore)
+	}
+	if repo.sessions[0].ID != first.ID || repo.sessions[0].Status != domain.ClarificationStatusSkipped {
+		t.Errorf("expected previous pending session to be skipped, got %s", repo.sessions[0].Status)
+	}
+	if repo.sessions[1].Status != domain.ClarificationStatusPending {
+		t.Errorf("expected new session to be pending, got %s", repo.sessions[1].Status)
+	}
+}
+
+func TestService_ApplyAnswers_ClosesPendingSession(t *testing.T) {
+	l := shortLead()
+	svc, repo, leadService := newTestService(l)
+	owner := domain.Actor{UserID: l.OwnerUserID, Role: domain.UserRoleUser}
+
+	started, _, err := svc.StartClarification(context.Background(), ownerOf(l), l.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	answers := []domain.ClarificationAnswer{
+		{Field: "price", Value: "10000000"},
+		{Field: "roomNumber", Value: "2"},
+		{Field: "district", Value: "Центральный"},
+	}
+	session, updated, err := svc.ApplyAnswers(context.Background(), owner, l.ID, answers)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if session.ID != started.ID || session.Status != domain.ClarificationStatusAnswered {
+		t.Errorf("expected session %s to be answered, got %s %s", started.ID, session.ID, session.Status)
+	}
+	if updated.Requirement.Price == nil || *updated.Requirement.Price.Min != 10000000 {
+		t.Errorf("expected lead requirement to be persisted, got %+v", leadService.lead.Requirement)
+	}
+	stored := repo.sessions[0]
+	if stored.Status != domain.ClarificationStatusAnswered || len(stored.Answers) != len(answers) {
+		t.Errorf("expected stored session to hold answers, got %s with %d answers", stored.Status, len(stored.Answers))
+	}
+	if stored.QualityScoreAfter == nil || *stored.QualityScoreAfter <= *stored.QualityScoreBefore {
+		t.Errorf("expected quality to improve, before=%v after=%v", *stored.QualityScoreBefore, stored.QualityScoreAfter)
+	}
+}
+
+func TestService_ApplyAnswers_WithoutSession(t *testing.T) {
+	l := shortLead()
+	svc, repo, _ := newTestService(l)
+	admin := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleAdmin}
+
+	session, _, err := svc.ApplyAnswers(context.Background(), admin, l.ID, []domain.ClarificationAnswer{{Field: "area", Value: "55"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(repo.sessions) != 1 || repo.sessions[0].Status != domain.ClarificationStatusAnswered {
+		t.Fatalf("expected answered session to be recorded, got %d sessions", len(repo.sessions))
+	}
+	if session.QualityScoreBefore == nil || session.QualityScoreAfter == nil || session.AnsweredAt == nil {
+		t.Errorf("expected quality scores and answered_at to be set, got %+v", session)
+	}
+}
+
+func TestService_ApplyAnswers_Errors(t *testing.T) {
+	l := shortLead()
+	owner := domain.Actor{UserID: l.OwnerUserID, Role: domain.UserRoleUser}
+	stranger := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+
+	tests := []struct {
+		name    string
+		actor   domain.Actor
+		leadID  uuid.UUID
+		answers []domain.ClarificationAnswer
+		wantErr error
+	}{
+		{name: "unknown field", actor: owner, leadID: l.ID, answers: []domain.ClarificationAnswer{{Field: "color", Value: "red"}}, wantErr: ErrInvalidAnswer},
+		{name: "not a number", actor: owner, leadID: l.ID, answers: []domain.ClarificationAnswer{{Field: "price", Value: "дорого"}}, wantErr: ErrInvalidAnswer},
+		{name: "not an owner", actor: stranger, leadID: l.ID, answers: []domain.ClarificationAnswer{{Field: "area", Value: "55"}}, wantErr: ErrPermissionDenied},
+		{name: "lead not found", actor: owner, leadID: uuid.New(), wantErr: ErrLeadNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, _ := newTestService(l)
+			if _, _, err := svc.StartClarification(context.Background(), ownerOf(l), l.ID); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			_, _, err := svc.ApplyAnswers(context.Background(), tt.actor, tt.leadID, tt.answers)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if repo.sessions[0].Status != domain.ClarificationStatusPending {
+				t.Errorf("session must stay pending on error, got %s", repo.sessions[0].Status)
+			}
+		})
+	}
+}
+
+func TestService_ListClarifications_Permissions(t *testing.T) {
+	l := shortLead()
+	svc, _, _ := newTestService(l)
+	if _, _, err := svc.StartClarification(context.Background(), ownerOf(l), l.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	list, err := svc.ListClarifications(context.Background(), domain.Actor{UserID: l.OwnerUserID, Role: domain.UserRoleUser}, l.ID)
+	if err != nil || len(list) != 1 {
+		t.Fatalf("expected owner to see 1 session, got %d (err=%v)", len(list), err)
+	}
+
+	_, err = svc.ListClarifications(context.Background(), domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}, l.ID)
+	if !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied for stranger, got %v", err)
+	}
+}
+
+func TestService_StartClarification_Permissions(t *testing.T) {
+	l := shortLead()
+	svc, repo, _ := newTestService(l)
+	pending, _, err := svc.StartClarification(context.Background(), ownerOf(l), l.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stranger := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+	if _, _, err := svc.StartClarification(context.Background(), stranger, l.ID); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied for stranger, got %v", err)
+	}
+	if len(repo.sessions) != 1 || repo.sessions[0].ID != pending.ID || repo.sessions[0].Status != domain.ClarificationStatusPending {
+		t.Fatalf("owner's pending session must stay untouched, got %d sessions", len(repo.sessions))
+	}
+
+	admin := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleAdmin}
+	if _, _, err := svc.StartClarification(context.Background(), admin, l.ID); err != nil {
+		t.Fatalf("expected admin to start clarification, got %v", err)
+	}
+}
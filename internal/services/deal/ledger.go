@@ -0,0 +1,34 @@
+package deal
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// Ledger — движение кредитов по сделке. Методы вызываются в транзакции смены статуса,
+// поэтому ошибка проводки откатывает и смену статуса.
+type Ledger interface {
+	HoldDealFunds(ctx context.Context, deal domain.Deal, buyerUserID uuid.UUID) error
+	ReleaseDealFunds(ctx context.Context, deal domain.Deal) error
+	RefundDealFunds(ctx context.Context, deal domain.Deal) error
+}
+
+// moveFunds — проводка, соответствующая переходу сделки в статус to:
+// при принятии цена блокируется у покупателя, при завершении переходит продавцу,
+// при отмене или отклонении принятой сделки возвращается покупателю.
+func (s *Service) moveFunds(ctx context.Context, deal domain.Deal, userID uuid.UUID, to domain.DealStatus) error {
+	switch to {
+	case domain.DealStatusAccepted:
+		return s.ledger.HoldDealFunds(ctx, deal, userID)
+	case domain.DealStatusCompleted:
+		return s.ledger.ReleaseDealFunds(ctx, deal)
+	case domain.DealStatusCancelled, domain.DealStatusRejected:
+		if deal.Status == domain.DealStatusAccepted {
+			return s.ledger.RefundDealFunds(ctx, deal)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package deal
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// noopLedger — кошелёк для тестов, которым движение кредитов не важно.
+type noopLedger struct{}
+
+func (noopLedger) HoldDealFunds(ctx context.Context, deal domain.Deal, buyerUserID uuid.UUID) error {
+	return nil
+}
+func (noopLedger) ReleaseDealFunds(ctx context.Context, deal domain.Deal) error { return nil }
+func (noopLedger) RefundDealFunds(ctx context.Context, deal domain.Deal) error  { return nil }
+
+// MockLedger — записывает вызовы кошелька; HoldErr имитирует нехватку средств.
+type MockLedger struct {
+	calls   []string
+	HoldErr error
+}
+
+func (m *MockLedger) HoldDealFunds(ctx context.Context, deal domain.Deal, buyerUserID uuid.UUID) error {
+	if m.HoldErr != nil {
+		return m.HoldErr
+	}
+	m.calls = append(m.calls, "hold")
+	return nil
+}
+func (m *MockLedger) ReleaseDealFunds(ctx context.Context, deal domain.Deal) error {
+	m.calls = append(m.calls, "release")
+	return nil
+}
+func (m *MockLedger) RefundDealFunds(ctx context.Context, deal domain.Deal) error {
+	m.calls = append(m.calls, "refund")
+	return nil
+}
+
+func TestService_Ledger_MovesFundsWithStatus(t *testing.T) {
+	seller := uuid.New()
+	buyer := uuid.New()
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		status domain.DealStatus
+		buyer  *uuid.UUID
+		action func(svc *Service, id uuid.UUID) (domain.Deal, error)
+		want   []string
+	}{
+		{
+			name:   "accept holds buyer funds",
+			status: domain.DealStatusPending,
+			action: func(svc *Service, id uuid.UUID) (domain.Deal, error) { return svc.AcceptDeal(ctx, id, buyer) },
+			want:   []string{"hold"},
+		},
+		{
+			name:   "complete releases funds to seller",
+			status: domain.DealStatusAccepted,
+			buyer:  &buyer,
+			action: func(svc *Service, id uuid.UUID) (domain.Deal, error) { return svc.CompleteDeal(ctx, id, buyer) },
+			want:   []string{"release"},
+		},
+		{
+			name:   "reject refunds buyer",
+			status: domain.DealStatusAccepted,
+			buyer:  &buyer,
+			action: func(svc *Service, id uuid.UUID) (domain.Deal, error) { return svc.RejectDeal(ctx, id, buyer) },
+			want:   []string{"refund"},
+		},
+		{
+			name:   "cancel accepted deal refunds buyer",
+			status: domain.DealStatusAccepted,
+			buyer:  &buyer,
+			action: func(svc *Service, id uuid.UUID) (domain.Deal, error) { return svc.CancelDeal(ctx, id, seller) },
+			want:   []string{"refund"},
+		},
+		{
+			name:   "cancel pending deal moves nothing",
+			status: domain.DealStatusPending,
+			action: func(svc *Service, id uuid.UUID) (domain.Deal, error) { return svc.CancelDeal(ctx, id, seller) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := uuid.New()
+			leadID := uuid.New()
+			repo := newMockDealRepository(domain.Deal{
+				ID:           id,
+				LeadID:       leadID,
+				SellerUserID: seller,
+				BuyerUserID:  tt.buyer,
+				Price:        500,
+				Status:       tt.status,
+			})
+			ledger := &MockLedger{}
+			svc := newTestService(repo, newMockLeadRepository(domain.Lead{ID: leadID, OwnerUserID: seller}), ledger)
+
+			if _, err := tt.action(svc, id); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(ledger.calls) != len(tt.want) {
+				t.Fatalf("expected ledger calls %v, got %v", tt.want, ledger.calls)
+			}
+			for i := range tt.want {
+				if ledger.calls[i] != tt.want[i] {
+					t.Fatalf("expected ledger calls %v, got %v", tt.want, ledger.calls)
+				}
+			}
+		})
+	}
+}
+
+func TestService_AcceptDeal_HoldFailureKeepsPending(t *testing.T) {
+	seller := uuid.New()
+	buyer := uuid.New()
+	dealID := uuid.New()
+
+	repo := newMockDealRepository(domain.Deal{
+		ID:           dealID,
+		SellerUserID: seller,
+		Price:        500,
+		Status:       domain.DealStatusPending,
+	})
+	errNoFunds := errors.New("insufficient funds")
+	svc := newTestService(repo, newMockLeadRepository(), &MockLedger{HoldErr: errNoFunds})
+
+	if _, err := svc.AcceptDeal(context.Background(), dealID, buyer); !errors.Is(err, errNoFunds) {
+		t.Fatalf("expected hold error, got %v", err)
+	}
+
+	deal := repo.deals[dealID]
+	if deal.Status != domain.DealStatusPending || deal.BuyerUserID != nil {
+		t.Fatalf("deal must stay PENDING without buyer, got %+v", deal)
+	}
+}
@@ -16,23 +16,44 @@ import (
 type DealRepository interface {
 	CreateDeal(ctx context.Context, deal domain.Deal) (uuid.UUID, error)
 	GetByID(ctx context.Context, id uuid.UUID) (domain.Deal, error)
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (domain.Deal, error)
 	UpdateDeal(ctx context.Context, dealID uuid.UUID, update domain.DealFilter) error
-	ListDeals(ctx context.Context, filter domain.DealFilter) ([]domain.Deal, error)
+	ListDeals(ctx context.Context, filter domain.DealFilter) (*domain.PaginatedResult[domain.Deal], error)
+}
+
+// LeadRepository — операции над лидом, выполняемые при завершении сделки.
+type LeadRepository interface {
+	UpdateLead(ctx context.Context, leadID uuid.UUID, update domain.LeadFilter) error
+}
+
+// TxManager — выполняет функцию в одной транзакции БД.
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type Service struct {
-	log  *slog.Logger
-	repo DealRepository
+	log       *slog.Logger
+	repo      DealRepository
+	leadRepo  LeadRepository
+	txManager TxManager
+	ledger    Ledger
 }
 
 var (
-	ErrDealNotFound = errors.New("deal not found")
+	ErrDealNotFound       = errors.New("deal not found")
+	ErrInvalidTransition  = errors.New("invalid deal status transition")
+	ErrNotDealParticipant = errors.New("user is not allowed to change deal status")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrDealTermsLocked    = errors.New("deal price can be changed only while deal is pending")
 )
 
-func New(log *slog.Logger, repo DealRepository) *Service {
+func New(log *slog.Logger, repo DealRepository, leadRepo LeadRepository, ledger Ledger, txManager TxManager) *Service {
 	return &Service{
-		log:  log,
-		repo: repo,
+		log:       log,
+		repo:      repo,
+		leadRepo:  leadRepo,
+		ledger:    ledger,
+		txManager: txManager,
 	}
 }
 
@@ -71,9 +92,53 @@ func (s *Service) GetDeal(ctx context.Context, id uuid.UUID) (domain.Deal, error
 }
 
 // UpdateDeal — частичное обновление данных сделки.
-func (s *Service) UpdateDeal(ctx context.Context, dealID uuid.UUID, update domain.DealFilter) (domain.Deal, error) {
+// Менять условия сделки может только продавец или админ. Статус и покупатель
+// меняются через Accept/Complete/Cancel/Reject; напрямую — только админом.
+// Цена меняется только в PENDING: после принятия кошелёк держит сумму, зафиксированную при блокировке.
+func (s *Service) UpdateDeal(ctx context.Context, actor domain.Actor, dealID uuid.UUID, update domain.DealFilter) (domain.Deal, error) {
 	const op = "deal.Service.UpdateDeal"
 
+	var updated domain.Deal
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		current, err := s.repo.GetByIDForUpdate(ctx, dealID)
+		if err != nil {
+			if errors.Is(err, repository.ErrDealNotFound) {
+				return ErrDealNotFound
+			}
+			return err
+		}
+
+		if !actor.IsAdmin() {
+			if current.SellerUserID != actor.UserID {
+				s.log.Warn("deal update denied: not a seller",
+					slog.String("deal_id", dealID.String()),
+					slog.String("user_id", actor.UserID.String()),
+				)
+				return ErrPermissionDenied
+			}
+			if update.Status != nil || update.BuyerUserID != nil {
+				return fmt.Errorf("status and buyer change only through deal flow: %w", ErrPermissionDenied)
+			}
+		}
+
+		if update.Price != nil && current.Status != domain.DealStatusPending {
+			return fmt.Errorf("%w: deal is %s", ErrDealTermsLocked, current.Status)
+		}
+
+		updated, err = s.updateDeal(ctx, dealID, update)
+		return err
+	})
+	if err != nil {
+		return domain.Deal{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated, nil
+}
+
+// updateDeal — обновляет сделку без проверки прав и возвращает актуальное состояние.
+func (s *Service) updateDeal(ctx context.Context, dealID uuid.UUID, update domain.DealFilter) (domain.Deal, error) {
+	const op = "deal.Service.updateDeal"
+
 	err := s.repo.UpdateDeal(ctx, dealID, update)
 	if err != nil {
 		if errors.Is(err, repository.ErrDealNotFound) {
@@ -90,42 +155,121 @@ func (s *Service) UpdateDeal(ctx context.Context, dealID uuid.UUID, update domai
 	return updated, nil
 }
 
-// ListDeals — возвращает сделки по фильтру.
-func (s *Service) ListDeals(ctx context.Context, filter domain.DealFilter) ([]domain.Deal, error) {
+// ListDeals — возвращает сделки по фильтру с пагинацией.
+func (s *Service) ListDeals(ctx context.Context, filter domain.DealFilter) (*domain.PaginatedResult[domain.Deal], error) {
 	const op = "deal.Service.ListDeals"
 
-	deals, err := s.repo.ListDeals(ctx, filter)
+	result, err := s.repo.ListDeals(ctx, filter)
 	if err != nil {
 		s.log.Error("failed to list deals", sl.Err(err))
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return deals, nil
+	return result, nil
 }
 
 // AcceptDeal — принимает сделку (покупатель принимает предложение).
 func (s *Service) AcceptDeal(ctx context.Context, dealID uuid.UUID, buyerUserID uuid.UUID) (domain.Deal, error) {
-	const op = "deal.Service.AcceptDeal"
+	return s.changeStatus(ctx, "deal.Service.AcceptDeal", dealID, buyerUserID, domain.DealStatusAccepted)
+}
+
+// CompleteDeal — завершает сделку (покупатель подтверждает получение лида).
+func (s *Service) CompleteDeal(ctx context.Context, dealID uuid.UUID, userID uuid.UUID) (domain.Deal, error) {
+	return s.changeStatus(ctx, "deal.Service.CompleteDeal", dealID, userID, domain.DealStatusCompleted)
+}
+
+// CancelDeal — отменяет сделку (продавец снимает предложение).
+func (s *Service) CancelDeal(ctx context.Context, dealID uuid.UUID, userID uuid.UUID) (domain.Deal, error) {
+	return s.changeStatus(ctx, "deal.Service.CancelDeal", dealID, userID, domain.DealStatusCancelled)
+}
 
-	// Получаем текущую сделку
-	deal, err := s.repo.GetByID(ctx, dealID)
+// RejectDeal — отклоняет сделку (покупатель отказывается от принятой сделки).
+func (s *Service) RejectDeal(ctx context.Context, dealID uuid.UUID, userID uuid.UUID) (domain.Deal, error) {
+	return s.changeStatus(ctx, "deal.Service.RejectDeal", dealID, userID, domain.DealStatusRejected)
+}
+
+// changeStatus переводит сделку в новый статус по таблице переходов.
+// Проверка, смена статуса, движение кредитов и связанные изменения лида выполняются в одной транзакции.
+func (s *Service) changeStatus(ctx context.Context, op string, dealID uuid.UUID, userID uuid.UUID, to domain.DealStatus) (domain.Deal, error) {
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("deal_id", dealID.String()),
+		slog.String("user_id", userID.String()),
+	)
+
+	var from domain.DealStatus
+	var updated domain.Deal
+
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		deal, err := s.repo.GetByIDForUpdate(ctx, dealID)
+		if err != nil {
+			if errors.Is(err, repository.ErrDealNotFound) {
+				return ErrDealNotFound
+			}
+			return err
+		}
+		from = deal.Status
+
+		if err := checkTransition(deal, userID, to); err != nil {
+			log.Warn("deal status transition denied",
+				slog.String("from", deal.Status.String()),
+				slog.String("to", to.String()),
+				sl.Err(err),
+			)
+			return err
+		}
+
+		if err := s.moveFunds(ctx, deal, userID, to); err != nil {
+			return err
+		}
+
+		// При завершении сделки лид переходит к покупателю
+		if to == domain.DealStatusCompleted {
+			if err := s.transferLead(ctx, deal); err != nil {
+				return err
+			}
+		}
+
+		update := domain.DealFilter{
+			Status: lo.ToPtr(to),
+		}
+		// При принятии сделки фиксируем покупателя
+		if to == domain.DealStatusAccepted {
+			update.BuyerUserID = &userID
+		}
+
+		updated, err = s.updateDeal(ctx, dealID, update)
+		return err
+	})
 	if err != nil {
-		if errors.Is(err, repository.ErrDealNotFound) {
-			return domain.Deal{}, fmt.Errorf("%s: %w", op, ErrDealNotFound)
+		if !errors.Is(err, ErrInvalidTransition) && !errors.Is(err, ErrNotDealParticipant) && !errors.Is(err, ErrDealNotFound) {
+			log.Error("failed to change deal status", sl.Err(err))
 		}
 		return domain.Deal{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Проверяем, что сделка в статусе PENDING
-	if deal.Status != domain.DealStatusPending {
-		return domain.Deal{}, fmt.Errorf("%s: deal is not in PENDING status", op)
+	log.Info("deal status changed",
+		slog.String("from", from.String()),
+		slog.String("to", to.String()),
+	)
+
+	return updated, nil
+}
+
+// transferLead — передаёт лид покупателю и помечает его купленным.
+func (s *Service) transferLead(ctx context.Context, deal domain.Deal) error {
+	if deal.BuyerUserID == nil {
+		return fmt.Errorf("%w: deal has no buyer", ErrInvalidTransition)
+	}
+
+	update := domain.LeadFilter{
+		OwnerUserID: deal.BuyerUserID,
+		Status:      lo.ToPtr(domain.LeadStatusPurchased),
 	}
 
-	// Обновляем сделку: устанавливаем покупателя и статус ACCEPTED
-	update := domain.DealFilter{
-		BuyerUserID: &buyerUserID,
-		Status:      lo.ToPtr(domain.DealStatusAccepted),
+	if err := s.leadRepo.UpdateLead(ctx, deal.LeadID, update); err != nil {
+		return fmt.Errorf("failed to transfer lead %s: %w", deal.LeadID, err)
 	}
 
-	return s.UpdateDeal(ctx, dealID, update)
+	return nil
 }
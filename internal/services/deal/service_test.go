@@ -0,0 +1,417 @@
+package deal
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"maps"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// MockDealRepository — in-memory мок репозитория сделок.
+type MockDealRepository struct {
+	deals map[uuid.UUID]domain.Deal
+	// UpdateErr — ошибка, которую вернёт UpdateDeal (для проверки отката)
+	UpdateErr error
+}
+
+func newMockDealRepository(deals ...domain.Deal) *MockDealRepository {
+	m := &MockDealRepository{deals: make(map[uuid.UUID]domain.Deal)}
+	for _, d := range deals {
+		m.deals[d.ID] = d
+	}
+	return m
+}
+
+func (m *MockDealRepository) CreateDeal(ctx context.Context, deal domain.Deal) (uuid.UUID, error) {
+	deal.ID = uuid.New()
+	m.deals[deal.ID] = deal
+	return deal.ID, nil
+}
+func (m *MockDealRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Deal, error) {
+	d, ok := m.deals[id]
+	if !ok {
+		return domain.Deal{}, repository.ErrDealNotFound
+	}
+	return d, nil
+}
+func (m *MockDealRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (domain.Deal, error) {
+	return m.GetByID(ctx, id)
+}
+func (m *MockDealRepository) UpdateDeal(ctx context.Context, dealID uuid.UUID, update domain.DealFilter) error {
+	if m.UpdateErr != nil {
+		return m.UpdateErr
+	}
+	d, ok := m.deals[dealID]
+	if !ok {
+		return repository.ErrDealNotFound
+	}
+	if update.BuyerUserID != nil {
+		d.BuyerUserID = update.BuyerUserID
+	}
+	if update.Status != nil {
+		d.Status = *update.Status
+	}
+	if update.Price != nil {
+		d.Price = *update.Price
+	}
+	m.deals[dealID] = d
+	return nil
+}
+func (m *MockDealRepository) ListDeals(ctx context.Context, filter domain.DealFilter) (*domain.PaginatedResult[domain.Deal], error) {
+	return &domain.PaginatedResult[domain.Deal]{}, nil
+}
+
+// MockLeadRepository — in-memory мок репозитория лидов.
+type MockLeadRepository struct {
+	leads map[uuid.UUID]domain.Lead
+}
+
+func newMockLeadRepository(leads ...domain.Lead) *MockLeadRepository {
+	m := &MockLeadRepository{leads: make(map[uuid.UUID]domain.Lead)}
+	for _, l := range leads {
+		m.leads[l.ID] = l
+	}
+	return m
+}
+
+func (m *MockLeadRepository) UpdateLead(ctx context.Context, leadID uuid.UUID, update domain.LeadFilter) error {
+	l, ok := m.leads[leadID]
+	if !ok {
+		return repository.ErrLeadNotFound
+	}
+	if update.OwnerUserID != nil {
+		l.OwnerUserID = *update.OwnerUserID
+	}
+	if update.Status != nil {
+		l.Status = *update.Status
+	}
+	m.leads[leadID] = l
+	return nil
+}
+
+// MockTxManager — эмулирует транзакцию: при ошибке восстанавливает состояние моков.
+type MockTxManager struct {
+	deals *MockDealRepository
+	leads *MockLeadRepository
+}
+
+func (m *MockTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	deals := maps.Clone(m.deals.deals)
+	leads := maps.Clone(m.leads.leads)
+
+	if err := fn(ctx); err != nil {
+		m.deals.deals = deals
+		m.leads.leads = leads
+		return err
+	}
+	return nil
+}
+
+func newTestService(deals *MockDealRepository, leads *MockLeadRepository, ledger Ledger) *Service {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	return New(log, deals, leads, ledger, &MockTxManager{deals: deals, leads: leads})
+}
+
+func TestService_DealLifecycle(t *testing.T) {
+	seller := uuid.New()
+	buyer := uuid.New()
+	stranger := uuid.New()
+	dealID := uuid.New()
+	leadID := uuid.New()
+
+	repo := newMockDealRepository(domain.Deal{
+		ID:           dealID,
+		LeadID:       leadID,
+		SellerUserID: seller,
+		Price:        1000,
+		Status:       domain.DealStatusPending,
+	})
+	svc := newTestService(repo, newMockLeadRepository(domain.Lead{ID: leadID, OwnerUserID: seller}), noopLedger{})
+	ctx := context.Background()
+
+	// Продавец не может принять собственную сделку
+	if _, err := svc.AcceptDeal(ctx, dealID, seller); !errors.Is(err, ErrNotDealParticipant) {
+		t.Fatalf("expected ErrNotDealParticipant, got %v", err)
+	}
+
+	// Завершить PENDING сделку нельзя
+	if _, err := svc.CompleteDeal(ctx, dealID, buyer); !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("expected ErrInvalidTransition, got %v", err)
+	}
+
+	deal, err := svc.AcceptDeal(ctx, dealID, buyer)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deal.Status != domain.DealStatusAccepted || deal.BuyerUserID == nil || *deal.BuyerUserID != buyer {
+		t.Fatalf("expected ACCEPTED deal with buyer %s, got %+v", buyer, deal)
+	}
+
+	// Только покупатель может завершить сделку
+	if _, err := svc.CompleteDeal(ctx, dealID, stranger); !errors.Is(err, ErrNotDealParticipant) {
+		t.Fatalf("expected ErrNotDealParticipant for stranger, got %v", err)
+	}
+	if _, err := svc.CompleteDeal(ctx, dealID, seller); !errors.Is(err, ErrNotDealParticipant) {
+		t.Fatalf("expected ErrNotDealParticipant for seller, got %v", err)
+	}
+
+	deal, err = svc.CompleteDeal(ctx, dealID, buyer)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deal.Status != domain.DealStatusCompleted {
+		t.Fatalf("expected COMPLETED, got %s", deal.Status)
+	}
+
+	// COMPLETED — конечный статус
+	if _, err := svc.CancelDeal(ctx, dealID, seller); !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("expected ErrInvalidTransition, got %v", err)
+	}
+}
+
+func TestService_CancelAndReject(t *testing.T) {
+	seller := uuid.New()
+	buyer := uuid.New()
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		status  domain.DealStatus
+		buyer   *uuid.UUID
+		action  func(svc *Service, id uuid.UUID) (domain.Deal, error)
+		wantErr error
+		want    domain.DealStatus
+	}{
+		{
+			name:   "seller cancels pending deal",
+			status: domain.DealStatusPending,
+			action: func(svc *Service, id uuid.UUID) (domain.Deal, error) { return svc.CancelDeal(ctx, id, seller) },
+			want:   domain.DealStatusCancelled,
+		},
+		{
+			name:    "buyer cannot cancel",
+			status:  domain.DealStatusAccepted,
+			buyer:   &buyer,
+			action:  func(svc *Service, id uuid.UUID) (domain.Deal, error) { return svc.CancelDeal(ctx, id, buyer) },
+			wantErr: ErrNotDealParticipant,
+		},
+		{
+			name:   "buyer rejects accepted deal",
+			status: domain.DealStatusAccepted,
+			buyer:  &buyer,
+			action: func(svc *Service, id uuid.UUID) (domain.Deal, error) { return svc.RejectDeal(ctx, id, buyer) },
+			want:   domain.DealStatusRejected,
+		},
+		{
+			name:    "seller cannot reject",
+			status:  domain.DealStatusAccepted,
+			buyer:   &buyer,
+			action:  func(svc *Service, id uuid.UUID) (domain.Deal, error) { return svc.RejectDeal(ctx, id, seller) },
+			wantErr: ErrNotDealParticipant,
+		},
+		{
+			name:    "rejected deal cannot be accepted again",
+			status:  domain.DealStatusRejected,
+			buyer:   &buyer,
+			action:  func(svc *Service, id uuid.UUID) (domain.Deal, error) { return svc.AcceptDeal(ctx, id, buyer) },
+			wantErr: ErrInvalidTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := uuid.New()
+			repo := newMockDealRepository(domain.Deal{
+				ID:           id,
+				SellerUserID: seller,
+				BuyerUserID:  tt.buyer,
+				Status:       tt.status,
+			})
+			svc := newTestService(repo, newMockLeadRepository(), noopLedger{})
+
+			deal, err := tt.action(svc, id)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if deal.Status != tt.want {
+				t.Errorf("expected status %s, got %s", tt.want, deal.Status)
+			}
+		})
+	}
+}
+
+func TestService_ChangeStatus_NotFound(t *testing.T) {
+	svc := newTestService(newMockDealRepository(), newMockLeadRepository(), noopLedger{})
+
+	_, err := svc.CompleteDeal(context.Background(), uuid.New(), uuid.New())
+	if !errors.Is(err, ErrDealNotFound) {
+		t.Fatalf("expected ErrDealNotFound, got %v", err)
+	}
+}
+
+func TestService_CompleteDeal_TransfersLead(t *testing.T) {
+	seller := uuid.New()
+	buyer := uuid.New()
+	dealID := uuid.New()
+	leadID := uuid.New()
+
+	deals := newMockDealRepository(domain.Deal{
+		ID:           dealID,
+		LeadID:       leadID,
+		SellerUserID: seller,
+		BuyerUserID:  &buyer,
+		Status:       domain.DealStatusAccepted,
+	})
+	leads := newMockLeadRepository(domain.Lead{
+		ID:            leadID,
+		OwnerUserID:   seller,
+		CreatedUserID: seller,
+		Status:        domain.LeadStatusPublished,
+	})
+	svc := newTestService(deals, leads, noopLedger{})
+
+	deal, err := svc.CompleteDeal(context.Background(), dealID, buyer)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deal.Status != domain.DealStatusCompleted {
+		t.Errorf("expected COMPLETED, got %s", deal.Status)
+	}
+
+	lead := leads.leads[leadID]
+	if lead.OwnerUserID != buyer {
+		t.Errorf("expected lead owner %s, got %s", buyer, lead.OwnerUserID)
+	}
+	if lead.Status != domain.LeadStatusPurchased {
+		t.Errorf("expected lead status PURCHASED, got %s", lead.Status)
+	}
+}
+
+func TestService_CompleteDeal_RollbackOnFailure(t *testing.T) {
+	seller := uuid.New()
+	buyer := uuid.New()
+	dealID := uuid.New()
+	leadID := uuid.New()
+
+	deals := newMockDealRepository(domain.Deal{
+		ID:           dealID,
+		LeadID:       leadID,
+		SellerUserID: seller,
+		BuyerUserID:  &buyer,
+		Status:       domain.DealStatusAccepted,
+	})
+	deals.UpdateErr = errors.New("connection lost")
+	leads := newMockLeadRepository(domain.Lead{
+		ID:          leadID,
+		OwnerUserID: seller,
+		Status:      domain.LeadStatusPublished,
+	})
+	svc := newTestService(deals, leads, noopLedger{})
+
+	if _, err := svc.CompleteDeal(context.Background(), dealID, buyer); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	lead := leads.leads[leadID]
+	if lead.OwnerUserID != seller || lead.Status != domain.LeadStatusPublished {
+		t.Errorf("expected lead to stay with seller, got owner %s status %s", lead.OwnerUserID, lead.Status)
+	}
+	if deals.deals[dealID].Status != domain.DealStatusAccepted {
+		t.Errorf("expected deal to stay ACCEPTED, got %s", deals.deals[dealID].Status)
+	}
+}
+
+func TestService_UpdateDeal_Ownership(t *testing.T) {
+	seller := uuid.New()
+	buyer := uuid.New()
+	price := 2000.0
+	accepted := domain.DealStatusAccepted
+
+	tests := []struct {
+		name    string
+		status  domain.DealStatus
+		actor   domain.Actor
+		update  domain.DealFilter
+		wantErr error
+	}{
+		{
+			name:   "seller changes price",
+			actor:  domain.Actor{UserID: seller, Role: domain.UserRoleUser},
+			update: domain.DealFilter{Price: &price},
+		},
+		{
+			name:    "buyer cannot change price",
+			actor:   domain.Actor{UserID: buyer, Role: domain.UserRoleUser},
+			update:  domain.DealFilter{Price: &price},
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:    "seller cannot bypass transitions",
+			actor:   domain.Actor{UserID: seller, Role: domain.UserRoleUser},
+			update:  domain.DealFilter{Status: &accepted, BuyerUserID: &seller},
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:   "admin sets status directly",
+			actor:  domain.Actor{UserID: uuid.New(), Role: domain.UserRoleAdmin},
+			update: domain.DealFilter{Status: &accepted, BuyerUserID: &buyer},
+		},
+		{
+			name:    "seller cannot change price of accepted deal",
+			status:  domain.DealStatusAccepted,
+			actor:   domain.Actor{UserID: seller, Role: domain.UserRoleUser},
+			update:  domain.DealFilter{Price: &price},
+			wantErr: ErrDealTermsLocked,
+		},
+		{
+			name:    "admin cannot change price of completed deal",
+			status:  domain.DealStatusCompleted,
+			actor:   domain.Actor{UserID: uuid.New(), Role: domain.UserRoleAdmin},
+			update:  domain.DealFilter{Price: &price},
+			wantErr: ErrDealTermsLocked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := uuid.New()
+			status := domain.DealStatusPending
+			if tt.status != "" {
+				status = tt.status
+			}
+			repo := newMockDealRepository(domain.Deal{
+				ID:           id,
+				SellerUserID: seller,
+				Price:        1000,
+				Status:       status,
+			})
+			svc := newTestService(repo, newMockLeadRepository(), noopLedger{})
+
+			_, err := svc.UpdateDeal(context.Background(), tt.actor, id, tt.update)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if repo.deals[id].Price != 1000 || repo.deals[id].Status != status {
+					t.Errorf("deal must stay unchanged, got %+v", repo.deals[id])
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package deal
+
+import (
+	"fmt"
+	"lead_exchange/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// dealActor — участник сделки, которому разрешён переход статуса.
+type dealActor int
+
+const (
+	actorSeller dealActor = iota + 1
+	actorBuyer
+)
+
+func (a dealActor) String() string {
+	switch a {
+	case actorSeller:
+		return "seller"
+	case actorBuyer:
+		return "buyer"
+	default:
+		return "unknown"
+	}
+}
+
+// dealTransitions — таблица допустимых переходов статусов сделки.
+// Для каждого перехода указан участник, который может его выполнить.
+// COMPLETED, CANCELLED и REJECTED — конечные статусы.
+var dealTransitions = map[domain.DealStatus]map[domain.DealStatus]dealActor{
+	domain.DealStatusPending: {
+		domain.DealStatusAccepted:  actorBuyer,
+		domain.DealStatusCancelled: actorSeller,
+	},
+	domain.DealStatusAccepted: {
+		domain.DealStatusCompleted: actorBuyer,
+		domain.DealStatusRejected:  actorBuyer,
+		domain.DealStatusCancelled: actorSeller,
+	},
+}
+
+// checkTransition проверяет, что переход from -> to допустим и пользователь может его выполнить.
+func checkTransition(deal domain.Deal, userID uuid.UUID, to domain.DealStatus) error {
+	actor, ok := dealTransitions[deal.Status][to]
+	if !ok {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, deal.Status, to)
+	}
+
+	switch actor {
+	case actorSeller:
+		if deal.SellerUserID != userID {
+			return fmt.Errorf("%w: only %s can move deal to %s", ErrNotDealParticipant, actor, to)
+		}
+	case actorBuyer:
+		// В PENDING покупатель ещё не назначен — им становится любой, кроме продавца
+		if deal.BuyerUserID == nil {
+			if deal.SellerUserID == userID {
+				return fmt.Errorf("%w: seller cannot act as buyer", ErrNotDealParticipant)
+			}
+			return nil
+		}
+		if *deal.BuyerUserID != userID {
+			return fmt.Errorf("%w: only %s can move deal to %s", ErrNotDealParticipant, actor, to)
+		}
+	}
+
+	return nil
+}
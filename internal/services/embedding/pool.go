@@ -0,0 +1,166 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Handler строит embedding для сущности своего типа (реализуется сервисами лидов и объектов).
+type Handler interface {
+	ProcessEmbeddingJob(ctx context.Context, job domain.EmbeddingJob) error
+}
+
+// Pool — пул воркеров очереди embedding с повторами и dead-letter.
+type Pool struct {
+	log      *slog.Logger
+	repo     JobRepository
+	handlers map[domain.EmbeddingEntityType]Handler
+	cfg      config.EmbeddingQueueConfig
+	now      func() time.Time
+}
+
+func NewPool(log *slog.Logger, repo JobRepository, handlers map[domain.EmbeddingEntityType]Handler, cfg config.EmbeddingQueueConfig) *Pool {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 1
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 2 * time.Second
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = 10 * time.Second
+	}
+	if cfg.MaxBackoff < cfg.BaseBackoff {
+		cfg.MaxBackoff = cfg.BaseBackoff
+	}
+	if cfg.JobTimeout <= 0 {
+		cfg.JobTimeout = 30 * time.Second
+	}
+	if cfg.StaleAfter <= 0 {
+		cfg.StaleAfter = 5 * time.Minute
+	}
+	return &Pool{
+		log:      log,
+		repo:     repo,
+		handlers: handlers,
+		cfg:      cfg,
+		now:      time.Now,
+	}
+}
+
+// Run запускает воркеров и блокируется до отмены контекста.
+func (p *Pool) Run(ctx context.Context) {
+	p.log.Info("embedding workers started", slog.Int("workers", p.cfg.Workers))
+
+	var wg sync.WaitGroup
+	for i := 0; i < p.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.work(ctx)
+		}()
+	}
+	wg.Wait()
+
+	p.log.Info("embedding workers stopped")
+}
+
+// work — цикл одного воркера: разбирает очередь, пока есть задачи, затем ждёт PollInterval.
+func (p *Pool) work(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			processed, err := p.ProcessNext(ctx)
+			if err != nil {
+				p.log.Error("embedding queue error", sl.Err(err))
+				break
+			}
+			if !processed || ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessNext берёт одну задачу и выполняет её. Возвращает false, если очередь пуста.
+func (p *Pool) ProcessNext(ctx context.Context) (bool, error) {
+	const op = "embedding.Pool.ProcessNext"
+
+	job, err := p.repo.ClaimNext(ctx, p.cfg.StaleAfter)
+	if err != nil {
+		if errors.Is(err, repository.ErrEmbeddingJobNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log := p.log.With(
+		slog.String("job_id", job.ID.String()),
+		slog.String("entity_type", job.EntityType.String()),
+		slog.String("entity_id", job.EntityID.String()),
+		slog.Int("attempt", job.Attempts),
+	)
+
+	jobErr := p.execute(ctx, job)
+	if jobErr == nil {
+		if err := p.repo.MarkDone(ctx, job.ID); err != nil {
+			return true, fmt.Errorf("%s: %w", op, err)
+		}
+		log.Info("embedding job done")
+		return true, nil
+	}
+
+	if errors.Is(jobErr, ErrPermanent) || job.Attempts >= job.MaxAttempts {
+		if err := p.repo.MarkDead(ctx, job.ID, jobErr.Error()); err != nil {
+			return true, fmt.Errorf("%s: %w", op, err)
+		}
+		log.Error("embedding job moved to dead-letter", sl.Err(jobErr))
+		return true, nil
+	}
+
+	runAt := p.now().Add(p.backoff(job.Attempts))
+	if err := p.repo.MarkRetry(ctx, job.ID, jobErr.Error(), runAt); err != nil {
+		return true, fmt.Errorf("%s: %w", op, err)
+	}
+	log.Warn("embedding job failed, will retry", slog.Time("run_at", runAt), sl.Err(jobErr))
+	return true, nil
+}
+
+func (p *Pool) execute(ctx context.Context, job domain.EmbeddingJob) error {
+	handler, ok := p.handlers[job.EntityType]
+	if !ok {
+		return fmt.Errorf("no handler for entity type %q: %w", job.EntityType, ErrPermanent)
+	}
+
+	jobCtx, cancel := context.WithTimeout(ctx, p.cfg.JobTimeout)
+	defer cancel()
+
+	return handler.ProcessEmbeddingJob(jobCtx, job)
+}
+
+// backoff — экспоненциальная задержка перед попыткой attempts+1: base, 2·base, 4·base… не больше MaxBackoff.
+func (p *Pool) backoff(attempts int) time.Duration {
+	delay := p.cfg.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= p.cfg.MaxBackoff {
+			return p.cfg.MaxBackoff
+		}
+	}
+	return delay
+}
@@ -0,0 +1,178 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MockJobRepository — очередь в памяти.
+type MockJobRepository struct {
+	jobs map[uuid.UUID]*domain.EmbeddingJob
+}
+
+func NewMockJobRepository() *MockJobRepository {
+	return &MockJobRepository{jobs: make(map[uuid.UUID]*domain.EmbeddingJob)}
+}
+
+func (m *MockJobRepository) Enqueue(ctx context.Context, job domain.EmbeddingJob) (uuid.UUID, error) {
+	for _, existing := range m.jobs {
+		if existing.EntityType == job.EntityType && existing.EntityID == job.EntityID && existing.Status == domain.EmbeddingJobStatusPending {
+			existing.Attempts = 0
+			return existing.ID, nil
+		}
+	}
+	job.ID = uuid.New()
+	job.Status = domain.EmbeddingJobStatusPending
+	m.jobs[job.ID] = &job
+	return job.ID, nil
+}
+
+func (m *MockJobRepository) ClaimNext(ctx context.Context, staleAfter time.Duration) (domain.EmbeddingJob, error) {
+	for _, job := range m.jobs {
+		if job.Status == domain.EmbeddingJobStatusPending && !job.RunAt.After(time.Now()) {
+			job.Status = domain.EmbeddingJobStatusRunning
+			job.Attempts++
+			return *job, nil
+		}
+	}
+	return domain.EmbeddingJob{}, repository.ErrEmbeddingJobNotFound
+}
+
+func (m *MockJobRepository) MarkDone(ctx context.Context, jobID uuid.UUID) error {
+	m.jobs[jobID].Status = domain.EmbeddingJobStatusDone
+	return nil
+}
+
+func (m *MockJobRepository) MarkRetry(ctx context.Context, jobID uuid.UUID, lastError string, runAt time.Time) error {
+	job := m.jobs[jobID]
+	job.Status = domain.EmbeddingJobStatusPending
+	job.LastError = &lastError
+	job.RunAt = runAt
+	return nil
+}
+
+func (m *MockJobRepository) MarkDead(ctx context.Context, jobID uuid.UUID, lastError string) error {
+	job := m.jobs[jobID]
+	job.Status = domain.EmbeddingJobStatusDead
+	job.LastError = &lastError
+	return nil
+}
+
+func (m *MockJobRepository) GetLatestByEntity(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID) (domain.EmbeddingJob, error) {
+	for _, job := range m.jobs {
+		if job.EntityType == entityType && job.EntityID == entityID {
+			return *job, nil
+		}
+	}
+	return domain.EmbeddingJob{}, repository.ErrEmbeddingJobNotFound
+}
+
+// MockHandler
+type MockHandler struct {
+	ProcessEmbeddingJobFunc func(ctx context.Context, job domain.EmbeddingJob) error
+}
+
+func (m *MockHandler) ProcessEmbeddingJob(ctx context.Context, job domain.EmbeddingJob) error {
+	if m.ProcessEmbeddingJobFunc != nil {
+		return m.ProcessEmbeddingJobFunc(ctx, job)
+	}
+	return nil
+}
+
+func newTestPool(repo JobRepository, handler Handler) *Pool {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	return NewPool(log, repo, map[domain.EmbeddingEntityType]Handler{domain.EmbeddingEntityLead: handler}, config.EmbeddingQueueConfig{
+		BaseBackoff: 10 * time.Second,
+		MaxBackoff:  15 * time.Second,
+	})
+}
+
+func TestPool_ProcessNext(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		attempts   int
+		handlerErr error
+		wantStatus domain.EmbeddingJobStatus
+		wantRunAt  time.Time
+	}{
+		{name: "success", wantStatus: domain.EmbeddingJobStatusDone},
+		{name: "first failure is retried after base backoff", handlerErr: errors.New("ml unavailable"), wantStatus: domain.EmbeddingJobStatusPending, wantRunAt: now.Add(10 * time.Second)},
+		{name: "backoff is capped", attempts: 1, handlerErr: errors.New("ml unavailable"), wantStatus: domain.EmbeddingJobStatusPending, wantRunAt: now.Add(15 * time.Second)},
+		{name: "last attempt goes to dead-letter", attempts: 2, handlerErr: errors.New("ml unavailable"), wantStatus: domain.EmbeddingJobStatusDead},
+		{name: "permanent error goes to dead-letter", handlerErr: fmt.Errorf("lead deleted: %w", ErrPermanent), wantStatus: domain.EmbeddingJobStatusDead},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMockJobRepository()
+			jobID, _ := repo.Enqueue(ctx, domain.EmbeddingJob{
+				EntityType:  domain.EmbeddingEntityLead,
+				EntityID:    uuid.New(),
+				Operation:   domain.EmbeddingOperationEmbed,
+				MaxAttempts: 3,
+			})
+			repo.jobs[jobID].Attempts = tt.attempts
+
+			pool := newTestPool(repo, &MockHandler{
+				ProcessEmbeddingJobFunc: func(ctx context.Context, job domain.EmbeddingJob) error {
+					return tt.handlerErr
+				},
+			})
+			pool.now = func() time.Time { return now }
+
+			processed, err := pool.ProcessNext(ctx)
+			if err != nil || !processed {
+				t.Fatalf("expected job to be processed, got processed=%v err=%v", processed, err)
+			}
+
+			job := repo.jobs[jobID]
+			if job.Status != tt.wantStatus {
+				t.Errorf("expected status %s, got %s", tt.wantStatus, job.Status)
+			}
+			if !tt.wantRunAt.IsZero() && !job.RunAt.Equal(tt.wantRunAt) {
+				t.Errorf("expected run_at %s, got %s", tt.wantRunAt, job.RunAt)
+			}
+			if tt.handlerErr != nil && job.LastError == nil {
+				t.Error("expected last_error to be recorded")
+			}
+		})
+	}
+}
+
+func TestPool_ProcessNext_EmptyQueue(t *testing.T) {
+	pool := newTestPool(NewMockJobRepository(), &MockHandler{})
+
+	processed, err := pool.ProcessNext(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if processed {
+		t.Error("expected empty queue to report nothing processed")
+	}
+}
+
+func TestPool_ProcessNext_UnknownEntityType(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockJobRepository()
+	jobID, _ := repo.Enqueue(ctx, domain.EmbeddingJob{EntityType: domain.EmbeddingEntityProperty, EntityID: uuid.New(), MaxAttempts: 3})
+
+	if _, err := newTestPool(repo, &MockHandler{}).ProcessNext(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.jobs[jobID].Status != domain.EmbeddingJobStatusDead {
+		t.Errorf("job without handler must go to dead-letter, got %s", repo.jobs[jobID].Status)
+	}
+}
@@ -0,0 +1,85 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrPermanent — ошибка, повтор которой не поможет (например, сущность удалена).
+// Обработчик оборачивает её, чтобы задача сразу ушла в DEAD.
+var ErrPermanent = errors.New("permanent embedding job failure")
+
+type JobRepository interface {
+	Enqueue(ctx context.Context, job domain.EmbeddingJob) (uuid.UUID, error)
+	ClaimNext(ctx context.Context, staleAfter time.Duration) (domain.EmbeddingJob, error)
+	MarkDone(ctx context.Context, jobID uuid.UUID) error
+	MarkRetry(ctx context.Context, jobID uuid.UUID, lastError string, runAt time.Time) error
+	MarkDead(ctx context.Context, jobID uuid.UUID, lastError string) error
+	GetLatestByEntity(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID) (domain.EmbeddingJob, error)
+}
+
+// Queue — постановка задач на генерацию embedding и чтение их статуса.
+type Queue struct {
+	log         *slog.Logger
+	repo        JobRepository
+	maxAttempts int
+}
+
+func NewQueue(log *slog.Logger, repo JobRepository, cfg config.EmbeddingQueueConfig) *Queue {
+	maxAttempts := cfg.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 5
+	}
+	return &Queue{
+		log:         log,
+		repo:        repo,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Enqueue — ставит сущность в очередь. Вызывается в транзакции изменения сущности,
+// чтобы задача и данные сохранялись атомарно.
+func (q *Queue) Enqueue(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID, operation domain.EmbeddingOperation) error {
+	const op = "embedding.Queue.Enqueue"
+
+	jobID, err := q.repo.Enqueue(ctx, domain.EmbeddingJob{
+		EntityType:  entityType,
+		EntityID:    entityID,
+		Operation:   operation,
+		MaxAttempts: q.maxAttempts,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	q.log.Debug("embedding job enqueued",
+		slog.String("job_id", jobID.String()),
+		slog.String("entity_type", entityType.String()),
+		slog.String("entity_id", entityID.String()),
+		slog.String("operation", operation.String()),
+	)
+	return nil
+}
+
+// LatestJob — последняя задача по сущности; nil, если сущность в очередь не ставилась.
+func (q *Queue) LatestJob(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID) (*domain.EmbeddingJob, error) {
+	const op = "embedding.Queue.LatestJob"
+
+	job, err := q.repo.GetLatestByEntity(ctx, entityType, entityID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEmbeddingJobNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &job, nil
+}
@@ -0,0 +1,143 @@
+package lead
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/services/team"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// DealRepository — чтение сделок для проверки доступа к контактам лида.
+type DealRepository interface {
+	PurchasedLeadIDs(ctx context.Context, buyerID uuid.UUID, leadIDs []uuid.UUID) ([]uuid.UUID, error)
+}
+
+// ApplyContactPolicy — скрывает контакты лидов от пользователей, которые их не купили.
+// Полные контакты видят владелец, создатель, коллеги владельца по агентству и покупатель по завершённой сделке.
+func (s *Service) ApplyContactPolicy(ctx context.Context, viewerID uuid.UUID, leads ...domain.Lead) ([]domain.Lead, error) {
+	const op = "lead.Service.ApplyContactPolicy"
+
+	result := make([]domain.Lead, len(leads))
+	copy(result, leads)
+
+	// Лиды, доступ к которым нельзя определить без обращения к сделкам
+	pending := make(map[uuid.UUID]struct{})
+	for _, l := range result {
+		if !canSeeContactsDirectly(l, viewerID) {
+			pending[l.ID] = struct{}{}
+		}
+	}
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	// Лиды коллег по агентству открыты так же, как свои
+	if viewerID != uuid.Nil {
+		members, err := team.Members(ctx, s.teams, viewerID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		for _, l := range result {
+			if lo.Contains(members, l.OwnerUserID) {
+				delete(pending, l.ID)
+			}
+		}
+		if len(pending) == 0 {
+			return result, nil
+		}
+	}
+
+	// Покупки проверяются только среди оставшихся лидов страницы, а не по всей истории покупателя
+	purchased := make(map[uuid.UUID]struct{})
+	if viewerID != uuid.Nil {
+		ids, err := s.dealRepo.PurchasedLeadIDs(ctx, viewerID, lo.Keys(pending))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		for _, id := range ids {
+			purchased[id] = struct{}{}
+		}
+	}
+
+	for i, l := range result {
+		if _, ok := pending[l.ID]; !ok {
+			continue
+		}
+		if _, ok := purchased[l.ID]; ok {
+			continue
+		}
+		result[i] = maskLeadContacts(l)
+	}
+
+	return result, nil
+}
+
+// canSeeContactsDirectly — владелец и создатель видят контакты без проверки сделок.
+func canSeeContactsDirectly(l domain.Lead, viewerID uuid.UUID) bool {
+	if viewerID == uuid.Nil {
+		return false
+	}
+	return l.OwnerUserID == viewerID || l.CreatedUserID == viewerID
+}
+
+// maskLeadContacts — возвращает копию лида с замаскированными контактами.
+func maskLeadContacts(l domain.Lead) domain.Lead {
+	l.ContactName = maskName(l.ContactName)
+	l.ContactPhone = maskPhone(l.ContactPhone)
+	if l.ContactEmail != nil {
+		l.ContactEmail = lo.ToPtr(maskEmail(*l.ContactEmail))
+	}
+	l.ContactsMasked = true
+	return l
+}
+
+// maskPhone оставляет две первые и две последние цифры: "+7 912 345 6712" → "+7 9** *** **12".
+func maskPhone(phone string) string {
+	digits := 0
+	for _, r := range phone {
+		if unicode.IsDigit(r) {
+			digits++
+		}
+	}
+
+	var b strings.Builder
+	idx := 0
+	for _, r := range phone {
+		if !unicode.IsDigit(r) {
+			b.WriteRune(r)
+			continue
+		}
+		if digits > 4 && (idx < 2 || idx >= digits-2) {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('*')
+		}
+		idx++
+	}
+	return b.String()
+}
+
+// maskName оставляет первую букву каждого слова: "Иван Петров" → "И*** П***".
+func maskName(name string) string {
+	words := strings.Fields(name)
+	for i, w := range words {
+		first := []rune(w)[0]
+		words[i] = string(first) + "***"
+	}
+	return strings.Join(words, " ")
+}
+
+// maskEmail оставляет первую букву локальной части и домен: "ivan@mail.ru" → "i***@mail.ru".
+func maskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return "***"
+	}
+	first := []rune(email[:at])[0]
+	return string(first) + "***" + email[at:]
+}
@@ -0,0 +1,120 @@
+package lead
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func TestMaskContacts(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(string) string
+		in   string
+		want string
+	}{
+		{"phone with spaces", maskPhone, "+7 912 345 6712", "+7 9** *** **12"},
+		{"phone digits only", maskPhone, "89123456712", "89*******12"},
+		{"short phone", maskPhone, "1234", "****"},
+		{"name", maskName, "Иван Петров", "И*** П***"},
+		{"email", maskEmail, "ivan@mail.ru", "i***@mail.ru"},
+		{"broken email", maskEmail, "ivan", "***"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(tt.in); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_ApplyContactPolicy(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := uuid.New()
+	buyer := uuid.New()
+	stranger := uuid.New()
+
+	lead := domain.Lead{
+		ID:            uuid.New(),
+		ContactName:   "Иван Петров",
+		ContactPhone:  "+7 912 345 6712",
+		ContactEmail:  lo.ToPtr("ivan@mail.ru"),
+		OwnerUserID:   owner,
+		CreatedUserID: owner,
+	}
+
+	dealRepo := &MockDealRepository{
+		PurchasedLeadIDsFunc: func(ctx context.Context, buyerID uuid.UUID, leadIDs []uuid.UUID) ([]uuid.UUID, error) {
+			if buyerID == buyer {
+				return leadIDs, nil
+			}
+			return nil, nil
+		},
+	}
+	svc := New(log, &MockLeadRepository{}, dealRepo, &MockMLClient{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockTeamDirectory{})
+
+	tests := []struct {
+		name       string
+		viewer     uuid.UUID
+		wantMasked bool
+	}{
+		{"owner sees contacts", owner, false},
+		{"buyer of completed deal sees contacts", buyer, false},
+		{"stranger gets masked contacts", stranger, true},
+		{"anonymous gets masked contacts", uuid.Nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.ApplyContactPolicy(context.Background(), tt.viewer, lead)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got[0].ContactsMasked != tt.wantMasked {
+				t.Fatalf("expected masked=%v, got %v", tt.wantMasked, got[0].ContactsMasked)
+			}
+			if tt.wantMasked && got[0].ContactPhone == lead.ContactPhone {
+				t.Errorf("expected phone to be masked, got %q", got[0].ContactPhone)
+			}
+			if !tt.wantMasked && got[0].ContactPhone != lead.ContactPhone {
+				t.Errorf("expected original phone, got %q", got[0].ContactPhone)
+			}
+		})
+	}
+}
+
+func TestService_ApplyContactPolicy_ChecksOnlyPageLeads(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	buyer := uuid.New()
+	own := domain.Lead{ID: uuid.New(), ContactPhone: "+7 912 345 6712", OwnerUserID: buyer}
+	bought := domain.Lead{ID: uuid.New(), ContactPhone: "+7 912 345 6712", OwnerUserID: uuid.New()}
+	foreign := domain.Lead{ID: uuid.New(), ContactPhone: "+7 912 345 6712", OwnerUserID: uuid.New()}
+
+	var asked []uuid.UUID
+	dealRepo := &MockDealRepository{
+		PurchasedLeadIDsFunc: func(ctx context.Context, buyerID uuid.UUID, leadIDs []uuid.UUID) ([]uuid.UUID, error) {
+			asked = leadIDs
+			return []uuid.UUID{bought.ID}, nil
+		},
+	}
+	svc := New(log, &MockLeadRepository{}, dealRepo, &MockMLClient{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockTeamDirectory{})
+
+	got, err := svc.ApplyContactPolicy(context.Background(), buyer, own, bought, foreign)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Покупки проверяются только для чужих лидов страницы
+	if len(asked) != 2 || lo.Contains(asked, own.ID) {
+		t.Errorf("expected purchases to be checked for 2 foreign leads, got %v", asked)
+	}
+	if got[0].ContactsMasked || got[1].ContactsMasked || !got[2].ContactsMasked {
+		t.Errorf("unexpected masking: own=%v bought=%v foreign=%v", got[0].ContactsMasked, got[1].ContactsMasked, got[2].ContactsMasked)
+	}
+}
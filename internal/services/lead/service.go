@@ -2,13 +2,14 @@ package lead
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/lib/logger/sl"
 	"lead_exchange/internal/lib/ml"
 	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/embedding"
+	"lead_exchange/internal/services/team"
 	"log/slog"
 	"time"
 
@@ -21,35 +22,89 @@ type LeadRepository interface {
 	UpdateLead(ctx context.Context, leadID uuid.UUID, update domain.LeadFilter) error
 	ListLeads(ctx context.Context, filter domain.LeadFilter) (*domain.PaginatedResult[domain.Lead], error)
 	UpdateEmbedding(ctx context.Context, leadID uuid.UUID, embedding []float32) error
+	UpdateEmbeddings(ctx context.Context, embeddings map[uuid.UUID][]float32) (int, error)
+	MatchLeadsWithHardFilters(ctx context.Context, propertyEmbedding []float32, filter domain.LeadFilter, hardFilters *domain.HardFilters, limit int) ([]domain.MatchedLead, error)
+}
+
+// EmbeddingQueue — очередь генерации embedding.
+type EmbeddingQueue interface {
+	Enqueue(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID, operation domain.EmbeddingOperation) error
+	LatestJob(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID) (*domain.EmbeddingJob, error)
+}
+
+// ReindexCheckpoints — контрольные точки массовой переиндексации.
+type ReindexCheckpoints interface {
+	GetCheckpoint(ctx context.Context, entityType domain.EmbeddingEntityType) (domain.ReindexProgress, error)
+	SaveCheckpoint(ctx context.Context, progress domain.ReindexProgress) error
+}
+
+// TxManager — запуск операций в одной транзакции.
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type Service struct {
-	log      *slog.Logger
-	repo     LeadRepository
-	mlClient ml.Client
+	log            *slog.Logger
+	repo           LeadRepository
+	dealRepo       DealRepository
+	mlClient       ml.Client
+	embeddingQueue EmbeddingQueue
+	checkpoints    ReindexCheckpoints
+	txManager      TxManager
+	teams          team.Directory
 }
 
 var (
-	ErrLeadNotFound = errors.New("lead not found")
+	ErrLeadNotFound     = errors.New("lead not found")
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrInvalidRequirement — требования лида не прошли валидацию.
+	ErrInvalidRequirement = errors.New("invalid lead requirement")
 )
 
-func New(log *slog.Logger, repo LeadRepository, mlClient ml.Client) *Service {
+func New(
+	log *slog.Logger,
+	repo LeadRepository,
+	dealRepo DealRepository,
+	mlClient ml.Client,
+	embeddingQueue EmbeddingQueue,
+	checkpoints ReindexCheckpoints,
+	txManager TxManager,
+	teams team.Directory,
+) *Service {
 	return &Service{
-		log:      log,
-		repo:     repo,
-		mlClient: mlClient,
+		log:            log,
+		repo:           repo,
+		dealRepo:       dealRepo,
+		mlClient:       mlClient,
+		embeddingQueue: embeddingQueue,
+		checkpoints:    checkpoints,
+		txManager:      txManager,
+		teams:          teams,
 	}
 }
 
-// CreateLead — создаёт нового лида и генерирует embedding.
+// CreateLead — создаёт нового лида и ставит генерацию embedding в очередь.
+// Лид и задача сохраняются в одной транзакции, поэтому embedding не теряется при сбое ML-сервиса.
 func (s *Service) CreateLead(ctx context.Context, lead domain.Lead) (uuid.UUID, error) {
 	const op = "lead.Service.CreateLead"
 	log := s.log.With(slog.String("op", op), slog.String("title", lead.Title))
 
 	log.Info("creating new lead")
 
-	// Сначала сохраняем лид без embedding
-	id, err := s.repo.CreateLead(ctx, lead)
+	if err := lead.Requirement.Validate(); err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w: %v", op, ErrInvalidRequirement, err)
+	}
+	lead.Requirement = lead.Requirement.Normalize()
+
+	var id uuid.UUID
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		id, err = s.repo.CreateLead(ctx, lead)
+		if err != nil {
+			return err
+		}
+		return s.embeddingQueue.Enqueue(ctx, domain.EmbeddingEntityLead, id, domain.EmbeddingOperationEmbed)
+	})
 	if err != nil {
 		log.Error("failed to create lead", sl.Err(err))
 		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
@@ -57,22 +112,6 @@ func (s *Service) CreateLead(ctx context.Context, lead domain.Lead) (uuid.UUID,
 
 	log.Info("lead created successfully", slog.String("lead_id", id.String()))
 
-	// Генерируем embedding синхронно с таймаутом 30 секунд
-	// Это критически важно для работы матчинга
-	embeddingCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	if err := s.generateAndUpdateEmbedding(embeddingCtx, id, lead); err != nil {
-		s.log.Error("failed to generate embedding for lead",
-			slog.String("lead_id", id.String()),
-			sl.Err(err),
-		)
-		// Не возвращаем ошибку — лид уже создан, просто матчинг не будет работать
-		// Можно будет переиндексировать позже
-	} else {
-		s.log.Info("embedding generated successfully", slog.String("lead_id", id.String()))
-	}
-
 	return id, nil
 }
 
@@ -80,43 +119,14 @@ func (s *Service) CreateLead(ctx context.Context, lead domain.Lead) (uuid.UUID,
 func (s *Service) generateAndUpdateEmbedding(ctx context.Context, leadID uuid.UUID, lead domain.Lead) error {
 	const op = "lead.Service.generateAndUpdateEmbedding"
 
-	// Парсим requirement из JSON
-	var requirementMap map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		if err := json.Unmarshal(lead.Requirement, &requirementMap); err != nil {
-			s.log.Warn("failed to parse requirement JSON", sl.Err(err))
-			requirementMap = nil
-		}
-	}
-
-	// Извлекаем данные из requirement для ML сервиса
-	var price *int64
-	var district *string
-	var rooms *int32
-	var area *float64
-
-	if requirementMap != nil {
-		if p, ok := requirementMap["price"].(float64); ok {
-			priceVal := int64(p)
-			price = &priceVal
-		}
-		if d, ok := requirementMap["district"].(string); ok {
-			district = &d
-		}
-		if r, ok := requirementMap["roomNumber"].(float64); ok {
-			roomsVal := int32(r)
-			rooms = &roomsVal
-		}
-		if a, ok := requirementMap["area"].(float64); ok {
-			area = &a
-		}
-	}
+	// Ориентиры из requirement для ML сервиса
+	price, district, rooms, area := requirementTargets(lead.Requirement)
 
 	// Подготавливаем запрос к ML сервису
 	mlReq := ml.PrepareAndEmbedRequest{
 		Title:       lead.Title,
 		Description: lead.Description,
-		Requirement: requirementMap,
+		Requirement: lead.Requirement.Map(),
 		Price:       price,
 		District:    district,
 		Rooms:       rooms,
@@ -162,10 +172,20 @@ func (s *Service) GetLead(ctx context.Context, id uuid.UUID) (domain.Lead, error
 }
 
 // UpdateLead — частичное обновление данных лида.
-func (s *Service) UpdateLead(ctx context.Context, leadID uuid.UUID, update domain.LeadFilter) (domain.Lead, error) {
+// Изменять лид может только владелец или админ; сменить владельца — только админ
+// (покупатель получает лид через завершение сделки).
+func (s *Service) UpdateLead(ctx context.Context, actor domain.Actor, leadID uuid.UUID, update domain.LeadFilter) (domain.Lead, error) {
 	const op = "lead.Service.UpdateLead"
 
-	err := s.repo.UpdateLead(ctx, leadID, update)
+	if update.Requirement != nil {
+		if err := update.Requirement.Validate(); err != nil {
+			return domain.Lead{}, fmt.Errorf("%s: %w: %v", op, ErrInvalidRequirement, err)
+		}
+		normalized := update.Requirement.Normalize()
+		update.Requirement = &normalized
+	}
+
+	current, err := s.repo.GetByID(ctx, leadID)
 	if err != nil {
 		if errors.Is(err, repository.ErrLeadNotFound) {
 			return domain.Lead{}, fmt.Errorf("%s: %w", op, ErrLeadNotFound)
@@ -173,18 +193,41 @@ func (s *Service) UpdateLead(ctx context.Context, leadID uuid.UUID, update domai
 		return domain.Lead{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	updated, err := s.repo.GetByID(ctx, leadID)
+	if !actor.IsAdmin() {
+		if current.OwnerUserID != actor.UserID {
+			s.log.Warn("lead update denied: not an owner",
+				slog.String("lead_id", leadID.String()),
+				slog.String("user_id", actor.UserID.String()),
+			)
+			return domain.Lead{}, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		}
+		if update.OwnerUserID != nil && *update.OwnerUserID != current.OwnerUserID {
+			return domain.Lead{}, fmt.Errorf("%s: owner can be changed only by admin or deal: %w", op, ErrPermissionDenied)
+		}
+	}
+
+	// Переиндексация ставится в очередь вместе с изменением, если изменились данные, влияющие на matching
+	reindex := update.Title != nil || update.Description != nil || update.Requirement != nil
+
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.UpdateLead(ctx, leadID, update); err != nil {
+			return err
+		}
+		if !reindex {
+			return nil
+		}
+		return s.embeddingQueue.Enqueue(ctx, domain.EmbeddingEntityLead, leadID, domain.EmbeddingOperationReindex)
+	})
 	if err != nil {
-		return domain.Lead{}, fmt.Errorf("%s: failed to fetch updated lead: %w", op, err)
+		if errors.Is(err, repository.ErrLeadNotFound) {
+			return domain.Lead{}, fmt.Errorf("%s: %w", op, ErrLeadNotFound)
+		}
+		return domain.Lead{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Переиндексируем embedding асинхронно, если изменились данные, влияющие на matching
-	if update.Title != nil || update.Description != nil || update.Requirement != nil {
-		go func() {
-			if err := s.reindexLead(context.Background(), leadID, updated); err != nil {
-				s.log.Error("failed to reindex lead", slog.String("lead_id", leadID.String()), sl.Err(err))
-			}
-		}()
+	updated, err := s.repo.GetByID(ctx, leadID)
+	if err != nil {
+		return domain.Lead{}, fmt.Errorf("%s: failed to fetch updated lead: %w", op, err)
 	}
 
 	return updated, nil
@@ -209,53 +252,77 @@ func (s *Service) ReindexLead(ctx context.Context, leadID uuid.UUID) error {
 	return nil
 }
 
-// reindexLead переиндексирует embedding для лида после обновления.
-func (s *Service) reindexLead(ctx context.Context, leadID uuid.UUID, lead domain.Lead) error {
-	const op = "lead.Service.reindexLead"
+// ScheduleReindex — ставит переиндексацию лида в очередь.
+func (s *Service) ScheduleReindex(ctx context.Context, leadID uuid.UUID) error {
+	const op = "lead.Service.ScheduleReindex"
 
-	// Парсим requirement из JSON
-	var requirementMap map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		if err := json.Unmarshal(lead.Requirement, &requirementMap); err != nil {
-			s.log.Warn("failed to parse requirement JSON", sl.Err(err))
-			requirementMap = nil
+	if _, err := s.repo.GetByID(ctx, leadID); err != nil {
+		if errors.Is(err, repository.ErrLeadNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrLeadNotFound)
 		}
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Извлекаем данные из requirement для ML сервиса
-	var price *int64
-	var district *string
-	var rooms *int32
-	var area *float64
+	if err := s.embeddingQueue.Enqueue(ctx, domain.EmbeddingEntityLead, leadID, domain.EmbeddingOperationReindex); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
 
-	if requirementMap != nil {
-		if p, ok := requirementMap["price"].(float64); ok {
-			priceVal := int64(p)
-			price = &priceVal
-		}
-		if d, ok := requirementMap["district"].(string); ok {
-			district = &d
-		}
-		if r, ok := requirementMap["roomNumber"].(float64); ok {
-			roomsVal := int32(r)
-			rooms = &roomsVal
+	return nil
+}
+
+// EmbeddingState — есть ли у лида embedding и статус последней задачи очереди.
+func (s *Service) EmbeddingState(ctx context.Context, leadID uuid.UUID) (domain.EmbeddingState, error) {
+	const op = "lead.Service.EmbeddingState"
+
+	lead, err := s.repo.GetByID(ctx, leadID)
+	if err != nil {
+		if errors.Is(err, repository.ErrLeadNotFound) {
+			return domain.EmbeddingState{}, fmt.Errorf("%s: %w", op, ErrLeadNotFound)
 		}
-		if a, ok := requirementMap["area"].(float64); ok {
-			area = &a
+		return domain.EmbeddingState{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	job, err := s.embeddingQueue.LatestJob(ctx, domain.EmbeddingEntityLead, leadID)
+	if err != nil {
+		return domain.EmbeddingState{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return domain.EmbeddingState{HasEmbedding: len(lead.Embedding) > 0, Job: job}, nil
+}
+
+// ProcessEmbeddingJob — выполняет задачу очереди embedding для лида.
+func (s *Service) ProcessEmbeddingJob(ctx context.Context, job domain.EmbeddingJob) error {
+	const op = "lead.Service.ProcessEmbeddingJob"
+
+	lead, err := s.repo.GetByID(ctx, job.EntityID)
+	if err != nil {
+		if errors.Is(err, repository.ErrLeadNotFound) {
+			return fmt.Errorf("%s: %w: %w", op, embedding.ErrPermanent, ErrLeadNotFound)
 		}
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Подготавливаем запрос к ML сервису для переиндексации
-	mlReq := ml.ReindexRequest{
-		EntityID:    leadID.String(),
-		EntityType:  "lead",
-		Title:       lead.Title,
-		Description: lead.Description,
-		Price:       price,
-		District:    district,
-		Rooms:       rooms,
-		Area:        area,
+	switch job.Operation {
+	case domain.EmbeddingOperationEmbed:
+		err = s.generateAndUpdateEmbedding(ctx, lead.ID, lead)
+	case domain.EmbeddingOperationReindex:
+		err = s.reindexLead(ctx, lead.ID, lead)
+	default:
+		return fmt.Errorf("%s: unknown operation %q: %w", op, job.Operation, embedding.ErrPermanent)
 	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// reindexLead переиндексирует embedding для лида после обновления.
+func (s *Service) reindexLead(ctx context.Context, leadID uuid.UUID, lead domain.Lead) error {
+	const op = "lead.Service.reindexLead"
+
+	lead.ID = leadID
+	mlReq := s.reindexRequest(lead)
 
 	// Получаем новый embedding от ML сервиса
 	mlResp, err := s.mlClient.Reindex(ctx, mlReq)
@@ -278,10 +345,41 @@ func (s *Service) reindexLead(ctx context.Context, leadID uuid.UUID, lead domain
 	return nil
 }
 
+// requirementTargets — целевые цена, район, комнаты и площадь из требований лида.
+func requirementTargets(req domain.LeadRequirement) (price *int64, district *string, rooms *int32, area *float64) {
+	criteria := req.SoftCriteria()
+	if criteria == nil {
+		return nil, nil, nil, nil
+	}
+	return criteria.TargetPrice, criteria.TargetDistrict, criteria.TargetRooms, criteria.TargetArea
+}
+
+// reindexRequest — запрос к ML-сервису на переиндексацию лида.
+func (s *Service) reindexRequest(lead domain.Lead) ml.ReindexRequest {
+	// Ориентиры из requirement для ML сервиса
+	price, district, rooms, area := requirementTargets(lead.Requirement)
+
+	return ml.ReindexRequest{
+		EntityID:    lead.ID.String(),
+		EntityType:  "lead",
+		Title:       lead.Title,
+		Description: lead.Description,
+		Price:       price,
+		District:    district,
+		Rooms:       rooms,
+		Area:        area,
+	}
+}
+
 // ListLeads — возвращает лидов по фильтру с пагинацией.
 func (s *Service) ListLeads(ctx context.Context, filter domain.LeadFilter) (*domain.PaginatedResult[domain.Lead], error) {
 	const op = "lead.Service.ListLeads"
 
+	filter, err := s.resolveTeam(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	result, err := s.repo.ListLeads(ctx, filter)
 	if err != nil {
 		s.log.Error("failed to list leads", sl.Err(err))
@@ -291,49 +389,174 @@ func (s *Service) ListLeads(ctx context.Context, filter domain.LeadFilter) (*dom
 	return result, nil
 }
 
-// ReindexAllLeads переиндексирует все лиды (с эмбеддингами и без).
-// Возвращает количество успешно переиндексированных и общее количество.
-func (s *Service) ReindexAllLeads(ctx context.Context) (success int, total int, errs []error) {
+// MatchLeadsByEmbedding — векторный поиск лидов, близких к embedding объекта.
+// Ранжирование по весам выполняет сервис объектов (property.Service.MatchLeads).
+func (s *Service) MatchLeadsByEmbedding(
+	ctx context.Context,
+	propertyEmbedding []float32,
+	filter domain.LeadFilter,
+	hardFilters *domain.HardFilters,
+	limit int,
+) ([]domain.MatchedLead, error) {
+	const op = "lead.Service.MatchLeadsByEmbedding"
+
+	matches, err := s.repo.MatchLeadsWithHardFilters(ctx, propertyEmbedding, filter, hardFilters, limit)
+	if err != nil {
+		s.log.Error("failed to match leads", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return matches, nil
+}
+
+// ReindexAllLeads — пакетная переиндексация всех лидов через ml.Client.ReindexBatch.
+// Лиды обходятся курсором PageCursor в порядке создания. Embedding пакета и курсор
+// сохраняются в одной транзакции, поэтому после сбоя переиндексация продолжается
+// с последнего сохранённого пакета. onProgress вызывается после каждого пакета.
+func (s *Service) ReindexAllLeads(ctx context.Context, opts domain.ReindexOptions, onProgress func(domain.ReindexProgress)) (domain.ReindexProgress, error) {
 	const op = "lead.Service.ReindexAllLeads"
+	log := s.log.With(slog.String("op", op))
 
-	s.log.Info("starting reindex of all leads")
+	progress, err := s.reindexCheckpoint(ctx, opts.Restart)
+	if err != nil {
+		return domain.ReindexProgress{}, fmt.Errorf("%s: %w", op, err)
+	}
 
-	// Получаем все лиды
-	filter := domain.LeadFilter{
-		Pagination: &domain.PaginationParams{
-			PageSize: 1000,
-		},
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = domain.DefaultReindexBatchSize
 	}
 
-	result, err := s.repo.ListLeads(ctx, filter)
+	log.Info("starting batch reindex of leads",
+		slog.Bool("resumed", progress.Cursor != ""),
+		slog.Int("processed", progress.Processed),
+	)
+
+	for !progress.Done {
+		page, err := s.repo.ListLeads(ctx, domain.LeadFilter{
+			Pagination: &domain.PaginationParams{
+				PageSize:       int32(batchSize),
+				PageToken:      progress.Cursor,
+				OrderBy:        "created_at",
+				OrderDirection: domain.OrderAsc,
+			},
+		})
+		if err != nil {
+			return progress, fmt.Errorf("%s: failed to list leads: %w", op, err)
+		}
+
+		embeddings, failed, err := s.reindexBatch(ctx, page.Items)
+		if err != nil {
+			return progress, fmt.Errorf("%s: %w", op, err)
+		}
+
+		next := progress
+		next.Total = int(page.TotalCount)
+		next.Processed += len(page.Items)
+		next.Failed += failed
+		next.Cursor = page.NextPageToken
+		next.Done = !page.HasMore
+		next.UpdatedAt = time.Now()
+		if len(page.Items) > 0 {
+			next.LastID = page.Items[len(page.Items)-1].ID
+		}
+
+		err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+			updated, err := s.repo.UpdateEmbeddings(ctx, embeddings)
+			if err != nil {
+				return err
+			}
+			// Лиды, удалённые за время запроса к ML-сервису, считаются неуспешными
+			next.Success += updated
+			next.Failed += len(embeddings) - updated
+			return s.checkpoints.SaveCheckpoint(ctx, next)
+		})
+		if err != nil {
+			return progress, fmt.Errorf("%s: failed to save batch: %w", op, err)
+		}
+		progress = next
+
+		log.Info("leads batch reindexed",
+			slog.Int("processed", progress.Processed),
+			slog.Int("total", progress.Total),
+			slog.Int("failed", progress.Failed),
+		)
+		if onProgress != nil {
+			onProgress(progress)
+		}
+	}
+
+	log.Info("reindex completed",
+		slog.Int("success", progress.Success),
+		slog.Int("total", progress.Total),
+		slog.Int("failed", progress.Failed),
+	)
+
+	return progress, nil
+}
+
+// reindexCheckpoint — контрольная точка, с которой продолжается переиндексация.
+// Завершённая переиндексация и restart начинаются с начала.
+func (s *Service) reindexCheckpoint(ctx context.Context, restart bool) (domain.ReindexProgress, error) {
+	fresh := domain.ReindexProgress{EntityType: domain.EmbeddingEntityLead, StartedAt: time.Now()}
+	if restart {
+		return fresh, nil
+	}
+
+	checkpoint, err := s.checkpoints.GetCheckpoint(ctx, domain.EmbeddingEntityLead)
 	if err != nil {
-		return 0, 0, []error{fmt.Errorf("%s: failed to list leads: %w", op, err)}
+		if errors.Is(err, repository.ErrReindexCheckpointNotFound) {
+			return fresh, nil
+		}
+		return domain.ReindexProgress{}, err
+	}
+	if checkpoint.Done {
+		return fresh, nil
+	}
+
+	return checkpoint, nil
+}
+
+// reindexBatch — получает embedding пакета лидов одним запросом ReindexBatch.
+// Возвращает embedding успешно обработанных лидов и количество отказов ML-сервиса.
+func (s *Service) reindexBatch(ctx context.Context, leads []domain.Lead) (map[uuid.UUID][]float32, int, error) {
+	if len(leads) == 0 {
+		return nil, 0, nil
 	}
 
-	total = len(result.Items)
-	success = 0
-	errs = make([]error, 0)
+	req := ml.ReindexBatchRequest{Entities: make([]ml.ReindexRequest, 0, len(leads))}
+	inBatch := make(map[uuid.UUID]struct{}, len(leads))
+	for _, lead := range leads {
+		req.Entities = append(req.Entities, s.reindexRequest(lead))
+		inBatch[lead.ID] = struct{}{}
+	}
 
-	for _, lead := range result.Items {
-		// Переиндексируем все лиды для обновления размерности эмбеддингов
-		reindexErr := s.reindexLead(ctx, lead.ID, lead)
-		if reindexErr != nil {
-			s.log.Warn("failed to reindex lead",
-				slog.String("lead_id", lead.ID.String()),
-				sl.Err(reindexErr),
-			)
-			errs = append(errs, fmt.Errorf("lead %s: %w", lead.ID.String(), reindexErr))
+	resp, err := s.mlClient.ReindexBatch(ctx, req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to reindex batch: %w", err)
+	}
+
+	embeddings := make(map[uuid.UUID][]float32, len(resp.Results))
+	for _, result := range resp.Results {
+		id, err := uuid.Parse(result.EntityID)
+		if err != nil || len(result.Embedding) == 0 {
+			continue
+		}
+		if _, ok := inBatch[id]; !ok {
 			continue
 		}
-		success++
-		s.log.Info("lead reindexed", slog.String("lead_id", lead.ID.String()), slog.Int("progress", success))
+
+		embedding := make([]float32, len(result.Embedding))
+		for i, v := range result.Embedding {
+			embedding[i] = float32(v)
+		}
+		embeddings[id] = embedding
 	}
 
-	s.log.Info("reindex completed",
-		slog.Int("success", success),
-		slog.Int("total", total),
-		slog.Int("errors", len(errs)),
-	)
+	failed := len(leads) - len(embeddings)
+	if failed > 0 {
+		s.log.Warn("ml service failed to reindex some leads", slog.Int("failed", failed))
+	}
 
-	return success, total, errs
+	return embeddings, failed, nil
 }
@@ -2,8 +2,11 @@ package lead
 
 import (
 	"context"
+	"errors"
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/lib/ml"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/embedding"
 	"log/slog"
 	"os"
 	"testing"
@@ -13,8 +16,11 @@ import (
 
 // MockLeadRepository
 type MockLeadRepository struct {
-	GetByIDFunc         func(ctx context.Context, id uuid.UUID) (domain.Lead, error)
-	UpdateEmbeddingFunc func(ctx context.Context, leadID uuid.UUID, embedding []float32) error
+	GetByIDFunc          func(ctx context.Context, id uuid.UUID) (domain.Lead, error)
+	UpdateLeadFunc       func(ctx context.Context, leadID uuid.UUID, update domain.LeadFilter) error
+	UpdateEmbeddingFunc  func(ctx context.Context, leadID uuid.UUID, embedding []float32) error
+	ListLeadsFunc        func(ctx context.Context, filter domain.LeadFilter) (*domain.PaginatedResult[domain.Lead], error)
+	UpdateEmbeddingsFunc func(ctx context.Context, embeddings map[uuid.UUID][]float32) (int, error)
 	// other methods not needed for this test
 }
 
@@ -28,9 +34,15 @@ func (m *MockLeadRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.
 	return domain.Lead{}, nil
 }
 func (m *MockLeadRepository) UpdateLead(ctx context.Context, leadID uuid.UUID, update domain.LeadFilter) error {
+	if m.UpdateLeadFunc != nil {
+		return m.UpdateLeadFunc(ctx, leadID, update)
+	}
 	return nil
 }
 func (m *MockLeadRepository) ListLeads(ctx context.Context, filter domain.LeadFilter) (*domain.PaginatedResult[domain.Lead], error) {
+	if m.ListLeadsFunc != nil {
+		return m.ListLeadsFunc(ctx, filter)
+	}
 	return &domain.PaginatedResult[domain.Lead]{}, nil
 }
 func (m *MockLeadRepository) UpdateEmbedding(ctx context.Context, leadID uuid.UUID, embedding []float32) error {
@@ -39,10 +51,33 @@ func (m *MockLeadRepository) UpdateEmbedding(ctx context.Context, leadID uuid.UU
 	}
 	return nil
 }
+func (m *MockLeadRepository) UpdateEmbeddings(ctx context.Context, embeddings map[uuid.UUID][]float32) (int, error) {
+	if m.UpdateEmbeddingsFunc != nil {
+		return m.UpdateEmbeddingsFunc(ctx, embeddings)
+	}
+	return len(embeddings), nil
+}
+
+func (m *MockLeadRepository) MatchLeadsWithHardFilters(ctx context.Context, propertyEmbedding []float32, filter domain.LeadFilter, hardFilters *domain.HardFilters, limit int) ([]domain.MatchedLead, error) {
+	return nil, nil
+}
+
+// MockDealRepository
+type MockDealRepository struct {
+	PurchasedLeadIDsFunc func(ctx context.Context, buyerID uuid.UUID, leadIDs []uuid.UUID) ([]uuid.UUID, error)
+}
+
+func (m *MockDealRepository) PurchasedLeadIDs(ctx context.Context, buyerID uuid.UUID, leadIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if m.PurchasedLeadIDsFunc != nil {
+		return m.PurchasedLeadIDsFunc(ctx, buyerID, leadIDs)
+	}
+	return nil, nil
+}
 
 // MockMLClient
 type MockMLClient struct {
-	ReindexFunc func(ctx context.Context, req ml.ReindexRequest) (*ml.ReindexResponse, error)
+	ReindexFunc      func(ctx context.Context, req ml.ReindexRequest) (*ml.ReindexResponse, error)
+	ReindexBatchFunc func(ctx context.Context, req ml.ReindexBatchRequest) (*ml.ReindexBatchResponse, error)
 }
 
 func (m *MockMLClient) PrepareAndEmbed(ctx context.Context, req ml.PrepareAndEmbedRequest) (*ml.PrepareAndEmbedResponse, error) {
@@ -55,6 +90,9 @@ func (m *MockMLClient) Reindex(ctx context.Context, req ml.ReindexRequest) (*ml.
 	return nil, nil
 }
 func (m *MockMLClient) ReindexBatch(ctx context.Context, req ml.ReindexBatchRequest) (*ml.ReindexBatchResponse, error) {
+	if m.ReindexBatchFunc != nil {
+		return m.ReindexBatchFunc(ctx, req)
+	}
 	return nil, nil
 }
 func (m *MockMLClient) GetModelInfo(ctx context.Context) (*ml.ModelInfo, error) {
@@ -99,7 +137,7 @@ func TestService_ReindexLead(t *testing.T) {
 		},
 	}
 
-	svc := New(log, repo, mlClient)
+	svc := New(log, repo, &MockDealRepository{}, mlClient, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockTeamDirectory{})
 
 	err := svc.ReindexLead(context.Background(), leadID)
 	if err != nil {
@@ -107,3 +145,321 @@ func TestService_ReindexLead(t *testing.T) {
 	}
 }
 
+func TestService_UpdateLead_Ownership(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := uuid.New()
+	buyer := uuid.New()
+	leadID := uuid.New()
+	city := "Москва"
+
+	tests := []struct {
+		name    string
+		actor   domain.Actor
+		update  domain.LeadFilter
+		wantErr error
+	}{
+		{
+			name:   "owner updates lead",
+			actor:  domain.Actor{UserID: owner, Role: domain.UserRoleUser},
+			update: domain.LeadFilter{City: &city},
+		},
+		{
+			name:    "other user cannot update lead",
+			actor:   domain.Actor{UserID: buyer, Role: domain.UserRoleUser},
+			update:  domain.LeadFilter{City: &city},
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:    "owner cannot hand lead over directly",
+			actor:   domain.Actor{UserID: owner, Role: domain.UserRoleUser},
+			update:  domain.LeadFilter{OwnerUserID: &buyer},
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:   "admin reassigns lead",
+			actor:  domain.Actor{UserID: uuid.New(), Role: domain.UserRoleAdmin},
+			update: domain.LeadFilter{OwnerUserID: &buyer},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := false
+			repo := &MockLeadRepository{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+					return domain.Lead{ID: leadID, OwnerUserID: owner, CreatedUserID: owner}, nil
+				},
+				UpdateLeadFunc: func(ctx context.Context, id uuid.UUID, update domain.LeadFilter) error {
+					updated = true
+					return nil
+				},
+			}
+			svc := New(log, repo, &MockDealRepository{}, &MockMLClient{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockTeamDirectory{})
+
+			_, err := svc.UpdateLead(context.Background(), tt.actor, leadID, tt.update)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if updated {
+					t.Error("repository must not be called on denied update")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !updated {
+				t.Error("expected repository update")
+			}
+		})
+	}
+}
+
+func TestService_UpdateLead_EnqueuesReindex(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := uuid.New()
+	leadID := uuid.New()
+	title := "Новый заголовок"
+	city := "Казань"
+
+	tests := []struct {
+		name        string
+		update      domain.LeadFilter
+		wantEnqueue bool
+	}{
+		{name: "title change triggers reindex", update: domain.LeadFilter{Title: &title}, wantEnqueue: true},
+		{name: "city change does not affect embedding", update: domain.LeadFilter{City: &city}, wantEnqueue: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockLeadRepository{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+					return domain.Lead{ID: leadID, OwnerUserID: owner}, nil
+				},
+			}
+			var enqueued []domain.EmbeddingOperation
+			queue := &MockEmbeddingQueue{
+				EnqueueFunc: func(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID, operation domain.EmbeddingOperation) error {
+					if entityType != domain.EmbeddingEntityLead || entityID != leadID {
+						t.Errorf("unexpected job for %s %s", entityType, entityID)
+					}
+					enqueued = append(enqueued, operation)
+					return nil
+				},
+			}
+			svc := New(log, repo, &MockDealRepository{}, &MockMLClient{}, queue, &MockReindexCheckpoints{}, &MockTxManager{}, &MockTeamDirectory{})
+
+			actor := domain.Actor{UserID: owner, Role: domain.UserRoleUser}
+			if _, err := svc.UpdateLead(context.Background(), actor, leadID, tt.update); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantEnqueue && (len(enqueued) != 1 || enqueued[0] != domain.EmbeddingOperationReindex) {
+				t.Errorf("expected one REINDEX job, got %v", enqueued)
+			}
+			if !tt.wantEnqueue && len(enqueued) != 0 {
+				t.Errorf("expected no jobs, got %v", enqueued)
+			}
+		})
+	}
+}
+
+func TestService_UpdateLead_ValidatesRequirement(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := uuid.New()
+	leadID := uuid.New()
+	low, high := int64(5000000), int64(9000000)
+
+	tests := []struct {
+		name        string
+		requirement domain.LeadRequirement
+		wantErr     error
+	}{
+		{name: "valid range is stored with current version", requirement: domain.LeadRequirement{Price: &domain.Range[int64]{Min: &low, Max: &high}}},
+		{name: "min above max is rejected", requirement: domain.LeadRequirement{Price: &domain.Range[int64]{Min: &high, Max: &low}}, wantErr: ErrInvalidRequirement},
+		{name: "empty district is rejected", requirement: domain.LeadRequirement{Districts: []string{" "}}, wantErr: ErrInvalidRequirement},
+		{name: "unknown version is rejected", requirement: domain.LeadRequirement{Version: 2}, wantErr: ErrInvalidRequirement},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stored *domain.LeadRequirement
+			repo := &MockLeadRepository{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+					return domain.Lead{ID: leadID, OwnerUserID: owner}, nil
+				},
+				UpdateLeadFunc: func(ctx context.Context, id uuid.UUID, update domain.LeadFilter) error {
+					stored = update.Requirement
+					return nil
+				},
+			}
+			svc := New(log, repo, &MockDealRepository{}, &MockMLClient{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockTeamDirectory{})
+
+			actor := domain.Actor{UserID: owner, Role: domain.UserRoleUser}
+			requirement := tt.requirement
+			_, err := svc.UpdateLead(context.Background(), actor, leadID, domain.LeadFilter{Requirement: &requirement})
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if stored != nil {
+					t.Error("invalid requirement must not be stored")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if stored == nil || stored.Version != domain.LeadRequirementVersion {
+				t.Errorf("expected requirement with version %d, got %+v", domain.LeadRequirementVersion, stored)
+			}
+		})
+	}
+}
+
+func TestService_ProcessEmbeddingJob_DeletedLeadIsPermanent(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	repo := &MockLeadRepository{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+			return domain.Lead{}, repository.ErrLeadNotFound
+		},
+	}
+	svc := New(log, repo, &MockDealRepository{}, &MockMLClient{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockTeamDirectory{})
+
+	err := svc.ProcessEmbeddingJob(context.Background(), domain.EmbeddingJob{
+		EntityType: domain.EmbeddingEntityLead,
+		EntityID:   uuid.New(),
+		Operation:  domain.EmbeddingOperationReindex,
+	})
+	if !errors.Is(err, embedding.ErrPermanent) {
+		t.Errorf("expected permanent error, got %v", err)
+	}
+}
+
+func TestService_ReindexAllLeads_ResumesFromCheckpoint(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	ctx := context.Background()
+
+	leads := []domain.Lead{{ID: uuid.New()}, {ID: uuid.New()}, {ID: uuid.New()}}
+	failing := leads[1].ID
+
+	// Две страницы по 2 лида; токен страницы — индекс первого элемента
+	repo := &MockLeadRepository{
+		ListLeadsFunc: func(ctx context.Context, filter domain.LeadFilter) (*domain.PaginatedResult[domain.Lead], error) {
+			if filter.Pagination.OrderDirection != domain.OrderAsc {
+				t.Errorf("reindex must walk leads in creation order")
+			}
+			if filter.Pagination.PageToken == "" {
+				return &domain.PaginatedResult[domain.Lead]{Items: leads[:2], NextPageToken: "2", TotalCount: 3, HasMore: true}, nil
+			}
+			return &domain.PaginatedResult[domain.Lead]{Items: leads[2:], TotalCount: 3}, nil
+		},
+	}
+
+	mlDown := true
+	var batches int
+	mlClient := &MockMLClient{
+		ReindexBatchFunc: func(ctx context.Context, req ml.ReindexBatchRequest) (*ml.ReindexBatchResponse, error) {
+			batches++
+			if batches == 2 && mlDown {
+				return nil, errors.New("ml service unavailable")
+			}
+			resp := &ml.ReindexBatchResponse{}
+			for _, entity := range req.Entities {
+				if entity.EntityID == failing.String() {
+					continue
+				}
+				resp.Results = append(resp.Results, ml.ReindexResponse{EntityID: entity.EntityID, Embedding: []float64{0.1, 0.2}})
+			}
+			return resp, nil
+		},
+	}
+
+	checkpoints := &MockReindexCheckpoints{}
+	svc := New(log, repo, &MockDealRepository{}, mlClient, &MockEmbeddingQueue{}, checkpoints, &MockTxManager{}, &MockTeamDirectory{})
+	opts := domain.ReindexOptions{BatchSize: 2}
+
+	// Первый запуск падает на втором пакете, курсор первого пакета сохранён
+	if _, err := svc.ReindexAllLeads(ctx, opts, nil); err == nil {
+		t.Fatal("expected error when ML service is down")
+	}
+	saved := checkpoints.checkpoints[domain.EmbeddingEntityLead]
+	if saved.Cursor != "2" || saved.Processed != 2 || saved.Done {
+		t.Fatalf("unexpected checkpoint after failure: %+v", saved)
+	}
+
+	// Повторный запуск продолжает со второго пакета
+	mlDown = false
+	var reported []domain.ReindexProgress
+	progress, err := svc.ReindexAllLeads(ctx, opts, func(p domain.ReindexProgress) {
+		reported = append(reported, p)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(reported) != 1 {
+		t.Errorf("expected progress for 1 remaining batch, got %d", len(reported))
+	}
+	if !progress.Done || progress.Total != 3 || progress.Processed != 3 || progress.Success != 2 || progress.Failed != 1 {
+		t.Errorf("unexpected final progress: %+v", progress)
+	}
+
+	// Завершённая переиндексация при следующем запуске начинается с начала
+	batches = 0
+	if _, err := svc.ReindexAllLeads(ctx, opts, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if batches != 2 {
+		t.Errorf("expected full reindex of 2 batches, got %d", batches)
+	}
+}
+
+// MockEmbeddingQueue
+type MockEmbeddingQueue struct {
+	EnqueueFunc   func(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID, operation domain.EmbeddingOperation) error
+	LatestJobFunc func(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID) (*domain.EmbeddingJob, error)
+}
+
+func (m *MockEmbeddingQueue) Enqueue(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID, operation domain.EmbeddingOperation) error {
+	if m.EnqueueFunc != nil {
+		return m.EnqueueFunc(ctx, entityType, entityID, operation)
+	}
+	return nil
+}
+func (m *MockEmbeddingQueue) LatestJob(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID) (*domain.EmbeddingJob, error) {
+	if m.LatestJobFunc != nil {
+		return m.LatestJobFunc(ctx, entityType, entityID)
+	}
+	return nil, nil
+}
+
+// MockReindexCheckpoints
+type MockReindexCheckpoints struct {
+	checkpoints map[domain.EmbeddingEntityType]domain.ReindexProgress
+}
+
+func (m *MockReindexCheckpoints) GetCheckpoint(ctx context.Context, entityType domain.EmbeddingEntityType) (domain.ReindexProgress, error) {
+	checkpoint, ok := m.checkpoints[entityType]
+	if !ok {
+		return domain.ReindexProgress{}, repository.ErrReindexCheckpointNotFound
+	}
+	return checkpoint, nil
+}
+func (m *MockReindexCheckpoints) SaveCheckpoint(ctx context.Context, progress domain.ReindexProgress) error {
+	if m.checkpoints == nil {
+		m.checkpoints = make(map[domain.EmbeddingEntityType]domain.ReindexProgress)
+	}
+	m.checkpoints[progress.EntityType] = progress
+	return nil
+}
+
+// MockTxManager
+type MockTxManager struct{}
+
+func (m *MockTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,22 @@
+package lead
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/services/team"
+)
+
+// resolveTeam — раскрывает TeamOf фильтра в список владельцев.
+func (s *Service) resolveTeam(ctx context.Context, filter domain.LeadFilter) (domain.LeadFilter, error) {
+	if filter.TeamOf == nil {
+		return filter, nil
+	}
+
+	members, err := team.Members(ctx, s.teams, *filter.TeamOf)
+	if err != nil {
+		return domain.LeadFilter{}, err
+	}
+	filter.OwnerUserIDs = members
+
+	return filter, nil
+}
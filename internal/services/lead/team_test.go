@@ -0,0 +1,85 @@
+package lead
+
+import (
+	"context"
+	"lead_exchange/internal/domain"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MockTeamDirectory — одна команда в памяти; остальные пользователи — вне агентств.
+type MockTeamDirectory struct {
+	members []uuid.UUID
+}
+
+func (m *MockTeamDirectory) TeamMembers(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	if lo.Contains(m.members, userID) {
+		return m.members, nil
+	}
+	return []uuid.UUID{userID}, nil
+}
+
+func TestService_ListLeads_Team(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	agent, colleague := uuid.New(), uuid.New()
+
+	var got domain.LeadFilter
+	repo := &MockLeadRepository{
+		ListLeadsFunc: func(ctx context.Context, filter domain.LeadFilter) (*domain.PaginatedResult[domain.Lead], error) {
+			got = filter
+			return &domain.PaginatedResult[domain.Lead]{}, nil
+		},
+	}
+	svc := New(log, repo, &MockDealRepository{}, &MockMLClient{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockTeamDirectory{members: []uuid.UUID{agent, colleague}})
+
+	// Пользователь вне агентства видит только свои лиды
+	loner := uuid.New()
+	if _, err := svc.ListLeads(context.Background(), domain.LeadFilter{TeamOf: &loner}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.OwnerUserIDs) != 1 || got.OwnerUserIDs[0] != loner {
+		t.Errorf("expected only own leads outside an agency, got %v", got.OwnerUserIDs)
+	}
+
+	if _, err := svc.ListLeads(context.Background(), domain.LeadFilter{TeamOf: &agent}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.OwnerUserIDs) != 2 || !lo.Contains(got.OwnerUserIDs, colleague) {
+		t.Errorf("expected leads of the whole team, got %v", got.OwnerUserIDs)
+	}
+
+	if _, err := svc.ListLeads(context.Background(), domain.LeadFilter{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.OwnerUserIDs != nil {
+		t.Errorf("expected no owner restriction without TeamOf, got %v", got.OwnerUserIDs)
+	}
+}
+
+func TestService_ApplyContactPolicy_Teammates(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner, colleague, stranger := uuid.New(), uuid.New(), uuid.New()
+	lead := domain.Lead{ID: uuid.New(), ContactName: "Иван Петров", ContactPhone: "+7 912 345 6712", OwnerUserID: owner}
+
+	svc := New(log, &MockLeadRepository{}, &MockDealRepository{}, &MockMLClient{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockTeamDirectory{members: []uuid.UUID{owner, colleague}})
+
+	got, err := svc.ApplyContactPolicy(context.Background(), colleague, lead)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got[0].ContactsMasked {
+		t.Error("colleague from the same agency must see contacts")
+	}
+
+	got, err = svc.ApplyContactPolicy(context.Background(), stranger, lead)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got[0].ContactsMasked {
+		t.Error("user outside the agency must get masked contacts")
+	}
+}
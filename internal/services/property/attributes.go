@@ -0,0 +1,41 @@
+package property
+
+import (
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+)
+
+var ErrInvalidAttributes = errors.New("invalid property attributes")
+
+// checkBuildingAttributes — этаж не выше этажности здания, координаты задаются парой.
+// Те же правила закреплены CHECK-ограничениями properties; проверка в сервисе отдаёт
+// ErrInvalidAttributes вместо ошибки БД.
+func checkBuildingAttributes(floor, totalFloors *int32, latitude, longitude *float64) error {
+	if floor != nil && totalFloors != nil && *floor > *totalFloors {
+		return fmt.Errorf("floor must not exceed total_floors: %w", ErrInvalidAttributes)
+	}
+	if (latitude == nil) != (longitude == nil) {
+		return fmt.Errorf("latitude and longitude must be set together: %w", ErrInvalidAttributes)
+	}
+	return nil
+}
+
+// checkUpdatedAttributes — проверка объекта после частичного обновления: непереданные поля
+// берутся из сохранённого объекта, поэтому этаж сверяется с этажностью из БД.
+func checkUpdatedAttributes(current domain.Property, update domain.PropertyFilter) error {
+	return checkBuildingAttributes(
+		updatedValue(update.Floor, current.Floor),
+		updatedValue(update.TotalFloors, current.TotalFloors),
+		updatedValue(update.Latitude, current.Latitude),
+		updatedValue(update.Longitude, current.Longitude),
+	)
+}
+
+// updatedValue — новое значение поля, если оно передано в обновлении, иначе сохранённое.
+func updatedValue[T any](update, current *T) *T {
+	if update != nil {
+		return update
+	}
+	return current
+}
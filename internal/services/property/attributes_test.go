@@ -0,0 +1,87 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestCheckBuildingAttributes(t *testing.T) {
+	floor, lowFloor, total := int32(10), int32(3), int32(9)
+	lat := 55.75
+
+	tests := []struct {
+		name        string
+		floor       *int32
+		totalFloors *int32
+		latitude    *float64
+		longitude   *float64
+		wantErr     bool
+	}{
+		{name: "nothing set"},
+		{name: "floor within building", floor: &lowFloor, totalFloors: &total},
+		{name: "floor above building", floor: &floor, totalFloors: &total, wantErr: true},
+		{name: "floor without total floors", floor: &floor},
+		{name: "latitude without longitude", latitude: &lat, wantErr: true},
+		{name: "coordinates pair", latitude: &lat, longitude: &lat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkBuildingAttributes(tt.floor, tt.totalFloors, tt.latitude, tt.longitude)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidAttributes) {
+				t.Errorf("expected ErrInvalidAttributes, got %v", err)
+			}
+		})
+	}
+}
+
+func TestService_UpdateProperty_ChecksStoredAttributes(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := uuid.New()
+	total := int32(9)
+	lat, lon := 55.75, 37.61
+	stored := domain.Property{ID: uuid.New(), OwnerUserID: owner, TotalFloors: &total, Latitude: &lat, Longitude: &lon}
+
+	updates := 0
+	repo := &MockPropertyRepository{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Property, error) {
+			return stored, nil
+		},
+		UpdatePropertyFunc: func(ctx context.Context, id uuid.UUID, update domain.PropertyFilter) error {
+			updates++
+			return nil
+		},
+	}
+	svc := New(log, repo, &MockMLClient{}, &MockLeadService{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockIndexListener{}, config.JSONLDConfig{}, NewMockJSONLDCache(), &MockTeamDirectory{})
+	actor := domain.Actor{UserID: owner, Role: domain.UserRoleUser}
+
+	// Передан только этаж: он сверяется с этажностью из БД
+	high, low := int32(12), int32(5)
+	if _, err := svc.UpdateProperty(context.Background(), actor, stored.ID, domain.PropertyFilter{Floor: &high}); !errors.Is(err, ErrInvalidAttributes) {
+		t.Fatalf("expected ErrInvalidAttributes for floor above stored total_floors, got %v", err)
+	}
+	if updates != 0 {
+		t.Fatal("invalid update must not reach the repository")
+	}
+
+	// Одна координата меняется, вторая остаётся сохранённой
+	newLat := 55.76
+	for _, update := range []domain.PropertyFilter{{Floor: &low}, {Latitude: &newLat}} {
+		if _, err := svc.UpdateProperty(context.Background(), actor, stored.ID, update); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if updates != 2 {
+		t.Errorf("expected 2 updates, got %d", updates)
+	}
+}
@@ -0,0 +1,77 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/lib/jsonld"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JSONLDCache — кэш JSON-LD разметки объектов по паре (объект, base_url).
+// Сервис кэширует только разметку для base_url из конфига.
+type JSONLDCache interface {
+	GetJSONLD(ctx context.Context, propertyID uuid.UUID, baseURL string) ([]byte, error)
+	SaveJSONLD(ctx context.Context, propertyID uuid.UUID, baseURL string, data []byte, ttl time.Duration) error
+	InvalidateJSONLD(ctx context.Context, propertyID uuid.UUID) error
+}
+
+// GetPropertyJSONLD — JSON-LD разметка объекта (schema.org). Пустой baseURL — адрес из конфига.
+// Разметка для адреса из конфига берётся из кэша, а при промахе генерируется и кэшируется
+// на JSONLD_CACHE_TTL; ошибки кэша не мешают отдать разметку. Разметка для другого base_url
+// генерируется на каждый запрос: адрес задаёт клиент, и кэш по нему рос бы без ограничений.
+func (s *Service) GetPropertyJSONLD(ctx context.Context, propertyID uuid.UUID, baseURL string) ([]byte, error) {
+	const op = "property.Service.GetPropertyJSONLD"
+
+	if baseURL == "" {
+		baseURL = s.jsonldCfg.BaseURL
+	}
+	cacheable := baseURL == s.jsonldCfg.BaseURL
+
+	if cacheable {
+		data, err := s.jsonldCache.GetJSONLD(ctx, propertyID, baseURL)
+		if err == nil {
+			return data, nil
+		}
+		if !errors.Is(err, repository.ErrJSONLDNotCached) {
+			s.log.Warn("failed to read cached json-ld",
+				slog.String("property_id", propertyID.String()),
+				sl.Err(err),
+			)
+		}
+	}
+
+	p, err := s.repo.GetByID(ctx, propertyID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPropertyNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, ErrPropertyNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	data, err := jsonld.NewGenerator().GeneratePropertyJSONLDBytes(p, baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if cacheable && s.jsonldCfg.CacheTTL > 0 {
+		if err := s.jsonldCache.SaveJSONLD(ctx, propertyID, baseURL, data, s.jsonldCfg.CacheTTL); err != nil {
+			s.log.Warn("failed to cache json-ld",
+				slog.String("property_id", propertyID.String()),
+				sl.Err(err),
+			)
+		}
+	}
+
+	return data, nil
+}
+
+// invalidateJSONLD — сбрасывает кэш разметки объекта после изменения его данных.
+func (s *Service) invalidateJSONLD(ctx context.Context, propertyID uuid.UUID) error {
+	return s.jsonldCache.InvalidateJSONLD(ctx, propertyID)
+}
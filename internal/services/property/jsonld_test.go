@@ -0,0 +1,125 @@
+package property
+
+import (
+	"context"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MockJSONLDCache — кэш разметки в памяти, ключ — объект и base_url.
+type MockJSONLDCache struct {
+	data map[uuid.UUID]map[string][]byte
+	ttl  time.Duration
+}
+
+func NewMockJSONLDCache() *MockJSONLDCache {
+	return &MockJSONLDCache{data: make(map[uuid.UUID]map[string][]byte)}
+}
+
+func (m *MockJSONLDCache) GetJSONLD(ctx context.Context, propertyID uuid.UUID, baseURL string) ([]byte, error) {
+	if data, ok := m.data[propertyID][baseURL]; ok {
+		return data, nil
+	}
+	return nil, repository.ErrJSONLDNotCached
+}
+
+func (m *MockJSONLDCache) SaveJSONLD(ctx context.Context, propertyID uuid.UUID, baseURL string, data []byte, ttl time.Duration) error {
+	if m.data[propertyID] == nil {
+		m.data[propertyID] = make(map[string][]byte)
+	}
+	m.data[propertyID][baseURL] = data
+	m.ttl = ttl
+	return nil
+}
+
+func (m *MockJSONLDCache) InvalidateJSONLD(ctx context.Context, propertyID uuid.UUID) error {
+	delete(m.data, propertyID)
+	return nil
+}
+
+func TestService_GetPropertyJSONLD_Cache(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := uuid.New()
+	propertyID := uuid.New()
+	title := "Квартира у парка"
+
+	loads := 0
+	repo := &MockPropertyRepository{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Property, error) {
+			loads++
+			return domain.Property{ID: id, Title: title, OwnerUserID: owner, PropertyType: domain.PropertyTypeHouse}, nil
+		},
+		UpdatePropertyFunc: func(ctx context.Context, id uuid.UUID, update domain.PropertyFilter) error {
+			title = *update.Title
+			return nil
+		},
+	}
+	cache := NewMockJSONLDCache()
+	cfg := config.JSONLDConfig{BaseURL: "https://example.ru", CacheTTL: time.Hour}
+	svc := New(log, repo, &MockMLClient{}, &MockLeadService{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockIndexListener{}, cfg, cache, &MockTeamDirectory{})
+
+	first, err := svc.GetPropertyJSONLD(context.Background(), propertyID, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(first), "https://example.ru") {
+		t.Errorf("expected configured base URL in JSON-LD, got %s", first)
+	}
+	if _, err := svc.GetPropertyJSONLD(context.Background(), propertyID, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loads != 1 {
+		t.Errorf("expected cached JSON-LD to be reused, property loaded %d times", loads)
+	}
+	if cache.ttl != time.Hour {
+		t.Errorf("expected cache ttl from config, got %s", cache.ttl)
+	}
+
+	// Адрес из конфига, переданный явно, берётся из того же кэша
+	if _, err := svc.GetPropertyJSONLD(context.Background(), propertyID, "https://example.ru"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loads != 1 {
+		t.Errorf("expected configured base URL to hit the cache, property loaded %d times", loads)
+	}
+
+	// Другой base_url генерируется на каждый запрос и в кэш не попадает
+	for range 2 {
+		partner, err := svc.GetPropertyJSONLD(context.Background(), propertyID, "https://partner.ru")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(string(partner), "https://partner.ru") {
+			t.Errorf("expected requested base URL in JSON-LD, got %s", partner)
+		}
+	}
+	if loads != 3 || len(cache.data[propertyID]) != 1 {
+		t.Errorf("expected client base URL to bypass the cache, loads=%d entries=%d", loads, len(cache.data[propertyID]))
+	}
+
+	// Изменение объекта сбрасывает кэш
+	newTitle := "Квартира с ремонтом"
+	actor := domain.Actor{UserID: owner, Role: domain.UserRoleUser}
+	if _, err := svc.UpdateProperty(context.Background(), actor, propertyID, domain.PropertyFilter{Title: &newTitle}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cache.data[propertyID]) != 0 {
+		t.Fatalf("expected cache to be invalidated on update, got %d entries", len(cache.data[propertyID]))
+	}
+
+	updated, err := svc.GetPropertyJSONLD(context.Background(), propertyID, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(updated), newTitle) {
+		t.Errorf("expected regenerated JSON-LD with new title, got %s", updated)
+	}
+}
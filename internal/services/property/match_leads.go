@@ -0,0 +1,111 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MatchLeads находит опубликованных лидов, которым подходит объект (обратный матчинг).
+// Жёсткие фильтры зеркальны прямому матчингу, ранжирование — те же веса и объяснения, что в rankMatches.
+func (s *Service) MatchLeads(ctx context.Context, propertyID uuid.UUID, filter domain.LeadFilter, limit int) ([]domain.MatchedLead, error) {
+	const op = "property.Service.MatchLeads"
+
+	property, err := s.repo.GetByID(ctx, propertyID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPropertyNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, ErrPropertyNotFound)
+		}
+		return nil, fmt.Errorf("%s: failed to get property: %w", op, err)
+	}
+
+	if len(property.Embedding) == 0 {
+		s.log.Error("property has no embedding",
+			slog.String("property_id", propertyID.String()),
+			slog.String("property_title", property.Title),
+		)
+		return nil, fmt.Errorf("%s: property has no embedding (property_id=%s)", op, propertyID.String())
+	}
+
+	if limit <= 0 {
+		limit = 10
+	}
+
+	// Берём больше кандидатов для взвешенного ранжирования
+	fetchLimit := limit * 5
+	if fetchLimit > 100 {
+		fetchLimit = 100
+	}
+
+	// По умолчанию ищем только среди опубликованных лидов
+	if filter.Status == nil {
+		filter.Status = lo.ToPtr(domain.LeadStatusPublished)
+	}
+
+	if property.City != nil && *property.City != "" {
+		normalized := domain.NormalizeCity(*property.City)
+		property.City = &normalized
+	}
+	hardFilters := domain.DefaultHardFiltersFromProperty(property)
+
+	s.log.Debug("matching leads with hard filters",
+		slog.String("property_id", propertyID.String()),
+		slog.Any("hard_filters", hardFilters),
+	)
+
+	matches, err := s.leadService.MatchLeadsByEmbedding(ctx, property.Embedding, filter, &hardFilters, fetchLimit)
+	if err != nil {
+		s.log.Error("failed to match leads", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Обязательные особенности лида объект должен содержать целиком
+	matches = lo.Filter(matches, func(m domain.MatchedLead, _ int) bool {
+		return property.HasFeatures(m.Lead.Requirement.MustHave)
+	})
+
+	matches = s.rankLeadMatches(property, matches, domain.DefaultWeights())
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	return matches, nil
+}
+
+// rankLeadMatches считает scores объекта относительно критериев каждого лида и сортирует по TotalScore.
+func (s *Service) rankLeadMatches(property domain.Property, matches []domain.MatchedLead, w domain.MatchWeights) []domain.MatchedLead {
+	for i := range matches {
+		m := domain.MatchedProperty{
+			Property:   property,
+			Similarity: matches[i].Similarity,
+		}
+		criteria := matches[i].Lead.Requirement.SoftCriteria()
+		lw := w
+		if criteria != nil && criteria.PreferredFeatures != nil {
+			lw = withFeaturesWeight(w)
+		}
+		s.calculateScores(&m, lw, criteria)
+
+		matches[i].TotalScore = m.TotalScore
+		matches[i].PriceScore = m.PriceScore
+		matches[i].DistrictScore = m.DistrictScore
+		matches[i].RoomsScore = m.RoomsScore
+		matches[i].AreaScore = m.AreaScore
+		matches[i].SemanticScore = m.SemanticScore
+		matches[i].MatchExplanation = m.MatchExplanation
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return lo.FromPtr(matches[i].TotalScore) > lo.FromPtr(matches[j].TotalScore)
+	})
+
+	return matches
+}
@@ -0,0 +1,85 @@
+package property
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"math"
+
+	"github.com/google/uuid"
+)
+
+// neutralSimilarity — семантическая близость для ad-hoc поиска, у которого нет embedding.
+const neutralSimilarity = 0.5
+
+// IndexListener получает уведомления о том, что embedding объектов создан или обновлён
+// (например, очередь воркера сохранённых поисков). Ошибка означает, что уведомление не сохранено.
+type IndexListener interface {
+	PropertiesIndexed(ctx context.Context, propertyIDs ...uuid.UUID) error
+}
+
+// MatchSavedSearch оценивает один объект для сохранённого поиска.
+// Возвращает false, если объект не проходит жёсткие фильтры поиска.
+// Поиск по лиду использует те же фильтры и критерии, что MatchPropertiesWeighted.
+func (s *Service) MatchSavedSearch(ctx context.Context, search domain.SavedSearch, property domain.Property) (domain.MatchedProperty, bool, error) {
+	const op = "property.Service.MatchSavedSearch"
+
+	var (
+		hardFilters domain.HardFilters
+		criteria    *domain.SoftCriteria
+		similarity  = neutralSimilarity
+	)
+
+	if search.LeadID != nil {
+		lead, err := s.leadService.GetLead(ctx, *search.LeadID)
+		if err != nil {
+			return domain.MatchedProperty{}, false, fmt.Errorf("%s: failed to get lead: %w", op, err)
+		}
+		if len(lead.Embedding) == 0 || len(property.Embedding) == 0 {
+			return domain.MatchedProperty{}, false, fmt.Errorf("%s: lead or property has no embedding", op)
+		}
+
+		criteria = lead.Requirement.SoftCriteria()
+		hardFilters = *s.buildHardFiltersFromLead(lead, criteria)
+		similarity = cosineSimilarity(lead.Embedding, property.Embedding)
+	} else {
+		hardFilters = search.Filter.HardFilters()
+		criteria = search.Filter.SoftCriteria()
+	}
+
+	if !hardFilters.Matches(property) {
+		return domain.MatchedProperty{}, false, nil
+	}
+
+	w := domain.DefaultWeights()
+	if search.Weights != nil {
+		w = search.Weights.Normalize()
+	}
+	if criteria != nil && criteria.PreferredFeatures != nil {
+		w = withFeaturesWeight(w)
+	}
+
+	m := domain.MatchedProperty{Property: property, Similarity: similarity}
+	s.calculateScores(&m, w, criteria)
+
+	return m, true, nil
+}
+
+// cosineSimilarity — косинусная близость векторов, как 1 - (a <=> b) в pgvector.
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -11,11 +11,16 @@ import (
 	"lead_exchange/internal/lib/reranker"
 	"lead_exchange/internal/repository"
 	"lead_exchange/internal/repository/property_repository"
+	"lead_exchange/internal/services/embedding"
+	"lead_exchange/internal/services/team"
 	"lead_exchange/internal/services/weights"
 	"log/slog"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 type PropertyRepository interface {
@@ -24,15 +29,34 @@ type PropertyRepository interface {
 	UpdateProperty(ctx context.Context, propertyID uuid.UUID, update domain.PropertyFilter) error
 	ListProperties(ctx context.Context, filter domain.PropertyFilter) (*domain.PaginatedResult[domain.Property], error)
 	UpdateEmbedding(ctx context.Context, propertyID uuid.UUID, embedding []float32) error
+	UpdateEmbeddings(ctx context.Context, embeddings map[uuid.UUID][]float32) (int, error)
 	MatchProperties(ctx context.Context, leadEmbedding []float32, filter domain.PropertyFilter, limit int) ([]domain.MatchedProperty, error)
 	MatchPropertiesWithHardFilters(ctx context.Context, leadEmbedding []float32, filter domain.PropertyFilter, hardFilters *domain.HardFilters, limit int) ([]domain.MatchedProperty, error)
 	HybridSearch(ctx context.Context, params property_repository.HybridSearchParams) ([]domain.MatchedProperty, error)
 	FulltextSearch(ctx context.Context, query string, filter domain.PropertyFilter, limit int) ([]domain.MatchedProperty, error)
 }
 
-// LeadService нужен для получения embedding лида при матчинге.
+// LeadService нужен для получения embedding лида при матчинге и для обратного матчинга.
 type LeadService interface {
 	GetLead(ctx context.Context, id uuid.UUID) (domain.Lead, error)
+	MatchLeadsByEmbedding(ctx context.Context, propertyEmbedding []float32, filter domain.LeadFilter, hardFilters *domain.HardFilters, limit int) ([]domain.MatchedLead, error)
+}
+
+// EmbeddingQueue — очередь генерации embedding.
+type EmbeddingQueue interface {
+	Enqueue(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID, operation domain.EmbeddingOperation) error
+	LatestJob(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID) (*domain.EmbeddingJob, error)
+}
+
+// ReindexCheckpoints — контрольные точки массовой переиндексации.
+type ReindexCheckpoints interface {
+	GetCheckpoint(ctx context.Context, entityType domain.EmbeddingEntityType) (domain.ReindexProgress, error)
+	SaveCheckpoint(ctx context.Context, progress domain.ReindexProgress) error
+}
+
+// TxManager — запуск операций в одной транзакции.
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type Service struct {
@@ -43,10 +67,18 @@ type Service struct {
 	weightsAnalyzer *weights.Analyzer
 	leadService     LeadService
 	searchCfg       config.SearchConfig
+	embeddingQueue  EmbeddingQueue
+	checkpoints     ReindexCheckpoints
+	txManager       TxManager
+	indexListener   IndexListener
+	jsonldCfg       config.JSONLDConfig
+	jsonldCache     JSONLDCache
+	teams           team.Directory
 }
 
 var (
 	ErrPropertyNotFound = errors.New("property not found")
+	ErrPermissionDenied = errors.New("permission denied")
 )
 
 func New(
@@ -54,13 +86,27 @@ func New(
 	repo PropertyRepository,
 	mlClient ml.Client,
 	leadService LeadService,
+	embeddingQueue EmbeddingQueue,
+	checkpoints ReindexCheckpoints,
+	txManager TxManager,
+	indexListener IndexListener,
+	jsonldCfg config.JSONLDConfig,
+	jsonldCache JSONLDCache,
+	teams team.Directory,
 ) *Service {
 	return &Service{
-		log:         log,
-		repo:        repo,
-		mlClient:    mlClient,
-		leadService: leadService,
-		searchCfg:   config.SearchConfig{},
+		log:            log,
+		repo:           repo,
+		mlClient:       mlClient,
+		leadService:    leadService,
+		searchCfg:      config.SearchConfig{},
+		embeddingQueue: embeddingQueue,
+		checkpoints:    checkpoints,
+		txManager:      txManager,
+		indexListener:  indexListener,
+		jsonldCfg:      jsonldCfg,
+		jsonldCache:    jsonldCache,
+		teams:          teams,
 	}
 }
 
@@ -73,6 +119,13 @@ func NewWithAdvancedSearch(
 	weightsAnalyzer *weights.Analyzer,
 	leadService LeadService,
 	searchCfg config.SearchConfig,
+	embeddingQueue EmbeddingQueue,
+	checkpoints ReindexCheckpoints,
+	txManager TxManager,
+	indexListener IndexListener,
+	jsonldCfg config.JSONLDConfig,
+	jsonldCache JSONLDCache,
+	teams team.Directory,
 ) *Service {
 	return &Service{
 		log:             log,
@@ -82,18 +135,37 @@ func NewWithAdvancedSearch(
 		weightsAnalyzer: weightsAnalyzer,
 		leadService:     leadService,
 		searchCfg:       searchCfg,
+		embeddingQueue:  embeddingQueue,
+		checkpoints:     checkpoints,
+		txManager:       txManager,
+		indexListener:   indexListener,
+		jsonldCfg:       jsonldCfg,
+		jsonldCache:     jsonldCache,
+		teams:           teams,
 	}
 }
 
-// CreateProperty — создаёт новый объект недвижимости и генерирует embedding.
+// CreateProperty — создаёт новый объект недвижимости и ставит генерацию embedding в очередь.
+// Объект и задача сохраняются в одной транзакции, поэтому embedding не теряется при сбое ML-сервиса.
 func (s *Service) CreateProperty(ctx context.Context, property domain.Property) (uuid.UUID, error) {
 	const op = "property.Service.CreateProperty"
 	log := s.log.With(slog.String("op", op), slog.String("title", property.Title))
 
 	log.Info("creating new property")
 
-	// Сначала сохраняем объект без embedding
-	id, err := s.repo.CreateProperty(ctx, property)
+	if err := checkBuildingAttributes(property.Floor, property.TotalFloors, property.Latitude, property.Longitude); err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var id uuid.UUID
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		id, err = s.repo.CreateProperty(ctx, property)
+		if err != nil {
+			return err
+		}
+		return s.embeddingQueue.Enqueue(ctx, domain.EmbeddingEntityProperty, id, domain.EmbeddingOperationEmbed)
+	})
 	if err != nil {
 		log.Error("failed to create property", sl.Err(err))
 		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
@@ -101,21 +173,6 @@ func (s *Service) CreateProperty(ctx context.Context, property domain.Property)
 
 	log.Info("property created successfully", slog.String("property_id", id.String()))
 
-	// Генерируем embedding синхронно с таймаутом 30 секунд
-	// Это критически важно для работы матчинга
-	embeddingCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	if err := s.generateAndUpdateEmbedding(embeddingCtx, id, property); err != nil {
-		s.log.Error("failed to generate embedding for property",
-			slog.String("property_id", id.String()),
-			sl.Err(err),
-		)
-		// Не возвращаем ошибку — объект уже создан
-	} else {
-		s.log.Info("embedding generated successfully", slog.String("property_id", id.String()))
-	}
-
 	return id, nil
 }
 
@@ -131,6 +188,7 @@ func (s *Service) generateAndUpdateEmbedding(ctx context.Context, propertyID uui
 		Rooms:       property.Rooms,
 		Area:        property.Area,
 		Address:     &property.Address,
+		District:    property.District,
 	}
 
 	// Получаем embedding от ML сервиса
@@ -172,10 +230,11 @@ func (s *Service) GetProperty(ctx context.Context, id uuid.UUID) (domain.Propert
 }
 
 // UpdateProperty — частичное обновление данных объекта недвижимости.
-func (s *Service) UpdateProperty(ctx context.Context, propertyID uuid.UUID, update domain.PropertyFilter) (domain.Property, error) {
+// Изменять объект может только владелец или админ; сменить владельца — только админ.
+func (s *Service) UpdateProperty(ctx context.Context, actor domain.Actor, propertyID uuid.UUID, update domain.PropertyFilter) (domain.Property, error) {
 	const op = "property.Service.UpdateProperty"
 
-	err := s.repo.UpdateProperty(ctx, propertyID, update)
+	current, err := s.repo.GetByID(ctx, propertyID)
 	if err != nil {
 		if errors.Is(err, repository.ErrPropertyNotFound) {
 			return domain.Property{}, fmt.Errorf("%s: %w", op, ErrPropertyNotFound)
@@ -183,19 +242,50 @@ func (s *Service) UpdateProperty(ctx context.Context, propertyID uuid.UUID, upda
 		return domain.Property{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	updated, err := s.repo.GetByID(ctx, propertyID)
+	if !actor.IsAdmin() {
+		if current.OwnerUserID != actor.UserID {
+			s.log.Warn("property update denied: not an owner",
+				slog.String("property_id", propertyID.String()),
+				slog.String("user_id", actor.UserID.String()),
+			)
+			return domain.Property{}, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		}
+		if update.OwnerUserID != nil && *update.OwnerUserID != current.OwnerUserID {
+			return domain.Property{}, fmt.Errorf("%s: owner can be changed only by admin: %w", op, ErrPermissionDenied)
+		}
+	}
+
+	if err := checkUpdatedAttributes(current, update); err != nil {
+		return domain.Property{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Переиндексация ставится в очередь вместе с изменением, если изменились данные, влияющие на matching
+	reindex := update.Title != nil || update.Description != nil || update.Address != nil ||
+		update.Price != nil || update.Rooms != nil || update.Area != nil || update.District != nil
+
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.UpdateProperty(ctx, propertyID, update); err != nil {
+			return err
+		}
+		// Разметка строится из данных объекта, поэтому кэш сбрасывается при любом изменении
+		if err := s.invalidateJSONLD(ctx, propertyID); err != nil {
+			return err
+		}
+		if !reindex {
+			return nil
+		}
+		return s.embeddingQueue.Enqueue(ctx, domain.EmbeddingEntityProperty, propertyID, domain.EmbeddingOperationReindex)
+	})
 	if err != nil {
-		return domain.Property{}, fmt.Errorf("%s: failed to fetch updated property: %w", op, err)
+		if errors.Is(err, repository.ErrPropertyNotFound) {
+			return domain.Property{}, fmt.Errorf("%s: %w", op, ErrPropertyNotFound)
+		}
+		return domain.Property{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Переиндексируем embedding асинхронно, если изменились данные, влияющие на matching
-	if update.Title != nil || update.Description != nil || update.Address != nil ||
-		update.Price != nil || update.Rooms != nil || update.Area != nil {
-		go func() {
-			if err := s.reindexProperty(context.Background(), propertyID, updated); err != nil {
-				s.log.Error("failed to reindex property", slog.String("property_id", propertyID.String()), sl.Err(err))
-			}
-		}()
+	updated, err := s.repo.GetByID(ctx, propertyID)
+	if err != nil {
+		return domain.Property{}, fmt.Errorf("%s: failed to fetch updated property: %w", op, err)
 	}
 
 	return updated, nil
@@ -220,21 +310,82 @@ func (s *Service) ReindexProperty(ctx context.Context, propertyID uuid.UUID) err
 	return nil
 }
 
+// ScheduleReindex — ставит переиндексацию объекта в очередь.
+func (s *Service) ScheduleReindex(ctx context.Context, propertyID uuid.UUID) error {
+	const op = "property.Service.ScheduleReindex"
+
+	if _, err := s.repo.GetByID(ctx, propertyID); err != nil {
+		if errors.Is(err, repository.ErrPropertyNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrPropertyNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.embeddingQueue.Enqueue(ctx, domain.EmbeddingEntityProperty, propertyID, domain.EmbeddingOperationReindex); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// EmbeddingState — есть ли у объекта embedding и статус последней задачи очереди.
+func (s *Service) EmbeddingState(ctx context.Context, propertyID uuid.UUID) (domain.EmbeddingState, error) {
+	const op = "property.Service.EmbeddingState"
+
+	property, err := s.repo.GetByID(ctx, propertyID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPropertyNotFound) {
+			return domain.EmbeddingState{}, fmt.Errorf("%s: %w", op, ErrPropertyNotFound)
+		}
+		return domain.EmbeddingState{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	job, err := s.embeddingQueue.LatestJob(ctx, domain.EmbeddingEntityProperty, propertyID)
+	if err != nil {
+		return domain.EmbeddingState{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return domain.EmbeddingState{HasEmbedding: len(property.Embedding) > 0, Job: job}, nil
+}
+
+// ProcessEmbeddingJob — выполняет задачу очереди embedding для объекта.
+// После успешной индексации уведомляет слушателя (сохранённые поиски).
+func (s *Service) ProcessEmbeddingJob(ctx context.Context, job domain.EmbeddingJob) error {
+	const op = "property.Service.ProcessEmbeddingJob"
+
+	property, err := s.repo.GetByID(ctx, job.EntityID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPropertyNotFound) {
+			return fmt.Errorf("%s: %w: %w", op, embedding.ErrPermanent, ErrPropertyNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	switch job.Operation {
+	case domain.EmbeddingOperationEmbed:
+		if err := s.generateAndUpdateEmbedding(ctx, property.ID, property); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := s.indexListener.PropertiesIndexed(ctx, property.ID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	case domain.EmbeddingOperationReindex:
+		if err := s.reindexProperty(ctx, property.ID, property); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	default:
+		return fmt.Errorf("%s: unknown operation %q: %w", op, job.Operation, embedding.ErrPermanent)
+	}
+
+	return nil
+}
+
 // reindexProperty переиндексирует embedding для объекта недвижимости после обновления.
 func (s *Service) reindexProperty(ctx context.Context, propertyID uuid.UUID, property domain.Property) error {
 	const op = "property.Service.reindexProperty"
 
-	// Подготавливаем запрос к ML сервису для переиндексации
-	mlReq := ml.ReindexRequest{
-		EntityID:    propertyID.String(),
-		EntityType:  "property",
-		Title:       property.Title,
-		Description: property.Description,
-		Price:       property.Price,
-		Rooms:       property.Rooms,
-		Area:        property.Area,
-		Address:     &property.Address,
-	}
+	property.ID = propertyID
+	mlReq := reindexRequest(property)
 
 	// Получаем новый embedding от ML сервиса
 	mlResp, err := s.mlClient.Reindex(ctx, mlReq)
@@ -254,60 +405,193 @@ func (s *Service) reindexProperty(ctx context.Context, propertyID uuid.UUID, pro
 	}
 
 	s.log.Info("property reindexed successfully", slog.String("property_id", propertyID.String()))
+	if err := s.indexListener.PropertiesIndexed(ctx, propertyID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
 	return nil
 }
 
-// ReindexAllProperties переиндексирует все объекты недвижимости.
-// Возвращает количество успешно переиндексированных и общее количество.
-func (s *Service) ReindexAllProperties(ctx context.Context) (success int, total int, errs []error) {
+// reindexRequest — запрос к ML-сервису на переиндексацию объекта.
+func reindexRequest(property domain.Property) ml.ReindexRequest {
+	return ml.ReindexRequest{
+		EntityID:    property.ID.String(),
+		EntityType:  "property",
+		Title:       property.Title,
+		Description: property.Description,
+		Price:       property.Price,
+		Rooms:       property.Rooms,
+		Area:        property.Area,
+		Address:     &property.Address,
+		District:    property.District,
+	}
+}
+
+// ReindexAllProperties — пакетная переиндексация всех объектов через ml.Client.ReindexBatch.
+// Объекты обходятся курсором PageCursor в порядке создания. Embedding пакета и курсор
+// сохраняются в одной транзакции, поэтому после сбоя переиндексация продолжается
+// с последнего сохранённого пакета. onProgress вызывается после каждого пакета.
+// Новый embedding меняет semantic score, поэтому объекты пакета ставятся в очередь сохранённых поисков
+// в той же транзакции.
+func (s *Service) ReindexAllProperties(ctx context.Context, opts domain.ReindexOptions, onProgress func(domain.ReindexProgress)) (domain.ReindexProgress, error) {
 	const op = "property.Service.ReindexAllProperties"
+	log := s.log.With(slog.String("op", op))
 
-	s.log.Info("starting reindex of all properties")
+	progress, err := s.reindexCheckpoint(ctx, opts.Restart)
+	if err != nil {
+		return domain.ReindexProgress{}, fmt.Errorf("%s: %w", op, err)
+	}
 
-	// Получаем все объекты
-	filter := domain.PropertyFilter{
-		Pagination: &domain.PaginationParams{
-			PageSize: 1000,
-		},
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = domain.DefaultReindexBatchSize
 	}
 
-	result, err := s.repo.ListProperties(ctx, filter)
+	log.Info("starting batch reindex of properties",
+		slog.Bool("resumed", progress.Cursor != ""),
+		slog.Int("processed", progress.Processed),
+	)
+
+	for !progress.Done {
+		page, err := s.repo.ListProperties(ctx, domain.PropertyFilter{
+			Pagination: &domain.PaginationParams{
+				PageSize:       int32(batchSize),
+				PageToken:      progress.Cursor,
+				OrderBy:        "created_at",
+				OrderDirection: domain.OrderAsc,
+			},
+		})
+		if err != nil {
+			return progress, fmt.Errorf("%s: failed to list properties: %w", op, err)
+		}
+
+		embeddings, failed, err := s.reindexBatch(ctx, page.Items)
+		if err != nil {
+			return progress, fmt.Errorf("%s: %w", op, err)
+		}
+
+		next := progress
+		next.Total = int(page.TotalCount)
+		next.Processed += len(page.Items)
+		next.Failed += failed
+		next.Cursor = page.NextPageToken
+		next.Done = !page.HasMore
+		next.UpdatedAt = time.Now()
+		if len(page.Items) > 0 {
+			next.LastID = page.Items[len(page.Items)-1].ID
+		}
+
+		err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+			updated, err := s.repo.UpdateEmbeddings(ctx, embeddings)
+			if err != nil {
+				return err
+			}
+			// Объекты, удалённые за время запроса к ML-сервису, считаются неуспешными
+			next.Success += updated
+			next.Failed += len(embeddings) - updated
+			if err := s.indexListener.PropertiesIndexed(ctx, lo.Keys(embeddings)...); err != nil {
+				return err
+			}
+			return s.checkpoints.SaveCheckpoint(ctx, next)
+		})
+		if err != nil {
+			return progress, fmt.Errorf("%s: failed to save batch: %w", op, err)
+		}
+		progress = next
+
+		log.Info("properties batch reindexed",
+			slog.Int("processed", progress.Processed),
+			slog.Int("total", progress.Total),
+			slog.Int("failed", progress.Failed),
+		)
+		if onProgress != nil {
+			onProgress(progress)
+		}
+	}
+
+	log.Info("reindex completed",
+		slog.Int("success", progress.Success),
+		slog.Int("total", progress.Total),
+		slog.Int("failed", progress.Failed),
+	)
+
+	return progress, nil
+}
+
+// reindexCheckpoint — контрольная точка, с которой продолжается переиндексация.
+// Завершённая переиндексация и restart начинаются с начала.
+func (s *Service) reindexCheckpoint(ctx context.Context, restart bool) (domain.ReindexProgress, error) {
+	fresh := domain.ReindexProgress{EntityType: domain.EmbeddingEntityProperty, StartedAt: time.Now()}
+	if restart {
+		return fresh, nil
+	}
+
+	checkpoint, err := s.checkpoints.GetCheckpoint(ctx, domain.EmbeddingEntityProperty)
 	if err != nil {
-		return 0, 0, []error{fmt.Errorf("%s: failed to list properties: %w", op, err)}
+		if errors.Is(err, repository.ErrReindexCheckpointNotFound) {
+			return fresh, nil
+		}
+		return domain.ReindexProgress{}, err
+	}
+	if checkpoint.Done {
+		return fresh, nil
 	}
 
-	total = len(result.Items)
-	success = 0
-	errs = make([]error, 0)
+	return checkpoint, nil
+}
 
-	for _, property := range result.Items {
-		// Переиндексируем все объекты
-		reindexErr := s.reindexProperty(ctx, property.ID, property)
-		if reindexErr != nil {
-			s.log.Warn("failed to reindex property",
-				slog.String("property_id", property.ID.String()),
-				sl.Err(reindexErr),
-			)
-			errs = append(errs, fmt.Errorf("property %s: %w", property.ID.String(), reindexErr))
+// reindexBatch — получает embedding пакета объектов одним запросом ReindexBatch.
+// Возвращает embedding успешно обработанных объектов и количество отказов ML-сервиса.
+func (s *Service) reindexBatch(ctx context.Context, properties []domain.Property) (map[uuid.UUID][]float32, int, error) {
+	if len(properties) == 0 {
+		return nil, 0, nil
+	}
+
+	req := ml.ReindexBatchRequest{Entities: make([]ml.ReindexRequest, 0, len(properties))}
+	inBatch := make(map[uuid.UUID]struct{}, len(properties))
+	for _, property := range properties {
+		req.Entities = append(req.Entities, reindexRequest(property))
+		inBatch[property.ID] = struct{}{}
+	}
+
+	resp, err := s.mlClient.ReindexBatch(ctx, req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to reindex batch: %w", err)
+	}
+
+	embeddings := make(map[uuid.UUID][]float32, len(resp.Results))
+	for _, result := range resp.Results {
+		id, err := uuid.Parse(result.EntityID)
+		if err != nil || len(result.Embedding) == 0 {
+			continue
+		}
+		if _, ok := inBatch[id]; !ok {
 			continue
 		}
-		success++
-		s.log.Info("property reindexed", slog.String("property_id", property.ID.String()), slog.Int("progress", success))
+
+		embedding := make([]float32, len(result.Embedding))
+		for i, v := range result.Embedding {
+			embedding[i] = float32(v)
+		}
+		embeddings[id] = embedding
 	}
 
-	s.log.Info("reindex completed",
-		slog.Int("success", success),
-		slog.Int("total", total),
-		slog.Int("errors", len(errs)),
-	)
+	failed := len(properties) - len(embeddings)
+	if failed > 0 {
+		s.log.Warn("ml service failed to reindex some properties", slog.Int("failed", failed))
+	}
 
-	return success, total, errs
+	return embeddings, failed, nil
 }
 
 // ListProperties — возвращает объекты недвижимости по фильтру с пагинацией.
 func (s *Service) ListProperties(ctx context.Context, filter domain.PropertyFilter) (*domain.PaginatedResult[domain.Property], error) {
 	const op = "property.Service.ListProperties"
 
+	filter, err := s.resolveTeam(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	result, err := s.repo.ListProperties(ctx, filter)
 	if err != nil {
 		s.log.Error("failed to list properties", sl.Err(err))
@@ -326,17 +610,22 @@ func (s *Service) MatchProperties(ctx context.Context, leadID uuid.UUID, filter
 // - Гибридный поиск (векторный + полнотекстовый)
 // - Динамические веса на основе анализа лида
 // - Реранкер для финального ранжирования
+// Стратегия берётся из config.SearchConfig и может быть переопределена opts для этого запроса.
+// Возвращает фактически применённую стратегию.
 func (s *Service) MatchPropertiesAdvanced(
 	ctx context.Context,
 	leadID uuid.UUID,
 	filter domain.PropertyFilter,
 	limit int,
-) ([]domain.MatchedProperty, error) {
+	opts domain.SearchOptions,
+) ([]domain.MatchedProperty, domain.EffectiveSearchOptions, error) {
 	const op = "property.Service.MatchPropertiesAdvanced"
 
+	effective := s.resolveSearchOptions(opts, limit)
+
 	lead, err := s.leadService.GetLead(ctx, leadID)
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to get lead: %w", op, err)
+		return nil, effective, fmt.Errorf("%s: failed to get lead: %w", op, err)
 	}
 
 	// Если у лида нет эмбеддинга, это ошибка — он должен был быть сгенерирован при создании
@@ -345,12 +634,12 @@ func (s *Service) MatchPropertiesAdvanced(
 			slog.String("lead_id", leadID.String()),
 			slog.String("lead_title", lead.Title),
 		)
-		return nil, fmt.Errorf("%s: lead has no embedding (lead_id=%s)", op, leadID.String())
+		return nil, effective, fmt.Errorf("%s: lead has no embedding (lead_id=%s)", op, leadID.String())
 	}
 
 	// Анализируем лид для динамических весов
 	var analysisResult *weights.AnalyzeResult
-	if s.weightsAnalyzer != nil && s.searchCfg.DynamicWeightsEnabled {
+	if s.weightsAnalyzer != nil && effective.DynamicWeights {
 		analysisResult, err = s.weightsAnalyzer.AnalyzeLead(ctx, lead)
 		if err != nil {
 			s.log.Warn("failed to analyze lead, using default weights",
@@ -367,6 +656,7 @@ func (s *Service) MatchPropertiesAdvanced(
 	if analysisResult != nil {
 		matchWeights = analysisResult.Weights
 		softCriteria = analysisResult.Criteria
+		effective.DynamicWeightsApplied = true
 
 		s.log.Debug("using dynamic weights",
 			slog.String("lead_id", leadID.String()),
@@ -378,49 +668,62 @@ func (s *Service) MatchPropertiesAdvanced(
 	// Извлекаем критерии из requirement лида для жёстких фильтров
 	hardFilters := s.buildHardFiltersFromLead(lead, softCriteria)
 
-	// Определяем количество кандидатов для получения
-	candidateLimit := s.searchCfg.RerankerCandidates
-	if candidateLimit <= 0 {
-		candidateLimit = 50
+	// Гео-фильтр запроса — жёсткий фильтр и компонент ранжирования по удалённости
+	if filter.Geo != nil {
+		hardFilters.Geo = filter.Geo
+		softCriteria = withGeoCriteria(softCriteria, filter.Geo)
+		matchWeights = withDistanceWeight(matchWeights)
+	}
+
+	// Визуальные предпочтения лида сравниваются с признаками фото объектов
+	if visual := domain.VisualPreferenceVector(lead.Requirement.VisualPreferences); visual != nil {
+		softCriteria = withVisualCriteria(softCriteria, visual)
+		matchWeights = withVisualWeight(matchWeights)
 	}
-	if candidateLimit < limit {
-		candidateLimit = limit * 5
+
+	// Желательные особенности лида — компонент ранжирования, обязательные — жёсткий фильтр
+	if preferred := domain.NormalizeFeatures(lead.Requirement.NiceToHave); preferred != nil {
+		softCriteria = withFeatureCriteria(softCriteria, preferred)
+		matchWeights = withFeaturesWeight(matchWeights)
 	}
 
 	var matches []domain.MatchedProperty
 
 	// Выбираем стратегию поиска
-	if s.searchCfg.HybridSearchEnabled {
+	if effective.HybridSearch {
 		// Гибридный поиск (векторный + полнотекстовый)
 		searchQuery := lead.Title + " " + lead.Description
 
 		matches, err = s.repo.HybridSearch(ctx, property_repository.HybridSearchParams{
 			LeadEmbedding:  lead.Embedding,
 			SearchQuery:    searchQuery,
-			VectorWeight:   s.searchCfg.VectorWeight,
-			FulltextWeight: s.searchCfg.FulltextWeight,
+			VectorWeight:   effective.VectorWeight,
+			FulltextWeight: effective.FulltextWeight,
 			Filter:         filter,
 			HardFilters:    hardFilters,
-			Limit:          candidateLimit,
+			Limit:          effective.Candidates,
 		})
 	} else {
 		// Только векторный поиск
-		matches, err = s.repo.MatchPropertiesWithHardFilters(ctx, lead.Embedding, filter, hardFilters, candidateLimit)
+		matches, err = s.repo.MatchPropertiesWithHardFilters(ctx, lead.Embedding, filter, hardFilters, effective.Candidates)
 	}
 
 	if err != nil {
 		s.log.Error("failed to search properties", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, effective, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Применяем реранкер если включен
-	if s.searchCfg.UseReranker && s.rerankerClient != nil && s.rerankerClient.IsEnabled() && len(matches) > 0 {
-		matches, err = s.applyReranker(ctx, lead, matches, limit)
+	if effective.UseReranker && s.rerankerClient != nil && s.rerankerClient.IsEnabled() && len(matches) > 0 {
+		reranked, err := s.applyReranker(ctx, lead, matches, limit)
 		if err != nil {
 			s.log.Warn("reranker failed, using original ranking",
 				slog.String("lead_id", leadID.String()),
 				sl.Err(err),
 			)
+		} else {
+			matches = reranked
+			effective.RerankerApplied = true
 		}
 	}
 
@@ -437,11 +740,62 @@ func (s *Service) MatchPropertiesAdvanced(
 	s.log.Info("advanced matching completed",
 		slog.String("lead_id", leadID.String()),
 		slog.Int("results", len(matches)),
-		slog.Bool("hybrid_search", s.searchCfg.HybridSearchEnabled),
-		slog.Bool("reranker_used", s.searchCfg.UseReranker && s.rerankerClient != nil),
+		slog.Bool("hybrid_search", effective.HybridSearch),
+		slog.Bool("reranker_used", effective.RerankerApplied),
+		slog.Bool("dynamic_weights", effective.DynamicWeightsApplied),
 	)
 
-	return matches, nil
+	return matches, effective, nil
+}
+
+// resolveSearchOptions — стратегия поиска: значения запроса поверх config.SearchConfig.
+func (s *Service) resolveSearchOptions(opts domain.SearchOptions, limit int) domain.EffectiveSearchOptions {
+	effective := domain.EffectiveSearchOptions{
+		HybridSearch:   s.searchCfg.HybridSearchEnabled,
+		VectorWeight:   s.searchCfg.VectorWeight,
+		FulltextWeight: s.searchCfg.FulltextWeight,
+		UseReranker:    s.searchCfg.UseReranker,
+		Candidates:     s.searchCfg.RerankerCandidates,
+		DynamicWeights: s.searchCfg.DynamicWeightsEnabled,
+	}
+
+	if opts.HybridSearch != nil {
+		effective.HybridSearch = *opts.HybridSearch
+	}
+	if opts.UseReranker != nil {
+		effective.UseReranker = *opts.UseReranker
+	}
+	if opts.DynamicWeights != nil {
+		effective.DynamicWeights = *opts.DynamicWeights
+	}
+	if opts.Candidates != nil && *opts.Candidates > 0 {
+		effective.Candidates = *opts.Candidates
+	}
+
+	// Если задан только один вес, второй дополняет его до 1; оба — нормализуются
+	switch {
+	case opts.VectorWeight != nil && opts.FulltextWeight != nil:
+		if sum := *opts.VectorWeight + *opts.FulltextWeight; sum > 0 {
+			effective.VectorWeight = *opts.VectorWeight / sum
+			effective.FulltextWeight = *opts.FulltextWeight / sum
+		}
+	case opts.VectorWeight != nil:
+		effective.VectorWeight = *opts.VectorWeight
+		effective.FulltextWeight = 1 - *opts.VectorWeight
+	case opts.FulltextWeight != nil:
+		effective.FulltextWeight = *opts.FulltextWeight
+		effective.VectorWeight = 1 - *opts.FulltextWeight
+	}
+
+	// Определяем количество кандидатов для получения
+	if effective.Candidates <= 0 {
+		effective.Candidates = 50
+	}
+	if effective.Candidates < limit {
+		effective.Candidates = limit * 5
+	}
+
+	return effective
 }
 
 // applyReranker применяет нейросетевой реранкер к кандидатам.
@@ -527,6 +881,18 @@ func (s *Service) MatchPropertiesWeighted(
 
 	// Извлекаем критерии из requirement лида для жёстких фильтров
 	hardFilters := s.buildHardFiltersFromLead(lead, criteria)
+	if filter.Geo != nil {
+		hardFilters.Geo = filter.Geo
+		criteria = withGeoCriteria(criteria, filter.Geo)
+	}
+	visual := domain.VisualPreferenceVector(lead.Requirement.VisualPreferences)
+	if visual != nil {
+		criteria = withVisualCriteria(criteria, visual)
+	}
+	preferred := domain.NormalizeFeatures(lead.Requirement.NiceToHave)
+	if preferred != nil {
+		criteria = withFeatureCriteria(criteria, preferred)
+	}
 
 	s.log.Debug("matching properties with hard filters",
 		slog.String("lead_id", leadID.String()),
@@ -544,6 +910,15 @@ func (s *Service) MatchPropertiesWeighted(
 		if weights != nil {
 			w = weights.Normalize()
 		}
+		if filter.Geo != nil {
+			w = withDistanceWeight(w)
+		}
+		if visual != nil {
+			w = withVisualWeight(w)
+		}
+		if preferred != nil {
+			w = withFeaturesWeight(w)
+		}
 		matches = s.rankMatches(matches, w, criteria)
 		if len(matches) > limit {
 			matches = matches[:limit]
@@ -598,9 +973,78 @@ func (s *Service) buildHardFiltersFromLead(lead domain.Lead, criteria *domain.So
 		}
 	}
 
+	// Обязательные особенности: объект без любой из них не подходит
+	hf.Features = domain.NormalizeFeatures(lead.Requirement.MustHave)
+
 	return hf
 }
 
+// withGeoCriteria — копия критериев с центром гео-поиска для ранжирования по удалённости.
+func withGeoCriteria(criteria *domain.SoftCriteria, geo *domain.GeoFilter) *domain.SoftCriteria {
+	c := domain.SoftCriteria{}
+	if criteria != nil {
+		c = *criteria
+	}
+	c.Geo = geo
+	return &c
+}
+
+// withDistanceWeight — при гео-поиске удалённость учитывается в ранжировании,
+// даже если вес не задан явно.
+func withDistanceWeight(w domain.MatchWeights) domain.MatchWeights {
+	if w.Distance > 0 {
+		return w
+	}
+	w.Distance = domain.DefaultDistanceWeight
+	return w.Normalize()
+}
+
+// withVisualCriteria — копия критериев с визуальными предпочтениями лида.
+// Предпочтения, уже заданные в критериях запроса, не перезаписываются.
+func withVisualCriteria(criteria *domain.SoftCriteria, visual []float64) *domain.SoftCriteria {
+	c := domain.SoftCriteria{}
+	if criteria != nil {
+		c = *criteria
+	}
+	if c.VisualPreferences == nil {
+		c.VisualPreferences = visual
+	}
+	return &c
+}
+
+// withVisualWeight — визуальные предпочтения лида учитываются в ранжировании,
+// даже если вес не задан явно.
+func withVisualWeight(w domain.MatchWeights) domain.MatchWeights {
+	if w.Visual > 0 {
+		return w
+	}
+	w.Visual = domain.DefaultVisualWeight
+	return w.Normalize()
+}
+
+// withFeatureCriteria — копия критериев с желательными особенностями лида.
+// Особенности, уже заданные в критериях запроса, не перезаписываются.
+func withFeatureCriteria(criteria *domain.SoftCriteria, preferred []string) *domain.SoftCriteria {
+	c := domain.SoftCriteria{}
+	if criteria != nil {
+		c = *criteria
+	}
+	if c.PreferredFeatures == nil {
+		c.PreferredFeatures = preferred
+	}
+	return &c
+}
+
+// withFeaturesWeight — желательные особенности лида учитываются в ранжировании,
+// даже если вес не задан явно.
+func withFeaturesWeight(w domain.MatchWeights) domain.MatchWeights {
+	if w.Features > 0 {
+		return w
+	}
+	w.Features = domain.DefaultFeaturesWeight
+	return w.Normalize()
+}
+
 // rankMatches применяет взвешенное ранжирование к результатам.
 func (s *Service) rankMatches(matches []domain.MatchedProperty, w domain.MatchWeights, criteria *domain.SoftCriteria) []domain.MatchedProperty {
 	for i := range matches {
@@ -638,7 +1082,7 @@ func (s *Service) calculateScores(m *domain.MatchedProperty, w domain.MatchWeigh
 	price := s.calcPriceScore(p.Price, criteria)
 
 	// District score
-	district := s.calcDistrictScore(p.Address, criteria)
+	district := s.calcDistrictScore(p, criteria)
 
 	// Rooms score
 	rooms := s.calcRoomsScore(p.Rooms, criteria)
@@ -646,8 +1090,18 @@ func (s *Service) calculateScores(m *domain.MatchedProperty, w domain.MatchWeigh
 	// Area score
 	area := s.calcAreaScore(p.Area, criteria)
 
+	// Distance score — только при гео-поиске
+	distance, distanceKm, hasDistance := s.calcDistanceScore(p, criteria)
+
+	// Visual score — только при визуальных предпочтениях и проанализированных фото
+	visual, hasVisual := s.calcVisualScore(p, criteria)
+
+	// Features score — только при желательных особенностях лида
+	features, hasFeatures := s.calcFeaturesScore(p, criteria)
+
 	// Total weighted score
-	total := w.Price*price + w.District*district + w.Rooms*rooms + w.Area*area + w.Semantic*semantic
+	total := w.Price*price + w.District*district + w.Rooms*rooms + w.Area*area + w.Semantic*semantic +
+		w.Distance*distance + w.Visual*visual + w.Features*features
 
 	m.TotalScore = &total
 	m.PriceScore = &price
@@ -655,6 +1109,16 @@ func (s *Service) calculateScores(m *domain.MatchedProperty, w domain.MatchWeigh
 	m.RoomsScore = &rooms
 	m.AreaScore = &area
 	m.SemanticScore = &semantic
+	if hasDistance {
+		m.DistanceScore = &distance
+		m.DistanceKm = &distanceKm
+	}
+	if hasVisual {
+		m.VisualScore = &visual
+	}
+	if hasFeatures {
+		m.FeaturesScore = &features
+	}
 
 	// Генерируем объяснение
 	expl := s.generateExplanation(m)
@@ -677,8 +1141,27 @@ func (s *Service) calcPriceScore(objPrice *int64, c *domain.SoftCriteria) float6
 	return max(0.0, 0.7-(dev-20)/100*0.7)
 }
 
-func (s *Service) calcDistrictScore(address string, c *domain.SoftCriteria) float64 {
-	if c == nil || address == "" {
+// calcDistrictScore — совпадение района. Если у объекта заполнен район, сравнивается он;
+// поиск по подстроке адреса остаётся для объектов без района.
+func (s *Service) calcDistrictScore(p domain.Property, c *domain.SoftCriteria) float64 {
+	if c == nil {
+		return 0.3
+	}
+	if p.District != nil && strings.TrimSpace(*p.District) != "" {
+		district := strings.ToLower(strings.TrimSpace(*p.District))
+		if c.TargetDistrict != nil && sameDistrict(district, *c.TargetDistrict) {
+			return 1.0
+		}
+		for _, pref := range c.PreferredDistricts {
+			if sameDistrict(district, pref) {
+				return 0.7
+			}
+		}
+		return 0.3
+	}
+
+	address := p.Address
+	if address == "" {
 		return 0.3
 	}
 	addrLower := toLower(address)
@@ -695,6 +1178,16 @@ func (s *Service) calcDistrictScore(address string, c *domain.SoftCriteria) floa
 	return 0.3
 }
 
+// sameDistrict — район объекта (в нижнем регистре) совпадает с названием из критериев
+// или одно содержит другое: «центральный район» и «Центральный».
+func sameDistrict(district, name string) bool {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return false
+	}
+	return district == name || strings.Contains(district, name) || strings.Contains(name, district)
+}
+
 func (s *Service) calcRoomsScore(objRooms *int32, c *domain.SoftCriteria) float64 {
 	if objRooms == nil || c == nil || c.TargetRooms == nil {
 		return 0.5
@@ -723,6 +1216,46 @@ func (s *Service) calcAreaScore(objArea *float64, c *domain.SoftCriteria) float6
 	return max(0.0, 0.7-(dev-15)/50*0.7)
 }
 
+// calcDistanceScore — затухание по расстоянию до центра гео-поиска: 1 в центре,
+// 0.5 на границе радиуса, вдвое меньше на каждый следующий радиус.
+// Без гео-поиска или координат объекта — нейтральные 0.5 и false.
+func (s *Service) calcDistanceScore(p domain.Property, c *domain.SoftCriteria) (score, km float64, ok bool) {
+	if c == nil || c.Geo == nil || c.Geo.RadiusKm <= 0 {
+		return 0.5, 0, false
+	}
+	km, ok = c.Geo.Distance(p)
+	if !ok {
+		return 0.5, 0, false
+	}
+	return math.Pow(2, -km/c.Geo.RadiusKm), km, true
+}
+
+// calcVisualScore — соответствие визуальных признаков объекта предпочтениям лида.
+// Без предпочтений или без анализа фото — нейтральные 0.5 и false.
+func (s *Service) calcVisualScore(p domain.Property, c *domain.SoftCriteria) (float64, bool) {
+	if c == nil {
+		return 0.5, false
+	}
+	score, ok := domain.VisualMatch(p.VisualFeatures, c.VisualPreferences)
+	if !ok {
+		return 0.5, false
+	}
+	return score, true
+}
+
+// calcFeaturesScore — доля желательных особенностей лида, которые есть у объекта.
+// Без желательных особенностей — нейтральные 0.5 и false.
+func (s *Service) calcFeaturesScore(p domain.Property, c *domain.SoftCriteria) (float64, bool) {
+	if c == nil {
+		return 0.5, false
+	}
+	score, ok := domain.FeatureMatch(p.Features, c.PreferredFeatures)
+	if !ok {
+		return 0.5, false
+	}
+	return score, true
+}
+
 func (s *Service) generateExplanation(m *domain.MatchedProperty) string {
 	var parts []string
 	if m.PriceScore != nil && *m.PriceScore >= 0.7 && m.Property.Price != nil {
@@ -740,6 +1273,15 @@ func (s *Service) generateExplanation(m *domain.MatchedProperty) string {
 	if m.SemanticScore != nil && *m.SemanticScore >= 0.6 {
 		parts = append(parts, "описание соответствует")
 	}
+	if m.DistanceScore != nil && *m.DistanceScore >= 0.5 && m.DistanceKm != nil {
+		parts = append(parts, fmt.Sprintf("%.1f км от точки поиска", *m.DistanceKm))
+	}
+	if m.VisualScore != nil && *m.VisualScore >= 0.7 {
+		parts = append(parts, "по фото соответствует пожеланиям")
+	}
+	if m.FeaturesScore != nil && *m.FeaturesScore >= 0.7 {
+		parts = append(parts, "есть желательные особенности")
+	}
 	if len(parts) == 0 {
 		return "частичное совпадение"
 	}
@@ -803,4 +1345,3 @@ func findSubstring(s, substr string) int {
 	}
 	return -1
 }
-
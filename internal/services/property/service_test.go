@@ -2,20 +2,31 @@ package property
 
 import (
 	"context"
+	"errors"
+	"lead_exchange/internal/config"
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/lib/ml"
+	"lead_exchange/internal/repository"
 	"lead_exchange/internal/repository/property_repository"
 	"log/slog"
+	"math"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 // MockPropertyRepository
 type MockPropertyRepository struct {
-	GetByIDFunc         func(ctx context.Context, id uuid.UUID) (domain.Property, error)
-	UpdateEmbeddingFunc func(ctx context.Context, propertyID uuid.UUID, embedding []float32) error
+	GetByIDFunc          func(ctx context.Context, id uuid.UUID) (domain.Property, error)
+	UpdatePropertyFunc   func(ctx context.Context, propertyID uuid.UUID, update domain.PropertyFilter) error
+	UpdateEmbeddingFunc  func(ctx context.Context, propertyID uuid.UUID, embedding []float32) error
+	ListPropertiesFunc   func(ctx context.Context, filter domain.PropertyFilter) (*domain.PaginatedResult[domain.Property], error)
+	UpdateEmbeddingsFunc func(ctx context.Context, embeddings map[uuid.UUID][]float32) (int, error)
+	HybridSearchFunc     func(ctx context.Context, params property_repository.HybridSearchParams) ([]domain.MatchedProperty, error)
+	MatchWithFiltersFunc func(ctx context.Context, leadEmbedding []float32, filter domain.PropertyFilter, hardFilters *domain.HardFilters, limit int) ([]domain.MatchedProperty, error)
 }
 
 func (m *MockPropertyRepository) CreateProperty(ctx context.Context, property domain.Property) (uuid.UUID, error) {
@@ -28,9 +39,15 @@ func (m *MockPropertyRepository) GetByID(ctx context.Context, id uuid.UUID) (dom
 	return domain.Property{}, nil
 }
 func (m *MockPropertyRepository) UpdateProperty(ctx context.Context, propertyID uuid.UUID, update domain.PropertyFilter) error {
+	if m.UpdatePropertyFunc != nil {
+		return m.UpdatePropertyFunc(ctx, propertyID, update)
+	}
 	return nil
 }
 func (m *MockPropertyRepository) ListProperties(ctx context.Context, filter domain.PropertyFilter) (*domain.PaginatedResult[domain.Property], error) {
+	if m.ListPropertiesFunc != nil {
+		return m.ListPropertiesFunc(ctx, filter)
+	}
 	return &domain.PaginatedResult[domain.Property]{}, nil
 }
 func (m *MockPropertyRepository) UpdateEmbedding(ctx context.Context, propertyID uuid.UUID, embedding []float32) error {
@@ -39,13 +56,25 @@ func (m *MockPropertyRepository) UpdateEmbedding(ctx context.Context, propertyID
 	}
 	return nil
 }
+func (m *MockPropertyRepository) UpdateEmbeddings(ctx context.Context, embeddings map[uuid.UUID][]float32) (int, error) {
+	if m.UpdateEmbeddingsFunc != nil {
+		return m.UpdateEmbeddingsFunc(ctx, embeddings)
+	}
+	return len(embeddings), nil
+}
 func (m *MockPropertyRepository) MatchProperties(ctx context.Context, leadEmbedding []float32, filter domain.PropertyFilter, limit int) ([]domain.MatchedProperty, error) {
 	return nil, nil
 }
 func (m *MockPropertyRepository) MatchPropertiesWithHardFilters(ctx context.Context, leadEmbedding []float32, filter domain.PropertyFilter, hardFilters *domain.HardFilters, limit int) ([]domain.MatchedProperty, error) {
+	if m.MatchWithFiltersFunc != nil {
+		return m.MatchWithFiltersFunc(ctx, leadEmbedding, filter, hardFilters, limit)
+	}
 	return nil, nil
 }
 func (m *MockPropertyRepository) HybridSearch(ctx context.Context, params property_repository.HybridSearchParams) ([]domain.MatchedProperty, error) {
+	if m.HybridSearchFunc != nil {
+		return m.HybridSearchFunc(ctx, params)
+	}
 	return nil, nil
 }
 func (m *MockPropertyRepository) FulltextSearch(ctx context.Context, query string, filter domain.PropertyFilter, limit int) ([]domain.MatchedProperty, error) {
@@ -54,7 +83,8 @@ func (m *MockPropertyRepository) FulltextSearch(ctx context.Context, query strin
 
 // MockMLClient
 type MockMLClient struct {
-	ReindexFunc func(ctx context.Context, req ml.ReindexRequest) (*ml.ReindexResponse, error)
+	ReindexFunc      func(ctx context.Context, req ml.ReindexRequest) (*ml.ReindexResponse, error)
+	ReindexBatchFunc func(ctx context.Context, req ml.ReindexBatchRequest) (*ml.ReindexBatchResponse, error)
 }
 
 func (m *MockMLClient) PrepareAndEmbed(ctx context.Context, req ml.PrepareAndEmbedRequest) (*ml.PrepareAndEmbedResponse, error) {
@@ -67,6 +97,9 @@ func (m *MockMLClient) Reindex(ctx context.Context, req ml.ReindexRequest) (*ml.
 	return nil, nil
 }
 func (m *MockMLClient) ReindexBatch(ctx context.Context, req ml.ReindexBatchRequest) (*ml.ReindexBatchResponse, error) {
+	if m.ReindexBatchFunc != nil {
+		return m.ReindexBatchFunc(ctx, req)
+	}
 	return nil, nil
 }
 func (m *MockMLClient) GetModelInfo(ctx context.Context) (*ml.ModelInfo, error) {
@@ -74,11 +107,23 @@ func (m *MockMLClient) GetModelInfo(ctx context.Context) (*ml.ModelInfo, error)
 }
 
 // MockLeadService
-type MockLeadService struct{}
+type MockLeadService struct {
+	GetLeadFunc               func(ctx context.Context, id uuid.UUID) (domain.Lead, error)
+	MatchLeadsByEmbeddingFunc func(ctx context.Context, propertyEmbedding []float32, filter domain.LeadFilter, hardFilters *domain.HardFilters, limit int) ([]domain.MatchedLead, error)
+}
 
 func (m *MockLeadService) GetLead(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+	if m.GetLeadFunc != nil {
+		return m.GetLeadFunc(ctx, id)
+	}
 	return domain.Lead{}, nil
 }
+func (m *MockLeadService) MatchLeadsByEmbedding(ctx context.Context, propertyEmbedding []float32, filter domain.LeadFilter, hardFilters *domain.HardFilters, limit int) ([]domain.MatchedLead, error) {
+	if m.MatchLeadsByEmbeddingFunc != nil {
+		return m.MatchLeadsByEmbeddingFunc(ctx, propertyEmbedding, filter, hardFilters, limit)
+	}
+	return nil, nil
+}
 
 func TestService_ReindexProperty(t *testing.T) {
 	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
@@ -121,7 +166,7 @@ func TestService_ReindexProperty(t *testing.T) {
 
 	leadService := &MockLeadService{}
 
-	svc := New(log, repo, mlClient, leadService)
+	svc := New(log, repo, mlClient, leadService, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockIndexListener{}, config.JSONLDConfig{}, NewMockJSONLDCache(), &MockTeamDirectory{})
 
 	err := svc.ReindexProperty(context.Background(), propertyID)
 	if err != nil {
@@ -130,6 +175,77 @@ func TestService_ReindexProperty(t *testing.T) {
 }
 
 // TestCalcPriceScore тестирует расчёт score по цене.
+func TestService_UpdateProperty_Ownership(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := uuid.New()
+	stranger := uuid.New()
+	propertyID := uuid.New()
+	city := "Москва"
+
+	tests := []struct {
+		name    string
+		actor   domain.Actor
+		update  domain.PropertyFilter
+		wantErr error
+	}{
+		{
+			name:   "owner updates property",
+			actor:  domain.Actor{UserID: owner, Role: domain.UserRoleUser},
+			update: domain.PropertyFilter{City: &city},
+		},
+		{
+			name:    "stranger cannot update property",
+			actor:   domain.Actor{UserID: stranger, Role: domain.UserRoleUser},
+			update:  domain.PropertyFilter{City: &city},
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:    "owner cannot reassign property",
+			actor:   domain.Actor{UserID: owner, Role: domain.UserRoleUser},
+			update:  domain.PropertyFilter{OwnerUserID: &stranger},
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:   "admin reassigns property",
+			actor:  domain.Actor{UserID: stranger, Role: domain.UserRoleAdmin},
+			update: domain.PropertyFilter{OwnerUserID: &stranger},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := false
+			repo := &MockPropertyRepository{
+				GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Property, error) {
+					return domain.Property{ID: propertyID, OwnerUserID: owner}, nil
+				},
+				UpdatePropertyFunc: func(ctx context.Context, id uuid.UUID, update domain.PropertyFilter) error {
+					updated = true
+					return nil
+				},
+			}
+			svc := New(log, repo, &MockMLClient{}, &MockLeadService{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockIndexListener{}, config.JSONLDConfig{}, NewMockJSONLDCache(), &MockTeamDirectory{})
+
+			_, err := svc.UpdateProperty(context.Background(), tt.actor, propertyID, tt.update)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if updated {
+					t.Error("repository must not be called on denied update")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !updated {
+				t.Error("expected repository update")
+			}
+		})
+	}
+}
+
 func TestCalcPriceScore(t *testing.T) {
 	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	svc := &Service{log: log}
@@ -230,16 +346,21 @@ func TestCalcDistrictScore(t *testing.T) {
 	svc := &Service{log: log}
 
 	tests := []struct {
-		name    string
-		address string
-		target  *string
-		prefs   []string
-		want    float64
+		name     string
+		address  string
+		district *string
+		target   *string
+		prefs    []string
+		want     float64
 	}{
-		{"exact match", "Центральный район", ptr[string]("Центральный"), nil, 1.0},
-		{"in preferred", "Арбат, Москва", nil, []string{"Арбат", "Тверской"}, 0.7},
-		{"no match", "Бирюлёво", ptr[string]("Центр"), []string{"Арбат"}, 0.3},
-		{"empty address", "", ptr[string]("Центр"), nil, 0.3},
+		{"exact match", "Центральный район", nil, ptr[string]("Центральный"), nil, 1.0},
+		{"in preferred", "Арбат, Москва", nil, nil, []string{"Арбат", "Тверской"}, 0.7},
+		{"no match", "Бирюлёво", nil, ptr[string]("Центр"), []string{"Арбат"}, 0.3},
+		{"empty address", "", nil, ptr[string]("Центр"), nil, 0.3},
+		{"structured district match", "ул. Ленина, 1", ptr[string]("Центральный район"), ptr[string]("центральный"), nil, 1.0},
+		{"structured district in preferred", "ул. Ленина, 1", ptr[string]("Тверской"), nil, []string{"Арбат", "Тверской"}, 0.7},
+		{"structured district wins over address", "Арбатская ул., 5", ptr[string]("Хамовники"), ptr[string]("Арбат"), nil, 0.3},
+		{"blank district falls back to address", "Арбат, Москва", ptr[string]("  "), ptr[string]("Арбат"), nil, 1.0},
 	}
 
 	for _, tt := range tests {
@@ -248,7 +369,7 @@ func TestCalcDistrictScore(t *testing.T) {
 				TargetDistrict:     tt.target,
 				PreferredDistricts: tt.prefs,
 			}
-			score := svc.calcDistrictScore(tt.address, criteria)
+			score := svc.calcDistrictScore(domain.Property{Address: tt.address, District: tt.district}, criteria)
 			if score != tt.want {
 				t.Errorf("calcDistrictScore() = %v, want %v", score, tt.want)
 			}
@@ -311,7 +432,7 @@ func TestWeightPresets(t *testing.T) {
 
 	for _, p := range presets {
 		w := p.Weights.Normalize()
-		sum := w.Price + w.District + w.Rooms + w.Area + w.Semantic
+		sum := w.Price + w.District + w.Rooms + w.Area + w.Semantic + w.Distance + w.Visual
 		if sum < 0.99 || sum > 1.01 {
 			t.Errorf("preset %s: normalized sum = %v, want ~1.0", p.ID, sum)
 		}
@@ -330,3 +451,644 @@ func ptr[T any](v T) *T {
 	return &v
 }
 
+func TestService_MatchLeads(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	propertyID := uuid.New()
+	price := int64(10000000)
+	rooms := int32(2)
+	area := 55.0
+	city := "москва"
+
+	repo := &MockPropertyRepository{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Property, error) {
+			return domain.Property{
+				ID:           propertyID,
+				Address:      "Москва, Хамовники",
+				City:         &city,
+				PropertyType: domain.PropertyTypeApartment,
+				Price:        &price,
+				Rooms:        &rooms,
+				Area:         &area,
+				Embedding:    []float32{0.1, 0.2},
+			}, nil
+		},
+	}
+
+	exact := domain.Lead{ID: uuid.New(), Requirement: domain.LeadRequirement{
+		Price:     &domain.Range[int64]{Max: ptr(int64(10000000))},
+		Rooms:     &domain.Range[int32]{Min: ptr(int32(2)), Max: ptr(int32(2))},
+		Area:      &domain.Range[float64]{Min: ptr(55.0)},
+		Districts: []string{"Хамовники"},
+	}}
+	partial := domain.Lead{ID: uuid.New(), Requirement: domain.LeadRequirement{
+		Price: &domain.Range[int64]{Max: ptr(int64(11500000))},
+		Rooms: &domain.Range[int32]{Min: ptr(int32(3)), Max: ptr(int32(3))},
+	}}
+
+	leadService := &MockLeadService{
+		MatchLeadsByEmbeddingFunc: func(ctx context.Context, emb []float32, filter domain.LeadFilter, hf *domain.HardFilters, limit int) ([]domain.MatchedLead, error) {
+			if filter.Status == nil || *filter.Status != domain.LeadStatusPublished {
+				t.Errorf("expected PUBLISHED status filter by default, got %v", filter.Status)
+			}
+			if hf == nil || hf.City == nil || *hf.City != "Москва" {
+				t.Errorf("expected normalized city hard filter, got %+v", hf)
+			}
+			if hf.PropertyType == nil || *hf.PropertyType != domain.PropertyTypeApartment {
+				t.Errorf("expected property type hard filter, got %v", hf.PropertyType)
+			}
+			if *hf.MinRooms != 1 || *hf.MaxRooms != 3 {
+				t.Errorf("expected rooms range 1..3, got %d..%d", *hf.MinRooms, *hf.MaxRooms)
+			}
+			if *hf.MinPrice > 8400000 || *hf.MaxPrice < 12500000 {
+				t.Errorf("unexpected price range %d..%d", *hf.MinPrice, *hf.MaxPrice)
+			}
+			// Векторный поиск ставит частичное совпадение выше
+			return []domain.MatchedLead{
+				{Lead: partial, Similarity: 0.8},
+				{Lead: exact, Similarity: 0.75},
+			}, nil
+		},
+	}
+
+	svc := New(log, repo, &MockMLClient{}, leadService, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockIndexListener{}, config.JSONLDConfig{}, NewMockJSONLDCache(), &MockTeamDirectory{})
+
+	matches, err := svc.MatchLeads(context.Background(), propertyID, domain.LeadFilter{}, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matches) != 2 {
+		t.Fatalf("expected 2 matches, got %d", len(matches))
+	}
+	if matches[0].Lead.ID != exact.ID {
+		t.Errorf("expected exact lead to be ranked first")
+	}
+	for _, m := range matches {
+		if m.TotalScore == nil || m.MatchExplanation == nil {
+			t.Errorf("expected scores and explanation for lead %s", m.Lead.ID)
+		}
+	}
+}
+
+func TestService_MatchSavedSearch(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	price := int64(10000000)
+	rooms := int32(2)
+	city := "Москва"
+
+	property := domain.Property{
+		ID:           uuid.New(),
+		Address:      "Москва, Хамовники",
+		City:         &city,
+		PropertyType: domain.PropertyTypeApartment,
+		Price:        &price,
+		Rooms:        &rooms,
+		Embedding:    []float32{0.3, 0.4},
+	}
+
+	lead := domain.Lead{
+		ID:   uuid.New(),
+		City: ptr("москва"),
+		Requirement: domain.LeadRequirement{
+			Price:     &domain.Range[int64]{Max: &price},
+			Rooms:     &domain.Range[int32]{Min: &rooms, Max: &rooms},
+			Districts: []string{"Хамовники"},
+		},
+		Embedding: []float32{0.6, 0.8},
+	}
+	leadService := &MockLeadService{
+		GetLeadFunc: func(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+			return lead, nil
+		},
+	}
+	svc := New(log, &MockPropertyRepository{}, &MockMLClient{}, leadService, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockIndexListener{}, config.JSONLDConfig{}, NewMockJSONLDCache(), &MockTeamDirectory{})
+
+	t.Run("lead search", func(t *testing.T) {
+		m, ok, err := svc.MatchSavedSearch(context.Background(), domain.SavedSearch{LeadID: &lead.ID}, property)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !ok {
+			t.Fatal("expected property to pass lead hard filters")
+		}
+		// Векторы сонаправлены — косинусная близость 1
+		if m.SemanticScore == nil || *m.SemanticScore < 0.999 {
+			t.Errorf("expected semantic score ~1, got %v", m.SemanticScore)
+		}
+		if m.TotalScore == nil || *m.TotalScore < 0.9 {
+			t.Errorf("expected high total score for exact match, got %v", m.TotalScore)
+		}
+	})
+
+	t.Run("ad-hoc filter rejects other city", func(t *testing.T) {
+		search := domain.SavedSearch{Filter: domain.SavedSearchFilter{City: ptr("Казань")}}
+		_, ok, err := svc.MatchSavedSearch(context.Background(), search, property)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if ok {
+			t.Error("expected property from another city to be filtered out")
+		}
+	})
+
+	t.Run("ad-hoc filter with targets", func(t *testing.T) {
+		search := domain.SavedSearch{Filter: domain.SavedSearchFilter{
+			City:        ptr("москва"),
+			MaxPrice:    ptr(int64(12000000)),
+			TargetRooms: ptr(int32(2)),
+		}}
+		m, ok, err := svc.MatchSavedSearch(context.Background(), search, property)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !ok {
+			t.Fatal("expected property to pass ad-hoc filter")
+		}
+		if *m.RoomsScore != 1.0 {
+			t.Errorf("expected rooms score 1.0, got %v", *m.RoomsScore)
+		}
+		if *m.SemanticScore != neutralSimilarity {
+			t.Errorf("expected neutral semantic score without lead, got %v", *m.SemanticScore)
+		}
+	})
+}
+
+func TestService_ReindexAllProperties_BatchUpdate(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	properties := []domain.Property{{ID: uuid.New()}, {ID: uuid.New()}}
+	deleted := properties[1].ID
+
+	repo := &MockPropertyRepository{
+		ListPropertiesFunc: func(ctx context.Context, filter domain.PropertyFilter) (*domain.PaginatedResult[domain.Property], error) {
+			return &domain.PaginatedResult[domain.Property]{Items: properties, TotalCount: 2}, nil
+		},
+		UpdateEmbeddingsFunc: func(ctx context.Context, embeddings map[uuid.UUID][]float32) (int, error) {
+			if len(embeddings) != 2 {
+				t.Errorf("expected one multi-row update for 2 properties, got %d", len(embeddings))
+			}
+			// Один объект удалён за время запроса к ML-сервису
+			if _, ok := embeddings[deleted]; ok {
+				return len(embeddings) - 1, nil
+			}
+			return len(embeddings), nil
+		},
+	}
+	mlClient := &MockMLClient{
+		ReindexBatchFunc: func(ctx context.Context, req ml.ReindexBatchRequest) (*ml.ReindexBatchResponse, error) {
+			resp := &ml.ReindexBatchResponse{}
+			for _, entity := range req.Entities {
+				resp.Results = append(resp.Results, ml.ReindexResponse{EntityID: entity.EntityID, Embedding: []float64{0.1, 0.2}})
+			}
+			return resp, nil
+		},
+	}
+
+	listener := &MockIndexListener{}
+	svc := New(log, repo, mlClient, &MockLeadService{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, listener, config.JSONLDConfig{}, NewMockJSONLDCache(), &MockTeamDirectory{})
+
+	progress, err := svc.ReindexAllProperties(context.Background(), domain.ReindexOptions{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !progress.Done || progress.Processed != 2 || progress.Success != 1 || progress.Failed != 1 {
+		t.Errorf("unexpected progress: %+v", progress)
+	}
+	// Сохранённые поиски получают объекты пакета
+	if len(listener.indexed) != 2 || !lo.Contains(listener.indexed, properties[0].ID) {
+		t.Errorf("expected batch to be passed to saved searches, got %v", listener.indexed)
+	}
+}
+
+func TestService_ResolveSearchOptions(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := config.SearchConfig{
+		HybridSearchEnabled: true,
+		VectorWeight:        0.7,
+		FulltextWeight:      0.3,
+		RerankerCandidates:  50,
+	}
+	svc := NewWithAdvancedSearch(log, &MockPropertyRepository{}, &MockMLClient{}, nil, nil, &MockLeadService{}, cfg, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockIndexListener{}, config.JSONLDConfig{}, NewMockJSONLDCache(), &MockTeamDirectory{})
+
+	tests := []struct {
+		name  string
+		opts  domain.SearchOptions
+		limit int
+		want  domain.EffectiveSearchOptions
+	}{
+		{
+			name:  "server defaults",
+			limit: 10,
+			want:  domain.EffectiveSearchOptions{HybridSearch: true, VectorWeight: 0.7, FulltextWeight: 0.3, Candidates: 50},
+		},
+		{
+			name:  "request disables hybrid and enables reranker",
+			opts:  domain.SearchOptions{HybridSearch: lo.ToPtr(false), UseReranker: lo.ToPtr(true), Candidates: lo.ToPtr(20)},
+			limit: 10,
+			want:  domain.EffectiveSearchOptions{HybridSearch: false, VectorWeight: 0.7, FulltextWeight: 0.3, UseReranker: true, Candidates: 20},
+		},
+		{
+			name:  "single weight is complemented",
+			opts:  domain.SearchOptions{FulltextWeight: lo.ToPtr(0.6)},
+			limit: 10,
+			want:  domain.EffectiveSearchOptions{HybridSearch: true, VectorWeight: 0.4, FulltextWeight: 0.6, Candidates: 50},
+		},
+		{
+			name:  "both weights are normalized",
+			opts:  domain.SearchOptions{VectorWeight: lo.ToPtr(1.0), FulltextWeight: lo.ToPtr(1.0)},
+			limit: 10,
+			want:  domain.EffectiveSearchOptions{HybridSearch: true, VectorWeight: 0.5, FulltextWeight: 0.5, Candidates: 50},
+		},
+		{
+			name:  "candidates cover limit",
+			opts:  domain.SearchOptions{Candidates: lo.ToPtr(5)},
+			limit: 10,
+			want:  domain.EffectiveSearchOptions{HybridSearch: true, VectorWeight: 0.7, FulltextWeight: 0.3, Candidates: 50},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := svc.resolveSearchOptions(tt.opts, tt.limit)
+			if math.Abs(got.VectorWeight-tt.want.VectorWeight) > 1e-9 || math.Abs(got.FulltextWeight-tt.want.FulltextWeight) > 1e-9 {
+				t.Errorf("weights: expected %v/%v, got %v/%v", tt.want.VectorWeight, tt.want.FulltextWeight, got.VectorWeight, got.FulltextWeight)
+			}
+			got.VectorWeight, got.FulltextWeight = tt.want.VectorWeight, tt.want.FulltextWeight
+			if got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestService_MatchPropertiesAdvanced_PerRequestStrategy(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	leadService := &MockLeadService{
+		GetLeadFunc: func(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+			return domain.Lead{ID: id, Title: "Квартира", Embedding: []float32{0.1, 0.2}}, nil
+		},
+	}
+
+	var hybridCalls, vectorCalls int
+	repo := &MockPropertyRepository{
+		HybridSearchFunc: func(ctx context.Context, params property_repository.HybridSearchParams) ([]domain.MatchedProperty, error) {
+			hybridCalls++
+			if params.VectorWeight != 0.2 || params.Filter.MaxRooms == nil {
+				t.Errorf("expected request weights and filter in hybrid search, got %+v", params)
+			}
+			return nil, nil
+		},
+		MatchWithFiltersFunc: func(ctx context.Context, leadEmbedding []float32, filter domain.PropertyFilter, hardFilters *domain.HardFilters, limit int) ([]domain.MatchedProperty, error) {
+			vectorCalls++
+			return nil, nil
+		},
+	}
+
+	cfg := config.SearchConfig{HybridSearchEnabled: false, VectorWeight: 0.7, FulltextWeight: 0.3}
+	svc := NewWithAdvancedSearch(log, repo, &MockMLClient{}, nil, nil, leadService, cfg, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockIndexListener{}, config.JSONLDConfig{}, NewMockJSONLDCache(), &MockTeamDirectory{})
+	filter := domain.PropertyFilter{MaxRooms: lo.ToPtr(int32(3))}
+
+	// Сервер настроен на векторный поиск, запрос включает гибридный
+	_, effective, err := svc.MatchPropertiesAdvanced(context.Background(), uuid.New(), filter, 10, domain.SearchOptions{
+		HybridSearch: lo.ToPtr(true),
+		VectorWeight: lo.ToPtr(0.2),
+		UseReranker:  lo.ToPtr(true),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hybridCalls != 1 || vectorCalls != 0 {
+		t.Errorf("expected hybrid search, got hybrid=%d vector=%d", hybridCalls, vectorCalls)
+	}
+	if !effective.HybridSearch || !effective.UseReranker || effective.RerankerApplied {
+		t.Errorf("unexpected effective options: %+v", effective)
+	}
+
+	// Без переопределений используется настройка сервера
+	if _, _, err := svc.MatchPropertiesAdvanced(context.Background(), uuid.New(), filter, 10, domain.SearchOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vectorCalls != 1 {
+		t.Errorf("expected vector search with server defaults, got %d calls", vectorCalls)
+	}
+}
+
+func TestCalcDistanceScore(t *testing.T) {
+	svc := &Service{}
+	// Центр — Красная площадь, радиус 2 км
+	geo := &domain.GeoFilter{Center: domain.GeoPoint{Latitude: 55.7539, Longitude: 37.6208}, RadiusKm: 2}
+
+	tests := []struct {
+		name      string
+		lat, lon  *float64
+		criteria  *domain.SoftCriteria
+		wantOK    bool
+		wantScore float64
+	}{
+		{name: "at center", lat: lo.ToPtr(55.7539), lon: lo.ToPtr(37.6208), criteria: &domain.SoftCriteria{Geo: geo}, wantOK: true, wantScore: 1.0},
+		// ~2 км к северу: 0.018° широты
+		{name: "on radius border", lat: lo.ToPtr(55.7539 + 2/111.32), lon: lo.ToPtr(37.6208), criteria: &domain.SoftCriteria{Geo: geo}, wantOK: true, wantScore: 0.5},
+		{name: "no coordinates", criteria: &domain.SoftCriteria{Geo: geo}, wantScore: 0.5},
+		{name: "no geo search", lat: lo.ToPtr(55.7539), lon: lo.ToPtr(37.6208), criteria: &domain.SoftCriteria{}, wantScore: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, _, ok := svc.calcDistanceScore(domain.Property{Latitude: tt.lat, Longitude: tt.lon}, tt.criteria)
+			if ok != tt.wantOK {
+				t.Fatalf("expected ok=%v, got %v", tt.wantOK, ok)
+			}
+			if diff := score - tt.wantScore; diff > 0.01 || diff < -0.01 {
+				t.Errorf("expected score %.2f, got %.3f", tt.wantScore, score)
+			}
+		})
+	}
+}
+
+func TestService_MatchPropertiesAdvanced_GeoFilter(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	leadService := &MockLeadService{
+		GetLeadFunc: func(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+			return domain.Lead{ID: id, Title: "Квартира у метро", Embedding: []float32{0.1, 0.2}}, nil
+		},
+	}
+
+	geo := &domain.GeoFilter{Center: domain.GeoPoint{Latitude: 55.7539, Longitude: 37.6208}, RadiusKm: 2}
+	near := domain.Property{ID: uuid.New(), Latitude: lo.ToPtr(55.7545), Longitude: lo.ToPtr(37.6210)}
+	far := domain.Property{ID: uuid.New(), Latitude: lo.ToPtr(55.7700), Longitude: lo.ToPtr(37.6208)}
+
+	repo := &MockPropertyRepository{
+		MatchWithFiltersFunc: func(ctx context.Context, leadEmbedding []float32, filter domain.PropertyFilter, hardFilters *domain.HardFilters, limit int) ([]domain.MatchedProperty, error) {
+			if hardFilters == nil || hardFilters.Geo != geo {
+				t.Errorf("expected geo hard filter, got %+v", hardFilters)
+			}
+			// Векторный поиск ставит дальний объект выше
+			return []domain.MatchedProperty{
+				{Property: far, Similarity: 0.82},
+				{Property: near, Similarity: 0.80},
+			}, nil
+		},
+	}
+
+	svc := NewWithAdvancedSearch(log, repo, &MockMLClient{}, nil, nil, leadService, config.SearchConfig{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockIndexListener{}, config.JSONLDConfig{}, NewMockJSONLDCache(), &MockTeamDirectory{})
+
+	matches, _, err := svc.MatchPropertiesAdvanced(context.Background(), uuid.New(), domain.PropertyFilter{Geo: geo}, 10, domain.SearchOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matches) != 2 || matches[0].Property.ID != near.ID {
+		t.Fatalf("expected nearer property to be ranked first, got %+v", matches)
+	}
+	if matches[0].DistanceKm == nil || *matches[0].DistanceKm > 0.1 || matches[0].DistanceScore == nil {
+		t.Errorf("expected distance to be reported, got km=%v score=%v", matches[0].DistanceKm, matches[0].DistanceScore)
+	}
+}
+
+// visualFeatures — признаки фото с заданными значениями, остальные нулевые.
+func visualFeatures(values map[int]float32) []float32 {
+	f := make([]float32, domain.VisualFeatureCount)
+	for i, v := range values {
+		f[i] = v
+	}
+	return f
+}
+
+func TestCalcVisualScore(t *testing.T) {
+	svc := &Service{}
+	prefs := domain.VisualPreferenceVector([]string{"renovated", "вид на парк"})
+
+	tests := []struct {
+		name      string
+		features  []float32
+		criteria  *domain.SoftCriteria
+		wantOK    bool
+		wantScore float64
+	}{
+		{name: "renovated with park view", features: visualFeatures(map[int]float32{domain.VisualQuality: 0.9, domain.VisualGoodView: 1}), criteria: &domain.SoftCriteria{VisualPreferences: prefs}, wantOK: true, wantScore: 0.95},
+		{name: "renovated without view", features: visualFeatures(map[int]float32{domain.VisualQuality: 0.9}), criteria: &domain.SoftCriteria{VisualPreferences: prefs}, wantOK: true, wantScore: 0.45},
+		{name: "photos not analyzed", criteria: &domain.SoftCriteria{VisualPreferences: prefs}, wantScore: 0.5},
+		{name: "no visual preferences", features: visualFeatures(map[int]float32{domain.VisualQuality: 0.9}), criteria: &domain.SoftCriteria{}, wantScore: 0.5},
+		{name: "no criteria", features: visualFeatures(map[int]float32{domain.VisualQuality: 0.9}), wantScore: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, ok := svc.calcVisualScore(domain.Property{VisualFeatures: tt.features}, tt.criteria)
+			if ok != tt.wantOK {
+				t.Fatalf("expected ok=%v, got %v", tt.wantOK, ok)
+			}
+			if diff := score - tt.wantScore; diff > 0.01 || diff < -0.01 {
+				t.Errorf("expected score %.2f, got %.3f", tt.wantScore, score)
+			}
+		})
+	}
+
+	if domain.VisualPreferenceVector([]string{"рядом школа"}) != nil {
+		t.Error("unrecognized preferences must not produce a vector")
+	}
+}
+
+func TestService_MatchPropertiesAdvanced_VisualPreferences(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	leadService := &MockLeadService{
+		GetLeadFunc: func(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+			return domain.Lead{
+				ID:          id,
+				Title:       "Квартира с видом",
+				Embedding:   []float32{0.1, 0.2},
+				Requirement: domain.LeadRequirement{VisualPreferences: []string{"park_view", "renovated"}},
+			}, nil
+		},
+	}
+
+	withView := domain.Property{ID: uuid.New(), VisualFeatures: visualFeatures(map[int]float32{domain.VisualQuality: 0.9, domain.VisualGoodView: 1})}
+	plain := domain.Property{ID: uuid.New(), VisualFeatures: visualFeatures(map[int]float32{domain.VisualQuality: 0.3})}
+
+	repo := &MockPropertyRepository{
+		MatchWithFiltersFunc: func(ctx context.Context, leadEmbedding []float32, filter domain.PropertyFilter, hardFilters *domain.HardFilters, limit int) ([]domain.MatchedProperty, error) {
+			// Векторный поиск ставит объект без вида выше
+			return []domain.MatchedProperty{
+				{Property: plain, Similarity: 0.82},
+				{Property: withView, Similarity: 0.80},
+			}, nil
+		},
+	}
+
+	svc := NewWithAdvancedSearch(log, repo, &MockMLClient{}, nil, nil, leadService, config.SearchConfig{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockIndexListener{}, config.JSONLDConfig{}, NewMockJSONLDCache(), &MockTeamDirectory{})
+
+	matches, _, err := svc.MatchPropertiesAdvanced(context.Background(), uuid.New(), domain.PropertyFilter{}, 10, domain.SearchOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matches) != 2 || matches[0].Property.ID != withView.ID {
+		t.Fatalf("expected property matching visual preferences to be ranked first, got %+v", matches)
+	}
+	if matches[0].VisualScore == nil || *matches[0].VisualScore < 0.9 {
+		t.Errorf("expected visual score to be reported, got %v", matches[0].VisualScore)
+	}
+	if matches[0].MatchExplanation == nil || !strings.Contains(*matches[0].MatchExplanation, "по фото") {
+		t.Errorf("expected explanation to mention photos, got %v", matches[0].MatchExplanation)
+	}
+}
+
+func TestCalcFeaturesScore(t *testing.T) {
+	svc := &Service{}
+	criteria := &domain.SoftCriteria{PreferredFeatures: domain.NormalizeFeatures([]string{"Балкон", " парковка "})}
+
+	tests := []struct {
+		name      string
+		features  []string
+		criteria  *domain.SoftCriteria
+		wantOK    bool
+		wantScore float64
+	}{
+		{name: "all preferred features", features: []string{"балкон", "Парковка", "лифт"}, criteria: criteria, wantOK: true, wantScore: 1},
+		{name: "half of preferred features", features: []string{"балкон"}, criteria: criteria, wantOK: true, wantScore: 0.5},
+		{name: "none of preferred features", features: []string{"лифт"}, criteria: criteria, wantOK: true, wantScore: 0},
+		{name: "no preferred features", features: []string{"балкон"}, criteria: &domain.SoftCriteria{}, wantScore: 0.5},
+		{name: "no criteria", features: []string{"балкон"}, wantScore: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, ok := svc.calcFeaturesScore(domain.Property{Features: tt.features}, tt.criteria)
+			if ok != tt.wantOK {
+				t.Fatalf("expected ok=%v, got %v", tt.wantOK, ok)
+			}
+			if diff := score - tt.wantScore; diff > 0.01 || diff < -0.01 {
+				t.Errorf("expected score %.2f, got %.3f", tt.wantScore, score)
+			}
+		})
+	}
+}
+
+func TestService_MatchPropertiesAdvanced_Features(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	leadService := &MockLeadService{
+		GetLeadFunc: func(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+			return domain.Lead{
+				ID:        id,
+				Title:     "Квартира с парковкой",
+				Embedding: []float32{0.1, 0.2},
+				Requirement: domain.LeadRequirement{
+					MustHave:   []string{"Парковка"},
+					NiceToHave: []string{"балкон", "кладовая"},
+				},
+			}, nil
+		},
+	}
+
+	withExtras := domain.Property{ID: uuid.New(), Features: []string{"парковка", "Балкон", "кладовая"}}
+	plain := domain.Property{ID: uuid.New(), Features: []string{"парковка"}}
+
+	repo := &MockPropertyRepository{
+		MatchWithFiltersFunc: func(ctx context.Context, leadEmbedding []float32, filter domain.PropertyFilter, hardFilters *domain.HardFilters, limit int) ([]domain.MatchedProperty, error) {
+			if hardFilters == nil || len(hardFilters.Features) != 1 || hardFilters.Features[0] != "парковка" {
+				t.Errorf("expected must-have features as a normalized hard filter, got %+v", hardFilters)
+			}
+			// Векторный поиск ставит объект без желательных особенностей выше
+			return []domain.MatchedProperty{
+				{Property: plain, Similarity: 0.82},
+				{Property: withExtras, Similarity: 0.80},
+			}, nil
+		},
+	}
+
+	svc := NewWithAdvancedSearch(log, repo, &MockMLClient{}, nil, nil, leadService, config.SearchConfig{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockIndexListener{}, config.JSONLDConfig{}, NewMockJSONLDCache(), &MockTeamDirectory{})
+
+	matches, _, err := svc.MatchPropertiesAdvanced(context.Background(), uuid.New(), domain.PropertyFilter{}, 10, domain.SearchOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matches) != 2 || matches[0].Property.ID != withExtras.ID {
+		t.Fatalf("expected property with nice-to-have features to be ranked first, got %+v", matches)
+	}
+	if matches[0].FeaturesScore == nil || *matches[0].FeaturesScore != 1 {
+		t.Errorf("expected features score to be reported, got %v", matches[0].FeaturesScore)
+	}
+	if matches[0].MatchExplanation == nil || !strings.Contains(*matches[0].MatchExplanation, "особенности") {
+		t.Errorf("expected explanation to mention features, got %v", matches[0].MatchExplanation)
+	}
+}
+
+func TestService_MatchLeads_MustHave(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := &MockPropertyRepository{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (domain.Property, error) {
+			return domain.Property{ID: id, Features: []string{"Балкон"}, Embedding: []float32{0.1, 0.2}}, nil
+		},
+	}
+	satisfied := domain.Lead{ID: uuid.New(), Requirement: domain.LeadRequirement{MustHave: []string{"балкон"}}}
+	unsatisfied := domain.Lead{ID: uuid.New(), Requirement: domain.LeadRequirement{MustHave: []string{"балкон", "парковка"}}}
+
+	leadService := &MockLeadService{
+		MatchLeadsByEmbeddingFunc: func(ctx context.Context, emb []float32, filter domain.LeadFilter, hf *domain.HardFilters, limit int) ([]domain.MatchedLead, error) {
+			return []domain.MatchedLead{{Lead: unsatisfied, Similarity: 0.9}, {Lead: satisfied, Similarity: 0.8}}, nil
+		},
+	}
+
+	svc := New(log, repo, &MockMLClient{}, leadService, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockIndexListener{}, config.JSONLDConfig{}, NewMockJSONLDCache(), &MockTeamDirectory{})
+
+	matches, err := svc.MatchLeads(context.Background(), uuid.New(), domain.LeadFilter{}, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matches) != 1 || matches[0].Lead.ID != satisfied.ID {
+		t.Errorf("expected only the lead whose must-have features the property has, got %+v", matches)
+	}
+}
+
+// MockEmbeddingQueue
+type MockEmbeddingQueue struct {
+	EnqueueFunc   func(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID, operation domain.EmbeddingOperation) error
+	LatestJobFunc func(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID) (*domain.EmbeddingJob, error)
+}
+
+func (m *MockEmbeddingQueue) Enqueue(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID, operation domain.EmbeddingOperation) error {
+	if m.EnqueueFunc != nil {
+		return m.EnqueueFunc(ctx, entityType, entityID, operation)
+	}
+	return nil
+}
+func (m *MockEmbeddingQueue) LatestJob(ctx context.Context, entityType domain.EmbeddingEntityType, entityID uuid.UUID) (*domain.EmbeddingJob, error) {
+	if m.LatestJobFunc != nil {
+		return m.LatestJobFunc(ctx, entityType, entityID)
+	}
+	return nil, nil
+}
+
+// MockReindexCheckpoints
+type MockReindexCheckpoints struct {
+	checkpoints map[domain.EmbeddingEntityType]domain.ReindexProgress
+}
+
+func (m *MockReindexCheckpoints) GetCheckpoint(ctx context.Context, entityType domain.EmbeddingEntityType) (domain.ReindexProgress, error) {
+	checkpoint, ok := m.checkpoints[entityType]
+	if !ok {
+		return domain.ReindexProgress{}, repository.ErrReindexCheckpointNotFound
+	}
+	return checkpoint, nil
+}
+func (m *MockReindexCheckpoints) SaveCheckpoint(ctx context.Context, progress domain.ReindexProgress) error {
+	if m.checkpoints == nil {
+		m.checkpoints = make(map[domain.EmbeddingEntityType]domain.ReindexProgress)
+	}
+	m.checkpoints[progress.EntityType] = progress
+	return nil
+}
+
+// MockTxManager
+type MockTxManager struct{}
+
+func (m *MockTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// MockIndexListener
+type MockIndexListener struct {
+	indexed []uuid.UUID
+}
+
+func (m *MockIndexListener) PropertiesIndexed(ctx context.Context, propertyIDs ...uuid.UUID) error {
+	m.indexed = append(m.indexed, propertyIDs...)
+	return nil
+}
@@ -0,0 +1,56 @@
+package property
+
+import (
+	"context"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MockTeamDirectory — одна команда в памяти; остальные пользователи — вне агентств.
+type MockTeamDirectory struct {
+	members []uuid.UUID
+}
+
+func (m *MockTeamDirectory) TeamMembers(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	if lo.Contains(m.members, userID) {
+		return m.members, nil
+	}
+	return []uuid.UUID{userID}, nil
+}
+
+func TestService_ListProperties_Team(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	agent, colleague := uuid.New(), uuid.New()
+
+	var got domain.PropertyFilter
+	repo := &MockPropertyRepository{
+		ListPropertiesFunc: func(ctx context.Context, filter domain.PropertyFilter) (*domain.PaginatedResult[domain.Property], error) {
+			got = filter
+			return &domain.PaginatedResult[domain.Property]{}, nil
+		},
+	}
+	team := &MockTeamDirectory{members: []uuid.UUID{agent, colleague}}
+	svc := New(log, repo, &MockMLClient{}, &MockLeadService{}, &MockEmbeddingQueue{}, &MockReindexCheckpoints{}, &MockTxManager{}, &MockIndexListener{}, config.JSONLDConfig{}, NewMockJSONLDCache(), team)
+
+	// Пользователь вне агентства видит только свои объекты
+	loner := uuid.New()
+	if _, err := svc.ListProperties(context.Background(), domain.PropertyFilter{TeamOf: &loner}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.OwnerUserIDs) != 1 || got.OwnerUserIDs[0] != loner {
+		t.Errorf("expected only own properties outside an agency, got %v", got.OwnerUserIDs)
+	}
+
+	if _, err := svc.ListProperties(context.Background(), domain.PropertyFilter{TeamOf: &agent}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.OwnerUserIDs) != 2 || !lo.Contains(got.OwnerUserIDs, colleague) {
+		t.Errorf("expected properties of the whole team, got %v", got.OwnerUserIDs)
+	}
+}
@@ -0,0 +1,172 @@
+package propertyimage
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/lib/vision"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// AnalyzeImages — CV-анализ фотографий объекта с сохранением результатов.
+// Без imageURLs анализируется вся галерея; переданные ссылки добавляются в галерею
+// как внешние фотографии (повторная ссылка переиспользует существующую запись).
+// Результат по каждой фотографии пишется в property_images, сводный — в properties.
+// Фотографии, которые не удалось проанализировать, пропускаются и в ответ не попадают.
+func (s *Service) AnalyzeImages(ctx context.Context, actor domain.Actor, propertyID uuid.UUID, imageURLs []string) ([]domain.PropertyImage, domain.PropertyVisualSummary, error) {
+	const op = "propertyimage.Service.AnalyzeImages"
+	log := s.log.With(slog.String("op", op), slog.String("property_id", propertyID.String()))
+
+	if s.visionClient == nil || !s.visionClient.IsEnabled() {
+		return nil, domain.PropertyVisualSummary{}, fmt.Errorf("%s: %w", op, ErrVisionDisabled)
+	}
+
+	if err := s.authorize(ctx, actor, propertyID); err != nil {
+		return nil, domain.PropertyVisualSummary{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	images, err := s.imagesToAnalyze(ctx, propertyID, imageURLs)
+	if err != nil {
+		return nil, domain.PropertyVisualSummary{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(images) == 0 {
+		return nil, domain.PropertyVisualSummary{}, fmt.Errorf("%s: %w", op, ErrNoImages)
+	}
+
+	var (
+		analyzed []domain.PropertyImage
+		results  []*vision.ImageAnalysis
+		lastErr  error
+	)
+	analyzedAt := time.Now()
+	for _, img := range images {
+		result, err := s.analyzeImage(ctx, img)
+		if err != nil {
+			log.Warn("failed to analyze image", slog.String("image_id", img.ID.String()), sl.Err(err))
+			lastErr = err
+			continue
+		}
+		img.Analysis = imageAnalysisToDomain(result, analyzedAt)
+		analyzed = append(analyzed, img)
+		results = append(results, result)
+	}
+	if len(analyzed) == 0 {
+		return nil, domain.PropertyVisualSummary{}, fmt.Errorf("%s: no image could be analyzed: %w", op, lastErr)
+	}
+
+	summary := visualSummaryToDomain(vision.Aggregate(results))
+
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		for _, img := range analyzed {
+			if err := s.repo.SaveImageAnalysis(ctx, img.ID, *img.Analysis); err != nil {
+				return err
+			}
+		}
+		return s.repo.SaveVisualSummary(ctx, propertyID, summary)
+	})
+	if err != nil {
+		log.Error("failed to save image analysis", sl.Err(err))
+		return nil, domain.PropertyVisualSummary{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("images analyzed",
+		slog.Int("analyzed", len(analyzed)),
+		slog.Int("failed", len(images)-len(analyzed)),
+		slog.Float64("average_quality", summary.AverageQuality),
+	)
+
+	return analyzed, summary, nil
+}
+
+// imagesToAnalyze — фотографии для анализа: вся галерея или записи для переданных ссылок.
+func (s *Service) imagesToAnalyze(ctx context.Context, propertyID uuid.UUID, imageURLs []string) ([]domain.PropertyImage, error) {
+	existing, err := s.listImages(ctx, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(imageURLs) == 0 {
+		return existing, nil
+	}
+
+	byURL := lo.KeyBy(lo.Filter(existing, func(img domain.PropertyImage, _ int) bool {
+		return img.ImageURL != ""
+	}), func(img domain.PropertyImage) string {
+		return img.ImageURL
+	})
+
+	var images, added []domain.PropertyImage
+	for _, url := range lo.Uniq(imageURLs) {
+		if img, ok := byURL[url]; ok {
+			images = append(images, img)
+			continue
+		}
+		img := domain.PropertyImage{
+			ID:         uuid.New(),
+			PropertyID: propertyID,
+			ImageURL:   url,
+			URL:        url,
+		}
+		images = append(images, img)
+		added = append(added, img)
+	}
+
+	if len(existing)+len(added) > domain.MaxPropertyImages {
+		return nil, fmt.Errorf("gallery is limited to %d images: %w", domain.MaxPropertyImages, ErrTooManyImages)
+	}
+	if err := s.repo.AddImages(ctx, propertyID, added); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// analyzeImage — файл из MinIO передаётся в CV API содержимым, внешняя фотография — ссылкой.
+func (s *Service) analyzeImage(ctx context.Context, img domain.PropertyImage) (*vision.ImageAnalysis, error) {
+	if img.StoragePath == "" {
+		return s.visionClient.AnalyzeImageURL(ctx, img.ImageURL)
+	}
+
+	data, err := s.storage.GetObject(ctx, img.StoragePath)
+	if err != nil {
+		return nil, err
+	}
+	return s.visionClient.AnalyzeImage(ctx, data)
+}
+
+func imageAnalysisToDomain(a *vision.ImageAnalysis, analyzedAt time.Time) *domain.ImageAnalysis {
+	return &domain.ImageAnalysis{
+		DetectedFeatures: featuresToDomain(a.DetectedFeatures),
+		RoomType:         a.RoomType,
+		QualityScore:     a.QualityScore,
+		ViewType:         a.ViewType,
+		Brightness:       a.Brightness,
+		Tags:             a.Tags,
+		// Признаки отдельной фотографии считаются так же, как сводные по объекту
+		VisualFeatures: vision.Aggregate([]*vision.ImageAnalysis{a}).EmbeddingFeatures,
+		Confidence:     a.Confidence,
+		AnalyzedAt:     analyzedAt,
+	}
+}
+
+func visualSummaryToDomain(a *vision.PropertyImageAnalysis) domain.PropertyVisualSummary {
+	return domain.PropertyVisualSummary{
+		TotalImages:       a.TotalImages,
+		AverageQuality:    a.AverageQuality,
+		DetectedRooms:     a.DetectedRooms,
+		AllFeatures:       featuresToDomain(a.AllFeatures),
+		ViewTypes:         a.ViewTypes,
+		OverallAssessment: a.OverallAssessment,
+		VisualFeatures:    a.EmbeddingFeatures,
+	}
+}
+
+func featuresToDomain(features []vision.Feature) []domain.ImageFeature {
+	return lo.Map(features, func(f vision.Feature, _ int) domain.ImageFeature {
+		return domain.ImageFeature{Name: f.Name, Confidence: f.Confidence, Category: f.Category}
+	})
+}
@@ -0,0 +1,193 @@
+package propertyimage
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/vision"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// newVisionServer — CV API: файлы из галереи оцениваются как кухня, внешние ссылки — как спальня с видом на парк.
+// Ссылки из failing отвечают ошибкой.
+func newVisionServer(t *testing.T, failing ...string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		var req struct {
+			Image string `json:"image"`
+			URL   string `json:"url"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode vision request: %v", err)
+		}
+
+		var resp vision.ImageAnalysis
+		switch r.URL.Path {
+		case "/analyze":
+			data, _ := base64.StdEncoding.DecodeString(req.Image)
+			if string(data) != string(pngHeader) {
+				t.Errorf("unexpected image payload %q", data)
+			}
+			resp = vision.ImageAnalysis{
+				RoomType:         "kitchen",
+				QualityScore:     0.9,
+				Brightness:       0.7,
+				Confidence:       0.8,
+				DetectedFeatures: []vision.Feature{{Name: "modern_kitchen", Confidence: 0.9, Category: "interior"}},
+			}
+		case "/analyze-url":
+			for _, url := range failing {
+				if req.URL == url {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+			}
+			resp = vision.ImageAnalysis{RoomType: "bedroom", ViewType: "park", QualityScore: 0.5, Confidence: 0.6}
+		default:
+			t.Errorf("unexpected vision endpoint %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func newVisionClient(server *httptest.Server) vision.Client {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	return vision.NewClient(config.VisionConfig{Enabled: true, BaseURL: server.URL, Timeout: 5 * time.Second}, log)
+}
+
+func TestService_AnalyzeImages_Gallery(t *testing.T) {
+	server, _ := newVisionServer(t)
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+	propID := uuid.New()
+	repo := NewMockRepository()
+	props := &MockPropertyService{properties: map[uuid.UUID]domain.Property{propID: {ID: propID, OwnerUserID: owner.UserID}}}
+	svc := New(log, repo, props, NewMockStorage(), newVisionClient(server), &MockTxManager{}, 0)
+	gallery := addImages(t, svc, owner, propID, 2)
+
+	images, summary, err := svc.AnalyzeImages(context.Background(), owner, propID, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(images) != 2 || summary.TotalImages != 2 {
+		t.Fatalf("expected 2 analyzed images, got %d (summary %d)", len(images), summary.TotalImages)
+	}
+	for _, img := range gallery {
+		stored := repo.images[img.ID]
+		if stored.Analysis == nil || stored.Analysis.RoomType != "kitchen" {
+			t.Fatalf("expected analysis to be persisted for %s, got %+v", img.ID, stored.Analysis)
+		}
+		if len(stored.Analysis.VisualFeatures) != 16 {
+			t.Errorf("expected 16 visual features, got %d", len(stored.Analysis.VisualFeatures))
+		}
+	}
+
+	saved, ok := repo.summaries[propID]
+	if !ok {
+		t.Fatal("expected visual summary to be persisted")
+	}
+	if saved.AverageQuality != 0.9 || saved.OverallAssessment == "" || len(saved.VisualFeatures) != 16 {
+		t.Errorf("unexpected visual summary: %+v", saved)
+	}
+}
+
+func TestService_AnalyzeImages_URLs(t *testing.T) {
+	ctx := context.Background()
+	const (
+		goodURL = "https://cdn.example.com/bedroom.jpg"
+		badURL  = "https://cdn.example.com/broken.jpg"
+	)
+	server, calls := newVisionServer(t, badURL)
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+	propID := uuid.New()
+	repo := NewMockRepository()
+	props := &MockPropertyService{properties: map[uuid.UUID]domain.Property{propID: {ID: propID, OwnerUserID: owner.UserID}}}
+	svc := New(log, repo, props, NewMockStorage(), newVisionClient(server), &MockTxManager{}, 0)
+	addImages(t, svc, owner, propID, 1)
+
+	images, summary, err := svc.AnalyzeImages(ctx, owner, propID, []string{goodURL, badURL, goodURL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected only the requested urls to be analyzed once each, got %d calls", calls.Load())
+	}
+	if len(images) != 1 || images[0].ImageURL != goodURL || images[0].URL != goodURL {
+		t.Fatalf("expected only %s in results, got %+v", goodURL, images)
+	}
+	if summary.TotalImages != 1 || len(summary.ViewTypes) != 1 || summary.ViewTypes[0] != "park" {
+		t.Errorf("unexpected summary: %+v", summary)
+	}
+
+	// Внешние ссылки становятся фотографиями галереи, повторный анализ переиспользует запись
+	if got := len(repo.images); got != 3 {
+		t.Fatalf("expected external images to join the gallery, got %d images", got)
+	}
+	again, _, err := svc.AnalyzeImages(ctx, owner, propID, []string{goodURL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.images) != 3 || again[0].ID != images[0].ID {
+		t.Errorf("expected existing record to be reused, got %d images", len(repo.images))
+	}
+
+	gallery, err := svc.ListImages(ctx, propID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gallery[1].URL != goodURL {
+		t.Errorf("external image must be listed with its own url, got %q", gallery[1].URL)
+	}
+}
+
+func TestService_AnalyzeImages_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	server, _ := newVisionServer(t, "https://cdn.example.com/broken.jpg")
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+	propID := uuid.New()
+	repo := NewMockRepository()
+	props := &MockPropertyService{properties: map[uuid.UUID]domain.Property{propID: {ID: propID, OwnerUserID: owner.UserID}}}
+	svc := New(log, repo, props, NewMockStorage(), newVisionClient(server), &MockTxManager{}, 0)
+
+	disabled := New(log, repo, props, NewMockStorage(), nil, &MockTxManager{}, 0)
+	if _, _, err := disabled.AnalyzeImages(ctx, owner, propID, nil); !errors.Is(err, ErrVisionDisabled) {
+		t.Errorf("expected ErrVisionDisabled, got %v", err)
+	}
+
+	if _, _, err := svc.AnalyzeImages(ctx, owner, propID, nil); !errors.Is(err, ErrNoImages) {
+		t.Errorf("expected ErrNoImages for empty gallery, got %v", err)
+	}
+
+	stranger := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+	if _, _, err := svc.AnalyzeImages(ctx, stranger, propID, nil); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied, got %v", err)
+	}
+
+	if _, _, err := svc.AnalyzeImages(ctx, owner, propID, []string{"https://cdn.example.com/broken.jpg"}); err == nil {
+		t.Error("expected error when no image could be analyzed")
+	}
+	if _, ok := repo.summaries[propID]; ok {
+		t.Error("summary must not be saved when nothing was analyzed")
+	}
+}
@@ -0,0 +1,311 @@
+package propertyimage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/lib/vision"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/property"
+	"log/slog"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// defaultPresignExpiry — срок жизни ссылок, если в конфиге он не задан.
+const defaultPresignExpiry = time.Hour
+
+// allowedContentTypes — допустимые форматы фотографий и расширения объектов в бакете.
+var allowedContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+type Repository interface {
+	CountImagesForUpdate(ctx context.Context, propertyID uuid.UUID) (int, error)
+	AddImages(ctx context.Context, propertyID uuid.UUID, images []domain.PropertyImage) error
+	ListImages(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error)
+	GetImage(ctx context.Context, imageID uuid.UUID) (domain.PropertyImage, error)
+	DeleteImage(ctx context.Context, imageID uuid.UUID) error
+	ReorderImages(ctx context.Context, propertyID uuid.UUID, imageIDs []uuid.UUID) error
+	SaveImageAnalysis(ctx context.Context, imageID uuid.UUID, analysis domain.ImageAnalysis) error
+	SaveVisualSummary(ctx context.Context, propertyID uuid.UUID, summary domain.PropertyVisualSummary) error
+}
+
+// PropertyService нужен для проверки объекта и его владельца.
+type PropertyService interface {
+	GetProperty(ctx context.Context, id uuid.UUID) (domain.Property, error)
+}
+
+// Storage — объектное хранилище с файлами фотографий (MinIO).
+type Storage interface {
+	PutObject(ctx context.Context, objectName string, data []byte, contentType string) error
+	PresignedURL(ctx context.Context, objectName string, expiry time.Duration) (string, error)
+	RemoveObject(ctx context.Context, objectName string) error
+	GetObject(ctx context.Context, objectName string) ([]byte, error)
+}
+
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type Service struct {
+	log             *slog.Logger
+	repo            Repository
+	propertyService PropertyService
+	storage         Storage
+	visionClient    vision.Client
+	txManager       TxManager
+	presignExpiry   time.Duration
+}
+
+var (
+	ErrPropertyNotFound = errors.New("property not found")
+	ErrImageNotFound    = errors.New("property image not found")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrInvalidImage     = errors.New("invalid image")
+	ErrTooManyImages    = errors.New("too many images")
+	ErrNoImages         = errors.New("property has no images")
+	ErrVisionDisabled   = errors.New("vision service is disabled")
+)
+
+func New(log *slog.Logger, repo Repository, propertyService PropertyService, storage Storage, visionClient vision.Client, txManager TxManager, presignExpiry time.Duration) *Service {
+	if presignExpiry <= 0 {
+		presignExpiry = defaultPresignExpiry
+	}
+	return &Service{
+		log:             log,
+		repo:            repo,
+		propertyService: propertyService,
+		storage:         storage,
+		visionClient:    visionClient,
+		txManager:       txManager,
+		presignExpiry:   presignExpiry,
+	}
+}
+
+// AddImages — загружает фотографии в MinIO под префикс объекта и добавляет их в конец галереи.
+// Возвращает галерею целиком. Изменять галерею может только владелец объекта или админ.
+func (s *Service) AddImages(ctx context.Context, actor domain.Actor, propertyID uuid.UUID, uploads []domain.PropertyImageUpload) ([]domain.PropertyImage, error) {
+	const op = "propertyimage.Service.AddImages"
+	log := s.log.With(slog.String("op", op), slog.String("property_id", propertyID.String()))
+
+	if err := s.authorize(ctx, actor, propertyID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	images := make([]domain.PropertyImage, 0, len(uploads))
+	for _, upload := range uploads {
+		contentType := http.DetectContentType(upload.Data)
+		ext, ok := allowedContentTypes[contentType]
+		if !ok {
+			return nil, fmt.Errorf("%s: %s: unsupported content type %s: %w", op, upload.FileName, contentType, ErrInvalidImage)
+		}
+		id := uuid.New()
+		images = append(images, domain.PropertyImage{
+			ID:          id,
+			PropertyID:  propertyID,
+			StoragePath: storagePath(propertyID, id, ext),
+			FileName:    path.Base(upload.FileName),
+			ContentType: contentType,
+		})
+	}
+
+	uploaded := make([]domain.PropertyImage, 0, len(images))
+	for i, img := range images {
+		if err := s.storage.PutObject(ctx, img.StoragePath, uploads[i].Data, img.ContentType); err != nil {
+			log.Error("failed to upload image", slog.String("storage_path", img.StoragePath), sl.Err(err))
+			s.removeObjects(ctx, uploaded)
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		uploaded = append(uploaded, img)
+	}
+
+	// Лимит проверяется под блокировкой объекта, иначе параллельные загрузки вместе превысят его
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		count, err := s.repo.CountImagesForUpdate(ctx, propertyID)
+		if err != nil {
+			if errors.Is(err, repository.ErrPropertyNotFound) {
+				return ErrPropertyNotFound
+			}
+			return err
+		}
+		if count+len(images) > domain.MaxPropertyImages {
+			return fmt.Errorf("gallery is limited to %d images: %w", domain.MaxPropertyImages, ErrTooManyImages)
+		}
+		return s.repo.AddImages(ctx, propertyID, images)
+	})
+	if err != nil {
+		if !errors.Is(err, ErrTooManyImages) {
+			log.Error("failed to save images", sl.Err(err))
+		}
+		s.removeObjects(ctx, uploaded)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("images added", slog.Int("count", len(images)))
+
+	return s.listImages(ctx, propertyID)
+}
+
+// ListImages — галерея объекта со свежими presigned-ссылками.
+func (s *Service) ListImages(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error) {
+	const op = "propertyimage.Service.ListImages"
+
+	if _, err := s.getProperty(ctx, propertyID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	images, err := s.listImages(ctx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return images, nil
+}
+
+// DeleteImage — удаляет фотографию из галереи и её файл из MinIO.
+func (s *Service) DeleteImage(ctx context.Context, actor domain.Actor, propertyID, imageID uuid.UUID) error {
+	const op = "propertyimage.Service.DeleteImage"
+	log := s.log.With(slog.String("op", op), slog.String("property_id", propertyID.String()), slog.String("image_id", imageID.String()))
+
+	if err := s.authorize(ctx, actor, propertyID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	img, err := s.repo.GetImage(ctx, imageID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPropertyImageNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrImageNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if img.PropertyID != propertyID {
+		return fmt.Errorf("%s: %w", op, ErrImageNotFound)
+	}
+
+	if err := s.repo.DeleteImage(ctx, imageID); err != nil {
+		if errors.Is(err, repository.ErrPropertyImageNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrImageNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Запись уже удалена: осиротевший файл не мешает галерее, поэтому ошибку только логируем
+	if img.StoragePath != "" {
+		if err := s.storage.RemoveObject(ctx, img.StoragePath); err != nil {
+			log.Warn("failed to remove image object", slog.String("storage_path", img.StoragePath), sl.Err(err))
+		}
+	}
+
+	log.Info("image deleted")
+	return nil
+}
+
+// ReorderImages — задаёт порядок галереи. imageIDs должен содержать все фотографии объекта ровно по одному разу.
+func (s *Service) ReorderImages(ctx context.Context, actor domain.Actor, propertyID uuid.UUID, imageIDs []uuid.UUID) ([]domain.PropertyImage, error) {
+	const op = "propertyimage.Service.ReorderImages"
+
+	if err := s.authorize(ctx, actor, propertyID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	existing, err := s.repo.ListImages(ctx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	known := make(map[uuid.UUID]bool, len(existing))
+	for _, img := range existing {
+		known[img.ID] = false
+	}
+	for _, id := range imageIDs {
+		seen, ok := known[id]
+		if !ok {
+			return nil, fmt.Errorf("%s: image %s does not belong to property: %w", op, id, ErrInvalidImage)
+		}
+		if seen {
+			return nil, fmt.Errorf("%s: image %s is listed twice: %w", op, id, ErrInvalidImage)
+		}
+		known[id] = true
+	}
+	if len(imageIDs) != len(existing) {
+		return nil, fmt.Errorf("%s: all %d images must be listed: %w", op, len(existing), ErrInvalidImage)
+	}
+
+	if err := s.repo.ReorderImages(ctx, propertyID, imageIDs); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return s.listImages(ctx, propertyID)
+}
+
+// authorize — объект существует, и менять его галерею может actor.
+func (s *Service) authorize(ctx context.Context, actor domain.Actor, propertyID uuid.UUID) error {
+	p, err := s.getProperty(ctx, propertyID)
+	if err != nil {
+		return err
+	}
+	if !actor.IsAdmin() && p.OwnerUserID != actor.UserID {
+		s.log.Warn("gallery change denied: not an owner",
+			slog.String("property_id", propertyID.String()),
+			slog.String("user_id", actor.UserID.String()),
+		)
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+func (s *Service) getProperty(ctx context.Context, propertyID uuid.UUID) (domain.Property, error) {
+	p, err := s.propertyService.GetProperty(ctx, propertyID)
+	if err != nil {
+		if errors.Is(err, property.ErrPropertyNotFound) {
+			return domain.Property{}, ErrPropertyNotFound
+		}
+		return domain.Property{}, err
+	}
+	return p, nil
+}
+
+// listImages — галерея с presigned-ссылками на срок presignExpiry.
+// Для внешних фотографий отдаётся их исходная ссылка.
+func (s *Service) listImages(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error) {
+	images, err := s.repo.ListImages(ctx, propertyID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range images {
+		if images[i].StoragePath == "" {
+			images[i].URL = images[i].ImageURL
+			continue
+		}
+		url, err := s.storage.PresignedURL(ctx, images[i].StoragePath, s.presignExpiry)
+		if err != nil {
+			return nil, err
+		}
+		images[i].URL = url
+	}
+
+	return images, nil
+}
+
+// removeObjects — откат загрузки: удаляет уже загруженные файлы.
+func (s *Service) removeObjects(ctx context.Context, images []domain.PropertyImage) {
+	for _, img := range images {
+		if err := s.storage.RemoveObject(ctx, img.StoragePath); err != nil {
+			s.log.Warn("failed to remove uploaded image", slog.String("storage_path", img.StoragePath), sl.Err(err))
+		}
+	}
+}
+
+// storagePath — путь файла в бакете: все фотографии объекта лежат под общим префиксом.
+func storagePath(propertyID, imageID uuid.UUID, ext string) string {
+	return fmt.Sprintf("properties/%s/%s%s", propertyID, imageID, ext)
+}
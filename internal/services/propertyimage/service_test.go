@@ -0,0 +1,355 @@
+package propertyimage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/property"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// pngHeader — сигнатура PNG, по которой определяется content type.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n0000")
+
+// MockRepository — галерея в памяти.
+type MockRepository struct {
+	images    map[uuid.UUID]domain.PropertyImage
+	summaries map[uuid.UUID]domain.PropertyVisualSummary
+	// countedInTx — лимит галереи проверялся внутри транзакции
+	countedInTx bool
+}
+
+func NewMockRepository() *MockRepository {
+	return &MockRepository{images: make(map[uuid.UUID]domain.PropertyImage)}
+}
+
+func (m *MockRepository) CountImagesForUpdate(ctx context.Context, propertyID uuid.UUID) (int, error) {
+	m.countedInTx = ctx.Value(txKey{}) != nil
+	return len(m.byProperty(propertyID)), nil
+}
+
+func (m *MockRepository) AddImages(ctx context.Context, propertyID uuid.UUID, images []domain.PropertyImage) error {
+	next := int32(len(m.byProperty(propertyID)))
+	for _, img := range images {
+		img.Position = next
+		next++
+		m.images[img.ID] = img
+	}
+	return nil
+}
+
+func (m *MockRepository) ListImages(ctx context.Context, propertyID uuid.UUID) ([]domain.PropertyImage, error) {
+	return m.byProperty(propertyID), nil
+}
+
+func (m *MockRepository) GetImage(ctx context.Context, imageID uuid.UUID) (domain.PropertyImage, error) {
+	img, ok := m.images[imageID]
+	if !ok {
+		return domain.PropertyImage{}, repository.ErrPropertyImageNotFound
+	}
+	return img, nil
+}
+
+func (m *MockRepository) DeleteImage(ctx context.Context, imageID uuid.UUID) error {
+	if _, ok := m.images[imageID]; !ok {
+		return repository.ErrPropertyImageNotFound
+	}
+	delete(m.images, imageID)
+	return nil
+}
+
+func (m *MockRepository) ReorderImages(ctx context.Context, propertyID uuid.UUID, imageIDs []uuid.UUID) error {
+	for i, id := range imageIDs {
+		img := m.images[id]
+		img.Position = int32(i)
+		m.images[id] = img
+	}
+	return nil
+}
+
+func (m *MockRepository) SaveImageAnalysis(ctx context.Context, imageID uuid.UUID, analysis domain.ImageAnalysis) error {
+	img, ok := m.images[imageID]
+	if !ok {
+		return repository.ErrPropertyImageNotFound
+	}
+	img.Analysis = &analysis
+	m.images[imageID] = img
+	return nil
+}
+
+func (m *MockRepository) SaveVisualSummary(ctx context.Context, propertyID uuid.UUID, summary domain.PropertyVisualSummary) error {
+	if m.summaries == nil {
+		m.summaries = make(map[uuid.UUID]domain.PropertyVisualSummary)
+	}
+	m.summaries[propertyID] = summary
+	return nil
+}
+
+func (m *MockRepository) byProperty(propertyID uuid.UUID) []domain.PropertyImage {
+	var images []domain.PropertyImage
+	for _, img := range m.images {
+		if img.PropertyID == propertyID {
+			images = append(images, img)
+		}
+	}
+	sort.Slice(images, func(i, j int) bool { return images[i].Position < images[j].Position })
+	return images
+}
+
+// MockPropertyService
+type MockPropertyService struct {
+	properties map[uuid.UUID]domain.Property
+}
+
+func (m *MockPropertyService) GetProperty(ctx context.Context, id uuid.UUID) (domain.Property, error) {
+	p, ok := m.properties[id]
+	if !ok {
+		return domain.Property{}, property.ErrPropertyNotFound
+	}
+	return p, nil
+}
+
+// MockStorage — бакет в памяти.
+type MockStorage struct {
+	objects    map[string][]byte
+	PutErrFunc func(objectName string) error
+}
+
+func NewMockStorage() *MockStorage {
+	return &MockStorage{objects: make(map[string][]byte)}
+}
+
+func (m *MockStorage) PutObject(ctx context.Context, objectName string, data []byte, contentType string) error {
+	if m.PutErrFunc != nil {
+		if err := m.PutErrFunc(objectName); err != nil {
+			return err
+		}
+	}
+	m.objects[objectName] = data
+	return nil
+}
+
+func (m *MockStorage) PresignedURL(ctx context.Context, objectName string, expiry time.Duration) (string, error) {
+	return "https://minio.local/photos/" + objectName + "?expires=" + expiry.String(), nil
+}
+
+func (m *MockStorage) RemoveObject(ctx context.Context, objectName string) error {
+	delete(m.objects, objectName)
+	return nil
+}
+
+func (m *MockStorage) GetObject(ctx context.Context, objectName string) ([]byte, error) {
+	data, ok := m.objects[objectName]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+	return data, nil
+}
+
+// txKey помечает контекст, выполняемый в MockTxManager.WithinTx.
+type txKey struct{}
+
+// MockTxManager — выполняет функцию без транзакции, помечая контекст.
+type MockTxManager struct{}
+
+func (m *MockTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, txKey{}, true))
+}
+
+// addImages — загружает в галерею n фотографий PNG от имени actor.
+func addImages(t *testing.T, svc *Service, actor domain.Actor, propertyID uuid.UUID, n int) []domain.PropertyImage {
+	t.Helper()
+	uploads := make([]domain.PropertyImageUpload, n)
+	for i := range uploads {
+		uploads[i] = domain.PropertyImageUpload{FileName: "photo.png", Data: pngHeader}
+	}
+	images, err := svc.AddImages(context.Background(), actor, propertyID, uploads)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return images
+}
+
+func TestService_AddImages(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+	propID := uuid.New()
+	repo := NewMockRepository()
+	storage := NewMockStorage()
+	props := &MockPropertyService{properties: map[uuid.UUID]domain.Property{propID: {ID: propID, OwnerUserID: owner.UserID}}}
+	svc := New(log, repo, props, storage, nil, &MockTxManager{}, 0)
+
+	addImages(t, svc, owner, propID, 1)
+	images := addImages(t, svc, owner, propID, 2)
+
+	if len(images) != 3 {
+		t.Fatalf("expected 3 images in gallery, got %d", len(images))
+	}
+	if !repo.countedInTx {
+		t.Error("expected gallery limit to be checked inside a transaction")
+	}
+	prefix := "properties/" + propID.String() + "/"
+	for i, img := range images {
+		if img.Position != int32(i) {
+			t.Errorf("image %d: expected position %d, got %d", i, i, img.Position)
+		}
+		if !strings.HasPrefix(img.StoragePath, prefix) || !strings.HasSuffix(img.StoragePath, ".png") {
+			t.Errorf("unexpected storage path %q", img.StoragePath)
+		}
+		if !bytes.Equal(storage.objects[img.StoragePath], pngHeader) {
+			t.Errorf("object %q was not uploaded", img.StoragePath)
+		}
+		if img.ContentType != "image/png" {
+			t.Errorf("expected content type image/png, got %q", img.ContentType)
+		}
+		if !strings.Contains(img.URL, img.StoragePath) || !strings.Contains(img.URL, defaultPresignExpiry.String()) {
+			t.Errorf("expected presigned url for %q, got %q", img.StoragePath, img.URL)
+		}
+	}
+}
+
+func TestService_AddImages_Rejected(t *testing.T) {
+	ctx := context.Background()
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+	propID := uuid.New()
+	storage := NewMockStorage()
+	props := &MockPropertyService{properties: map[uuid.UUID]domain.Property{propID: {ID: propID, OwnerUserID: owner.UserID}}}
+	svc := New(log, NewMockRepository(), props, storage, nil, &MockTxManager{}, 0)
+
+	stranger := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+	png := []domain.PropertyImageUpload{{FileName: "photo.png", Data: pngHeader}}
+
+	if _, err := svc.AddImages(ctx, stranger, propID, png); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied for non-owner, got %v", err)
+	}
+	if _, err := svc.AddImages(ctx, owner, uuid.New(), png); !errors.Is(err, ErrPropertyNotFound) {
+		t.Errorf("expected ErrPropertyNotFound, got %v", err)
+	}
+	text := []domain.PropertyImageUpload{{FileName: "notes.txt", Data: []byte("plain text")}}
+	if _, err := svc.AddImages(ctx, owner, propID, text); !errors.Is(err, ErrInvalidImage) {
+		t.Errorf("expected ErrInvalidImage for text file, got %v", err)
+	}
+	if len(storage.objects) != 0 {
+		t.Errorf("rejected uploads must not reach storage, got %d objects", len(storage.objects))
+	}
+}
+
+func TestService_AddImages_TooMany(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+	propID := uuid.New()
+	repo := NewMockRepository()
+	storage := NewMockStorage()
+	props := &MockPropertyService{properties: map[uuid.UUID]domain.Property{propID: {ID: propID, OwnerUserID: owner.UserID}}}
+	svc := New(log, repo, props, storage, nil, &MockTxManager{}, 0)
+
+	addImages(t, svc, owner, propID, domain.MaxPropertyImages-1)
+
+	uploads := []domain.PropertyImageUpload{
+		{FileName: "a.png", Data: pngHeader},
+		{FileName: "b.png", Data: pngHeader},
+	}
+	if _, err := svc.AddImages(context.Background(), owner, propID, uploads); !errors.Is(err, ErrTooManyImages) {
+		t.Fatalf("expected ErrTooManyImages, got %v", err)
+	}
+	if len(repo.images) != domain.MaxPropertyImages-1 || len(storage.objects) != domain.MaxPropertyImages-1 {
+		t.Errorf("expected rejected uploads to be removed, got %d rows and %d objects", len(repo.images), len(storage.objects))
+	}
+}
+
+func TestService_AddImages_RollsBackUploadedObjects(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+	propID := uuid.New()
+	repo := NewMockRepository()
+	calls := 0
+	storage := NewMockStorage()
+	storage.PutErrFunc = func(string) error {
+		calls++
+		if calls == 2 {
+			return errors.New("minio unavailable")
+		}
+		return nil
+	}
+	props := &MockPropertyService{properties: map[uuid.UUID]domain.Property{propID: {ID: propID, OwnerUserID: owner.UserID}}}
+	svc := New(log, repo, props, storage, nil, &MockTxManager{}, 0)
+
+	uploads := []domain.PropertyImageUpload{
+		{FileName: "a.png", Data: pngHeader},
+		{FileName: "b.png", Data: pngHeader},
+	}
+	if _, err := svc.AddImages(context.Background(), owner, propID, uploads); err == nil {
+		t.Fatal("expected upload error")
+	}
+	if len(storage.objects) != 0 || len(repo.images) != 0 {
+		t.Errorf("expected nothing stored after failed upload, got %d objects and %d rows", len(storage.objects), len(repo.images))
+	}
+}
+
+func TestService_DeleteImage(t *testing.T) {
+	ctx := context.Background()
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+	propID := uuid.New()
+	storage := NewMockStorage()
+	props := &MockPropertyService{properties: map[uuid.UUID]domain.Property{propID: {ID: propID, OwnerUserID: owner.UserID}}}
+	svc := New(log, NewMockRepository(), props, storage, nil, &MockTxManager{}, 0)
+	images := addImages(t, svc, owner, propID, 2)
+
+	if err := svc.DeleteImage(ctx, owner, uuid.New(), images[0].ID); !errors.Is(err, ErrPropertyNotFound) {
+		t.Errorf("expected ErrPropertyNotFound for another property, got %v", err)
+	}
+
+	admin := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleAdmin}
+	if err := svc.DeleteImage(ctx, admin, propID, images[0].ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := storage.objects[images[0].StoragePath]; ok {
+		t.Error("expected object to be removed from storage")
+	}
+	if err := svc.DeleteImage(ctx, owner, propID, images[0].ID); !errors.Is(err, ErrImageNotFound) {
+		t.Errorf("expected ErrImageNotFound on second delete, got %v", err)
+	}
+}
+
+func TestService_ReorderImages(t *testing.T) {
+	ctx := context.Background()
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	owner := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+	propID := uuid.New()
+	props := &MockPropertyService{properties: map[uuid.UUID]domain.Property{propID: {ID: propID, OwnerUserID: owner.UserID}}}
+	svc := New(log, NewMockRepository(), props, NewMockStorage(), nil, &MockTxManager{}, 0)
+	images := addImages(t, svc, owner, propID, 3)
+	a, b, c := images[0].ID, images[1].ID, images[2].ID
+
+	invalid := map[string][]uuid.UUID{
+		"missing image":  {a, b},
+		"duplicate":      {a, a, b},
+		"foreign image":  {a, b, uuid.New()},
+		"extra and dupe": {a, b, c, c},
+	}
+	for name, ids := range invalid {
+		if _, err := svc.ReorderImages(ctx, owner, propID, ids); !errors.Is(err, ErrInvalidImage) {
+			t.Errorf("%s: expected ErrInvalidImage, got %v", name, err)
+		}
+	}
+
+	reordered, err := svc.ReorderImages(ctx, owner, propID, []uuid.UUID{c, a, b})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := []uuid.UUID{reordered[0].ID, reordered[1].ID, reordered[2].ID}
+	if got[0] != c || got[1] != a || got[2] != b {
+		t.Errorf("unexpected order after reorder: %v", got)
+	}
+}
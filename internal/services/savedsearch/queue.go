@@ -0,0 +1,31 @@
+package savedsearch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// IndexQueue — очередь проиндексированных объектов между сервисом объектов и Worker.
+// Хранится в БД (saved_search_pending), поэтому уведомления не теряются при всплеске индексаций
+// и переживают перезапуск. Сервис объектов получает её как слушателя индексации.
+type IndexQueue struct {
+	repo Repository
+}
+
+func NewIndexQueue(repo Repository) *IndexQueue {
+	return &IndexQueue{repo: repo}
+}
+
+// PropertiesIndexed ставит объекты в очередь на обработку. Ошибка возвращается вызывающему,
+// чтобы индексация повторилась и объект не выпал из сохранённых поисков.
+func (q *IndexQueue) PropertiesIndexed(ctx context.Context, propertyIDs ...uuid.UUID) error {
+	const op = "savedsearch.IndexQueue.PropertiesIndexed"
+
+	if err := q.repo.AddPending(ctx, propertyIDs); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package savedsearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/lead"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// defaultMatchesLimit — сколько совпадений отдаётся за один запрос входящих.
+const defaultMatchesLimit = 50
+
+type Repository interface {
+	CreateSavedSearch(ctx context.Context, s domain.SavedSearch) (uuid.UUID, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.SavedSearch, error)
+	ListSavedSearches(ctx context.Context, userID *uuid.UUID) ([]domain.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, id uuid.UUID) error
+	AddMatch(ctx context.Context, m domain.SavedSearchMatch) (bool, error)
+	ListUnseenMatches(ctx context.Context, userID uuid.UUID, searchID *uuid.UUID, limit int) ([]domain.SavedSearchMatch, error)
+	MarkMatchesSeen(ctx context.Context, matchIDs []uuid.UUID) error
+	AddPending(ctx context.Context, propertyIDs []uuid.UUID) error
+	ListPending(ctx context.Context, limit int) ([]domain.SavedSearchPending, error)
+	RemovePending(ctx context.Context, p domain.SavedSearchPending) error
+}
+
+// LeadService нужен для проверки лида при создании поиска.
+type LeadService interface {
+	GetLead(ctx context.Context, id uuid.UUID) (domain.Lead, error)
+}
+
+type Service struct {
+	log         *slog.Logger
+	repo        Repository
+	leadService LeadService
+}
+
+var (
+	ErrSavedSearchNotFound = errors.New("saved search not found")
+	ErrInvalidSavedSearch  = errors.New("invalid saved search")
+	ErrPermissionDenied    = errors.New("permission denied")
+)
+
+func New(log *slog.Logger, repo Repository, leadService LeadService) *Service {
+	return &Service{
+		log:         log,
+		repo:        repo,
+		leadService: leadService,
+	}
+}
+
+// CreateSavedSearch — сохраняет поиск пользователя по лиду или по ad-hoc фильтру.
+// Поиск по лиду может создать только владелец лида или админ: входящие раскрывают подбор по лиду.
+func (s *Service) CreateSavedSearch(ctx context.Context, actor domain.Actor, search domain.SavedSearch) (domain.SavedSearch, error) {
+	const op = "savedsearch.Service.CreateSavedSearch"
+
+	search.UserID = actor.UserID
+
+	if search.LeadID != nil {
+		l, err := s.leadService.GetLead(ctx, *search.LeadID)
+		if err != nil {
+			if errors.Is(err, lead.ErrLeadNotFound) {
+				return domain.SavedSearch{}, fmt.Errorf("%s: lead not found: %w", op, ErrInvalidSavedSearch)
+			}
+			return domain.SavedSearch{}, fmt.Errorf("%s: %w", op, err)
+		}
+		if l.OwnerUserID != actor.UserID && !actor.IsAdmin() {
+			s.log.Warn("saved search denied: lead is not owned",
+				slog.String("lead_id", l.ID.String()),
+				slog.String("user_id", actor.UserID.String()),
+			)
+			return domain.SavedSearch{}, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		}
+	} else if search.Filter == (domain.SavedSearchFilter{}) {
+		return domain.SavedSearch{}, fmt.Errorf("%s: lead_id or filter is required: %w", op, ErrInvalidSavedSearch)
+	}
+
+	if search.MinScore <= 0 {
+		search.MinScore = domain.DefaultSavedSearchMinScore
+	}
+	if search.MinScore > 1 {
+		return domain.SavedSearch{}, fmt.Errorf("%s: min_score must be in (0, 1]: %w", op, ErrInvalidSavedSearch)
+	}
+	if search.Weights != nil {
+		search.Weights = lo.ToPtr(search.Weights.Normalize())
+	}
+
+	id, err := s.repo.CreateSavedSearch(ctx, search)
+	if err != nil {
+		s.log.Error("failed to create saved search", sl.Err(err))
+		return domain.SavedSearch{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	created, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return domain.SavedSearch{}, fmt.Errorf("%s: failed to fetch created search: %w", op, err)
+	}
+
+	s.log.Info("saved search created",
+		slog.String("search_id", id.String()),
+		slog.String("user_id", search.UserID.String()),
+	)
+
+	return created, nil
+}
+
+// ListSavedSearches — возвращает сохранённые поиски пользователя.
+func (s *Service) ListSavedSearches(ctx context.Context, userID uuid.UUID) ([]domain.SavedSearch, error) {
+	const op = "savedsearch.Service.ListSavedSearches"
+
+	searches, err := s.repo.ListSavedSearches(ctx, &userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return searches, nil
+}
+
+// DeleteSavedSearch — удаляет поиск. Удалить может только владелец или админ.
+func (s *Service) DeleteSavedSearch(ctx context.Context, actor domain.Actor, id uuid.UUID) error {
+	const op = "savedsearch.Service.DeleteSavedSearch"
+
+	if _, err := s.getOwned(ctx, actor, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.repo.DeleteSavedSearch(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrSavedSearchNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrSavedSearchNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListSavedSearchMatches — непросмотренные совпадения поисков пользователя.
+// searchID ограничивает выдачу одним поиском; markSeen отмечает выданные совпадения просмотренными.
+func (s *Service) ListSavedSearchMatches(
+	ctx context.Context,
+	actor domain.Actor,
+	searchID *uuid.UUID,
+	limit int,
+	markSeen bool,
+) ([]domain.SavedSearchMatch, error) {
+	const op = "savedsearch.Service.ListSavedSearchMatches"
+
+	userID := actor.UserID
+	if searchID != nil {
+		search, err := s.getOwned(ctx, actor, *searchID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		// Админ читает входящие владельца поиска
+		userID = search.UserID
+	}
+
+	if limit <= 0 {
+		limit = defaultMatchesLimit
+	}
+
+	matches, err := s.repo.ListUnseenMatches(ctx, userID, searchID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if markSeen && len(matches) > 0 {
+		ids := lo.Map(matches, func(m domain.SavedSearchMatch, _ int) uuid.UUID { return m.ID })
+		if err := s.repo.MarkMatchesSeen(ctx, ids); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return matches, nil
+}
+
+// getOwned — поиск, доступный владельцу или админу.
+func (s *Service) getOwned(ctx context.Context, actor domain.Actor, id uuid.UUID) (domain.SavedSearch, error) {
+	search, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrSavedSearchNotFound) {
+			return domain.SavedSearch{}, ErrSavedSearchNotFound
+		}
+		return domain.SavedSearch{}, err
+	}
+
+	if search.UserID != actor.UserID && !actor.IsAdmin() {
+		return domain.SavedSearch{}, ErrPermissionDenied
+	}
+
+	return search, nil
+}
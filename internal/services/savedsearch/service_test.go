@@ -0,0 +1,239 @@
+package savedsearch
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"lead_exchange/internal/services/lead"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MockRepository — хранилище сохранённых поисков и входящих в памяти.
+type MockRepository struct {
+	Searches map[uuid.UUID]domain.SavedSearch
+	Matches  map[uuid.UUID]domain.SavedSearchMatch
+	Pending  map[uuid.UUID]time.Time
+}
+
+func NewMockRepository() *MockRepository {
+	return &MockRepository{
+		Searches: make(map[uuid.UUID]domain.SavedSearch),
+		Matches:  make(map[uuid.UUID]domain.SavedSearchMatch),
+		Pending:  make(map[uuid.UUID]time.Time),
+	}
+}
+
+func (m *MockRepository) CreateSavedSearch(ctx context.Context, s domain.SavedSearch) (uuid.UUID, error) {
+	s.ID = uuid.New()
+	m.Searches[s.ID] = s
+	return s.ID, nil
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.SavedSearch, error) {
+	s, ok := m.Searches[id]
+	if !ok {
+		return domain.SavedSearch{}, repository.ErrSavedSearchNotFound
+	}
+	return s, nil
+}
+
+func (m *MockRepository) ListSavedSearches(ctx context.Context, userID *uuid.UUID) ([]domain.SavedSearch, error) {
+	var result []domain.SavedSearch
+	for _, s := range m.Searches {
+		if userID == nil || s.UserID == *userID {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockRepository) DeleteSavedSearch(ctx context.Context, id uuid.UUID) error {
+	if _, ok := m.Searches[id]; !ok {
+		return repository.ErrSavedSearchNotFound
+	}
+	delete(m.Searches, id)
+	return nil
+}
+
+func (m *MockRepository) AddMatch(ctx context.Context, match domain.SavedSearchMatch) (bool, error) {
+	for _, existing := range m.Matches {
+		if existing.SearchID == match.SearchID && existing.PropertyID == match.PropertyID {
+			return false, nil
+		}
+	}
+	match.ID = uuid.New()
+	m.Matches[match.ID] = match
+	return true, nil
+}
+
+func (m *MockRepository) ListUnseenMatches(ctx context.Context, userID uuid.UUID, searchID *uuid.UUID, limit int) ([]domain.SavedSearchMatch, error) {
+	var result []domain.SavedSearchMatch
+	for _, match := range m.Matches {
+		if match.SeenAt != nil || m.Searches[match.SearchID].UserID != userID {
+			continue
+		}
+		if searchID != nil && match.SearchID != *searchID {
+			continue
+		}
+		result = append(result, match)
+	}
+	return result, nil
+}
+
+func (m *MockRepository) MarkMatchesSeen(ctx context.Context, matchIDs []uuid.UUID) error {
+	for _, id := range matchIDs {
+		match := m.Matches[id]
+		match.SeenAt = lo.ToPtr(match.CreatedAt)
+		m.Matches[id] = match
+	}
+	return nil
+}
+
+func (m *MockRepository) AddPending(ctx context.Context, propertyIDs []uuid.UUID) error {
+	for _, id := range propertyIDs {
+		m.Pending[id] = time.Now()
+	}
+	return nil
+}
+
+func (m *MockRepository) ListPending(ctx context.Context, limit int) ([]domain.SavedSearchPending, error) {
+	var result []domain.SavedSearchPending
+	for id, at := range m.Pending {
+		if len(result) == limit {
+			break
+		}
+		result = append(result, domain.SavedSearchPending{PropertyID: id, IndexedAt: at})
+	}
+	return result, nil
+}
+
+func (m *MockRepository) RemovePending(ctx context.Context, p domain.SavedSearchPending) error {
+	if at, ok := m.Pending[p.PropertyID]; ok && at.Equal(p.IndexedAt) {
+		delete(m.Pending, p.PropertyID)
+	}
+	return nil
+}
+
+// MockLeadService
+type MockLeadService struct {
+	GetLeadFunc func(ctx context.Context, id uuid.UUID) (domain.Lead, error)
+}
+
+func (m *MockLeadService) GetLead(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+	if m.GetLeadFunc != nil {
+		return m.GetLeadFunc(ctx, id)
+	}
+	return domain.Lead{ID: id}, nil
+}
+
+func TestService_CreateSavedSearch(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	userID := uuid.New()
+	actor := domain.Actor{UserID: userID, Role: domain.UserRoleUser}
+
+	t.Run("defaults min score", func(t *testing.T) {
+		svc := New(log, NewMockRepository(), &MockLeadService{})
+
+		created, err := svc.CreateSavedSearch(context.Background(), actor, domain.SavedSearch{
+			Filter: domain.SavedSearchFilter{City: lo.ToPtr("Москва")},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if created.MinScore != domain.DefaultSavedSearchMinScore {
+			t.Errorf("expected min score %v, got %v", domain.DefaultSavedSearchMinScore, created.MinScore)
+		}
+	})
+
+	t.Run("requires lead or filter", func(t *testing.T) {
+		svc := New(log, NewMockRepository(), &MockLeadService{})
+
+		_, err := svc.CreateSavedSearch(context.Background(), actor, domain.SavedSearch{})
+		if !errors.Is(err, ErrInvalidSavedSearch) {
+			t.Errorf("expected ErrInvalidSavedSearch, got %v", err)
+		}
+	})
+
+	t.Run("unknown lead", func(t *testing.T) {
+		svc := New(log, NewMockRepository(), &MockLeadService{
+			GetLeadFunc: func(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+				return domain.Lead{}, lead.ErrLeadNotFound
+			},
+		})
+
+		_, err := svc.CreateSavedSearch(context.Background(), actor, domain.SavedSearch{
+			LeadID: lo.ToPtr(uuid.New()),
+		})
+		if !errors.Is(err, ErrInvalidSavedSearch) {
+			t.Errorf("expected ErrInvalidSavedSearch, got %v", err)
+		}
+	})
+
+	t.Run("lead ownership", func(t *testing.T) {
+		foreign := uuid.New()
+		repo := NewMockRepository()
+		svc := New(log, repo, &MockLeadService{
+			GetLeadFunc: func(ctx context.Context, id uuid.UUID) (domain.Lead, error) {
+				owner := userID
+				if id == foreign {
+					owner = uuid.New()
+				}
+				return domain.Lead{ID: id, OwnerUserID: owner}, nil
+			},
+		})
+
+		if _, err := svc.CreateSavedSearch(context.Background(), actor, domain.SavedSearch{LeadID: lo.ToPtr(uuid.New())}); err != nil {
+			t.Fatalf("owner must be able to search by own lead: %v", err)
+		}
+		if _, err := svc.CreateSavedSearch(context.Background(), actor, domain.SavedSearch{LeadID: &foreign}); !errors.Is(err, ErrPermissionDenied) {
+			t.Errorf("expected ErrPermissionDenied for foreign lead, got %v", err)
+		}
+		admin := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleAdmin}
+		if _, err := svc.CreateSavedSearch(context.Background(), admin, domain.SavedSearch{LeadID: &foreign}); err != nil {
+			t.Errorf("admin must be able to search by any lead: %v", err)
+		}
+		if len(repo.Searches) != 2 {
+			t.Errorf("expected 2 saved searches, got %d", len(repo.Searches))
+		}
+	})
+}
+
+func TestService_ListSavedSearchMatches(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	repo := NewMockRepository()
+	svc := New(log, repo, &MockLeadService{})
+
+	owner := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+	stranger := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleUser}
+
+	searchID, _ := repo.CreateSavedSearch(context.Background(), domain.SavedSearch{UserID: owner.UserID})
+	_, _ = repo.AddMatch(context.Background(), domain.SavedSearchMatch{SearchID: searchID, PropertyID: uuid.New(), Score: 0.8})
+
+	if _, err := svc.ListSavedSearchMatches(context.Background(), stranger, &searchID, 0, true); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied for stranger, got %v", err)
+	}
+
+	matches, err := svc.ListSavedSearchMatches(context.Background(), owner, nil, 0, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("expected 1 unseen match, got %d", len(matches))
+	}
+
+	// Выданные совпадения отмечены просмотренными и повторно не возвращаются
+	matches, err = svc.ListSavedSearchMatches(context.Background(), owner, nil, 0, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matches) != 0 {
+		t.Errorf("expected no unseen matches, got %d", len(matches))
+	}
+}
@@ -0,0 +1,165 @@
+package savedsearch
+
+import (
+	"context"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// PropertyMatcher — оценка объекта для сохранённого поиска, реализуется сервисом объектов.
+type PropertyMatcher interface {
+	GetProperty(ctx context.Context, id uuid.UUID) (domain.Property, error)
+	MatchSavedSearch(ctx context.Context, search domain.SavedSearch, property domain.Property) (domain.MatchedProperty, bool, error)
+}
+
+const (
+	// defaultPollInterval — как часто воркер проверяет очередь, когда она пуста.
+	defaultPollInterval = 5 * time.Second
+	// pendingBatchSize — сколько объектов очереди разбирается за один проход.
+	pendingBatchSize = 100
+)
+
+// Worker прогоняет сохранённые поиски по каждому созданному или переиндексированному объекту
+// и записывает во входящие совпадения со score не ниже порога поиска.
+// Объекты берутся из очереди IndexQueue и удаляются из неё только после обработки.
+type Worker struct {
+	log          *slog.Logger
+	repo         Repository
+	properties   PropertyMatcher
+	pollInterval time.Duration
+}
+
+func NewWorker(log *slog.Logger, repo Repository, properties PropertyMatcher) *Worker {
+	return &Worker{
+		log:          log,
+		repo:         repo,
+		properties:   properties,
+		pollInterval: defaultPollInterval,
+	}
+}
+
+// Run разбирает очередь, пока в ней есть объекты, затем ждёт pollInterval; до отмены контекста.
+func (w *Worker) Run(ctx context.Context) {
+	w.log.Info("saved search worker started")
+
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			processed, err := w.ProcessPending(ctx)
+			if err != nil {
+				w.log.Error("failed to process saved search queue", sl.Err(err))
+				break
+			}
+			if processed == 0 || ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			w.log.Info("saved search worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessPending обрабатывает один пакет очереди и возвращает число обработанных объектов.
+// Объекты, обработка которых не удалась, остаются в очереди до следующего прохода.
+func (w *Worker) ProcessPending(ctx context.Context) (int, error) {
+	const op = "savedsearch.Worker.ProcessPending"
+
+	pending, err := w.repo.ListPending(ctx, pendingBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	processed, failed := 0, 0
+	for _, p := range pending {
+		if err := w.ProcessProperty(ctx, p.PropertyID); err != nil {
+			w.log.Error("failed to process saved searches",
+				slog.String("property_id", p.PropertyID.String()),
+				sl.Err(err),
+			)
+			failed++
+			continue
+		}
+		if err := w.repo.RemovePending(ctx, p); err != nil {
+			return processed, fmt.Errorf("%s: %w", op, err)
+		}
+		processed++
+	}
+
+	if failed > 0 {
+		return processed, fmt.Errorf("%s: %d of %d properties failed, will retry", op, failed, len(pending))
+	}
+
+	return processed, nil
+}
+
+// ProcessProperty прогоняет все сохранённые поиски по одному объекту.
+func (w *Worker) ProcessProperty(ctx context.Context, propertyID uuid.UUID) error {
+	const op = "savedsearch.Worker.ProcessProperty"
+
+	property, err := w.properties.GetProperty(ctx, propertyID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Проданные и удалённые объекты брокерам не интересны
+	if property.Status == domain.PropertyStatusSold || property.Status == domain.PropertyStatusDeleted {
+		return nil
+	}
+
+	searches, err := w.repo.ListSavedSearches(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	added := 0
+	for _, search := range searches {
+		match, ok, err := w.properties.MatchSavedSearch(ctx, search, property)
+		if err != nil {
+			w.log.Warn("failed to evaluate saved search",
+				slog.String("search_id", search.ID.String()),
+				slog.String("property_id", propertyID.String()),
+				sl.Err(err),
+			)
+			continue
+		}
+
+		score := lo.FromPtr(match.TotalScore)
+		if !ok || score < search.MinScore {
+			continue
+		}
+
+		inserted, err := w.repo.AddMatch(ctx, domain.SavedSearchMatch{
+			SearchID:         search.ID,
+			PropertyID:       propertyID,
+			Score:            score,
+			MatchExplanation: match.MatchExplanation,
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if inserted {
+			added++
+		}
+	}
+
+	w.log.Debug("saved searches processed",
+		slog.String("property_id", propertyID.String()),
+		slog.Int("searches", len(searches)),
+		slog.Int("new_matches", added),
+	)
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package savedsearch
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MockPropertyMatcher
+type MockPropertyMatcher struct {
+	GetPropertyFunc      func(ctx context.Context, id uuid.UUID) (domain.Property, error)
+	MatchSavedSearchFunc func(ctx context.Context, search domain.SavedSearch, property domain.Property) (domain.MatchedProperty, bool, error)
+}
+
+func (m *MockPropertyMatcher) GetProperty(ctx context.Context, id uuid.UUID) (domain.Property, error) {
+	if m.GetPropertyFunc != nil {
+		return m.GetPropertyFunc(ctx, id)
+	}
+	return domain.Property{ID: id, Status: domain.PropertyStatusPublished}, nil
+}
+
+func (m *MockPropertyMatcher) MatchSavedSearch(ctx context.Context, search domain.SavedSearch, property domain.Property) (domain.MatchedProperty, bool, error) {
+	if m.MatchSavedSearchFunc != nil {
+		return m.MatchSavedSearchFunc(ctx, search, property)
+	}
+	return domain.MatchedProperty{}, false, nil
+}
+
+func TestWorker_ProcessProperty(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	ctx := context.Background()
+
+	repo := NewMockRepository()
+	strict, _ := repo.CreateSavedSearch(ctx, domain.SavedSearch{UserID: uuid.New(), MinScore: 0.9})
+	loose, _ := repo.CreateSavedSearch(ctx, domain.SavedSearch{UserID: uuid.New(), MinScore: 0.5})
+	filtered, _ := repo.CreateSavedSearch(ctx, domain.SavedSearch{UserID: uuid.New(), MinScore: 0.1})
+	broken, _ := repo.CreateSavedSearch(ctx, domain.SavedSearch{UserID: uuid.New(), MinScore: 0.1})
+
+	matcher := &MockPropertyMatcher{
+		MatchSavedSearchFunc: func(ctx context.Context, search domain.SavedSearch, property domain.Property) (domain.MatchedProperty, bool, error) {
+			switch search.ID {
+			case filtered:
+				// Объект не прошёл жёсткие фильтры
+				return domain.MatchedProperty{}, false, nil
+			case broken:
+				return domain.MatchedProperty{}, false, errors.New("lead has no embedding")
+			}
+			return domain.MatchedProperty{
+				Property:         property,
+				TotalScore:       lo.ToPtr(0.7),
+				MatchExplanation: lo.ToPtr("район подходит"),
+			}, true, nil
+		},
+	}
+	worker := NewWorker(log, repo, matcher)
+
+	propertyID := uuid.New()
+	if err := worker.ProcessProperty(ctx, propertyID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(repo.Matches) != 1 {
+		t.Fatalf("expected 1 match in inbox, got %d", len(repo.Matches))
+	}
+	for _, m := range repo.Matches {
+		if m.SearchID != loose {
+			t.Errorf("expected match for search %s, got %s (strict=%s)", loose, m.SearchID, strict)
+		}
+		if m.PropertyID != propertyID || m.Score != 0.7 {
+			t.Errorf("unexpected match: %+v", m)
+		}
+	}
+
+	// Повторная индексация того же объекта не дублирует запись во входящих
+	if err := worker.ProcessProperty(ctx, propertyID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.Matches) != 1 {
+		t.Errorf("expected inbox to stay at 1 match, got %d", len(repo.Matches))
+	}
+}
+
+func TestWorker_ProcessProperty_SkipsSoldProperty(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	ctx := context.Background()
+
+	repo := NewMockRepository()
+	_, _ = repo.CreateSavedSearch(ctx, domain.SavedSearch{UserID: uuid.New(), MinScore: 0.1})
+
+	called := false
+	matcher := &MockPropertyMatcher{
+		GetPropertyFunc: func(ctx context.Context, id uuid.UUID) (domain.Property, error) {
+			return domain.Property{ID: id, Status: domain.PropertyStatusSold}, nil
+		},
+		MatchSavedSearchFunc: func(ctx context.Context, search domain.SavedSearch, property domain.Property) (domain.MatchedProperty, bool, error) {
+			called = true
+			return domain.MatchedProperty{TotalScore: lo.ToPtr(1.0)}, true, nil
+		},
+	}
+
+	if err := NewWorker(log, repo, matcher).ProcessProperty(ctx, uuid.New()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if called || len(repo.Matches) != 0 {
+		t.Errorf("sold property must not be matched")
+	}
+}
+
+func TestWorker_ProcessPending(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	ctx := context.Background()
+
+	repo := NewMockRepository()
+	search, _ := repo.CreateSavedSearch(ctx, domain.SavedSearch{UserID: uuid.New(), MinScore: 0.5})
+
+	indexed, unavailable := uuid.New(), uuid.New()
+	if err := NewIndexQueue(repo).PropertiesIndexed(ctx, indexed, unavailable); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	matcher := &MockPropertyMatcher{
+		GetPropertyFunc: func(ctx context.Context, id uuid.UUID) (domain.Property, error) {
+			if id == unavailable {
+				return domain.Property{}, errors.New("database is down")
+			}
+			return domain.Property{ID: id, Status: domain.PropertyStatusPublished}, nil
+		},
+		MatchSavedSearchFunc: func(ctx context.Context, s domain.SavedSearch, property domain.Property) (domain.MatchedProperty, bool, error) {
+			return domain.MatchedProperty{Property: property, TotalScore: lo.ToPtr(0.8)}, true, nil
+		},
+	}
+	worker := NewWorker(log, repo, matcher)
+
+	processed, err := worker.ProcessPending(ctx)
+	if err == nil {
+		t.Fatal("expected error for the property that failed")
+	}
+	if processed != 1 {
+		t.Errorf("expected 1 processed property, got %d", processed)
+	}
+	if len(repo.Matches) != 1 {
+		t.Fatalf("expected 1 match in inbox, got %d", len(repo.Matches))
+	}
+	for _, m := range repo.Matches {
+		if m.SearchID != search || m.PropertyID != indexed {
+			t.Errorf("unexpected match: %+v", m)
+		}
+	}
+
+	// Обработанный объект уходит из очереди, неудачный остаётся до следующего прохода
+	if _, ok := repo.Pending[indexed]; ok {
+		t.Error("processed property must leave the queue")
+	}
+	if _, ok := repo.Pending[unavailable]; !ok {
+		t.Error("failed property must stay in the queue")
+	}
+}
+
+func TestWorker_ProcessPending_KeepsReindexedProperty(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	ctx := context.Background()
+
+	repo := NewMockRepository()
+	propertyID := uuid.New()
+	queue := NewIndexQueue(repo)
+	_ = queue.PropertiesIndexed(ctx, propertyID)
+
+	// Объект переиндексировали, пока воркер его обрабатывал
+	matcher := &MockPropertyMatcher{
+		GetPropertyFunc: func(ctx context.Context, id uuid.UUID) (domain.Property, error) {
+			repo.Pending[id] = repo.Pending[id].Add(time.Second)
+			return domain.Property{ID: id, Status: domain.PropertyStatusPublished}, nil
+		},
+	}
+
+	if _, err := NewWorker(log, repo, matcher).ProcessPending(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := repo.Pending[propertyID]; !ok {
+		t.Error("property reindexed during processing must stay in the queue")
+	}
+}
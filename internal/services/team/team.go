@@ -0,0 +1,27 @@
+// Package team — состав команд (агентств) для командной видимости лидов и объектов.
+package team
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// Directory — состав команд, реализуется сервисом агентств.
+type Directory interface {
+	TeamMembers(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// Members — участники команды пользователя, включая его самого. Одно правило для лидов и объектов:
+// пользователь вне агентства — команда из одного себя.
+func Members(ctx context.Context, dir Directory, userID uuid.UUID) ([]uuid.UUID, error) {
+	members, err := dir.TeamMembers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !lo.Contains(members, userID) {
+		members = append(members, userID)
+	}
+	return members, nil
+}
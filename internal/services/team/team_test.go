@@ -0,0 +1,46 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// MockDirectory возвращает заданный состав команды.
+type MockDirectory struct {
+	members []uuid.UUID
+	err     error
+}
+
+func (m *MockDirectory) TeamMembers(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return m.members, m.err
+}
+
+func TestMembers(t *testing.T) {
+	user, colleague := uuid.New(), uuid.New()
+
+	got, err := Members(context.Background(), &MockDirectory{members: []uuid.UUID{user, colleague}}, user)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("expected the whole team, got %v", got)
+	}
+
+	// Пользователь входит в свою команду, даже если справочник его не вернул
+	got, err = Members(context.Background(), &MockDirectory{}, user)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || !lo.Contains(got, user) {
+		t.Errorf("expected the user alone, got %v", got)
+	}
+
+	failure := errors.New("directory is down")
+	if _, err := Members(context.Background(), &MockDirectory{err: failure}, user); !errors.Is(err, failure) {
+		t.Errorf("expected directory error, got %v", err)
+	}
+}
@@ -0,0 +1,195 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/jwt"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/lib/mailer"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Mailer отправляет письма пользователям.
+type Mailer interface {
+	Send(ctx context.Context, msg mailer.Message) error
+}
+
+// UserTokenRepository хранит одноразовые токены из писем (user_tokens).
+type UserTokenRepository interface {
+	CreateUserToken(ctx context.Context, t domain.UserToken) (uuid.UUID, error)
+	GetByHash(ctx context.Context, tokenHash string) (domain.UserToken, error)
+	MarkUsed(ctx context.Context, id uuid.UUID) error
+	InvalidateUserTokens(ctx context.Context, userID uuid.UUID, purpose domain.UserTokenPurpose) error
+}
+
+// RequestPasswordReset — отправляет письмо со ссылкой сброса пароля. Неизвестный email не считается ошибкой,
+// чтобы по ответу нельзя было узнать, зарегистрирован ли адрес. Ранее отправленные ссылки перестают действовать.
+func (s *Service) RequestPasswordReset(ctx context.Context, email string) error {
+	const op = "user.Service.RequestPasswordReset"
+	log := s.log.With(slog.String("op", op), slog.String("email", email))
+
+	user, err := s.repo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			log.Info("password reset requested for unknown email")
+			return nil
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var token string
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.userTokens.InvalidateUserTokens(ctx, user.ID, domain.UserTokenPasswordReset); err != nil {
+			return err
+		}
+		token, err = s.createUserToken(ctx, user.ID, domain.UserTokenPasswordReset, s.accountCfg.PasswordResetTTL)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	msg := mailer.Message{
+		To:      user.Email,
+		Subject: "Сброс пароля",
+		Body: fmt.Sprintf(
+			"Здравствуйте, %s!\n\nЧтобы задать новый пароль, перейдите по ссылке:\n%s\n\nСсылка действует %s. Если вы не запрашивали сброс пароля, просто проигнорируйте это письмо.\n",
+			user.FirstName, s.accountLink("/reset-password", token), s.accountCfg.PasswordResetTTL,
+		),
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		log.Error("failed to send password reset email", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("password reset email sent", slog.String("user_id", user.ID.String()))
+	return nil
+}
+
+// ConfirmPasswordReset — задаёт новый пароль по токену из письма. Все сессии пользователя завершаются.
+// Переход по ссылке из письма подтверждает и email.
+func (s *Service) ConfirmPasswordReset(ctx context.Context, token, newPassword string) error {
+	const op = "user.Service.ConfirmPasswordReset"
+
+	passHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var userID uuid.UUID
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		userID, err = s.useUserToken(ctx, token, domain.UserTokenPasswordReset)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.UpdatePassword(ctx, userID, passHash); err != nil {
+			return err
+		}
+		if err := s.repo.MarkEmailVerified(ctx, userID); err != nil {
+			return err
+		}
+		return s.tokens.RevokeUserTokens(ctx, userID)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log.Info("password reset", slog.String("user_id", userID.String()))
+	return nil
+}
+
+// VerifyEmail — подтверждает email по токену из письма, отправленного при регистрации.
+func (s *Service) VerifyEmail(ctx context.Context, token string) error {
+	const op = "user.Service.VerifyEmail"
+
+	var userID uuid.UUID
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		userID, err = s.useUserToken(ctx, token, domain.UserTokenEmailVerification)
+		if err != nil {
+			return err
+		}
+		return s.repo.MarkEmailVerified(ctx, userID)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log.Info("email verified", slog.String("user_id", userID.String()))
+	return nil
+}
+
+// sendEmailVerification — отправляет письмо со ссылкой подтверждения email.
+func (s *Service) sendEmailVerification(ctx context.Context, user domain.User) error {
+	token, err := s.createUserToken(ctx, user.ID, domain.UserTokenEmailVerification, s.accountCfg.EmailVerificationTTL)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Подтверждение email",
+		Body: fmt.Sprintf(
+			"Здравствуйте, %s!\n\nЧтобы подтвердить email, перейдите по ссылке:\n%s\n\nСсылка действует %s.\n",
+			user.FirstName, s.accountLink("/verify-email", token), s.accountCfg.EmailVerificationTTL,
+		),
+	})
+}
+
+// createUserToken — выпускает одноразовый токен и сохраняет его хэш.
+func (s *Service) createUserToken(ctx context.Context, userID uuid.UUID, purpose domain.UserTokenPurpose, ttl time.Duration) (string, error) {
+	token, err := jwt.NewOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = s.userTokens.CreateUserToken(ctx, domain.UserToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: jwt.HashOpaqueToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// useUserToken — проверяет токен из письма и гасит его; возвращает владельца токена.
+// Должен вызываться в транзакции вместе с действием, которое токен разрешает.
+func (s *Service) useUserToken(ctx context.Context, token string, purpose domain.UserTokenPurpose) (uuid.UUID, error) {
+	stored, err := s.userTokens.GetByHash(ctx, jwt.HashOpaqueToken(token))
+	if err != nil {
+		if errors.Is(err, repository.ErrUserTokenNotFound) {
+			return uuid.Nil, ErrInvalidUserToken
+		}
+		return uuid.Nil, err
+	}
+
+	if stored.Purpose != purpose || stored.UsedAt != nil || !stored.ExpiresAt.After(time.Now()) {
+		return uuid.Nil, ErrInvalidUserToken
+	}
+
+	if err := s.userTokens.MarkUsed(ctx, stored.ID); err != nil {
+		if errors.Is(err, repository.ErrUserTokenNotFound) {
+			return uuid.Nil, ErrInvalidUserToken
+		}
+		return uuid.Nil, err
+	}
+
+	return stored.UserID, nil
+}
+
+// accountLink — ссылка на страницу фронтенда с токеном.
+func (s *Service) accountLink(path, token string) string {
+	return s.accountCfg.LinkBaseURL + path + "?token=" + url.QueryEscape(token)
+}
@@ -0,0 +1,208 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/mailer"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"net/url"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MockMailer запоминает отправленные письма.
+type MockMailer struct {
+	sent []mailer.Message
+}
+
+func (m *MockMailer) Send(ctx context.Context, msg mailer.Message) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// lastToken — токен из ссылки в последнем письме.
+func (m *MockMailer) lastToken(t *testing.T) string {
+	t.Helper()
+	if len(m.sent) == 0 {
+		t.Fatal("expected mail to be sent")
+	}
+	match := regexp.MustCompile(`token=(\S+)`).FindStringSubmatch(m.sent[len(m.sent)-1].Body)
+	if match == nil {
+		t.Fatalf("expected token link in mail, got %q", m.sent[len(m.sent)-1].Body)
+	}
+	token, err := url.QueryUnescape(match[1])
+	if err != nil {
+		t.Fatalf("invalid token in link: %v", err)
+	}
+	return token
+}
+
+// MockUserTokenRepository — одноразовые токены в памяти.
+type MockUserTokenRepository struct {
+	tokens []*domain.UserToken
+}
+
+func (m *MockUserTokenRepository) CreateUserToken(ctx context.Context, t domain.UserToken) (uuid.UUID, error) {
+	t.ID = uuid.New()
+	m.tokens = append(m.tokens, &t)
+	return t.ID, nil
+}
+
+func (m *MockUserTokenRepository) GetByHash(ctx context.Context, tokenHash string) (domain.UserToken, error) {
+	for _, t := range m.tokens {
+		if t.TokenHash == tokenHash {
+			return *t, nil
+		}
+	}
+	return domain.UserToken{}, repository.ErrUserTokenNotFound
+}
+
+func (m *MockUserTokenRepository) MarkUsed(ctx context.Context, id uuid.UUID) error {
+	for _, t := range m.tokens {
+		if t.ID == id && t.UsedAt == nil {
+			now := time.Now()
+			t.UsedAt = &now
+			return nil
+		}
+	}
+	return repository.ErrUserTokenNotFound
+}
+
+func (m *MockUserTokenRepository) InvalidateUserTokens(ctx context.Context, userID uuid.UUID, purpose domain.UserTokenPurpose) error {
+	now := time.Now()
+	for _, t := range m.tokens {
+		if t.UserID == userID && t.Purpose == purpose && t.UsedAt == nil {
+			t.UsedAt = &now
+		}
+	}
+	return nil
+}
+
+func newAccountTestService(t *testing.T, cfg config.AccountConfig) (*Service, *MockUserRepository, *MockMailer, *MockUserTokenRepository, domain.User) {
+	t.Helper()
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	u := newTestUser(t)
+
+	users := &MockUserRepository{users: map[uuid.UUID]domain.User{u.ID: u}}
+	mail := &MockMailer{}
+	userTokens := &MockUserTokenRepository{}
+	cfg.LinkBaseURL = "https://app.example.ru"
+	svc := New(log, users, &MockRefreshTokenRepository{}, userTokens, mail, &MockTxManager{}, time.Hour, 24*time.Hour, "secret", cfg)
+	return svc, users, mail, userTokens, u
+}
+
+func TestService_PasswordReset(t *testing.T) {
+	svc, users, mail, userTokens, u := newAccountTestService(t, config.AccountConfig{PasswordResetTTL: time.Hour})
+	ctx := context.Background()
+
+	_, session, err := svc.Login(ctx, u.Email, testPassword)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := svc.RequestPasswordReset(ctx, u.Email); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	staleToken := mail.lastToken(t)
+	if err := svc.RequestPasswordReset(ctx, u.Email); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	token := mail.lastToken(t)
+
+	if err := svc.ConfirmPasswordReset(ctx, staleToken, "newpassword1"); !errors.Is(err, ErrInvalidUserToken) {
+		t.Errorf("expected earlier reset link to be invalidated, got %v", err)
+	}
+	if err := svc.ConfirmPasswordReset(ctx, token, "newpassword1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.ConfirmPasswordReset(ctx, token, "newpassword2"); !errors.Is(err, ErrInvalidUserToken) {
+		t.Errorf("expected token to be single-use, got %v", err)
+	}
+
+	if _, _, err := svc.Login(ctx, u.Email, testPassword); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("expected old password to stop working, got %v", err)
+	}
+	if _, _, err := svc.Login(ctx, u.Email, "newpassword1"); err != nil {
+		t.Errorf("expected login with new password, got %v", err)
+	}
+	if _, err := svc.Refresh(ctx, session.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("expected sessions to be revoked after reset, got %v", err)
+	}
+	if users.users[u.ID].EmailVerifiedAt == nil {
+		t.Error("expected reset via email to verify it")
+	}
+	for _, stored := range userTokens.tokens {
+		if stored.TokenHash == token || stored.TokenHash == staleToken {
+			t.Error("expected only token hashes to be stored")
+		}
+	}
+}
+
+func TestService_RequestPasswordReset_UnknownEmail(t *testing.T) {
+	svc, _, mail, _, _ := newAccountTestService(t, config.AccountConfig{PasswordResetTTL: time.Hour})
+
+	if err := svc.RequestPasswordReset(context.Background(), "nobody@example.ru"); err != nil {
+		t.Fatalf("expected no error for unknown email, got %v", err)
+	}
+	if len(mail.sent) != 0 {
+		t.Errorf("expected no mail for unknown email, got %d", len(mail.sent))
+	}
+}
+
+func TestService_ConfirmPasswordReset_Expired(t *testing.T) {
+	svc, _, mail, userTokens, u := newAccountTestService(t, config.AccountConfig{PasswordResetTTL: time.Hour})
+	ctx := context.Background()
+
+	if err := svc.RequestPasswordReset(ctx, u.Email); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	userTokens.tokens[0].ExpiresAt = time.Now().Add(-time.Minute)
+
+	if err := svc.ConfirmPasswordReset(ctx, mail.lastToken(t), "newpassword1"); !errors.Is(err, ErrInvalidUserToken) {
+		t.Errorf("expected ErrInvalidUserToken for expired token, got %v", err)
+	}
+}
+
+func TestService_VerifyEmail_RequiredForLogin(t *testing.T) {
+	svc, _, mail, _, _ := newAccountTestService(t, config.AccountConfig{
+		EmailVerificationTTL:     time.Hour,
+		PasswordResetTTL:         time.Hour,
+		RequireEmailVerification: true,
+	})
+	ctx := context.Background()
+
+	const email = "new@example.ru"
+	if _, err := svc.Register(ctx, email, testPassword, "Анна", "Петрова"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mail.sent) != 1 || mail.sent[0].To != email {
+		t.Fatalf("expected verification mail to %s, got %+v", email, mail.sent)
+	}
+	token := mail.lastToken(t)
+
+	if _, _, err := svc.Login(ctx, email, testPassword); !errors.Is(err, ErrEmailNotVerified) {
+		t.Fatalf("expected ErrEmailNotVerified, got %v", err)
+	}
+
+	// Токен сброса пароля не подтверждает email через VerifyEmail
+	if err := svc.RequestPasswordReset(ctx, email); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.VerifyEmail(ctx, mail.lastToken(t)); !errors.Is(err, ErrInvalidUserToken) {
+		t.Errorf("expected token of other purpose to be rejected, got %v", err)
+	}
+
+	if err := svc.VerifyEmail(ctx, token); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := svc.Login(ctx, email, testPassword); err != nil {
+		t.Errorf("expected login after verification, got %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var ErrUnknownDevIdentity = errors.New("unknown dev identity")
+
+// ResolveDevIdentity — пользователь для отладочного токена (DEV_AUTH, только при ENV=local):
+// email любого пользователя (dev:admin@m.c) или роль (dev:admin) — тогда берётся активный пользователь с этой ролью.
+func (s *Service) ResolveDevIdentity(ctx context.Context, identity string) (uuid.UUID, error) {
+	const op = "user.Service.ResolveDevIdentity"
+
+	if strings.Contains(identity, "@") {
+		user, err := s.repo.GetByEmail(ctx, identity)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+		}
+		return user.ID, nil
+	}
+
+	role := domain.UserRole(strings.ToUpper(identity))
+	if role != domain.UserRoleUser && role != domain.UserRoleAdmin {
+		return uuid.Nil, fmt.Errorf("%s: %w: %q", op, ErrUnknownDevIdentity, identity)
+	}
+
+	active := domain.UserStatusActive
+	users, err := s.repo.ListUsers(ctx, domain.UserFilter{
+		Role:       &role,
+		Status:     &active,
+		Pagination: &domain.PaginationParams{PageSize: 1, OrderDirection: domain.OrderAsc},
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(users.Items) == 0 {
+		return uuid.Nil, fmt.Errorf("%s: %w: no active user with role %s", op, ErrUnknownDevIdentity, role)
+	}
+
+	return users.Items[0].ID, nil
+}
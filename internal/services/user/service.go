@@ -10,8 +10,8 @@ import (
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 
+	"lead_exchange/internal/config"
 	"lead_exchange/internal/domain"
-	"lead_exchange/internal/lib/jwt"
 	"lead_exchange/internal/lib/logger/sl"
 	"lead_exchange/internal/repository"
 )
@@ -21,27 +21,72 @@ type UserRepository interface {
 	GetByEmail(ctx context.Context, email string) (domain.User, error)
 	GetByID(ctx context.Context, id uuid.UUID) (domain.User, error)
 	UpdateUser(ctx context.Context, userID uuid.UUID, update domain.UserFilter) error
-	ListUsers(ctx context.Context, filter domain.UserFilter) ([]domain.User, error)
+	ListUsers(ctx context.Context, filter domain.UserFilter) (*domain.PaginatedResult[domain.User], error)
+	UpdatePassword(ctx context.Context, userID uuid.UUID, passwordHash []byte) error
+	MarkEmailVerified(ctx context.Context, userID uuid.UUID) error
+}
+
+// RefreshTokenRepository хранит выданные refresh-токены (refresh_tokens).
+type RefreshTokenRepository interface {
+	CreateRefreshToken(ctx context.Context, t domain.RefreshToken) (uuid.UUID, error)
+	GetByHash(ctx context.Context, tokenHash string) (domain.RefreshToken, error)
+	MarkRotated(ctx context.Context, id, replacedBy uuid.UUID) error
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+	RevokeUserTokens(ctx context.Context, userID uuid.UUID) error
+	IsAccessTokenRevoked(ctx context.Context, jti uuid.UUID) (bool, error)
+}
+
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type Service struct {
-	log      *slog.Logger
-	repo     UserRepository
-	tokenTTL time.Duration
-	secret   string
+	log             *slog.Logger
+	repo            UserRepository
+	tokens          RefreshTokenRepository
+	txManager       TxManager
+	tokenTTL        time.Duration
+	refreshTokenTTL time.Duration
+	secret          string
+
+	// Письма со ссылками сброса пароля и подтверждения email
+	accountCfg config.AccountConfig
+	mailer     Mailer
+	userTokens UserTokenRepository
 }
 
 var (
-	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrUserExists         = errors.New("user already exists")
+	ErrInvalidCredentials  = errors.New("invalid credentials")
+	ErrUserExists          = errors.New("user already exists")
+	ErrUserBanned          = errors.New("user is banned")
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reused")
+	ErrInvalidUserToken    = errors.New("invalid or expired token")
+	ErrEmailNotVerified    = errors.New("email is not verified")
 )
 
-func New(log *slog.Logger, repo UserRepository, tokenTTL time.Duration, secret string) *Service {
+func New(
+	log *slog.Logger,
+	repo UserRepository,
+	tokens RefreshTokenRepository,
+	userTokens UserTokenRepository,
+	mailer Mailer,
+	txManager TxManager,
+	tokenTTL, refreshTokenTTL time.Duration,
+	secret string,
+	accountCfg config.AccountConfig,
+) *Service {
 	return &Service{
-		log:      log,
-		repo:     repo,
-		tokenTTL: tokenTTL,
-		secret:   secret,
+		log:             log,
+		repo:            repo,
+		tokens:          tokens,
+		txManager:       txManager,
+		tokenTTL:        tokenTTL,
+		refreshTokenTTL: refreshTokenTTL,
+		secret:          secret,
+		accountCfg:      accountCfg,
+		mailer:          mailer,
+		userTokens:      userTokens,
 	}
 }
 
@@ -68,11 +113,18 @@ func (s *Service) Register(ctx context.Context, email, password, firstName, last
 	}
 
 	log.Info("user registered successfully", slog.String("user_id", id.String()))
+
+	// Письмо не должно ломать регистрацию: ссылку можно получить заново через сброс пароля
+	user := domain.User{ID: id, Email: email, FirstName: firstName}
+	if err := s.sendEmailVerification(ctx, user); err != nil {
+		log.Error("failed to send email verification", sl.Err(err))
+	}
+
 	return id, nil
 }
 
-// Login — аутентификация пользователя и выдача JWT-токена.
-func (s *Service) Login(ctx context.Context, email, password string) (uuid.UUID, string, error) {
+// Login — аутентификация пользователя и выдача пары токенов. Вход начинает новую цепочку ротации refresh-токенов.
+func (s *Service) Login(ctx context.Context, email, password string) (uuid.UUID, domain.TokenPair, error) {
 	const op = "user.Service.Login"
 	log := s.log.With(slog.String("op", op), slog.String("email", email))
 
@@ -82,25 +134,35 @@ func (s *Service) Login(ctx context.Context, email, password string) (uuid.UUID,
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
 			log.Warn("user not found", sl.Err(err))
-			return uuid.Nil, "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+			return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 		}
 		log.Error("failed to fetch user", sl.Err(err))
-		return uuid.Nil, "", fmt.Errorf("%s: %w", op, err)
+		return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
 	}
 
 	if err := bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(password)); err != nil {
 		log.Info("invalid password", sl.Err(err))
-		return uuid.Nil, "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 	}
 
-	token, err := jwt.NewToken(user, s.secret, s.tokenTTL)
+	if user.Status == domain.UserStatusBanned {
+		log.Warn("banned user tried to login")
+		return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, ErrUserBanned)
+	}
+
+	if s.accountCfg.RequireEmailVerification && user.EmailVerifiedAt == nil {
+		log.Info("email is not verified")
+		return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, ErrEmailNotVerified)
+	}
+
+	tokens, _, err := s.issueTokens(ctx, user, uuid.New())
 	if err != nil {
-		log.Error("failed to generate token", sl.Err(err))
-		return uuid.Nil, "", fmt.Errorf("%s: %w", op, err)
+		log.Error("failed to generate tokens", sl.Err(err))
+		return uuid.Nil, domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
 	}
 
 	log.Info("login successful")
-	return user.ID, token, nil
+	return user.ID, tokens, nil
 }
 
 // GetProfile — возвращает профиль пользователя по ID.
@@ -136,7 +198,7 @@ func (s *Service) UpdateProfile(ctx context.Context, userID uuid.UUID, update do
 }
 
 // ListUsers — возвращает пользователей по фильтру (например, для админа).
-func (s *Service) ListUsers(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+func (s *Service) ListUsers(ctx context.Context, filter domain.UserFilter) (*domain.PaginatedResult[domain.User], error) {
 	return s.repo.ListUsers(ctx, filter)
 }
 
@@ -156,5 +218,12 @@ func (s *Service) UpdateUserStatus(ctx context.Context, userID uuid.UUID, status
 		return domain.User{}, fmt.Errorf("%s: %w", op, err)
 	}
 
+	// Заблокированный пользователь теряет все сессии; его access-токены отсекает JWT-интерсептор
+	if status == domain.UserStatusBanned {
+		if err := s.tokens.RevokeUserTokens(ctx, userID); err != nil {
+			return domain.User{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
 	return s.repo.GetByID(ctx, userID)
 }
@@ -0,0 +1,287 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/config"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MockUserRepository — пользователи в памяти.
+type MockUserRepository struct {
+	users map[uuid.UUID]domain.User
+}
+
+func (m *MockUserRepository) CreateUser(ctx context.Context, email, firstName, lastName string, passwordHash []byte) (uuid.UUID, error) {
+	id := uuid.New()
+	m.users[id] = domain.User{ID: id, Email: email, FirstName: firstName, LastName: lastName, PasswordHash: passwordHash, Status: domain.UserStatusActive}
+	return id, nil
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (domain.User, error) {
+	for _, u := range m.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return domain.User{}, repository.ErrUserNotFound
+}
+
+func (m *MockUserRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.User, error) {
+	u, ok := m.users[id]
+	if !ok {
+		return domain.User{}, repository.ErrUserNotFound
+	}
+	return u, nil
+}
+
+func (m *MockUserRepository) UpdateUser(ctx context.Context, userID uuid.UUID, update domain.UserFilter) error {
+	u, ok := m.users[userID]
+	if !ok {
+		return repository.ErrUserNotFound
+	}
+	if update.Status != nil {
+		u.Status = *update.Status
+	}
+	m.users[userID] = u
+	return nil
+}
+
+func (m *MockUserRepository) UpdatePassword(ctx context.Context, userID uuid.UUID, passwordHash []byte) error {
+	u, ok := m.users[userID]
+	if !ok {
+		return repository.ErrUserNotFound
+	}
+	u.PasswordHash = passwordHash
+	m.users[userID] = u
+	return nil
+}
+
+func (m *MockUserRepository) MarkEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	u, ok := m.users[userID]
+	if !ok {
+		return repository.ErrUserNotFound
+	}
+	if u.EmailVerifiedAt == nil {
+		now := time.Now()
+		u.EmailVerifiedAt = &now
+	}
+	m.users[userID] = u
+	return nil
+}
+
+func (m *MockUserRepository) ListUsers(ctx context.Context, filter domain.UserFilter) (*domain.PaginatedResult[domain.User], error) {
+	return &domain.PaginatedResult[domain.User]{}, nil
+}
+
+// MockRefreshTokenRepository — refresh-токены в памяти.
+type MockRefreshTokenRepository struct {
+	tokens []*domain.RefreshToken
+}
+
+func (m *MockRefreshTokenRepository) CreateRefreshToken(ctx context.Context, t domain.RefreshToken) (uuid.UUID, error) {
+	t.ID = uuid.New()
+	m.tokens = append(m.tokens, &t)
+	return t.ID, nil
+}
+
+func (m *MockRefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (domain.RefreshToken, error) {
+	for _, t := range m.tokens {
+		if t.TokenHash == tokenHash {
+			return *t, nil
+		}
+	}
+	return domain.RefreshToken{}, repository.ErrRefreshTokenNotFound
+}
+
+func (m *MockRefreshTokenRepository) MarkRotated(ctx context.Context, id, replacedBy uuid.UUID) error {
+	for _, t := range m.tokens {
+		if t.ID == id {
+			t.ReplacedBy = &replacedBy
+			return nil
+		}
+	}
+	return repository.ErrRefreshTokenNotFound
+}
+
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	now := time.Now()
+	for _, t := range m.tokens {
+		if t.FamilyID == familyID && t.RevokedAt == nil {
+			t.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+func (m *MockRefreshTokenRepository) RevokeUserTokens(ctx context.Context, userID uuid.UUID) error {
+	now := time.Now()
+	for _, t := range m.tokens {
+		if t.UserID == userID && t.RevokedAt == nil {
+			t.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+func (m *MockRefreshTokenRepository) IsAccessTokenRevoked(ctx context.Context, jti uuid.UUID) (bool, error) {
+	for _, t := range m.tokens {
+		if t.AccessJTI == jti && t.RevokedAt != nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MockTxManager выполняет функцию без транзакции.
+type MockTxManager struct{}
+
+func (m *MockTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+const testPassword = "password123"
+
+// newTestUser — активный пользователь с паролем testPassword.
+func newTestUser(t *testing.T) domain.User {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	return domain.User{ID: uuid.New(), Email: "broker@example.ru", PasswordHash: hash, Role: domain.UserRoleUser, Status: domain.UserStatusActive}
+}
+
+func newTestService(t *testing.T) (*Service, *MockUserRepository, *MockRefreshTokenRepository, domain.User) {
+	t.Helper()
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	u := newTestUser(t)
+
+	users := &MockUserRepository{users: map[uuid.UUID]domain.User{u.ID: u}}
+	tokens := &MockRefreshTokenRepository{}
+	svc := New(log, users, tokens, &MockUserTokenRepository{}, &MockMailer{}, &MockTxManager{}, time.Hour, 24*time.Hour, "secret", config.AccountConfig{})
+	return svc, users, tokens, u
+}
+
+func TestService_Refresh_Rotation(t *testing.T) {
+	svc, _, tokens, u := newTestService(t)
+	ctx := context.Background()
+
+	_, first, err := svc.Login(ctx, u.Email, testPassword)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.AccessToken == "" || first.RefreshToken == "" {
+		t.Fatalf("expected both tokens to be issued, got %+v", first)
+	}
+
+	second, err := svc.Refresh(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Error("expected refresh token to be rotated")
+	}
+	if len(tokens.tokens) != 2 || tokens.tokens[0].ReplacedBy == nil || *tokens.tokens[0].ReplacedBy != tokens.tokens[1].ID {
+		t.Fatalf("expected old token to be replaced by the new one")
+	}
+	if tokens.tokens[0].FamilyID != tokens.tokens[1].FamilyID {
+		t.Error("expected rotated token to stay in the same family")
+	}
+
+	if _, err := svc.Refresh(ctx, second.RefreshToken); err != nil {
+		t.Fatalf("expected rotated token to be accepted, got %v", err)
+	}
+}
+
+func TestService_Refresh_ReuseRevokesFamily(t *testing.T) {
+	svc, _, tokens, u := newTestService(t)
+	ctx := context.Background()
+
+	_, first, err := svc.Login(ctx, u.Email, testPassword)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := svc.Refresh(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Украденный старый токен предъявлен повторно
+	if _, err := svc.Refresh(ctx, first.RefreshToken); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("expected ErrRefreshTokenReused, got %v", err)
+	}
+	for _, tok := range tokens.tokens {
+		if tok.RevokedAt == nil {
+			t.Errorf("expected token %s of the family to be revoked", tok.ID)
+		}
+	}
+
+	if _, err := svc.Refresh(ctx, second.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("expected legitimate token to be revoked too, got %v", err)
+	}
+	if _, revoked, _ := svc.CheckAccessToken(ctx, u.ID, tokens.tokens[1].AccessJTI); !revoked {
+		t.Error("expected access token of revoked family to be rejected")
+	}
+}
+
+func TestService_Logout(t *testing.T) {
+	svc, _, tokens, u := newTestService(t)
+	ctx := context.Background()
+
+	_, pair, err := svc.Login(ctx, u.Email, testPassword)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if role, revoked, err := svc.CheckAccessToken(ctx, u.ID, tokens.tokens[0].AccessJTI); err != nil || revoked || role != u.Role {
+		t.Fatalf("expected fresh access token to be valid, role=%s revoked=%v err=%v", role, revoked, err)
+	}
+
+	if err := svc.Logout(ctx, pair.RefreshToken); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.Refresh(ctx, pair.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken after logout, got %v", err)
+	}
+	if _, revoked, _ := svc.CheckAccessToken(ctx, u.ID, tokens.tokens[0].AccessJTI); !revoked {
+		t.Error("expected access token to be revoked after logout")
+	}
+	if err := svc.Logout(ctx, "unknown"); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken for unknown token, got %v", err)
+	}
+}
+
+func TestService_UpdateUserStatus_BanRevokesTokens(t *testing.T) {
+	svc, _, tokens, u := newTestService(t)
+	ctx := context.Background()
+
+	_, pair, err := svc.Login(ctx, u.Email, testPassword)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.UpdateUserStatus(ctx, u.ID, domain.UserStatusBanned); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if tokens.tokens[0].RevokedAt == nil {
+		t.Error("expected refresh tokens to be revoked on ban")
+	}
+	if _, revoked, _ := svc.CheckAccessToken(ctx, u.ID, uuid.Nil); !revoked {
+		t.Error("expected tokens of banned user to be rejected")
+	}
+	if _, err := svc.Refresh(ctx, pair.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("expected ErrInvalidRefreshToken, got %v", err)
+	}
+	if _, _, err := svc.Login(ctx, u.Email, testPassword); !errors.Is(err, ErrUserBanned) {
+		t.Errorf("expected ErrUserBanned on login, got %v", err)
+	}
+}
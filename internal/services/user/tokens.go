@@ -0,0 +1,156 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/jwt"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Refresh — обменивает refresh-токен на новую пару токенов (ротация).
+// Повторное предъявление уже заменённого токена означает его утечку: вся цепочка
+// отзывается, и обоим владельцам токенов придётся войти заново.
+func (s *Service) Refresh(ctx context.Context, refreshToken string) (domain.TokenPair, error) {
+	const op = "user.Service.Refresh"
+	log := s.log.With(slog.String("op", op))
+
+	var tokens domain.TokenPair
+	// rejectErr — отказ, при котором отзыв токенов всё равно должен закоммититься
+	var rejectErr error
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		stored, err := s.tokens.GetByHash(ctx, jwt.HashOpaqueToken(refreshToken))
+		if err != nil {
+			if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+				return ErrInvalidRefreshToken
+			}
+			return err
+		}
+
+		if stored.RevokedAt != nil || !stored.ExpiresAt.After(time.Now()) {
+			return ErrInvalidRefreshToken
+		}
+
+		if stored.ReplacedBy != nil {
+			log.Warn("refresh token reuse detected, revoking token family",
+				slog.String("user_id", stored.UserID.String()),
+				slog.String("family_id", stored.FamilyID.String()),
+			)
+			rejectErr = ErrRefreshTokenReused
+			return s.tokens.RevokeFamily(ctx, stored.FamilyID)
+		}
+
+		user, err := s.repo.GetByID(ctx, stored.UserID)
+		if err != nil {
+			return err
+		}
+		if user.Status == domain.UserStatusBanned {
+			rejectErr = ErrUserBanned
+			return s.tokens.RevokeUserTokens(ctx, user.ID)
+		}
+
+		var newID uuid.UUID
+		tokens, newID, err = s.issueTokens(ctx, user, stored.FamilyID)
+		if err != nil {
+			return err
+		}
+		return s.tokens.MarkRotated(ctx, stored.ID, newID)
+	})
+	if err == nil {
+		err = rejectErr
+	}
+	if err != nil {
+		if !errors.Is(err, ErrInvalidRefreshToken) && !errors.Is(err, ErrRefreshTokenReused) && !errors.Is(err, ErrUserBanned) {
+			log.Error("failed to refresh tokens", sl.Err(err))
+		}
+		return domain.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tokens, nil
+}
+
+// Logout — отзывает цепочку refresh-токенов, начатую входом, и access-токены, выданные в ней.
+// Повторный выход с тем же токеном не считается ошибкой.
+func (s *Service) Logout(ctx context.Context, refreshToken string) error {
+	const op = "user.Service.Logout"
+
+	stored, err := s.tokens.GetByHash(ctx, jwt.HashOpaqueToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.tokens.RevokeFamily(ctx, stored.FamilyID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log.Info("user logged out",
+		slog.String("user_id", stored.UserID.String()),
+		slog.String("family_id", stored.FamilyID.String()),
+	)
+	return nil
+}
+
+// CheckAccessToken — проверка access-токена для JWT-интерсептора: токен отозван,
+// если пользователь заблокирован или сессия, в которой выдан токен, завершена.
+// Возвращает текущую роль пользователя из той же записи, чтобы не читать её повторно.
+func (s *Service) CheckAccessToken(ctx context.Context, userID uuid.UUID, jti uuid.UUID) (domain.UserRole, bool, error) {
+	const op = "user.Service.CheckAccessToken"
+
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		return domain.UserRoleUnspecified, false, fmt.Errorf("%s: %w", op, err)
+	}
+	if user.Status == domain.UserStatusBanned {
+		return user.Role, true, nil
+	}
+
+	if jti == uuid.Nil {
+		return user.Role, false, nil
+	}
+	revoked, err := s.tokens.IsAccessTokenRevoked(ctx, jti)
+	if err != nil {
+		return domain.UserRoleUnspecified, false, fmt.Errorf("%s: %w", op, err)
+	}
+	return user.Role, revoked, nil
+}
+
+// issueTokens — выпускает access-токен и refresh-токен в цепочке familyID; возвращает и ID сохранённого refresh-токена.
+func (s *Service) issueTokens(ctx context.Context, user domain.User, familyID uuid.UUID) (domain.TokenPair, uuid.UUID, error) {
+	jti := uuid.New()
+	accessToken, err := jwt.NewToken(user, jti, s.secret, s.tokenTTL)
+	if err != nil {
+		return domain.TokenPair{}, uuid.Nil, err
+	}
+
+	refreshToken, err := jwt.NewOpaqueToken()
+	if err != nil {
+		return domain.TokenPair{}, uuid.Nil, err
+	}
+
+	now := time.Now()
+	id, err := s.tokens.CreateRefreshToken(ctx, domain.RefreshToken{
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: jwt.HashOpaqueToken(refreshToken),
+		AccessJTI: jti,
+		ExpiresAt: now.Add(s.refreshTokenTTL),
+	})
+	if err != nil {
+		return domain.TokenPair{}, uuid.Nil, err
+	}
+
+	return domain.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresAt:    now.Add(s.tokenTTL),
+	}, id, nil
+}
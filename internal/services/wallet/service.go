@@ -0,0 +1,323 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/lib/logger/sl"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Repository — счета, операции и проводки кошелька.
+type Repository interface {
+	GetOrCreateAccount(ctx context.Context, userID uuid.UUID, kind domain.WalletAccountKind) (domain.WalletAccount, error)
+	GetSystemAccount(ctx context.Context, kind domain.WalletAccountKind) (domain.WalletAccount, error)
+	ListAccountsByUser(ctx context.Context, userID uuid.UUID) ([]domain.WalletAccount, error)
+	PostTransaction(ctx context.Context, t domain.LedgerTransaction) (domain.LedgerTransaction, error)
+	GetDealTransaction(ctx context.Context, dealID uuid.UUID, kind domain.LedgerTransactionKind) (domain.LedgerTransaction, error)
+	ListStatement(ctx context.Context, filter domain.WalletStatementFilter) (*domain.PaginatedResult[domain.WalletStatementEntry], error)
+}
+
+// UserRepository — проверка существования владельца кошелька.
+type UserRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (domain.User, error)
+}
+
+// TxManager — выполняет функцию в одной транзакции БД.
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+var (
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrInvalidAmount     = errors.New("amount must be positive")
+	ErrUserNotFound      = errors.New("user not found")
+	ErrPermissionDenied  = errors.New("permission denied")
+)
+
+// Service — кредитный кошелёк пользователей: пополнение и вывод админом,
+// блокировка средств покупателя под сделку и расчёт по ней.
+type Service struct {
+	log       *slog.Logger
+	repo      Repository
+	users     UserRepository
+	txManager TxManager
+}
+
+func New(log *slog.Logger, repo Repository, users UserRepository, txManager TxManager) *Service {
+	return &Service{
+		log:       log,
+		repo:      repo,
+		users:     users,
+		txManager: txManager,
+	}
+}
+
+// GetBalance — остатки пользователя. Чужой кошелёк может смотреть только админ.
+func (s *Service) GetBalance(ctx context.Context, actor domain.Actor, userID uuid.UUID) (domain.WalletBalance, error) {
+	const op = "wallet.Service.GetBalance"
+
+	if userID != actor.UserID && !actor.IsAdmin() {
+		return domain.WalletBalance{}, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	balance, err := s.balance(ctx, userID)
+	if err != nil {
+		return domain.WalletBalance{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return balance, nil
+}
+
+// ListStatement — выписка по счетам пользователя. Чужую выписку может смотреть только админ.
+func (s *Service) ListStatement(ctx context.Context, actor domain.Actor, filter domain.WalletStatementFilter) (*domain.PaginatedResult[domain.WalletStatementEntry], error) {
+	const op = "wallet.Service.ListStatement"
+
+	if filter.UserID != actor.UserID && !actor.IsAdmin() {
+		return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	result, err := s.repo.ListStatement(ctx, filter)
+	if err != nil {
+		s.log.Error("failed to list wallet statement", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// TopUp — зачисление кредитов пользователю (только админ).
+func (s *Service) TopUp(ctx context.Context, actor domain.Actor, userID uuid.UUID, amount int64, comment string) (domain.LedgerTransaction, error) {
+	const op = "wallet.Service.TopUp"
+	return s.adjust(ctx, op, actor, userID, amount, comment, domain.LedgerTopUp)
+}
+
+// Withdraw — списание свободных кредитов пользователя (только админ).
+func (s *Service) Withdraw(ctx context.Context, actor domain.Actor, userID uuid.UUID, amount int64, comment string) (domain.LedgerTransaction, error) {
+	const op = "wallet.Service.Withdraw"
+	return s.adjust(ctx, op, actor, userID, amount, comment, domain.LedgerWithdrawal)
+}
+
+// adjust — пополнение или вывод: проводка между счётом AVAILABLE пользователя и системным EXTERNAL.
+func (s *Service) adjust(ctx context.Context, op string, actor domain.Actor, userID uuid.UUID, amount int64, comment string, kind domain.LedgerTransactionKind) (domain.LedgerTransaction, error) {
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user_id", userID.String()),
+		slog.Int64("amount", amount),
+	)
+
+	if !actor.IsAdmin() {
+		return domain.LedgerTransaction{}, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+	if amount <= 0 {
+		return domain.LedgerTransaction{}, fmt.Errorf("%s: %w", op, ErrInvalidAmount)
+	}
+	if _, err := s.users.GetByID(ctx, userID); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return domain.LedgerTransaction{}, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+		return domain.LedgerTransaction{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var posted domain.LedgerTransaction
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		account, err := s.repo.GetOrCreateAccount(ctx, userID, domain.WalletAccountAvailable)
+		if err != nil {
+			return err
+		}
+		external, err := s.repo.GetSystemAccount(ctx, domain.WalletAccountExternal)
+		if err != nil {
+			return err
+		}
+
+		credit, debit := account.ID, external.ID
+		if kind == domain.LedgerWithdrawal {
+			credit, debit = external.ID, account.ID
+		}
+
+		posted, err = s.post(ctx, domain.LedgerTransaction{
+			Kind:      kind,
+			CreatedBy: &actor.UserID,
+			Comment:   strings.TrimSpace(comment),
+		}, debit, credit, amount)
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, ErrInsufficientFunds) {
+			log.Error("failed to post wallet transaction", sl.Err(err))
+		}
+		return domain.LedgerTransaction{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("wallet transaction posted",
+		slog.String("kind", kind.String()),
+		slog.String("transaction_id", posted.ID.String()),
+		slog.String("admin_id", actor.UserID.String()),
+	)
+	return posted, nil
+}
+
+// HoldDealFunds — блокирует цену сделки на счёте покупателя при принятии.
+// Вызывается внутри транзакции смены статуса сделки; при нехватке средств возвращает ErrInsufficientFunds.
+func (s *Service) HoldDealFunds(ctx context.Context, deal domain.Deal, buyerUserID uuid.UUID) error {
+	const op = "wallet.Service.HoldDealFunds"
+
+	amount := int64(math.Round(deal.Price))
+	if amount <= 0 {
+		return nil
+	}
+
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		available, err := s.repo.GetOrCreateAccount(ctx, buyerUserID, domain.WalletAccountAvailable)
+		if err != nil {
+			return err
+		}
+		held, err := s.repo.GetOrCreateAccount(ctx, buyerUserID, domain.WalletAccountHeld)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.post(ctx, domain.LedgerTransaction{
+			Kind:   domain.LedgerDealHold,
+			DealID: &deal.ID,
+		}, available.ID, held.ID, amount)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, ErrInsufficientFunds) {
+			s.log.Warn("not enough credits to accept deal",
+				slog.String("deal_id", deal.ID.String()),
+				slog.String("buyer_id", buyerUserID.String()),
+				slog.Int64("amount", amount),
+			)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ReleaseDealFunds — переводит заблокированные по сделке средства продавцу при завершении.
+func (s *Service) ReleaseDealFunds(ctx context.Context, deal domain.Deal) error {
+	const op = "wallet.Service.ReleaseDealFunds"
+
+	err := s.settle(ctx, deal, domain.LedgerDealRelease, func(ctx context.Context, _ uuid.UUID) (uuid.UUID, error) {
+		seller, err := s.repo.GetOrCreateAccount(ctx, deal.SellerUserID, domain.WalletAccountAvailable)
+		return seller.ID, err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RefundDealFunds — возвращает заблокированные по сделке средства покупателю при отмене или отклонении.
+func (s *Service) RefundDealFunds(ctx context.Context, deal domain.Deal) error {
+	const op = "wallet.Service.RefundDealFunds"
+
+	err := s.settle(ctx, deal, domain.LedgerDealRefund, func(ctx context.Context, source uuid.UUID) (uuid.UUID, error) {
+		// Средства возвращаются на счёт, с которого были заблокированы
+		return source, nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// settle — переводит сумму блокировки со счёта HELD на счёт, выбранный target
+// по счёту, с которого средства блокировались. Сделки без блокировки (бесплатные
+// или принятые до появления кошелька) пропускаются.
+func (s *Service) settle(ctx context.Context, deal domain.Deal, kind domain.LedgerTransactionKind,
+	target func(ctx context.Context, source uuid.UUID) (uuid.UUID, error),
+) error {
+	return s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		hold, err := s.repo.GetDealTransaction(ctx, deal.ID, domain.LedgerDealHold)
+		if err != nil {
+			if errors.Is(err, repository.ErrLedgerTransactionNotFound) {
+				s.log.Info("deal has no held funds, nothing to settle",
+					slog.String("deal_id", deal.ID.String()),
+					slog.String("kind", kind.String()),
+				)
+				return nil
+			}
+			return err
+		}
+		source, held, amount, err := holdAccounts(hold)
+		if err != nil {
+			return err
+		}
+
+		credit, err := target(ctx, source)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.post(ctx, domain.LedgerTransaction{
+			Kind:   kind,
+			DealID: &deal.ID,
+		}, held, credit, amount)
+		return err
+	})
+}
+
+// holdAccounts — счёт AVAILABLE, с которого заблокированы средства, счёт HELD и сумма блокировки.
+func holdAccounts(hold domain.LedgerTransaction) (source, held uuid.UUID, amount int64, err error) {
+	if len(hold.Entries) != 2 {
+		return uuid.Nil, uuid.Nil, 0, fmt.Errorf("hold %s has %d entries, expected 2", hold.ID, len(hold.Entries))
+	}
+	for _, e := range hold.Entries {
+		if e.Amount < 0 {
+			source = e.AccountID
+		} else {
+			held, amount = e.AccountID, e.Amount
+		}
+	}
+	return source, held, amount, nil
+}
+
+// post — проводит перевод amount со счёта debit на счёт credit.
+func (s *Service) post(ctx context.Context, t domain.LedgerTransaction, debit, credit uuid.UUID, amount int64) (domain.LedgerTransaction, error) {
+	t.Entries = []domain.LedgerEntry{
+		{AccountID: debit, Amount: -amount},
+		{AccountID: credit, Amount: amount},
+	}
+
+	posted, err := s.repo.PostTransaction(ctx, t)
+	if err != nil {
+		if errors.Is(err, repository.ErrInsufficientFunds) {
+			return domain.LedgerTransaction{}, ErrInsufficientFunds
+		}
+		return domain.LedgerTransaction{}, err
+	}
+
+	return posted, nil
+}
+
+// balance — остатки по существующим счетам пользователя; счетов может ещё не быть.
+func (s *Service) balance(ctx context.Context, userID uuid.UUID) (domain.WalletBalance, error) {
+	accounts, err := s.repo.ListAccountsByUser(ctx, userID)
+	if err != nil {
+		return domain.WalletBalance{}, err
+	}
+
+	balance := domain.WalletBalance{UserID: userID}
+	for _, a := range accounts {
+		switch a.Kind {
+		case domain.WalletAccountAvailable:
+			balance.Available = a.Balance
+		case domain.WalletAccountHeld:
+			balance.Held = a.Balance
+		}
+	}
+
+	return balance, nil
+}
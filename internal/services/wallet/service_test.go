@@ -0,0 +1,277 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"lead_exchange/internal/domain"
+	"lead_exchange/internal/repository"
+	"log/slog"
+	"maps"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MockRepository — счета и журнал в памяти с проверкой остатков, как в PostTransaction.
+type MockRepository struct {
+	accounts     map[uuid.UUID]domain.WalletAccount
+	transactions []domain.LedgerTransaction
+}
+
+func NewMockRepository() *MockRepository {
+	external := domain.WalletAccount{ID: uuid.New(), Kind: domain.WalletAccountExternal}
+	return &MockRepository{accounts: map[uuid.UUID]domain.WalletAccount{external.ID: external}}
+}
+
+func (m *MockRepository) GetOrCreateAccount(ctx context.Context, userID uuid.UUID, kind domain.WalletAccountKind) (domain.WalletAccount, error) {
+	for _, a := range m.accounts {
+		if a.UserID != nil && *a.UserID == userID && a.Kind == kind {
+			return a, nil
+		}
+	}
+	a := domain.WalletAccount{ID: uuid.New(), UserID: &userID, Kind: kind}
+	m.accounts[a.ID] = a
+	return a, nil
+}
+
+func (m *MockRepository) GetSystemAccount(ctx context.Context, kind domain.WalletAccountKind) (domain.WalletAccount, error) {
+	for _, a := range m.accounts {
+		if a.UserID == nil && a.Kind == kind {
+			return a, nil
+		}
+	}
+	return domain.WalletAccount{}, repository.ErrWalletAccountNotFound
+}
+
+func (m *MockRepository) ListAccountsByUser(ctx context.Context, userID uuid.UUID) ([]domain.WalletAccount, error) {
+	var out []domain.WalletAccount
+	for _, a := range m.accounts {
+		if a.UserID != nil && *a.UserID == userID {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (m *MockRepository) PostTransaction(ctx context.Context, t domain.LedgerTransaction) (domain.LedgerTransaction, error) {
+	if !t.IsBalanced() {
+		return domain.LedgerTransaction{}, errors.New("entries are not balanced")
+	}
+	if t.DealID != nil {
+		if _, err := m.GetDealTransaction(ctx, *t.DealID, t.Kind); err == nil {
+			return domain.LedgerTransaction{}, repository.ErrLedgerTransactionExists
+		}
+	}
+
+	accounts := maps.Clone(m.accounts)
+	for i, e := range t.Entries {
+		a := accounts[e.AccountID]
+		a.Balance += e.Amount
+		if a.Kind != domain.WalletAccountExternal && a.Balance < 0 {
+			return domain.LedgerTransaction{}, repository.ErrInsufficientFunds
+		}
+		accounts[e.AccountID] = a
+		t.Entries[i].BalanceAfter = a.Balance
+	}
+	m.accounts = accounts
+
+	t.ID = uuid.New()
+	t.CreatedAt = time.Now()
+	m.transactions = append(m.transactions, t)
+	return t, nil
+}
+
+func (m *MockRepository) GetDealTransaction(ctx context.Context, dealID uuid.UUID, kind domain.LedgerTransactionKind) (domain.LedgerTransaction, error) {
+	for _, t := range m.transactions {
+		if t.DealID != nil && *t.DealID == dealID && t.Kind == kind {
+			return t, nil
+		}
+	}
+	return domain.LedgerTransaction{}, repository.ErrLedgerTransactionNotFound
+}
+
+func (m *MockRepository) ListStatement(ctx context.Context, filter domain.WalletStatementFilter) (*domain.PaginatedResult[domain.WalletStatementEntry], error) {
+	return &domain.PaginatedResult[domain.WalletStatementEntry]{}, nil
+}
+
+// MockUsers — известные пользователи.
+type MockUsers map[uuid.UUID]bool
+
+func (m MockUsers) GetByID(ctx context.Context, id uuid.UUID) (domain.User, error) {
+	if !m[id] {
+		return domain.User{}, repository.ErrUserNotFound
+	}
+	return domain.User{ID: id}, nil
+}
+
+// MockTxManager выполняет функцию без транзакции.
+type MockTxManager struct{}
+
+func (m *MockTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// balance — остатки пользователя глазами админа.
+func balance(t *testing.T, svc *Service, userID uuid.UUID) domain.WalletBalance {
+	t.Helper()
+	admin := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleAdmin}
+	b, err := svc.GetBalance(context.Background(), admin, userID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return b
+}
+
+func TestService_TopUpAndWithdraw(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	admin := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleAdmin}
+	buyer, seller := uuid.New(), uuid.New()
+	repo := NewMockRepository()
+	svc := New(log, repo, MockUsers{buyer: true, seller: true}, &MockTxManager{})
+	ctx := context.Background()
+	user := domain.Actor{UserID: buyer, Role: domain.UserRoleUser}
+
+	if _, err := svc.TopUp(ctx, user, buyer, 100, ""); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied, got %v", err)
+	}
+	if _, err := svc.TopUp(ctx, admin, buyer, 0, ""); !errors.Is(err, ErrInvalidAmount) {
+		t.Fatalf("expected ErrInvalidAmount, got %v", err)
+	}
+	if _, err := svc.TopUp(ctx, admin, uuid.New(), 100, ""); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+
+	tx, err := svc.TopUp(ctx, admin, buyer, 1000, " bonus ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.Kind != domain.LedgerTopUp || tx.Comment != "bonus" || tx.CreatedBy == nil || *tx.CreatedBy != admin.UserID {
+		t.Fatalf("unexpected transaction: %+v", tx)
+	}
+
+	if _, err := svc.Withdraw(ctx, admin, buyer, 1500, ""); !errors.Is(err, ErrInsufficientFunds) {
+		t.Fatalf("expected ErrInsufficientFunds, got %v", err)
+	}
+	if _, err := svc.Withdraw(ctx, admin, buyer, 400, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if b := balance(t, svc, buyer); b.Available != 600 || b.Held != 0 {
+		t.Fatalf("expected 600 available, got %+v", b)
+	}
+
+	// Системный счёт — контрагент: в сумме по всем счетам ноль
+	var total int64
+	for _, a := range repo.accounts {
+		total += a.Balance
+	}
+	if total != 0 {
+		t.Fatalf("ledger is not balanced: %d", total)
+	}
+}
+
+func TestService_DealHoldAndRelease(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	admin := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleAdmin}
+	buyer, seller := uuid.New(), uuid.New()
+	svc := New(log, NewMockRepository(), MockUsers{buyer: true, seller: true}, &MockTxManager{})
+	ctx := context.Background()
+	deal := domain.Deal{ID: uuid.New(), SellerUserID: seller, Price: 700}
+
+	if err := svc.HoldDealFunds(ctx, deal, buyer); !errors.Is(err, ErrInsufficientFunds) {
+		t.Fatalf("expected ErrInsufficientFunds, got %v", err)
+	}
+
+	if _, err := svc.TopUp(ctx, admin, buyer, 1000, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.HoldDealFunds(ctx, deal, buyer); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b := balance(t, svc, buyer); b.Available != 300 || b.Held != 700 {
+		t.Fatalf("expected 300 available and 700 held, got %+v", b)
+	}
+
+	// Повторная блокировка по той же сделке не проводится
+	if err := svc.HoldDealFunds(ctx, deal, buyer); !errors.Is(err, repository.ErrLedgerTransactionExists) {
+		t.Fatalf("expected ErrLedgerTransactionExists, got %v", err)
+	}
+
+	// Цена изменилась после принятия — переводится заблокированная сумма
+	deal.Price = 900
+	if err := svc.ReleaseDealFunds(ctx, deal); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b := balance(t, svc, buyer); b.Available != 300 || b.Held != 0 {
+		t.Fatalf("expected 300 available and nothing held, got %+v", b)
+	}
+	if b := balance(t, svc, seller); b.Available != 700 {
+		t.Fatalf("expected seller to receive 700, got %+v", b)
+	}
+}
+
+func TestService_DealRefund(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	admin := domain.Actor{UserID: uuid.New(), Role: domain.UserRoleAdmin}
+	buyer, seller := uuid.New(), uuid.New()
+	svc := New(log, NewMockRepository(), MockUsers{buyer: true, seller: true}, &MockTxManager{})
+	ctx := context.Background()
+	deal := domain.Deal{ID: uuid.New(), SellerUserID: seller, Price: 250}
+
+	if _, err := svc.TopUp(ctx, admin, buyer, 250, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.HoldDealFunds(ctx, deal, buyer); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.RefundDealFunds(ctx, deal); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if b := balance(t, svc, buyer); b.Available != 250 || b.Held != 0 {
+		t.Fatalf("expected full refund, got %+v", b)
+	}
+	if b := balance(t, svc, seller); b.Available != 0 {
+		t.Fatalf("seller must not receive funds, got %+v", b)
+	}
+}
+
+func TestService_SettleWithoutHold(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	buyer, seller := uuid.New(), uuid.New()
+	repo := NewMockRepository()
+	svc := New(log, repo, MockUsers{buyer: true, seller: true}, &MockTxManager{})
+	ctx := context.Background()
+
+	// Бесплатная сделка ничего не блокирует, и расчёт по ней пропускается
+	deal := domain.Deal{ID: uuid.New(), SellerUserID: seller}
+	if err := svc.HoldDealFunds(ctx, deal, buyer); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.ReleaseDealFunds(ctx, deal); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.transactions) != 0 {
+		t.Fatalf("expected no transactions, got %d", len(repo.transactions))
+	}
+}
+
+func TestService_GetBalance_Permissions(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	buyer, seller := uuid.New(), uuid.New()
+	svc := New(log, NewMockRepository(), MockUsers{buyer: true, seller: true}, &MockTxManager{})
+	ctx := context.Background()
+	actor := domain.Actor{UserID: buyer, Role: domain.UserRoleUser}
+
+	if _, err := svc.GetBalance(ctx, actor, buyer); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.GetBalance(ctx, actor, seller); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied, got %v", err)
+	}
+	if _, err := svc.ListStatement(ctx, actor, domain.WalletStatementFilter{UserID: seller}); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied, got %v", err)
+	}
+}
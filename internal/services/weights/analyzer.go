@@ -2,7 +2,6 @@ package weights
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -74,18 +73,10 @@ func (a *Analyzer) AnalyzeLead(ctx context.Context, lead domain.Lead) (*AnalyzeR
 func (a *Analyzer) llmAnalysis(ctx context.Context, lead domain.Lead) (*AnalyzeResult, error) {
 	const op = "weights.Analyzer.llmAnalysis"
 
-	// Парсим requirement
-	var requirementMap map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		if err := json.Unmarshal(lead.Requirement, &requirementMap); err != nil {
-			a.log.Warn("failed to parse requirement JSON", slog.String("error", err.Error()))
-		}
-	}
-
 	req := llm.AnalyzeLeadRequest{
 		Title:       lead.Title,
 		Description: lead.Description,
-		Requirement: requirementMap,
+		Requirement: lead.Requirement.Map(),
 	}
 
 	resp, err := a.llmClient.AnalyzeLeadIntent(ctx, req)
@@ -226,47 +217,9 @@ func (a *Analyzer) countKeywords(text string, keywords []string) int {
 	return count
 }
 
-// extractCriteriaFromRequirement извлекает критерии из JSON requirement.
+// extractCriteriaFromRequirement извлекает критерии из requirement.
 func (a *Analyzer) extractCriteriaFromRequirement(lead domain.Lead) *domain.SoftCriteria {
-	if len(lead.Requirement) == 0 {
-		return nil
-	}
-
-	var reqMap map[string]interface{}
-	if err := json.Unmarshal(lead.Requirement, &reqMap); err != nil {
-		return nil
-	}
-
-	criteria := &domain.SoftCriteria{}
-	hasData := false
-
-	if price, ok := reqMap["price"].(float64); ok {
-		p := int64(price)
-		criteria.TargetPrice = &p
-		hasData = true
-	}
-
-	if district, ok := reqMap["district"].(string); ok {
-		criteria.TargetDistrict = &district
-		hasData = true
-	}
-
-	if rooms, ok := reqMap["roomNumber"].(float64); ok {
-		r := int32(rooms)
-		criteria.TargetRooms = &r
-		hasData = true
-	}
-
-	if area, ok := reqMap["area"].(float64); ok {
-		criteria.TargetArea = &area
-		hasData = true
-	}
-
-	if !hasData {
-		return nil
-	}
-
-	return criteria
+	return lead.Requirement.SoftCriteria()
 }
 
 // adjustWeightsBasedOnData корректирует веса на основе заполненности данных.
@@ -367,27 +320,12 @@ func (a *Analyzer) IsShortLead(lead domain.Lead) bool {
 		return true
 	}
 
-	if len(lead.Requirement) == 0 {
-		return true
-	}
-
-	var reqMap map[string]interface{}
-	if err := json.Unmarshal(lead.Requirement, &reqMap); err != nil {
+	if lead.Requirement.IsEmpty() {
 		return true
 	}
 
 	// Проверяем наличие ключевых полей
-	hasPrice := false
-	hasRooms := false
-
-	if _, ok := reqMap["price"]; ok {
-		hasPrice = true
-	}
-	if _, ok := reqMap["roomNumber"]; ok {
-		hasRooms = true
-	}
-
-	return !hasPrice && !hasRooms
+	return lead.Requirement.Price.IsEmpty() && lead.Requirement.Rooms.IsEmpty()
 }
 
 // GetMissingFields возвращает список незаполненных важных полей.
@@ -398,25 +336,17 @@ func (a *Analyzer) GetMissingFields(lead domain.Lead) []string {
 		missing = append(missing, "city")
 	}
 
-	var reqMap map[string]interface{}
-	if len(lead.Requirement) > 0 {
-		json.Unmarshal(lead.Requirement, &reqMap)
-	}
-
-	if reqMap == nil {
-		return append(missing, "price", "roomNumber", "area", "district")
-	}
-
-	if _, ok := reqMap["price"]; !ok {
+	req := lead.Requirement
+	if req.Price.IsEmpty() {
 		missing = append(missing, "price")
 	}
-	if _, ok := reqMap["roomNumber"]; !ok {
+	if req.Rooms.IsEmpty() {
 		missing = append(missing, "roomNumber")
 	}
-	if _, ok := reqMap["area"]; !ok {
+	if req.Area.IsEmpty() {
 		missing = append(missing, "area")
 	}
-	if _, ok := reqMap["district"]; !ok {
+	if len(req.Districts) == 0 {
 		missing = append(missing, "district")
 	}
 
@@ -2,7 +2,6 @@ package weights
 
 import (
 	"context"
-	"encoding/json"
 	"lead_exchange/internal/config"
 	"lead_exchange/internal/domain"
 	"lead_exchange/internal/lib/llm"
@@ -219,19 +218,19 @@ func TestAnalyzer_ExtractCriteriaFromRequirement(t *testing.T) {
 
 	analyzer := NewAnalyzer(log, llmClient, cfg)
 
-	requirement := map[string]interface{}{
-		"price":      float64(15000000),
-		"district":   "Центр",
-		"roomNumber": float64(3),
-		"area":       float64(80),
+	price, rooms, area := int64(15000000), int32(3), float64(80)
+	requirement := domain.LeadRequirement{
+		Price:     &domain.Range[int64]{Max: &price},
+		Rooms:     &domain.Range[int32]{Min: &rooms, Max: &rooms},
+		Area:      &domain.Range[float64]{Min: &area},
+		Districts: []string{"Центр"},
 	}
-	reqJSON, _ := json.Marshal(requirement)
 
 	lead := domain.Lead{
 		ID:          uuid.New(),
 		Title:       "Test Lead",
 		Description: "Test",
-		Requirement: reqJSON,
+		Requirement: requirement,
 	}
 
 	result, err := analyzer.AnalyzeLead(context.Background(), lead)
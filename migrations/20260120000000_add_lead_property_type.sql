@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Тип недвижимости, который ищет лид (жёсткий фильтр при обратном матчинге)
+ALTER TABLE leads ADD COLUMN IF NOT EXISTS property_type TEXT;
+
+-- Индекс для обратного матчинга: опубликованные лиды по городу и типу
+CREATE INDEX IF NOT EXISTS leads_matching_idx ON leads (city, property_type, status)
+    WHERE status = 'PUBLISHED';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS leads_matching_idx;
+ALTER TABLE leads DROP COLUMN IF EXISTS property_type;
+
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Сохранённые поиски брокеров: по лиду или по ad-hoc фильтру
+CREATE TABLE IF NOT EXISTS saved_searches
+(
+    search_id  UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id    UUID             NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    name       TEXT             NOT NULL DEFAULT '',
+    lead_id    UUID             REFERENCES leads(lead_id) ON DELETE CASCADE,
+    filter     JSONB            NOT NULL DEFAULT '{}'::jsonb,
+    weights    JSONB,
+    min_score  DOUBLE PRECISION NOT NULL DEFAULT 0.6,
+    created_at TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ      NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS saved_searches_user_idx ON saved_searches (user_id);
+
+-- Входящие: новые объекты, найденные сохранёнными поисками
+CREATE TABLE IF NOT EXISTS saved_search_matches
+(
+    match_id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    search_id         UUID             NOT NULL REFERENCES saved_searches(search_id) ON DELETE CASCADE,
+    property_id       UUID             NOT NULL REFERENCES properties(property_id) ON DELETE CASCADE,
+    score             DOUBLE PRECISION NOT NULL,
+    match_explanation TEXT,
+    seen_at           TIMESTAMPTZ,
+    created_at        TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    UNIQUE (search_id, property_id)
+);
+
+-- Выборка непросмотренных совпадений
+CREATE INDEX IF NOT EXISTS saved_search_matches_unseen_idx ON saved_search_matches (search_id, created_at)
+    WHERE seen_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS saved_search_matches;
+DROP TABLE IF EXISTS saved_searches;
+
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Очередь задач на генерацию embedding лидов и объектов
+CREATE TABLE IF NOT EXISTS embedding_jobs
+(
+    job_id       UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_type  TEXT        NOT NULL,
+    entity_id    UUID        NOT NULL,
+    operation    TEXT        NOT NULL,
+    status       TEXT        NOT NULL DEFAULT 'PENDING',
+    attempts     INTEGER     NOT NULL DEFAULT 0,
+    max_attempts INTEGER     NOT NULL DEFAULT 5,
+    last_error   TEXT,
+    run_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    locked_at    TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Выборка задач, готовых к выполнению
+CREATE INDEX IF NOT EXISTS embedding_jobs_ready_idx ON embedding_jobs (run_at)
+    WHERE status IN ('PENDING', 'RUNNING');
+
+-- Статус по сущности и схлопывание повторных постановок в очередь
+CREATE INDEX IF NOT EXISTS embedding_jobs_entity_idx ON embedding_jobs (entity_type, entity_id, created_at DESC);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS embedding_jobs;
+
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Контрольные точки массовой переиндексации: одна строка на тип сущности
+CREATE TABLE IF NOT EXISTS reindex_checkpoints
+(
+    entity_type TEXT PRIMARY KEY,
+    cursor      TEXT        NOT NULL DEFAULT '',
+    total       INTEGER     NOT NULL DEFAULT 0,
+    processed   INTEGER     NOT NULL DEFAULT 0,
+    success     INTEGER     NOT NULL DEFAULT 0,
+    failed      INTEGER     NOT NULL DEFAULT 0,
+    done        BOOLEAN     NOT NULL DEFAULT FALSE,
+    started_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS reindex_checkpoints;
+
+-- +goose StatementEnd
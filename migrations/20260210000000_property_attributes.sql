@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Структурированные характеристики объекта: этаж, год постройки, район, координаты, особенности
+ALTER TABLE properties
+    ADD COLUMN floor        INTEGER,
+    ADD COLUMN total_floors INTEGER CHECK (total_floors > 0),
+    ADD COLUMN year_built   INTEGER CHECK (year_built BETWEEN 1800 AND 2100),
+    ADD COLUMN district     TEXT,
+    ADD COLUMN latitude     DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN longitude    DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    ADD COLUMN features     TEXT[] NOT NULL DEFAULT '{}',
+    ADD CONSTRAINT properties_floor_within_building CHECK (floor IS NULL OR total_floors IS NULL OR floor <= total_floors),
+    ADD CONSTRAINT properties_coordinates_pair CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+-- Район используется при ранжировании и фильтрации без учёта регистра
+CREATE INDEX IF NOT EXISTS idx_properties_district ON properties (LOWER(district));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_properties_district;
+
+ALTER TABLE properties
+    DROP CONSTRAINT IF EXISTS properties_coordinates_pair,
+    DROP CONSTRAINT IF EXISTS properties_floor_within_building,
+    DROP COLUMN IF EXISTS features,
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS district,
+    DROP COLUMN IF EXISTS year_built,
+    DROP COLUMN IF EXISTS total_floors,
+    DROP COLUMN IF EXISTS floor;
+
+-- +goose StatementEnd
@@ -0,0 +1,73 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Числовое значение ключа requirement; строки и прочие типы считаются неуказанными
+CREATE FUNCTION pg_temp.requirement_number(r JSONB, key TEXT) RETURNS NUMERIC AS $$
+    SELECT CASE WHEN jsonb_typeof(r -> key) = 'number' THEN (r ->> key)::numeric END
+$$ LANGUAGE sql IMMUTABLE;
+
+-- Диапазон {min, max}: одиночное значение задаёт обе границы, пустой диапазон — NULL
+CREATE FUNCTION pg_temp.requirement_range(point NUMERIC, lo NUMERIC, hi NUMERIC) RETURNS JSONB AS $$
+    SELECT NULLIF(jsonb_strip_nulls(jsonb_build_object('min', COALESCE(lo, point), 'max', COALESCE(hi, point))), '{}'::jsonb)
+$$ LANGUAGE sql IMMUTABLE;
+
+-- Список строк ключа requirement: нестроковые и пустые элементы отбрасываются, пустой список — NULL
+CREATE FUNCTION pg_temp.requirement_strings(r JSONB, key TEXT) RETURNS JSONB AS $$
+    SELECT CASE WHEN jsonb_typeof(r -> key) = 'array' THEN (
+        SELECT jsonb_agg(btrim(e #>> '{}'))
+        FROM jsonb_array_elements(r -> key) AS e
+        WHERE jsonb_typeof(e) = 'string' AND btrim(e #>> '{}') <> ''
+    ) END
+$$ LANGUAGE sql IMMUTABLE;
+
+-- Свободный JSON требований переводится в версионированную схему domain.LeadRequirement (version = 1):
+-- price/min_price/max_price, roomNumber/rooms/min_rooms/max_rooms, area/min_area/max_area → диапазоны,
+-- district → districts, must_have/nice_to_have — только строковые элементы. Нераспознанные ключи отбрасываются.
+UPDATE leads
+SET requirement = jsonb_strip_nulls(jsonb_build_object(
+        'version', 1,
+        'price', pg_temp.requirement_range(
+            round(pg_temp.requirement_number(requirement, 'price')),
+            round(pg_temp.requirement_number(requirement, 'min_price')),
+            round(pg_temp.requirement_number(requirement, 'max_price'))),
+        'rooms', pg_temp.requirement_range(
+            round(COALESCE(pg_temp.requirement_number(requirement, 'roomNumber'), pg_temp.requirement_number(requirement, 'rooms'))),
+            round(pg_temp.requirement_number(requirement, 'min_rooms')),
+            round(pg_temp.requirement_number(requirement, 'max_rooms'))),
+        'area', pg_temp.requirement_range(
+            pg_temp.requirement_number(requirement, 'area'),
+            pg_temp.requirement_number(requirement, 'min_area'),
+            pg_temp.requirement_number(requirement, 'max_area')),
+        'districts', CASE
+            WHEN jsonb_typeof(requirement -> 'district') = 'string' AND btrim(requirement ->> 'district') <> ''
+                THEN jsonb_build_array(btrim(requirement ->> 'district'))
+            END,
+        'must_have', pg_temp.requirement_strings(requirement, 'must_have'),
+        'nice_to_have', pg_temp.requirement_strings(requirement, 'nice_to_have')
+    ))
+WHERE jsonb_typeof(requirement) <> 'object' OR NOT requirement ? 'version';
+
+DROP FUNCTION pg_temp.requirement_strings(JSONB, TEXT);
+DROP FUNCTION pg_temp.requirement_range(NUMERIC, NUMERIC, NUMERIC);
+DROP FUNCTION pg_temp.requirement_number(JSONB, TEXT);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+-- Обратно в плоские ключи: верхняя граница бюджета, нижняя граница площади, первый район
+UPDATE leads
+SET requirement = jsonb_strip_nulls(jsonb_build_object(
+        'price', COALESCE(requirement -> 'price' -> 'max', requirement -> 'price' -> 'min'),
+        'min_price', requirement -> 'price' -> 'min',
+        'max_price', requirement -> 'price' -> 'max',
+        'roomNumber', COALESCE(requirement -> 'rooms' -> 'min', requirement -> 'rooms' -> 'max'),
+        'area', COALESCE(requirement -> 'area' -> 'min', requirement -> 'area' -> 'max'),
+        'district', requirement -> 'districts' -> 0,
+        'must_have', requirement -> 'must_have',
+        'nice_to_have', requirement -> 'nice_to_have'
+    ))
+WHERE jsonb_typeof(requirement) = 'object' AND requirement ? 'version';
+
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Гео-поиск «в радиусе от точки» через earthdistance (входит в contrib, PostGIS не нужен)
+CREATE EXTENSION IF NOT EXISTS cube;
+CREATE EXTENSION IF NOT EXISTS earthdistance;
+
+-- Индекс для earth_box(...) @> ll_to_earth(latitude, longitude); координаты задаются парой
+CREATE INDEX IF NOT EXISTS idx_properties_location
+    ON properties USING gist (ll_to_earth(latitude, longitude))
+    WHERE latitude IS NOT NULL AND longitude IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_properties_location;
+
+DROP EXTENSION IF EXISTS earthdistance;
+DROP EXTENSION IF EXISTS cube;
+
+-- +goose StatementEnd
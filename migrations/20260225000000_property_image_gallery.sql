@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Фотогалерея объекта: файлы лежат в MinIO под properties/<property_id>/, в таблице — путь к объекту.
+-- Ссылка (image_url) не хранится: при чтении выдаётся свежая presigned-ссылка.
+ALTER TABLE property_images ADD COLUMN IF NOT EXISTS file_name TEXT;
+ALTER TABLE property_images ADD COLUMN IF NOT EXISTS content_type VARCHAR(50);
+
+-- Порядок фотографий в галерее (0 — обложка)
+ALTER TABLE property_images ADD COLUMN IF NOT EXISTS position INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_property_images_property_position ON property_images(property_id, position);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_property_images_property_position;
+
+ALTER TABLE property_images DROP COLUMN IF EXISTS position;
+ALTER TABLE property_images DROP COLUMN IF EXISTS content_type;
+ALTER TABLE property_images DROP COLUMN IF EXISTS file_name;
+
+-- +goose StatementEnd
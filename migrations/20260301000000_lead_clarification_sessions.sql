@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Ответы хранятся списком {field, value}: одно поле (must_have) может прийти несколько раз
+ALTER TABLE lead_clarifications ALTER COLUMN answers SET DEFAULT '[]'::jsonb;
+UPDATE lead_clarifications SET answers = '[]'::jsonb WHERE answers IS NULL OR answers = '{}'::jsonb;
+
+-- История уточнений лида читается от новых к старым
+CREATE INDEX IF NOT EXISTS idx_lead_clarifications_lead_created
+    ON lead_clarifications(lead_id, created_at DESC);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_lead_clarifications_lead_created;
+
+UPDATE lead_clarifications SET answers = '{}'::jsonb WHERE answers = '[]'::jsonb;
+ALTER TABLE lead_clarifications ALTER COLUMN answers SET DEFAULT '{}'::jsonb;
+
+-- +goose StatementEnd
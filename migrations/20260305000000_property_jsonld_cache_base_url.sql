@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Разметка зависит от base_url запроса, поэтому кэш хранится по паре (объект, base_url)
+ALTER TABLE property_jsonld_cache ADD COLUMN IF NOT EXISTS base_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE property_jsonld_cache DROP CONSTRAINT IF EXISTS property_jsonld_cache_pkey;
+ALTER TABLE property_jsonld_cache ADD PRIMARY KEY (property_id, base_url);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+-- Кэш можно безопасно очистить: разметка будет сгенерирована заново
+TRUNCATE property_jsonld_cache;
+ALTER TABLE property_jsonld_cache DROP CONSTRAINT IF EXISTS property_jsonld_cache_pkey;
+ALTER TABLE property_jsonld_cache DROP COLUMN IF EXISTS base_url;
+ALTER TABLE property_jsonld_cache ADD PRIMARY KEY (property_id);
+
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Refresh-токены: хранится только SHA-256 хэш. Токены одной цепочки ротации
+-- объединены family_id, чтобы при повторном использовании отозвать всю цепочку.
+CREATE TABLE IF NOT EXISTS refresh_tokens
+(
+    token_id    UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id     UUID        NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    family_id   UUID        NOT NULL,
+    token_hash  TEXT        NOT NULL UNIQUE,
+    -- jti access-токена, выданного вместе с refresh-токеном
+    access_jti  UUID        NOT NULL,
+    expires_at  TIMESTAMPTZ NOT NULL,
+    replaced_by UUID        REFERENCES refresh_tokens(token_id) ON DELETE SET NULL,
+    revoked_at  TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS refresh_tokens_user_idx ON refresh_tokens (user_id);
+CREATE INDEX IF NOT EXISTS refresh_tokens_family_idx ON refresh_tokens (family_id);
+CREATE INDEX IF NOT EXISTS refresh_tokens_access_jti_idx ON refresh_tokens (access_jti);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS refresh_tokens;
+
+-- +goose StatementEnd
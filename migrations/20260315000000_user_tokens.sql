@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMPTZ;
+
+-- Существующие аккаунты считаются подтверждёнными, чтобы REQUIRE_EMAIL_VERIFICATION их не заблокировал
+UPDATE users SET email_verified_at = created_at WHERE email_verified_at IS NULL;
+
+-- Одноразовые токены из писем: сброс пароля и подтверждение email. Хранится только SHA-256 хэш.
+CREATE TABLE IF NOT EXISTS user_tokens
+(
+    token_id   UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id    UUID        NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    purpose    TEXT        NOT NULL CHECK (purpose IN ('password_reset', 'email_verification')),
+    token_hash TEXT        NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at    TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS user_tokens_user_purpose_idx ON user_tokens (user_id, purpose)
+    WHERE used_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS user_tokens;
+ALTER TABLE users DROP COLUMN IF EXISTS email_verified_at;
+
+-- +goose StatementEnd
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Агентства: команды брокеров с общими лидами, объектами и отчётностью по сделкам
+CREATE TABLE IF NOT EXISTS agencies
+(
+    agency_id  UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name       TEXT        NOT NULL,
+    created_by UUID        NOT NULL REFERENCES users(user_id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Участники агентства. Пользователь состоит не более чем в одном агентстве.
+CREATE TABLE IF NOT EXISTS agency_members
+(
+    agency_id UUID        NOT NULL REFERENCES agencies(agency_id) ON DELETE CASCADE,
+    user_id   UUID        NOT NULL UNIQUE REFERENCES users(user_id) ON DELETE CASCADE,
+    role      TEXT        NOT NULL CHECK (role IN ('OWNER', 'MANAGER', 'AGENT')),
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (agency_id, user_id)
+);
+
+-- Приглашения в агентство по email
+CREATE TABLE IF NOT EXISTS agency_invitations
+(
+    invitation_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    agency_id     UUID        NOT NULL REFERENCES agencies(agency_id) ON DELETE CASCADE,
+    email         TEXT        NOT NULL,
+    role          TEXT        NOT NULL CHECK (role IN ('MANAGER', 'AGENT')),
+    invited_by    UUID        NOT NULL REFERENCES users(user_id),
+    status        TEXT        NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'ACCEPTED', 'DECLINED', 'REVOKED')),
+    expires_at    TIMESTAMPTZ NOT NULL,
+    responded_at  TIMESTAMPTZ,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS agency_invitations_email_idx ON agency_invitations (LOWER(email))
+    WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS agency_invitations_agency_idx ON agency_invitations (agency_id)
+    WHERE status = 'PENDING';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS agency_invitations;
+DROP TABLE IF EXISTS agency_members;
+DROP TABLE IF EXISTS agencies;
+
+-- +goose StatementEnd
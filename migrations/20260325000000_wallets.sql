@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Счета кредитного кошелька. У пользователя счёт свободных (AVAILABLE) и
+-- заблокированных под сделки (HELD) кредитов; системный счёт EXTERNAL — контрагент
+-- пополнений и выводов, его баланс может быть отрицательным.
+CREATE TABLE IF NOT EXISTS wallet_accounts
+(
+    account_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id    UUID REFERENCES users(user_id) ON DELETE RESTRICT,
+    kind       TEXT        NOT NULL CHECK (kind IN ('AVAILABLE', 'HELD', 'EXTERNAL')),
+    balance    BIGINT      NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((kind = 'EXTERNAL') = (user_id IS NULL)),
+    CHECK (kind = 'EXTERNAL' OR balance >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_wallet_accounts_user_kind
+    ON wallet_accounts (user_id, kind) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_wallet_accounts_system_kind
+    ON wallet_accounts (kind) WHERE user_id IS NULL;
+
+INSERT INTO wallet_accounts (kind) VALUES ('EXTERNAL') ON CONFLICT DO NOTHING;
+
+-- Операции журнала. По одной сделке каждая операция (блокировка, перевод, возврат) проводится один раз.
+CREATE TABLE IF NOT EXISTS ledger_transactions
+(
+    transaction_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kind           TEXT        NOT NULL CHECK (kind IN ('TOP_UP', 'WITHDRAWAL', 'DEAL_HOLD', 'DEAL_RELEASE', 'DEAL_REFUND')),
+    deal_id        UUID REFERENCES deals(deal_id) ON DELETE RESTRICT,
+    created_by     UUID REFERENCES users(user_id) ON DELETE SET NULL,
+    comment        TEXT        NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ledger_transactions_deal_kind
+    ON ledger_transactions (deal_id, kind) WHERE deal_id IS NOT NULL;
+
+-- Проводки: положительная сумма — зачисление, отрицательная — списание.
+-- balance_after — остаток счёта после проводки, для выписки.
+CREATE TABLE IF NOT EXISTS ledger_entries
+(
+    entry_id       UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transaction_id UUID        NOT NULL REFERENCES ledger_transactions(transaction_id) ON DELETE RESTRICT,
+    account_id     UUID        NOT NULL REFERENCES wallet_accounts(account_id) ON DELETE RESTRICT,
+    amount         BIGINT      NOT NULL CHECK (amount <> 0),
+    balance_after  BIGINT      NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_transaction ON ledger_entries (transaction_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_account_created ON ledger_entries (account_id, created_at DESC, entry_id DESC);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS ledger_transactions;
+DROP TABLE IF EXISTS wallet_accounts;
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Проиндексированные объекты, ещё не прогнанные по сохранённым поискам: одна строка на объект.
+-- Повторная индексация до обработки сдвигает indexed_at, чтобы воркер не удалил свежее уведомление.
+CREATE TABLE IF NOT EXISTS saved_search_pending
+(
+    property_id UUID PRIMARY KEY REFERENCES properties(property_id) ON DELETE CASCADE,
+    indexed_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS saved_search_pending_indexed_idx ON saved_search_pending (indexed_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS saved_search_pending;
+
+-- +goose StatementEnd
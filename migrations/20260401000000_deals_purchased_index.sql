@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Купленные лиды покупателя: проверка доступа к контактам лидов страницы
+CREATE INDEX IF NOT EXISTS deals_completed_buyer_idx ON deals (buyer_user_id, lead_id)
+    WHERE status = 'COMPLETED';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS deals_completed_buyer_idx;
+
+-- +goose StatementEnd
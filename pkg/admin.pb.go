@@ -0,0 +1,322 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: admin.proto
+
+package leadexchangev1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ReindexTarget — какие сущности переиндексировать.
+type ReindexTarget int32
+
+const (
+	ReindexTarget_REINDEX_TARGET_UNSPECIFIED ReindexTarget = 0
+	ReindexTarget_REINDEX_TARGET_LEADS       ReindexTarget = 1
+	ReindexTarget_REINDEX_TARGET_PROPERTIES  ReindexTarget = 2
+	ReindexTarget_REINDEX_TARGET_ALL         ReindexTarget = 3
+)
+
+// Enum value maps for ReindexTarget.
+var (
+	ReindexTarget_name = map[int32]string{
+		0: "REINDEX_TARGET_UNSPECIFIED",
+		1: "REINDEX_TARGET_LEADS",
+		2: "REINDEX_TARGET_PROPERTIES",
+		3: "REINDEX_TARGET_ALL",
+	}
+	ReindexTarget_value = map[string]int32{
+		"REINDEX_TARGET_UNSPECIFIED": 0,
+		"REINDEX_TARGET_LEADS":       1,
+		"REINDEX_TARGET_PROPERTIES":  2,
+		"REINDEX_TARGET_ALL":         3,
+	}
+)
+
+func (x ReindexTarget) Enum() *ReindexTarget {
+	p := new(ReindexTarget)
+	*p = x
+	return p
+}
+
+func (x ReindexTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReindexTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (ReindexTarget) Type() protoreflect.EnumType {
+	return &file_admin_proto_enumTypes[0]
+}
+
+func (x ReindexTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReindexTarget.Descriptor instead.
+func (ReindexTarget) EnumDescriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type ReindexAllRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Target ReindexTarget          `protobuf:"varint,1,opt,name=target,proto3,enum=leadexchange.v1.ReindexTarget" json:"target,omitempty"`
+	// Размер пакета для ML-сервиса (по умолчанию 100)
+	BatchSize *int32 `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3,oneof" json:"batch_size,omitempty"`
+	// Начать заново, игнорируя незавершённую переиндексацию
+	Restart       bool `protobuf:"varint,3,opt,name=restart,proto3" json:"restart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexAllRequest) Reset() {
+	*x = ReindexAllRequest{}
+	mi := &file_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexAllRequest) ProtoMessage() {}
+
+func (x *ReindexAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexAllRequest.ProtoReflect.Descriptor instead.
+func (*ReindexAllRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReindexAllRequest) GetTarget() ReindexTarget {
+	if x != nil {
+		return x.Target
+	}
+	return ReindexTarget_REINDEX_TARGET_UNSPECIFIED
+}
+
+func (x *ReindexAllRequest) GetBatchSize() int32 {
+	if x != nil && x.BatchSize != nil {
+		return *x.BatchSize
+	}
+	return 0
+}
+
+func (x *ReindexAllRequest) GetRestart() bool {
+	if x != nil {
+		return x.Restart
+	}
+	return false
+}
+
+// ReindexProgress — ход переиндексации одного типа сущностей.
+type ReindexProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "lead" или "property"
+	EntityType string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	Total      int32  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Processed  int32  `protobuf:"varint,3,opt,name=processed,proto3" json:"processed,omitempty"`
+	Succeeded  int32  `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed     int32  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Последняя обработанная сущность
+	CurrentId     string `protobuf:"bytes,6,opt,name=current_id,json=currentId,proto3" json:"current_id,omitempty"`
+	Done          bool   `protobuf:"varint,7,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexProgress) Reset() {
+	*x = ReindexProgress{}
+	mi := &file_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexProgress) ProtoMessage() {}
+
+func (x *ReindexProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexProgress.ProtoReflect.Descriptor instead.
+func (*ReindexProgress) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReindexProgress) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ReindexProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReindexProgress) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *ReindexProgress) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *ReindexProgress) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ReindexProgress) GetCurrentId() string {
+	if x != nil {
+		return x.CurrentId
+	}
+	return ""
+}
+
+func (x *ReindexProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+var File_admin_proto protoreflect.FileDescriptor
+
+const file_admin_proto_rawDesc = "" +
+	"\n" +
+	"\vadmin.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xb0\x01\n" +
+	"\x11ReindexAllRequest\x12B\n" +
+	"\x06target\x18\x01 \x01(\x0e2\x1e.leadexchange.v1.ReindexTargetB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06target\x12.\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x01H\x00R\tbatchSize\x88\x01\x01\x12\x18\n" +
+	"\arestart\x18\x03 \x01(\bR\arestartB\r\n" +
+	"\v_batch_size\"\xcf\x01\n" +
+	"\x0fReindexProgress\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\x03 \x01(\x05R\tprocessed\x12\x1c\n" +
+	"\tsucceeded\x18\x04 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12\x1d\n" +
+	"\n" +
+	"current_id\x18\x06 \x01(\tR\tcurrentId\x12\x12\n" +
+	"\x04done\x18\a \x01(\bR\x04done*\x80\x01\n" +
+	"\rReindexTarget\x12\x1e\n" +
+	"\x1aREINDEX_TARGET_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14REINDEX_TARGET_LEADS\x10\x01\x12\x1d\n" +
+	"\x19REINDEX_TARGET_PROPERTIES\x10\x02\x12\x16\n" +
+	"\x12REINDEX_TARGET_ALL\x10\x032\x82\x01\n" +
+	"\fAdminService\x12r\n" +
+	"\n" +
+	"ReindexAll\x12\".leadexchange.v1.ReindexAllRequest\x1a .leadexchange.v1.ReindexProgress\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/admin/reindex0\x01B4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
+
+var (
+	file_admin_proto_rawDescOnce sync.Once
+	file_admin_proto_rawDescData []byte
+)
+
+func file_admin_proto_rawDescGZIP() []byte {
+	file_admin_proto_rawDescOnce.Do(func() {
+		file_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_proto_rawDesc), len(file_admin_proto_rawDesc)))
+	})
+	return file_admin_proto_rawDescData
+}
+
+var file_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_admin_proto_goTypes = []any{
+	(ReindexTarget)(0),        // 0: leadexchange.v1.ReindexTarget
+	(*ReindexAllRequest)(nil), // 1: leadexchange.v1.ReindexAllRequest
+	(*ReindexProgress)(nil),   // 2: leadexchange.v1.ReindexProgress
+}
+var file_admin_proto_depIdxs = []int32{
+	0, // 0: leadexchange.v1.ReindexAllRequest.target:type_name -> leadexchange.v1.ReindexTarget
+	1, // 1: leadexchange.v1.AdminService.ReindexAll:input_type -> leadexchange.v1.ReindexAllRequest
+	2, // 2: leadexchange.v1.AdminService.ReindexAll:output_type -> leadexchange.v1.ReindexProgress
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_admin_proto_init() }
+func file_admin_proto_init() {
+	if File_admin_proto != nil {
+		return
+	}
+	file_admin_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_proto_rawDesc), len(file_admin_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_proto_goTypes,
+		DependencyIndexes: file_admin_proto_depIdxs,
+		EnumInfos:         file_admin_proto_enumTypes,
+		MessageInfos:      file_admin_proto_msgTypes,
+	}.Build()
+	File_admin_proto = out.File
+	file_admin_proto_goTypes = nil
+	file_admin_proto_depIdxs = nil
+}
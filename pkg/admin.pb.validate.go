@@ -0,0 +1,295 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: admin.proto
+
+package leadexchangev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ReindexAllRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReindexAllRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReindexAllRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReindexAllRequestMultiError, or nil if none found.
+func (m *ReindexAllRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReindexAllRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ReindexAllRequest_Target_NotInLookup[m.GetTarget()]; ok {
+		err := ReindexAllRequestValidationError{
+			field:  "Target",
+			reason: "value must not be in list [REINDEX_TARGET_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ReindexTarget_name[int32(m.GetTarget())]; !ok {
+		err := ReindexAllRequestValidationError{
+			field:  "Target",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Restart
+
+	if m.BatchSize != nil {
+
+		if val := m.GetBatchSize(); val < 1 || val > 1000 {
+			err := ReindexAllRequestValidationError{
+				field:  "BatchSize",
+				reason: "value must be inside range [1, 1000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReindexAllRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReindexAllRequestMultiError is an error wrapping multiple validation errors
+// returned by ReindexAllRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReindexAllRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReindexAllRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReindexAllRequestMultiError) AllErrors() []error { return m }
+
+// ReindexAllRequestValidationError is the validation error returned by
+// ReindexAllRequest.Validate if the designated constraints aren't met.
+type ReindexAllRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReindexAllRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReindexAllRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReindexAllRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReindexAllRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReindexAllRequestValidationError) ErrorName() string {
+	return "ReindexAllRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReindexAllRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReindexAllRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReindexAllRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReindexAllRequestValidationError{}
+
+var _ReindexAllRequest_Target_NotInLookup = map[ReindexTarget]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ReindexProgress with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReindexProgress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReindexProgress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReindexProgressMultiError, or nil if none found.
+func (m *ReindexProgress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReindexProgress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityType
+
+	// no validation rules for Total
+
+	// no validation rules for Processed
+
+	// no validation rules for Succeeded
+
+	// no validation rules for Failed
+
+	// no validation rules for CurrentId
+
+	// no validation rules for Done
+
+	if len(errors) > 0 {
+		return ReindexProgressMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReindexProgressMultiError is an error wrapping multiple validation errors
+// returned by ReindexProgress.ValidateAll() if the designated constraints
+// aren't met.
+type ReindexProgressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReindexProgressMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReindexProgressMultiError) AllErrors() []error { return m }
+
+// ReindexProgressValidationError is the validation error returned by
+// ReindexProgress.Validate if the designated constraints aren't met.
+type ReindexProgressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReindexProgressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReindexProgressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReindexProgressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReindexProgressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReindexProgressValidationError) ErrorName() string { return "ReindexProgressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReindexProgressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReindexProgress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReindexProgressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReindexProgressValidationError{}
@@ -0,0 +1,1721 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: agency.proto
+
+package leadexchangev1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AgencyRole — роль участника агентства.
+type AgencyRole int32
+
+const (
+	// Не задана (значение по умолчанию, не используется)
+	AgencyRole_AGENCY_ROLE_UNSPECIFIED AgencyRole = 0
+	// Владелец: управляет ролями и составом
+	AgencyRole_AGENCY_ROLE_OWNER AgencyRole = 1
+	// Руководитель: приглашает агентов и перераспределяет их лиды
+	AgencyRole_AGENCY_ROLE_MANAGER AgencyRole = 2
+	// Агент
+	AgencyRole_AGENCY_ROLE_AGENT AgencyRole = 3
+)
+
+// Enum value maps for AgencyRole.
+var (
+	AgencyRole_name = map[int32]string{
+		0: "AGENCY_ROLE_UNSPECIFIED",
+		1: "AGENCY_ROLE_OWNER",
+		2: "AGENCY_ROLE_MANAGER",
+		3: "AGENCY_ROLE_AGENT",
+	}
+	AgencyRole_value = map[string]int32{
+		"AGENCY_ROLE_UNSPECIFIED": 0,
+		"AGENCY_ROLE_OWNER":       1,
+		"AGENCY_ROLE_MANAGER":     2,
+		"AGENCY_ROLE_AGENT":       3,
+	}
+)
+
+func (x AgencyRole) Enum() *AgencyRole {
+	p := new(AgencyRole)
+	*p = x
+	return p
+}
+
+func (x AgencyRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AgencyRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_agency_proto_enumTypes[0].Descriptor()
+}
+
+func (AgencyRole) Type() protoreflect.EnumType {
+	return &file_agency_proto_enumTypes[0]
+}
+
+func (x AgencyRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AgencyRole.Descriptor instead.
+func (AgencyRole) EnumDescriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{0}
+}
+
+// AgencyInvitationStatus — статус приглашения.
+type AgencyInvitationStatus int32
+
+const (
+	AgencyInvitationStatus_AGENCY_INVITATION_STATUS_UNSPECIFIED AgencyInvitationStatus = 0
+	AgencyInvitationStatus_AGENCY_INVITATION_STATUS_PENDING     AgencyInvitationStatus = 1
+	AgencyInvitationStatus_AGENCY_INVITATION_STATUS_ACCEPTED    AgencyInvitationStatus = 2
+	AgencyInvitationStatus_AGENCY_INVITATION_STATUS_DECLINED    AgencyInvitationStatus = 3
+	AgencyInvitationStatus_AGENCY_INVITATION_STATUS_REVOKED     AgencyInvitationStatus = 4
+)
+
+// Enum value maps for AgencyInvitationStatus.
+var (
+	AgencyInvitationStatus_name = map[int32]string{
+		0: "AGENCY_INVITATION_STATUS_UNSPECIFIED",
+		1: "AGENCY_INVITATION_STATUS_PENDING",
+		2: "AGENCY_INVITATION_STATUS_ACCEPTED",
+		3: "AGENCY_INVITATION_STATUS_DECLINED",
+		4: "AGENCY_INVITATION_STATUS_REVOKED",
+	}
+	AgencyInvitationStatus_value = map[string]int32{
+		"AGENCY_INVITATION_STATUS_UNSPECIFIED": 0,
+		"AGENCY_INVITATION_STATUS_PENDING":     1,
+		"AGENCY_INVITATION_STATUS_ACCEPTED":    2,
+		"AGENCY_INVITATION_STATUS_DECLINED":    3,
+		"AGENCY_INVITATION_STATUS_REVOKED":     4,
+	}
+)
+
+func (x AgencyInvitationStatus) Enum() *AgencyInvitationStatus {
+	p := new(AgencyInvitationStatus)
+	*p = x
+	return p
+}
+
+func (x AgencyInvitationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AgencyInvitationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_agency_proto_enumTypes[1].Descriptor()
+}
+
+func (AgencyInvitationStatus) Type() protoreflect.EnumType {
+	return &file_agency_proto_enumTypes[1]
+}
+
+func (x AgencyInvitationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AgencyInvitationStatus.Descriptor instead.
+func (AgencyInvitationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{1}
+}
+
+// Agency — агентство недвижимости.
+type Agency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgencyId      string                 `protobuf:"bytes,1,opt,name=agency_id,json=agencyId,proto3" json:"agency_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Agency) Reset() {
+	*x = Agency{}
+	mi := &file_agency_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Agency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Agency) ProtoMessage() {}
+
+func (x *Agency) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Agency.ProtoReflect.Descriptor instead.
+func (*Agency) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Agency) GetAgencyId() string {
+	if x != nil {
+		return x.AgencyId
+	}
+	return ""
+}
+
+func (x *Agency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Agency) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Agency) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// AgencyMember — участник агентства.
+type AgencyMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgencyId      string                 `protobuf:"bytes,1,opt,name=agency_id,json=agencyId,proto3" json:"agency_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          AgencyRole             `protobuf:"varint,3,opt,name=role,proto3,enum=leadexchange.v1.AgencyRole" json:"role,omitempty"`
+	JoinedAt      string                 `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgencyMember) Reset() {
+	*x = AgencyMember{}
+	mi := &file_agency_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgencyMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgencyMember) ProtoMessage() {}
+
+func (x *AgencyMember) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgencyMember.ProtoReflect.Descriptor instead.
+func (*AgencyMember) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AgencyMember) GetAgencyId() string {
+	if x != nil {
+		return x.AgencyId
+	}
+	return ""
+}
+
+func (x *AgencyMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AgencyMember) GetRole() AgencyRole {
+	if x != nil {
+		return x.Role
+	}
+	return AgencyRole_AGENCY_ROLE_UNSPECIFIED
+}
+
+func (x *AgencyMember) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+// AgencyInvitation — приглашение в агентство по email.
+type AgencyInvitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	AgencyId      string                 `protobuf:"bytes,2,opt,name=agency_id,json=agencyId,proto3" json:"agency_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role          AgencyRole             `protobuf:"varint,4,opt,name=role,proto3,enum=leadexchange.v1.AgencyRole" json:"role,omitempty"`
+	InvitedBy     string                 `protobuf:"bytes,5,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	Status        AgencyInvitationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=leadexchange.v1.AgencyInvitationStatus" json:"status,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgencyInvitation) Reset() {
+	*x = AgencyInvitation{}
+	mi := &file_agency_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgencyInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgencyInvitation) ProtoMessage() {}
+
+func (x *AgencyInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgencyInvitation.ProtoReflect.Descriptor instead.
+func (*AgencyInvitation) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AgencyInvitation) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *AgencyInvitation) GetAgencyId() string {
+	if x != nil {
+		return x.AgencyId
+	}
+	return ""
+}
+
+func (x *AgencyInvitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AgencyInvitation) GetRole() AgencyRole {
+	if x != nil {
+		return x.Role
+	}
+	return AgencyRole_AGENCY_ROLE_UNSPECIFIED
+}
+
+func (x *AgencyInvitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *AgencyInvitation) GetStatus() AgencyInvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AgencyInvitationStatus_AGENCY_INVITATION_STATUS_UNSPECIFIED
+}
+
+func (x *AgencyInvitation) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *AgencyInvitation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// AgencyReassignment — сколько лидов, объектов и открытых сделок передано.
+type AgencyReassignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId    string                 `protobuf:"bytes,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Leads         int32                  `protobuf:"varint,3,opt,name=leads,proto3" json:"leads,omitempty"`
+	Properties    int32                  `protobuf:"varint,4,opt,name=properties,proto3" json:"properties,omitempty"`
+	Deals         int32                  `protobuf:"varint,5,opt,name=deals,proto3" json:"deals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgencyReassignment) Reset() {
+	*x = AgencyReassignment{}
+	mi := &file_agency_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgencyReassignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgencyReassignment) ProtoMessage() {}
+
+func (x *AgencyReassignment) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgencyReassignment.ProtoReflect.Descriptor instead.
+func (*AgencyReassignment) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AgencyReassignment) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *AgencyReassignment) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *AgencyReassignment) GetLeads() int32 {
+	if x != nil {
+		return x.Leads
+	}
+	return 0
+}
+
+func (x *AgencyReassignment) GetProperties() int32 {
+	if x != nil {
+		return x.Properties
+	}
+	return 0
+}
+
+func (x *AgencyReassignment) GetDeals() int32 {
+	if x != nil {
+		return x.Deals
+	}
+	return 0
+}
+
+// AgencyMemberStats — показатели участника. Лиды и объекты — по текущему владельцу,
+// сделки — по продавцу и покупателю.
+type AgencyMemberStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Пустые user_id и role — итог по агентству
+	UserId          string     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role            AgencyRole `protobuf:"varint,2,opt,name=role,proto3,enum=leadexchange.v1.AgencyRole" json:"role,omitempty"`
+	LeadsOwned      int32      `protobuf:"varint,3,opt,name=leads_owned,json=leadsOwned,proto3" json:"leads_owned,omitempty"`
+	PropertiesOwned int32      `protobuf:"varint,4,opt,name=properties_owned,json=propertiesOwned,proto3" json:"properties_owned,omitempty"`
+	// Завершённые продажи
+	DealsSold  int32   `protobuf:"varint,5,opt,name=deals_sold,json=dealsSold,proto3" json:"deals_sold,omitempty"`
+	SoldVolume float64 `protobuf:"fixed64,6,opt,name=sold_volume,json=soldVolume,proto3" json:"sold_volume,omitempty"`
+	// Завершённые покупки
+	DealsBought  int32   `protobuf:"varint,7,opt,name=deals_bought,json=dealsBought,proto3" json:"deals_bought,omitempty"`
+	BoughtVolume float64 `protobuf:"fixed64,8,opt,name=bought_volume,json=boughtVolume,proto3" json:"bought_volume,omitempty"`
+	// Сделки в статусах PENDING и ACCEPTED
+	OpenDeals     int32 `protobuf:"varint,9,opt,name=open_deals,json=openDeals,proto3" json:"open_deals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgencyMemberStats) Reset() {
+	*x = AgencyMemberStats{}
+	mi := &file_agency_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgencyMemberStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgencyMemberStats) ProtoMessage() {}
+
+func (x *AgencyMemberStats) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgencyMemberStats.ProtoReflect.Descriptor instead.
+func (*AgencyMemberStats) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AgencyMemberStats) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AgencyMemberStats) GetRole() AgencyRole {
+	if x != nil {
+		return x.Role
+	}
+	return AgencyRole_AGENCY_ROLE_UNSPECIFIED
+}
+
+func (x *AgencyMemberStats) GetLeadsOwned() int32 {
+	if x != nil {
+		return x.LeadsOwned
+	}
+	return 0
+}
+
+func (x *AgencyMemberStats) GetPropertiesOwned() int32 {
+	if x != nil {
+		return x.PropertiesOwned
+	}
+	return 0
+}
+
+func (x *AgencyMemberStats) GetDealsSold() int32 {
+	if x != nil {
+		return x.DealsSold
+	}
+	return 0
+}
+
+func (x *AgencyMemberStats) GetSoldVolume() float64 {
+	if x != nil {
+		return x.SoldVolume
+	}
+	return 0
+}
+
+func (x *AgencyMemberStats) GetDealsBought() int32 {
+	if x != nil {
+		return x.DealsBought
+	}
+	return 0
+}
+
+func (x *AgencyMemberStats) GetBoughtVolume() float64 {
+	if x != nil {
+		return x.BoughtVolume
+	}
+	return 0
+}
+
+func (x *AgencyMemberStats) GetOpenDeals() int32 {
+	if x != nil {
+		return x.OpenDeals
+	}
+	return 0
+}
+
+type CreateAgencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAgencyRequest) Reset() {
+	*x = CreateAgencyRequest{}
+	mi := &file_agency_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAgencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAgencyRequest) ProtoMessage() {}
+
+func (x *CreateAgencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAgencyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAgencyRequest) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateAgencyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetAgencyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// agency_id — чужое агентство (только для админа); по умолчанию — своё
+	AgencyId      *string `protobuf:"bytes,1,opt,name=agency_id,json=agencyId,proto3,oneof" json:"agency_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgencyRequest) Reset() {
+	*x = GetAgencyRequest{}
+	mi := &file_agency_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgencyRequest) ProtoMessage() {}
+
+func (x *GetAgencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgencyRequest.ProtoReflect.Descriptor instead.
+func (*GetAgencyRequest) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAgencyRequest) GetAgencyId() string {
+	if x != nil && x.AgencyId != nil {
+		return *x.AgencyId
+	}
+	return ""
+}
+
+type AgencyResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Agency  *Agency                `protobuf:"bytes,1,opt,name=agency,proto3" json:"agency,omitempty"`
+	Members []*AgencyMember        `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// invitations — действующие приглашения; видны владельцу, руководителю и админу
+	Invitations   []*AgencyInvitation `protobuf:"bytes,3,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgencyResponse) Reset() {
+	*x = AgencyResponse{}
+	mi := &file_agency_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgencyResponse) ProtoMessage() {}
+
+func (x *AgencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgencyResponse.ProtoReflect.Descriptor instead.
+func (*AgencyResponse) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AgencyResponse) GetAgency() *Agency {
+	if x != nil {
+		return x.Agency
+	}
+	return nil
+}
+
+func (x *AgencyResponse) GetMembers() []*AgencyMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *AgencyResponse) GetInvitations() []*AgencyInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type InviteMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role          AgencyRole             `protobuf:"varint,2,opt,name=role,proto3,enum=leadexchange.v1.AgencyRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteMemberRequest) Reset() {
+	*x = InviteMemberRequest{}
+	mi := &file_agency_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteMemberRequest) ProtoMessage() {}
+
+func (x *InviteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InviteMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteMemberRequest) GetRole() AgencyRole {
+	if x != nil {
+		return x.Role
+	}
+	return AgencyRole_AGENCY_ROLE_UNSPECIFIED
+}
+
+type AgencyInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *AgencyInvitation      `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgencyInvitationResponse) Reset() {
+	*x = AgencyInvitationResponse{}
+	mi := &file_agency_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgencyInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgencyInvitationResponse) ProtoMessage() {}
+
+func (x *AgencyInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgencyInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AgencyInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AgencyInvitationResponse) GetInvitation() *AgencyInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type ListMyInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyInvitationsRequest) Reset() {
+	*x = ListMyInvitationsRequest{}
+	mi := &file_agency_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyInvitationsRequest) ProtoMessage() {}
+
+func (x *ListMyInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{10}
+}
+
+type ListMyInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*AgencyInvitation    `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyInvitationsResponse) Reset() {
+	*x = ListMyInvitationsResponse{}
+	mi := &file_agency_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyInvitationsResponse) ProtoMessage() {}
+
+func (x *ListMyInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListMyInvitationsResponse) GetInvitations() []*AgencyInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type AcceptInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_agency_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AcceptInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type DeclineInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineInvitationRequest) Reset() {
+	*x = DeclineInvitationRequest{}
+	mi := &file_agency_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInvitationRequest) ProtoMessage() {}
+
+func (x *DeclineInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineInvitationRequest.ProtoReflect.Descriptor instead.
+func (*DeclineInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeclineInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type RevokeInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_agency_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevokeInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type AgencyMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *AgencyMember          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgencyMemberResponse) Reset() {
+	*x = AgencyMemberResponse{}
+	mi := &file_agency_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgencyMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgencyMemberResponse) ProtoMessage() {}
+
+func (x *AgencyMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgencyMemberResponse.ProtoReflect.Descriptor instead.
+func (*AgencyMemberResponse) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AgencyMemberResponse) GetMember() *AgencyMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type UpdateMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          AgencyRole             `protobuf:"varint,2,opt,name=role,proto3,enum=leadexchange.v1.AgencyRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRoleRequest) Reset() {
+	*x = UpdateMemberRoleRequest{}
+	mi := &file_agency_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRoleRequest) ProtoMessage() {}
+
+func (x *UpdateMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateMemberRoleRequest) GetRole() AgencyRole {
+	if x != nil {
+		return x.Role
+	}
+	return AgencyRole_AGENCY_ROLE_UNSPECIFIED
+}
+
+type RemoveMemberRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// reassign_to_user_id — участник, которому перед исключением передаются лиды, объекты и открытые сделки
+	ReassignToUserId *string `protobuf:"bytes,2,opt,name=reassign_to_user_id,json=reassignToUserId,proto3,oneof" json:"reassign_to_user_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RemoveMemberRequest) Reset() {
+	*x = RemoveMemberRequest{}
+	mi := &file_agency_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberRequest) ProtoMessage() {}
+
+func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveMemberRequest) GetReassignToUserId() string {
+	if x != nil && x.ReassignToUserId != nil {
+		return *x.ReassignToUserId
+	}
+	return ""
+}
+
+type RemoveMemberResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// reassignment — заполняется, если был задан reassign_to_user_id
+	Reassignment  *AgencyReassignment `protobuf:"bytes,2,opt,name=reassignment,proto3" json:"reassignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberResponse) Reset() {
+	*x = RemoveMemberResponse{}
+	mi := &file_agency_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberResponse) ProtoMessage() {}
+
+func (x *RemoveMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMemberResponse) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveMemberResponse) GetReassignment() *AgencyReassignment {
+	if x != nil {
+		return x.Reassignment
+	}
+	return nil
+}
+
+type ReassignMemberAssetsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id — уходящий участник
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ToUserId      string `protobuf:"bytes,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignMemberAssetsRequest) Reset() {
+	*x = ReassignMemberAssetsRequest{}
+	mi := &file_agency_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignMemberAssetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignMemberAssetsRequest) ProtoMessage() {}
+
+func (x *ReassignMemberAssetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignMemberAssetsRequest.ProtoReflect.Descriptor instead.
+func (*ReassignMemberAssetsRequest) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReassignMemberAssetsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReassignMemberAssetsRequest) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+type ReassignMemberAssetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reassignment  *AgencyReassignment    `protobuf:"bytes,1,opt,name=reassignment,proto3" json:"reassignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignMemberAssetsResponse) Reset() {
+	*x = ReassignMemberAssetsResponse{}
+	mi := &file_agency_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignMemberAssetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignMemberAssetsResponse) ProtoMessage() {}
+
+func (x *ReassignMemberAssetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignMemberAssetsResponse.ProtoReflect.Descriptor instead.
+func (*ReassignMemberAssetsResponse) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReassignMemberAssetsResponse) GetReassignment() *AgencyReassignment {
+	if x != nil {
+		return x.Reassignment
+	}
+	return nil
+}
+
+type GetTeamDealReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// agency_id — чужое агентство (только для админа); по умолчанию — своё
+	AgencyId *string `protobuf:"bytes,1,opt,name=agency_id,json=agencyId,proto3,oneof" json:"agency_id,omitempty"`
+	// Период по дате изменения сделки, RFC 3339; границы необязательны
+	From          *string `protobuf:"bytes,2,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *string `protobuf:"bytes,3,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamDealReportRequest) Reset() {
+	*x = GetTeamDealReportRequest{}
+	mi := &file_agency_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamDealReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamDealReportRequest) ProtoMessage() {}
+
+func (x *GetTeamDealReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamDealReportRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamDealReportRequest) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetTeamDealReportRequest) GetAgencyId() string {
+	if x != nil && x.AgencyId != nil {
+		return *x.AgencyId
+	}
+	return ""
+}
+
+func (x *GetTeamDealReportRequest) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *GetTeamDealReportRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+type TeamDealReportResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	AgencyId string                 `protobuf:"bytes,1,opt,name=agency_id,json=agencyId,proto3" json:"agency_id,omitempty"`
+	From     *string                `protobuf:"bytes,2,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To       *string                `protobuf:"bytes,3,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	Members  []*AgencyMemberStats   `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	// totals — сумма по участникам; open_deals без повторов: сделка между участниками считается один раз
+	Totals        *AgencyMemberStats `protobuf:"bytes,5,opt,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamDealReportResponse) Reset() {
+	*x = TeamDealReportResponse{}
+	mi := &file_agency_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamDealReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamDealReportResponse) ProtoMessage() {}
+
+func (x *TeamDealReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agency_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamDealReportResponse.ProtoReflect.Descriptor instead.
+func (*TeamDealReportResponse) Descriptor() ([]byte, []int) {
+	return file_agency_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TeamDealReportResponse) GetAgencyId() string {
+	if x != nil {
+		return x.AgencyId
+	}
+	return ""
+}
+
+func (x *TeamDealReportResponse) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *TeamDealReportResponse) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+func (x *TeamDealReportResponse) GetMembers() []*AgencyMemberStats {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *TeamDealReportResponse) GetTotals() *AgencyMemberStats {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+var File_agency_proto protoreflect.FileDescriptor
+
+const file_agency_proto_rawDesc = "" +
+	"\n" +
+	"\fagency.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\"w\n" +
+	"\x06Agency\x12\x1b\n" +
+	"\tagency_id\x18\x01 \x01(\tR\bagencyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"\x92\x01\n" +
+	"\fAgencyMember\x12\x1b\n" +
+	"\tagency_id\x18\x01 \x01(\tR\bagencyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12/\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1b.leadexchange.v1.AgencyRoleR\x04role\x12\x1b\n" +
+	"\tjoined_at\x18\x04 \x01(\tR\bjoinedAt\"\xb9\x02\n" +
+	"\x10AgencyInvitation\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x12\x1b\n" +
+	"\tagency_id\x18\x02 \x01(\tR\bagencyId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12/\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x1b.leadexchange.v1.AgencyRoleR\x04role\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x05 \x01(\tR\tinvitedBy\x12?\n" +
+	"\x06status\x18\x06 \x01(\x0e2'.leadexchange.v1.AgencyInvitationStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\xa0\x01\n" +
+	"\x12AgencyReassignment\x12 \n" +
+	"\ffrom_user_id\x18\x01 \x01(\tR\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\tR\btoUserId\x12\x14\n" +
+	"\x05leads\x18\x03 \x01(\x05R\x05leads\x12\x1e\n" +
+	"\n" +
+	"properties\x18\x04 \x01(\x05R\n" +
+	"properties\x12\x14\n" +
+	"\x05deals\x18\x05 \x01(\x05R\x05deals\"\xd0\x02\n" +
+	"\x11AgencyMemberStats\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12/\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1b.leadexchange.v1.AgencyRoleR\x04role\x12\x1f\n" +
+	"\vleads_owned\x18\x03 \x01(\x05R\n" +
+	"leadsOwned\x12)\n" +
+	"\x10properties_owned\x18\x04 \x01(\x05R\x0fpropertiesOwned\x12\x1d\n" +
+	"\n" +
+	"deals_sold\x18\x05 \x01(\x05R\tdealsSold\x12\x1f\n" +
+	"\vsold_volume\x18\x06 \x01(\x01R\n" +
+	"soldVolume\x12!\n" +
+	"\fdeals_bought\x18\a \x01(\x05R\vdealsBought\x12#\n" +
+	"\rbought_volume\x18\b \x01(\x01R\fboughtVolume\x12\x1d\n" +
+	"\n" +
+	"open_deals\x18\t \x01(\x05R\topenDeals\"5\n" +
+	"\x13CreateAgencyRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xc8\x01R\x04name\"L\n" +
+	"\x10GetAgencyRequest\x12*\n" +
+	"\tagency_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\bagencyId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_agency_id\"\xbf\x01\n" +
+	"\x0eAgencyResponse\x12/\n" +
+	"\x06agency\x18\x01 \x01(\v2\x17.leadexchange.v1.AgencyR\x06agency\x127\n" +
+	"\amembers\x18\x02 \x03(\v2\x1d.leadexchange.v1.AgencyMemberR\amembers\x12C\n" +
+	"\vinvitations\x18\x03 \x03(\v2!.leadexchange.v1.AgencyInvitationR\vinvitations\"s\n" +
+	"\x13InviteMemberRequest\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12=\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1b.leadexchange.v1.AgencyRoleB\f\xfaB\t\x82\x01\x06\x10\x01\x18\x02\x18\x03R\x04role\"]\n" +
+	"\x18AgencyInvitationResponse\x12A\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2!.leadexchange.v1.AgencyInvitationR\n" +
+	"invitation\"\x1a\n" +
+	"\x18ListMyInvitationsRequest\"`\n" +
+	"\x19ListMyInvitationsResponse\x12C\n" +
+	"\vinvitations\x18\x01 \x03(\v2!.leadexchange.v1.AgencyInvitationR\vinvitations\"H\n" +
+	"\x17AcceptInvitationRequest\x12-\n" +
+	"\rinvitation_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\finvitationId\"I\n" +
+	"\x18DeclineInvitationRequest\x12-\n" +
+	"\rinvitation_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\finvitationId\"H\n" +
+	"\x17RevokeInvitationRequest\x12-\n" +
+	"\rinvitation_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\finvitationId\"M\n" +
+	"\x14AgencyMemberResponse\x125\n" +
+	"\x06member\x18\x01 \x01(\v2\x1d.leadexchange.v1.AgencyMemberR\x06member\"{\n" +
+	"\x17UpdateMemberRoleRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12=\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1b.leadexchange.v1.AgencyRoleB\f\xfaB\t\x82\x01\x06\x10\x01\x18\x02\x18\x03R\x04role\"\x8e\x01\n" +
+	"\x13RemoveMemberRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12<\n" +
+	"\x13reassign_to_user_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x10reassignToUserId\x88\x01\x01B\x16\n" +
+	"\x14_reassign_to_user_id\"y\n" +
+	"\x14RemoveMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12G\n" +
+	"\freassignment\x18\x02 \x01(\v2#.leadexchange.v1.AgencyReassignmentR\freassignment\"h\n" +
+	"\x1bReassignMemberAssetsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\btoUserId\"g\n" +
+	"\x1cReassignMemberAssetsResponse\x12G\n" +
+	"\freassignment\x18\x01 \x01(\v2#.leadexchange.v1.AgencyReassignmentR\freassignment\"\x92\x01\n" +
+	"\x18GetTeamDealReportRequest\x12*\n" +
+	"\tagency_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\bagencyId\x88\x01\x01\x12\x17\n" +
+	"\x04from\x18\x02 \x01(\tH\x01R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x03 \x01(\tH\x02R\x02to\x88\x01\x01B\f\n" +
+	"\n" +
+	"_agency_idB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to\"\xed\x01\n" +
+	"\x16TeamDealReportResponse\x12\x1b\n" +
+	"\tagency_id\x18\x01 \x01(\tR\bagencyId\x12\x17\n" +
+	"\x04from\x18\x02 \x01(\tH\x00R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x03 \x01(\tH\x01R\x02to\x88\x01\x01\x12<\n" +
+	"\amembers\x18\x04 \x03(\v2\".leadexchange.v1.AgencyMemberStatsR\amembers\x12:\n" +
+	"\x06totals\x18\x05 \x01(\v2\".leadexchange.v1.AgencyMemberStatsR\x06totalsB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_to*p\n" +
+	"\n" +
+	"AgencyRole\x12\x1b\n" +
+	"\x17AGENCY_ROLE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11AGENCY_ROLE_OWNER\x10\x01\x12\x17\n" +
+	"\x13AGENCY_ROLE_MANAGER\x10\x02\x12\x15\n" +
+	"\x11AGENCY_ROLE_AGENT\x10\x03*\xdc\x01\n" +
+	"\x16AgencyInvitationStatus\x12(\n" +
+	"$AGENCY_INVITATION_STATUS_UNSPECIFIED\x10\x00\x12$\n" +
+	" AGENCY_INVITATION_STATUS_PENDING\x10\x01\x12%\n" +
+	"!AGENCY_INVITATION_STATUS_ACCEPTED\x10\x02\x12%\n" +
+	"!AGENCY_INVITATION_STATUS_DECLINED\x10\x03\x12$\n" +
+	" AGENCY_INVITATION_STATUS_REVOKED\x10\x042\xfb\v\n" +
+	"\rAgencyService\x12n\n" +
+	"\fCreateAgency\x12$.leadexchange.v1.CreateAgencyRequest\x1a\x1f.leadexchange.v1.AgencyResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/agencies\x12c\n" +
+	"\tGetAgency\x12!.leadexchange.v1.GetAgencyRequest\x1a\x1f.leadexchange.v1.AgencyResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/agency\x12\x82\x01\n" +
+	"\fInviteMember\x12$.leadexchange.v1.InviteMemberRequest\x1a).leadexchange.v1.AgencyInvitationResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/agency/invitations\x12\x8a\x01\n" +
+	"\x11ListMyInvitations\x12).leadexchange.v1.ListMyInvitationsRequest\x1a*.leadexchange.v1.ListMyInvitationsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/agency-invitations\x12\x9d\x01\n" +
+	"\x10AcceptInvitation\x12(.leadexchange.v1.AcceptInvitationRequest\x1a%.leadexchange.v1.AgencyMemberResponse\"8\x82\xd3\xe4\x93\x022:\x01*\"-/v1/agency-invitations/{invitation_id}/accept\x12\x91\x01\n" +
+	"\x11DeclineInvitation\x12).leadexchange.v1.DeclineInvitationRequest\x1a\x16.google.protobuf.Empty\"9\x82\xd3\xe4\x93\x023:\x01*\"./v1/agency-invitations/{invitation_id}/decline\x12\x8e\x01\n" +
+	"\x10RevokeInvitation\x12(.leadexchange.v1.RevokeInvitationRequest\x1a\x16.google.protobuf.Empty\"8\x82\xd3\xe4\x93\x022:\x01*\"-/v1/agency-invitations/{invitation_id}/revoke\x12\x8c\x01\n" +
+	"\x10UpdateMemberRole\x12(.leadexchange.v1.UpdateMemberRoleRequest\x1a%.leadexchange.v1.AgencyMemberResponse\"'\x82\xd3\xe4\x93\x02!:\x01*2\x1c/v1/agency/members/{user_id}\x12\x81\x01\n" +
+	"\fRemoveMember\x12$.leadexchange.v1.RemoveMemberRequest\x1a%.leadexchange.v1.RemoveMemberResponse\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/v1/agency/members/{user_id}\x12\xa5\x01\n" +
+	"\x14ReassignMemberAssets\x12,.leadexchange.v1.ReassignMemberAssetsRequest\x1a-.leadexchange.v1.ReassignMemberAssetsResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/agency/members/{user_id}/reassign\x12\x82\x01\n" +
+	"\x11GetTeamDealReport\x12).leadexchange.v1.GetTeamDealReportRequest\x1a'.leadexchange.v1.TeamDealReportResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/agency/reportB4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
+
+var (
+	file_agency_proto_rawDescOnce sync.Once
+	file_agency_proto_rawDescData []byte
+)
+
+func file_agency_proto_rawDescGZIP() []byte {
+	file_agency_proto_rawDescOnce.Do(func() {
+		file_agency_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agency_proto_rawDesc), len(file_agency_proto_rawDesc)))
+	})
+	return file_agency_proto_rawDescData
+}
+
+var file_agency_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_agency_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_agency_proto_goTypes = []any{
+	(AgencyRole)(0),                      // 0: leadexchange.v1.AgencyRole
+	(AgencyInvitationStatus)(0),          // 1: leadexchange.v1.AgencyInvitationStatus
+	(*Agency)(nil),                       // 2: leadexchange.v1.Agency
+	(*AgencyMember)(nil),                 // 3: leadexchange.v1.AgencyMember
+	(*AgencyInvitation)(nil),             // 4: leadexchange.v1.AgencyInvitation
+	(*AgencyReassignment)(nil),           // 5: leadexchange.v1.AgencyReassignment
+	(*AgencyMemberStats)(nil),            // 6: leadexchange.v1.AgencyMemberStats
+	(*CreateAgencyRequest)(nil),          // 7: leadexchange.v1.CreateAgencyRequest
+	(*GetAgencyRequest)(nil),             // 8: leadexchange.v1.GetAgencyRequest
+	(*AgencyResponse)(nil),               // 9: leadexchange.v1.AgencyResponse
+	(*InviteMemberRequest)(nil),          // 10: leadexchange.v1.InviteMemberRequest
+	(*AgencyInvitationResponse)(nil),     // 11: leadexchange.v1.AgencyInvitationResponse
+	(*ListMyInvitationsRequest)(nil),     // 12: leadexchange.v1.ListMyInvitationsRequest
+	(*ListMyInvitationsResponse)(nil),    // 13: leadexchange.v1.ListMyInvitationsResponse
+	(*AcceptInvitationRequest)(nil),      // 14: leadexchange.v1.AcceptInvitationRequest
+	(*DeclineInvitationRequest)(nil),     // 15: leadexchange.v1.DeclineInvitationRequest
+	(*RevokeInvitationRequest)(nil),      // 16: leadexchange.v1.RevokeInvitationRequest
+	(*AgencyMemberResponse)(nil),         // 17: leadexchange.v1.AgencyMemberResponse
+	(*UpdateMemberRoleRequest)(nil),      // 18: leadexchange.v1.UpdateMemberRoleRequest
+	(*RemoveMemberRequest)(nil),          // 19: leadexchange.v1.RemoveMemberRequest
+	(*RemoveMemberResponse)(nil),         // 20: leadexchange.v1.RemoveMemberResponse
+	(*ReassignMemberAssetsRequest)(nil),  // 21: leadexchange.v1.ReassignMemberAssetsRequest
+	(*ReassignMemberAssetsResponse)(nil), // 22: leadexchange.v1.ReassignMemberAssetsResponse
+	(*GetTeamDealReportRequest)(nil),     // 23: leadexchange.v1.GetTeamDealReportRequest
+	(*TeamDealReportResponse)(nil),       // 24: leadexchange.v1.TeamDealReportResponse
+	(*emptypb.Empty)(nil),                // 25: google.protobuf.Empty
+}
+var file_agency_proto_depIdxs = []int32{
+	0,  // 0: leadexchange.v1.AgencyMember.role:type_name -> leadexchange.v1.AgencyRole
+	0,  // 1: leadexchange.v1.AgencyInvitation.role:type_name -> leadexchange.v1.AgencyRole
+	1,  // 2: leadexchange.v1.AgencyInvitation.status:type_name -> leadexchange.v1.AgencyInvitationStatus
+	0,  // 3: leadexchange.v1.AgencyMemberStats.role:type_name -> leadexchange.v1.AgencyRole
+	2,  // 4: leadexchange.v1.AgencyResponse.agency:type_name -> leadexchange.v1.Agency
+	3,  // 5: leadexchange.v1.AgencyResponse.members:type_name -> leadexchange.v1.AgencyMember
+	4,  // 6: leadexchange.v1.AgencyResponse.invitations:type_name -> leadexchange.v1.AgencyInvitation
+	0,  // 7: leadexchange.v1.InviteMemberRequest.role:type_name -> leadexchange.v1.AgencyRole
+	4,  // 8: leadexchange.v1.AgencyInvitationResponse.invitation:type_name -> leadexchange.v1.AgencyInvitation
+	4,  // 9: leadexchange.v1.ListMyInvitationsResponse.invitations:type_name -> leadexchange.v1.AgencyInvitation
+	3,  // 10: leadexchange.v1.AgencyMemberResponse.member:type_name -> leadexchange.v1.AgencyMember
+	0,  // 11: leadexchange.v1.UpdateMemberRoleRequest.role:type_name -> leadexchange.v1.AgencyRole
+	5,  // 12: leadexchange.v1.RemoveMemberResponse.reassignment:type_name -> leadexchange.v1.AgencyReassignment
+	5,  // 13: leadexchange.v1.ReassignMemberAssetsResponse.reassignment:type_name -> leadexchange.v1.AgencyReassignment
+	6,  // 14: leadexchange.v1.TeamDealReportResponse.members:type_name -> leadexchange.v1.AgencyMemberStats
+	6,  // 15: leadexchange.v1.TeamDealReportResponse.totals:type_name -> leadexchange.v1.AgencyMemberStats
+	7,  // 16: leadexchange.v1.AgencyService.CreateAgency:input_type -> leadexchange.v1.CreateAgencyRequest
+	8,  // 17: leadexchange.v1.AgencyService.GetAgency:input_type -> leadexchange.v1.GetAgencyRequest
+	10, // 18: leadexchange.v1.AgencyService.InviteMember:input_type -> leadexchange.v1.InviteMemberRequest
+	12, // 19: leadexchange.v1.AgencyService.ListMyInvitations:input_type -> leadexchange.v1.ListMyInvitationsRequest
+	14, // 20: leadexchange.v1.AgencyService.AcceptInvitation:input_type -> leadexchange.v1.AcceptInvitationRequest
+	15, // 21: leadexchange.v1.AgencyService.DeclineInvitation:input_type -> leadexchange.v1.DeclineInvitationRequest
+	16, // 22: leadexchange.v1.AgencyService.RevokeInvitation:input_type -> leadexchange.v1.RevokeInvitationRequest
+	18, // 23: leadexchange.v1.AgencyService.UpdateMemberRole:input_type -> leadexchange.v1.UpdateMemberRoleRequest
+	19, // 24: leadexchange.v1.AgencyService.RemoveMember:input_type -> leadexchange.v1.RemoveMemberRequest
+	21, // 25: leadexchange.v1.AgencyService.ReassignMemberAssets:input_type -> leadexchange.v1.ReassignMemberAssetsRequest
+	23, // 26: leadexchange.v1.AgencyService.GetTeamDealReport:input_type -> leadexchange.v1.GetTeamDealReportRequest
+	9,  // 27: leadexchange.v1.AgencyService.CreateAgency:output_type -> leadexchange.v1.AgencyResponse
+	9,  // 28: leadexchange.v1.AgencyService.GetAgency:output_type -> leadexchange.v1.AgencyResponse
+	11, // 29: leadexchange.v1.AgencyService.InviteMember:output_type -> leadexchange.v1.AgencyInvitationResponse
+	13, // 30: leadexchange.v1.AgencyService.ListMyInvitations:output_type -> leadexchange.v1.ListMyInvitationsResponse
+	17, // 31: leadexchange.v1.AgencyService.AcceptInvitation:output_type -> leadexchange.v1.AgencyMemberResponse
+	25, // 32: leadexchange.v1.AgencyService.DeclineInvitation:output_type -> google.protobuf.Empty
+	25, // 33: leadexchange.v1.AgencyService.RevokeInvitation:output_type -> google.protobuf.Empty
+	17, // 34: leadexchange.v1.AgencyService.UpdateMemberRole:output_type -> leadexchange.v1.AgencyMemberResponse
+	20, // 35: leadexchange.v1.AgencyService.RemoveMember:output_type -> leadexchange.v1.RemoveMemberResponse
+	22, // 36: leadexchange.v1.AgencyService.ReassignMemberAssets:output_type -> leadexchange.v1.ReassignMemberAssetsResponse
+	24, // 37: leadexchange.v1.AgencyService.GetTeamDealReport:output_type -> leadexchange.v1.TeamDealReportResponse
+	27, // [27:38] is the sub-list for method output_type
+	16, // [16:27] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_agency_proto_init() }
+func file_agency_proto_init() {
+	if File_agency_proto != nil {
+		return
+	}
+	file_agency_proto_msgTypes[6].OneofWrappers = []any{}
+	file_agency_proto_msgTypes[17].OneofWrappers = []any{}
+	file_agency_proto_msgTypes[21].OneofWrappers = []any{}
+	file_agency_proto_msgTypes[22].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agency_proto_rawDesc), len(file_agency_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   23,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_agency_proto_goTypes,
+		DependencyIndexes: file_agency_proto_depIdxs,
+		EnumInfos:         file_agency_proto_enumTypes,
+		MessageInfos:      file_agency_proto_msgTypes,
+	}.Build()
+	File_agency_proto = out.File
+	file_agency_proto_goTypes = nil
+	file_agency_proto_depIdxs = nil
+}
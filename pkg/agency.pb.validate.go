@@ -0,0 +1,3087 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: agency.proto
+
+package leadexchangev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _agency_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on Agency with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Agency) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Agency with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AgencyMultiError, or nil if none found.
+func (m *Agency) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Agency) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AgencyId
+
+	// no validation rules for Name
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return AgencyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgencyMultiError is an error wrapping multiple validation errors returned by
+// Agency.ValidateAll() if the designated constraints aren't met.
+type AgencyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgencyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgencyMultiError) AllErrors() []error { return m }
+
+// AgencyValidationError is the validation error returned by Agency.Validate if
+// the designated constraints aren't met.
+type AgencyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgencyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgencyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgencyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgencyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgencyValidationError) ErrorName() string { return "AgencyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AgencyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgency.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgencyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgencyValidationError{}
+
+// Validate checks the field values on AgencyMember with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AgencyMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgencyMember with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AgencyMemberMultiError, or
+// nil if none found.
+func (m *AgencyMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgencyMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AgencyId
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	// no validation rules for JoinedAt
+
+	if len(errors) > 0 {
+		return AgencyMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgencyMemberMultiError is an error wrapping multiple validation errors
+// returned by AgencyMember.ValidateAll() if the designated constraints aren't met.
+type AgencyMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgencyMemberMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgencyMemberMultiError) AllErrors() []error { return m }
+
+// AgencyMemberValidationError is the validation error returned by
+// AgencyMember.Validate if the designated constraints aren't met.
+type AgencyMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgencyMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgencyMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgencyMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgencyMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgencyMemberValidationError) ErrorName() string { return "AgencyMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AgencyMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgencyMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgencyMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgencyMemberValidationError{}
+
+// Validate checks the field values on AgencyInvitation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AgencyInvitation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgencyInvitation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AgencyInvitationMultiError, or nil if none found.
+func (m *AgencyInvitation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgencyInvitation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InvitationId
+
+	// no validation rules for AgencyId
+
+	// no validation rules for Email
+
+	// no validation rules for Role
+
+	// no validation rules for InvitedBy
+
+	// no validation rules for Status
+
+	// no validation rules for ExpiresAt
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return AgencyInvitationMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgencyInvitationMultiError is an error wrapping multiple validation errors
+// returned by AgencyInvitation.ValidateAll() if the designated constraints
+// aren't met.
+type AgencyInvitationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgencyInvitationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgencyInvitationMultiError) AllErrors() []error { return m }
+
+// AgencyInvitationValidationError is the validation error returned by
+// AgencyInvitation.Validate if the designated constraints aren't met.
+type AgencyInvitationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgencyInvitationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgencyInvitationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgencyInvitationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgencyInvitationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgencyInvitationValidationError) ErrorName() string { return "AgencyInvitationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AgencyInvitationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgencyInvitation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgencyInvitationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgencyInvitationValidationError{}
+
+// Validate checks the field values on AgencyReassignment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AgencyReassignment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgencyReassignment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AgencyReassignmentMultiError, or nil if none found.
+func (m *AgencyReassignment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgencyReassignment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromUserId
+
+	// no validation rules for ToUserId
+
+	// no validation rules for Leads
+
+	// no validation rules for Properties
+
+	// no validation rules for Deals
+
+	if len(errors) > 0 {
+		return AgencyReassignmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgencyReassignmentMultiError is an error wrapping multiple validation errors
+// returned by AgencyReassignment.ValidateAll() if the designated constraints
+// aren't met.
+type AgencyReassignmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgencyReassignmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgencyReassignmentMultiError) AllErrors() []error { return m }
+
+// AgencyReassignmentValidationError is the validation error returned by
+// AgencyReassignment.Validate if the designated constraints aren't met.
+type AgencyReassignmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgencyReassignmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgencyReassignmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgencyReassignmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgencyReassignmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgencyReassignmentValidationError) ErrorName() string {
+	return "AgencyReassignmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AgencyReassignmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgencyReassignment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgencyReassignmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgencyReassignmentValidationError{}
+
+// Validate checks the field values on AgencyMemberStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AgencyMemberStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgencyMemberStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AgencyMemberStatsMultiError, or nil if none found.
+func (m *AgencyMemberStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgencyMemberStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	// no validation rules for LeadsOwned
+
+	// no validation rules for PropertiesOwned
+
+	// no validation rules for DealsSold
+
+	// no validation rules for SoldVolume
+
+	// no validation rules for DealsBought
+
+	// no validation rules for BoughtVolume
+
+	// no validation rules for OpenDeals
+
+	if len(errors) > 0 {
+		return AgencyMemberStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgencyMemberStatsMultiError is an error wrapping multiple validation errors
+// returned by AgencyMemberStats.ValidateAll() if the designated constraints
+// aren't met.
+type AgencyMemberStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgencyMemberStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgencyMemberStatsMultiError) AllErrors() []error { return m }
+
+// AgencyMemberStatsValidationError is the validation error returned by
+// AgencyMemberStats.Validate if the designated constraints aren't met.
+type AgencyMemberStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgencyMemberStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgencyMemberStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgencyMemberStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgencyMemberStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgencyMemberStatsValidationError) ErrorName() string {
+	return "AgencyMemberStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AgencyMemberStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgencyMemberStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgencyMemberStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgencyMemberStatsValidationError{}
+
+// Validate checks the field values on CreateAgencyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAgencyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAgencyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAgencyRequestMultiError, or nil if none found.
+func (m *CreateAgencyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAgencyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 200 {
+		err := CreateAgencyRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateAgencyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAgencyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAgencyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAgencyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAgencyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAgencyRequestMultiError) AllErrors() []error { return m }
+
+// CreateAgencyRequestValidationError is the validation error returned by
+// CreateAgencyRequest.Validate if the designated constraints aren't met.
+type CreateAgencyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAgencyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAgencyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAgencyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAgencyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAgencyRequestValidationError) ErrorName() string {
+	return "CreateAgencyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAgencyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAgencyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAgencyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAgencyRequestValidationError{}
+
+// Validate checks the field values on GetAgencyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAgencyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAgencyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAgencyRequestMultiError, or nil if none found.
+func (m *GetAgencyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAgencyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.AgencyId != nil {
+
+		if err := m._validateUuid(m.GetAgencyId()); err != nil {
+			err = GetAgencyRequestValidationError{
+				field:  "AgencyId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAgencyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetAgencyRequest) _validateUuid(uuid string) error {
+	if matched := _agency_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetAgencyRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAgencyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAgencyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAgencyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAgencyRequestMultiError) AllErrors() []error { return m }
+
+// GetAgencyRequestValidationError is the validation error returned by
+// GetAgencyRequest.Validate if the designated constraints aren't met.
+type GetAgencyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAgencyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAgencyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAgencyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAgencyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAgencyRequestValidationError) ErrorName() string { return "GetAgencyRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAgencyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAgencyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAgencyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAgencyRequestValidationError{}
+
+// Validate checks the field values on AgencyResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AgencyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgencyResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AgencyResponseMultiError,
+// or nil if none found.
+func (m *AgencyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgencyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAgency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgencyResponseValidationError{
+					field:  "Agency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgencyResponseValidationError{
+					field:  "Agency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAgency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgencyResponseValidationError{
+				field:  "Agency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AgencyResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AgencyResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AgencyResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetInvitations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AgencyResponseValidationError{
+						field:  fmt.Sprintf("Invitations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AgencyResponseValidationError{
+						field:  fmt.Sprintf("Invitations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AgencyResponseValidationError{
+					field:  fmt.Sprintf("Invitations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AgencyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgencyResponseMultiError is an error wrapping multiple validation errors
+// returned by AgencyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AgencyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgencyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgencyResponseMultiError) AllErrors() []error { return m }
+
+// AgencyResponseValidationError is the validation error returned by
+// AgencyResponse.Validate if the designated constraints aren't met.
+type AgencyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgencyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgencyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgencyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgencyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgencyResponseValidationError) ErrorName() string { return "AgencyResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AgencyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgencyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgencyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgencyResponseValidationError{}
+
+// Validate checks the field values on InviteMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InviteMemberRequestMultiError, or nil if none found.
+func (m *InviteMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = InviteMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _InviteMemberRequest_Role_InLookup[m.GetRole()]; !ok {
+		err := InviteMemberRequestValidationError{
+			field:  "Role",
+			reason: "value must be in list [AGENCY_ROLE_MANAGER AGENCY_ROLE_AGENT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AgencyRole_name[int32(m.GetRole())]; !ok {
+		err := InviteMemberRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return InviteMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *InviteMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *InviteMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// InviteMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by InviteMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InviteMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteMemberRequestMultiError) AllErrors() []error { return m }
+
+// InviteMemberRequestValidationError is the validation error returned by
+// InviteMemberRequest.Validate if the designated constraints aren't met.
+type InviteMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteMemberRequestValidationError) ErrorName() string {
+	return "InviteMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteMemberRequestValidationError{}
+
+var _InviteMemberRequest_Role_InLookup = map[AgencyRole]struct{}{
+	2: {},
+	3: {},
+}
+
+// Validate checks the field values on AgencyInvitationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AgencyInvitationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgencyInvitationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AgencyInvitationResponseMultiError, or nil if none found.
+func (m *AgencyInvitationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgencyInvitationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvitation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgencyInvitationResponseValidationError{
+					field:  "Invitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgencyInvitationResponseValidationError{
+					field:  "Invitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvitation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgencyInvitationResponseValidationError{
+				field:  "Invitation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AgencyInvitationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgencyInvitationResponseMultiError is an error wrapping multiple validation
+// errors returned by AgencyInvitationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AgencyInvitationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgencyInvitationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgencyInvitationResponseMultiError) AllErrors() []error { return m }
+
+// AgencyInvitationResponseValidationError is the validation error returned by
+// AgencyInvitationResponse.Validate if the designated constraints aren't met.
+type AgencyInvitationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgencyInvitationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgencyInvitationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgencyInvitationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgencyInvitationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgencyInvitationResponseValidationError) ErrorName() string {
+	return "AgencyInvitationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AgencyInvitationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgencyInvitationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgencyInvitationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgencyInvitationResponseValidationError{}
+
+// Validate checks the field values on ListMyInvitationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMyInvitationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMyInvitationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMyInvitationsRequestMultiError, or nil if none found.
+func (m *ListMyInvitationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMyInvitationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListMyInvitationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMyInvitationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMyInvitationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMyInvitationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMyInvitationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMyInvitationsRequestMultiError) AllErrors() []error { return m }
+
+// ListMyInvitationsRequestValidationError is the validation error returned by
+// ListMyInvitationsRequest.Validate if the designated constraints aren't met.
+type ListMyInvitationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMyInvitationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMyInvitationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMyInvitationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMyInvitationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMyInvitationsRequestValidationError) ErrorName() string {
+	return "ListMyInvitationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMyInvitationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMyInvitationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMyInvitationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMyInvitationsRequestValidationError{}
+
+// Validate checks the field values on ListMyInvitationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMyInvitationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMyInvitationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMyInvitationsResponseMultiError, or nil if none found.
+func (m *ListMyInvitationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMyInvitationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvitations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMyInvitationsResponseValidationError{
+						field:  fmt.Sprintf("Invitations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMyInvitationsResponseValidationError{
+						field:  fmt.Sprintf("Invitations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMyInvitationsResponseValidationError{
+					field:  fmt.Sprintf("Invitations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMyInvitationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMyInvitationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMyInvitationsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListMyInvitationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMyInvitationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMyInvitationsResponseMultiError) AllErrors() []error { return m }
+
+// ListMyInvitationsResponseValidationError is the validation error returned by
+// ListMyInvitationsResponse.Validate if the designated constraints aren't met.
+type ListMyInvitationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMyInvitationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMyInvitationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMyInvitationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMyInvitationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMyInvitationsResponseValidationError) ErrorName() string {
+	return "ListMyInvitationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMyInvitationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMyInvitationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMyInvitationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMyInvitationsResponseValidationError{}
+
+// Validate checks the field values on AcceptInvitationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptInvitationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptInvitationRequestMultiError, or nil if none found.
+func (m *AcceptInvitationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptInvitationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetInvitationId()); err != nil {
+		err = AcceptInvitationRequestValidationError{
+			field:  "InvitationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AcceptInvitationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AcceptInvitationRequest) _validateUuid(uuid string) error {
+	if matched := _agency_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AcceptInvitationRequestMultiError is an error wrapping multiple validation
+// errors returned by AcceptInvitationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptInvitationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptInvitationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptInvitationRequestMultiError) AllErrors() []error { return m }
+
+// AcceptInvitationRequestValidationError is the validation error returned by
+// AcceptInvitationRequest.Validate if the designated constraints aren't met.
+type AcceptInvitationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptInvitationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptInvitationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptInvitationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptInvitationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptInvitationRequestValidationError) ErrorName() string {
+	return "AcceptInvitationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptInvitationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptInvitationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptInvitationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptInvitationRequestValidationError{}
+
+// Validate checks the field values on DeclineInvitationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeclineInvitationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeclineInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeclineInvitationRequestMultiError, or nil if none found.
+func (m *DeclineInvitationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeclineInvitationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetInvitationId()); err != nil {
+		err = DeclineInvitationRequestValidationError{
+			field:  "InvitationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeclineInvitationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeclineInvitationRequest) _validateUuid(uuid string) error {
+	if matched := _agency_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeclineInvitationRequestMultiError is an error wrapping multiple validation
+// errors returned by DeclineInvitationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeclineInvitationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeclineInvitationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeclineInvitationRequestMultiError) AllErrors() []error { return m }
+
+// DeclineInvitationRequestValidationError is the validation error returned by
+// DeclineInvitationRequest.Validate if the designated constraints aren't met.
+type DeclineInvitationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeclineInvitationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeclineInvitationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeclineInvitationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeclineInvitationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeclineInvitationRequestValidationError) ErrorName() string {
+	return "DeclineInvitationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeclineInvitationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeclineInvitationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeclineInvitationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeclineInvitationRequestValidationError{}
+
+// Validate checks the field values on RevokeInvitationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeInvitationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeInvitationRequestMultiError, or nil if none found.
+func (m *RevokeInvitationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeInvitationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetInvitationId()); err != nil {
+		err = RevokeInvitationRequestValidationError{
+			field:  "InvitationId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeInvitationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevokeInvitationRequest) _validateUuid(uuid string) error {
+	if matched := _agency_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevokeInvitationRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeInvitationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeInvitationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeInvitationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeInvitationRequestMultiError) AllErrors() []error { return m }
+
+// RevokeInvitationRequestValidationError is the validation error returned by
+// RevokeInvitationRequest.Validate if the designated constraints aren't met.
+type RevokeInvitationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeInvitationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeInvitationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeInvitationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeInvitationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeInvitationRequestValidationError) ErrorName() string {
+	return "RevokeInvitationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeInvitationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeInvitationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeInvitationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeInvitationRequestValidationError{}
+
+// Validate checks the field values on AgencyMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AgencyMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgencyMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AgencyMemberResponseMultiError, or nil if none found.
+func (m *AgencyMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgencyMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgencyMemberResponseValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgencyMemberResponseValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgencyMemberResponseValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AgencyMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgencyMemberResponseMultiError is an error wrapping multiple validation
+// errors returned by AgencyMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AgencyMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgencyMemberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgencyMemberResponseMultiError) AllErrors() []error { return m }
+
+// AgencyMemberResponseValidationError is the validation error returned by
+// AgencyMemberResponse.Validate if the designated constraints aren't met.
+type AgencyMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgencyMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgencyMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgencyMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgencyMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgencyMemberResponseValidationError) ErrorName() string {
+	return "AgencyMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AgencyMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgencyMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgencyMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgencyMemberResponseValidationError{}
+
+// Validate checks the field values on UpdateMemberRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMemberRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateMemberRoleRequestMultiError, or nil if none found.
+func (m *UpdateMemberRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMemberRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UpdateMemberRoleRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateMemberRoleRequest_Role_InLookup[m.GetRole()]; !ok {
+		err := UpdateMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must be in list [AGENCY_ROLE_MANAGER AGENCY_ROLE_AGENT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AgencyRole_name[int32(m.GetRole())]; !ok {
+		err := UpdateMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateMemberRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateMemberRoleRequest) _validateUuid(uuid string) error {
+	if matched := _agency_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateMemberRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateMemberRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateMemberRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMemberRoleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMemberRoleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMemberRoleRequestValidationError is the validation error returned by
+// UpdateMemberRoleRequest.Validate if the designated constraints aren't met.
+type UpdateMemberRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMemberRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMemberRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMemberRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMemberRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMemberRoleRequestValidationError) ErrorName() string {
+	return "UpdateMemberRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMemberRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMemberRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMemberRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMemberRoleRequestValidationError{}
+
+var _UpdateMemberRoleRequest_Role_InLookup = map[AgencyRole]struct{}{
+	2: {},
+	3: {},
+}
+
+// Validate checks the field values on RemoveMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveMemberRequestMultiError, or nil if none found.
+func (m *RemoveMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RemoveMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.ReassignToUserId != nil {
+
+		if err := m._validateUuid(m.GetReassignToUserId()); err != nil {
+			err = RemoveMemberRequestValidationError{
+				field:  "ReassignToUserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RemoveMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveMemberRequest) _validateUuid(uuid string) error {
+	if matched := _agency_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RemoveMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveMemberRequestValidationError is the validation error returned by
+// RemoveMemberRequest.Validate if the designated constraints aren't met.
+type RemoveMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveMemberRequestValidationError) ErrorName() string {
+	return "RemoveMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveMemberResponseMultiError, or nil if none found.
+func (m *RemoveMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if all {
+		switch v := interface{}(m.GetReassignment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveMemberResponseValidationError{
+					field:  "Reassignment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveMemberResponseValidationError{
+					field:  "Reassignment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReassignment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveMemberResponseValidationError{
+				field:  "Reassignment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveMemberResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveMemberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveMemberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveMemberResponseValidationError is the validation error returned by
+// RemoveMemberResponse.Validate if the designated constraints aren't met.
+type RemoveMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveMemberResponseValidationError) ErrorName() string {
+	return "RemoveMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveMemberResponseValidationError{}
+
+// Validate checks the field values on ReassignMemberAssetsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReassignMemberAssetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReassignMemberAssetsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReassignMemberAssetsRequestMultiError, or nil if none found.
+func (m *ReassignMemberAssetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReassignMemberAssetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ReassignMemberAssetsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetToUserId()); err != nil {
+		err = ReassignMemberAssetsRequestValidationError{
+			field:  "ToUserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReassignMemberAssetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReassignMemberAssetsRequest) _validateUuid(uuid string) error {
+	if matched := _agency_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReassignMemberAssetsRequestMultiError is an error wrapping multiple
+// validation errors returned by ReassignMemberAssetsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ReassignMemberAssetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReassignMemberAssetsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReassignMemberAssetsRequestMultiError) AllErrors() []error { return m }
+
+// ReassignMemberAssetsRequestValidationError is the validation error returned
+// by ReassignMemberAssetsRequest.Validate if the designated constraints
+// aren't met.
+type ReassignMemberAssetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReassignMemberAssetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReassignMemberAssetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReassignMemberAssetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReassignMemberAssetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReassignMemberAssetsRequestValidationError) ErrorName() string {
+	return "ReassignMemberAssetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReassignMemberAssetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReassignMemberAssetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReassignMemberAssetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReassignMemberAssetsRequestValidationError{}
+
+// Validate checks the field values on ReassignMemberAssetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReassignMemberAssetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReassignMemberAssetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReassignMemberAssetsResponseMultiError, or nil if none found.
+func (m *ReassignMemberAssetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReassignMemberAssetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReassignment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReassignMemberAssetsResponseValidationError{
+					field:  "Reassignment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReassignMemberAssetsResponseValidationError{
+					field:  "Reassignment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReassignment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReassignMemberAssetsResponseValidationError{
+				field:  "Reassignment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReassignMemberAssetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReassignMemberAssetsResponseMultiError is an error wrapping multiple
+// validation errors returned by ReassignMemberAssetsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ReassignMemberAssetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReassignMemberAssetsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReassignMemberAssetsResponseMultiError) AllErrors() []error { return m }
+
+// ReassignMemberAssetsResponseValidationError is the validation error returned
+// by ReassignMemberAssetsResponse.Validate if the designated constraints
+// aren't met.
+type ReassignMemberAssetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReassignMemberAssetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReassignMemberAssetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReassignMemberAssetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReassignMemberAssetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReassignMemberAssetsResponseValidationError) ErrorName() string {
+	return "ReassignMemberAssetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReassignMemberAssetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReassignMemberAssetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReassignMemberAssetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReassignMemberAssetsResponseValidationError{}
+
+// Validate checks the field values on GetTeamDealReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTeamDealReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTeamDealReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTeamDealReportRequestMultiError, or nil if none found.
+func (m *GetTeamDealReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTeamDealReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.AgencyId != nil {
+
+		if err := m._validateUuid(m.GetAgencyId()); err != nil {
+			err = GetTeamDealReportRequestValidationError{
+				field:  "AgencyId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.From != nil {
+		// no validation rules for From
+	}
+
+	if m.To != nil {
+		// no validation rules for To
+	}
+
+	if len(errors) > 0 {
+		return GetTeamDealReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetTeamDealReportRequest) _validateUuid(uuid string) error {
+	if matched := _agency_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetTeamDealReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTeamDealReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTeamDealReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTeamDealReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTeamDealReportRequestMultiError) AllErrors() []error { return m }
+
+// GetTeamDealReportRequestValidationError is the validation error returned by
+// GetTeamDealReportRequest.Validate if the designated constraints aren't met.
+type GetTeamDealReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTeamDealReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTeamDealReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTeamDealReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTeamDealReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTeamDealReportRequestValidationError) ErrorName() string {
+	return "GetTeamDealReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTeamDealReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTeamDealReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTeamDealReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTeamDealReportRequestValidationError{}
+
+// Validate checks the field values on TeamDealReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TeamDealReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeamDealReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TeamDealReportResponseMultiError, or nil if none found.
+func (m *TeamDealReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeamDealReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AgencyId
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TeamDealReportResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TeamDealReportResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TeamDealReportResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotals()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeamDealReportResponseValidationError{
+					field:  "Totals",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeamDealReportResponseValidationError{
+					field:  "Totals",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotals()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeamDealReportResponseValidationError{
+				field:  "Totals",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.From != nil {
+		// no validation rules for From
+	}
+
+	if m.To != nil {
+		// no validation rules for To
+	}
+
+	if len(errors) > 0 {
+		return TeamDealReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeamDealReportResponseMultiError is an error wrapping multiple validation
+// errors returned by TeamDealReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TeamDealReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeamDealReportResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeamDealReportResponseMultiError) AllErrors() []error { return m }
+
+// TeamDealReportResponseValidationError is the validation error returned by
+// TeamDealReportResponse.Validate if the designated constraints aren't met.
+type TeamDealReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeamDealReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeamDealReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeamDealReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeamDealReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeamDealReportResponseValidationError) ErrorName() string {
+	return "TeamDealReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TeamDealReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeamDealReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeamDealReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeamDealReportResponseValidationError{}
@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: agency.proto
+
+package leadexchangev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AgencyService_CreateAgency_FullMethodName         = "/leadexchange.v1.AgencyService/CreateAgency"
+	AgencyService_GetAgency_FullMethodName            = "/leadexchange.v1.AgencyService/GetAgency"
+	AgencyService_InviteMember_FullMethodName         = "/leadexchange.v1.AgencyService/InviteMember"
+	AgencyService_ListMyInvitations_FullMethodName    = "/leadexchange.v1.AgencyService/ListMyInvitations"
+	AgencyService_AcceptInvitation_FullMethodName     = "/leadexchange.v1.AgencyService/AcceptInvitation"
+	AgencyService_DeclineInvitation_FullMethodName    = "/leadexchange.v1.AgencyService/DeclineInvitation"
+	AgencyService_RevokeInvitation_FullMethodName     = "/leadexchange.v1.AgencyService/RevokeInvitation"
+	AgencyService_UpdateMemberRole_FullMethodName     = "/leadexchange.v1.AgencyService/UpdateMemberRole"
+	AgencyService_RemoveMember_FullMethodName         = "/leadexchange.v1.AgencyService/RemoveMember"
+	AgencyService_ReassignMemberAssets_FullMethodName = "/leadexchange.v1.AgencyService/ReassignMemberAssets"
+	AgencyService_GetTeamDealReport_FullMethodName    = "/leadexchange.v1.AgencyService/GetTeamDealReport"
+)
+
+// AgencyServiceClient is the client API for AgencyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AgencyServiceClient interface {
+	// Создать агентство; создатель становится владельцем.
+	CreateAgency(ctx context.Context, in *CreateAgencyRequest, opts ...grpc.CallOption) (*AgencyResponse, error)
+	// Получить своё агентство с участниками (админ может указать agency_id).
+	GetAgency(ctx context.Context, in *GetAgencyRequest, opts ...grpc.CallOption) (*AgencyResponse, error)
+	// Пригласить пользователя по email (владелец или руководитель).
+	InviteMember(ctx context.Context, in *InviteMemberRequest, opts ...grpc.CallOption) (*AgencyInvitationResponse, error)
+	// Получить действующие приглашения на email текущего пользователя.
+	ListMyInvitations(ctx context.Context, in *ListMyInvitationsRequest, opts ...grpc.CallOption) (*ListMyInvitationsResponse, error)
+	// Принять приглашение и вступить в агентство.
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AgencyMemberResponse, error)
+	// Отклонить приглашение.
+	DeclineInvitation(ctx context.Context, in *DeclineInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Отозвать приглашение (владелец или руководитель).
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Сменить роль участника (владелец).
+	UpdateMemberRole(ctx context.Context, in *UpdateMemberRoleRequest, opts ...grpc.CallOption) (*AgencyMemberResponse, error)
+	// Исключить участника или выйти из агентства; лиды уходящего можно сразу передать коллеге.
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
+	// Передать лиды, объекты и открытые сделки участника другому участнику (владелец или руководитель).
+	ReassignMemberAssets(ctx context.Context, in *ReassignMemberAssetsRequest, opts ...grpc.CallOption) (*ReassignMemberAssetsResponse, error)
+	// Отчёт по сделкам команды (владелец, руководитель или админ).
+	GetTeamDealReport(ctx context.Context, in *GetTeamDealReportRequest, opts ...grpc.CallOption) (*TeamDealReportResponse, error)
+}
+
+type agencyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAgencyServiceClient(cc grpc.ClientConnInterface) AgencyServiceClient {
+	return &agencyServiceClient{cc}
+}
+
+func (c *agencyServiceClient) CreateAgency(ctx context.Context, in *CreateAgencyRequest, opts ...grpc.CallOption) (*AgencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgencyResponse)
+	err := c.cc.Invoke(ctx, AgencyService_CreateAgency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agencyServiceClient) GetAgency(ctx context.Context, in *GetAgencyRequest, opts ...grpc.CallOption) (*AgencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgencyResponse)
+	err := c.cc.Invoke(ctx, AgencyService_GetAgency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agencyServiceClient) InviteMember(ctx context.Context, in *InviteMemberRequest, opts ...grpc.CallOption) (*AgencyInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgencyInvitationResponse)
+	err := c.cc.Invoke(ctx, AgencyService_InviteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agencyServiceClient) ListMyInvitations(ctx context.Context, in *ListMyInvitationsRequest, opts ...grpc.CallOption) (*ListMyInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyInvitationsResponse)
+	err := c.cc.Invoke(ctx, AgencyService_ListMyInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agencyServiceClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AgencyMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgencyMemberResponse)
+	err := c.cc.Invoke(ctx, AgencyService_AcceptInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agencyServiceClient) DeclineInvitation(ctx context.Context, in *DeclineInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AgencyService_DeclineInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agencyServiceClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AgencyService_RevokeInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agencyServiceClient) UpdateMemberRole(ctx context.Context, in *UpdateMemberRoleRequest, opts ...grpc.CallOption) (*AgencyMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgencyMemberResponse)
+	err := c.cc.Invoke(ctx, AgencyService_UpdateMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agencyServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMemberResponse)
+	err := c.cc.Invoke(ctx, AgencyService_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agencyServiceClient) ReassignMemberAssets(ctx context.Context, in *ReassignMemberAssetsRequest, opts ...grpc.CallOption) (*ReassignMemberAssetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignMemberAssetsResponse)
+	err := c.cc.Invoke(ctx, AgencyService_ReassignMemberAssets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agencyServiceClient) GetTeamDealReport(ctx context.Context, in *GetTeamDealReportRequest, opts ...grpc.CallOption) (*TeamDealReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamDealReportResponse)
+	err := c.cc.Invoke(ctx, AgencyService_GetTeamDealReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AgencyServiceServer is the server API for AgencyService service.
+// All implementations must embed UnimplementedAgencyServiceServer
+// for forward compatibility.
+type AgencyServiceServer interface {
+	// Создать агентство; создатель становится владельцем.
+	CreateAgency(context.Context, *CreateAgencyRequest) (*AgencyResponse, error)
+	// Получить своё агентство с участниками (админ может указать agency_id).
+	GetAgency(context.Context, *GetAgencyRequest) (*AgencyResponse, error)
+	// Пригласить пользователя по email (владелец или руководитель).
+	InviteMember(context.Context, *InviteMemberRequest) (*AgencyInvitationResponse, error)
+	// Получить действующие приглашения на email текущего пользователя.
+	ListMyInvitations(context.Context, *ListMyInvitationsRequest) (*ListMyInvitationsResponse, error)
+	// Принять приглашение и вступить в агентство.
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AgencyMemberResponse, error)
+	// Отклонить приглашение.
+	DeclineInvitation(context.Context, *DeclineInvitationRequest) (*emptypb.Empty, error)
+	// Отозвать приглашение (владелец или руководитель).
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*emptypb.Empty, error)
+	// Сменить роль участника (владелец).
+	UpdateMemberRole(context.Context, *UpdateMemberRoleRequest) (*AgencyMemberResponse, error)
+	// Исключить участника или выйти из агентства; лиды уходящего можно сразу передать коллеге.
+	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
+	// Передать лиды, объекты и открытые сделки участника другому участнику (владелец или руководитель).
+	ReassignMemberAssets(context.Context, *ReassignMemberAssetsRequest) (*ReassignMemberAssetsResponse, error)
+	// Отчёт по сделкам команды (владелец, руководитель или админ).
+	GetTeamDealReport(context.Context, *GetTeamDealReportRequest) (*TeamDealReportResponse, error)
+	mustEmbedUnimplementedAgencyServiceServer()
+}
+
+// UnimplementedAgencyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAgencyServiceServer struct{}
+
+func (UnimplementedAgencyServiceServer) CreateAgency(context.Context, *CreateAgencyRequest) (*AgencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAgency not implemented")
+}
+func (UnimplementedAgencyServiceServer) GetAgency(context.Context, *GetAgencyRequest) (*AgencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAgency not implemented")
+}
+func (UnimplementedAgencyServiceServer) InviteMember(context.Context, *InviteMemberRequest) (*AgencyInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InviteMember not implemented")
+}
+func (UnimplementedAgencyServiceServer) ListMyInvitations(context.Context, *ListMyInvitationsRequest) (*ListMyInvitationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyInvitations not implemented")
+}
+func (UnimplementedAgencyServiceServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AgencyMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (UnimplementedAgencyServiceServer) DeclineInvitation(context.Context, *DeclineInvitationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclineInvitation not implemented")
+}
+func (UnimplementedAgencyServiceServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedAgencyServiceServer) UpdateMemberRole(context.Context, *UpdateMemberRoleRequest) (*AgencyMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMemberRole not implemented")
+}
+func (UnimplementedAgencyServiceServer) RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedAgencyServiceServer) ReassignMemberAssets(context.Context, *ReassignMemberAssetsRequest) (*ReassignMemberAssetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReassignMemberAssets not implemented")
+}
+func (UnimplementedAgencyServiceServer) GetTeamDealReport(context.Context, *GetTeamDealReportRequest) (*TeamDealReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeamDealReport not implemented")
+}
+func (UnimplementedAgencyServiceServer) mustEmbedUnimplementedAgencyServiceServer() {}
+func (UnimplementedAgencyServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeAgencyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AgencyServiceServer will
+// result in compilation errors.
+type UnsafeAgencyServiceServer interface {
+	mustEmbedUnimplementedAgencyServiceServer()
+}
+
+func RegisterAgencyServiceServer(s grpc.ServiceRegistrar, srv AgencyServiceServer) {
+	// If the following call panics, it indicates UnimplementedAgencyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AgencyService_ServiceDesc, srv)
+}
+
+func _AgencyService_CreateAgency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAgencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgencyServiceServer).CreateAgency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgencyService_CreateAgency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgencyServiceServer).CreateAgency(ctx, req.(*CreateAgencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgencyService_GetAgency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgencyServiceServer).GetAgency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgencyService_GetAgency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgencyServiceServer).GetAgency(ctx, req.(*GetAgencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgencyService_InviteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgencyServiceServer).InviteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgencyService_InviteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgencyServiceServer).InviteMember(ctx, req.(*InviteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgencyService_ListMyInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgencyServiceServer).ListMyInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgencyService_ListMyInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgencyServiceServer).ListMyInvitations(ctx, req.(*ListMyInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgencyService_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgencyServiceServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgencyService_AcceptInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgencyServiceServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgencyService_DeclineInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgencyServiceServer).DeclineInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgencyService_DeclineInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgencyServiceServer).DeclineInvitation(ctx, req.(*DeclineInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgencyService_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgencyServiceServer).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgencyService_RevokeInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgencyServiceServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgencyService_UpdateMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgencyServiceServer).UpdateMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgencyService_UpdateMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgencyServiceServer).UpdateMemberRole(ctx, req.(*UpdateMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgencyService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgencyServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgencyService_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgencyServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgencyService_ReassignMemberAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignMemberAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgencyServiceServer).ReassignMemberAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgencyService_ReassignMemberAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgencyServiceServer).ReassignMemberAssets(ctx, req.(*ReassignMemberAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgencyService_GetTeamDealReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamDealReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgencyServiceServer).GetTeamDealReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgencyService_GetTeamDealReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgencyServiceServer).GetTeamDealReport(ctx, req.(*GetTeamDealReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AgencyService_ServiceDesc is the grpc.ServiceDesc for AgencyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AgencyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "leadexchange.v1.AgencyService",
+	HandlerType: (*AgencyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAgency",
+			Handler:    _AgencyService_CreateAgency_Handler,
+		},
+		{
+			MethodName: "GetAgency",
+			Handler:    _AgencyService_GetAgency_Handler,
+		},
+		{
+			MethodName: "InviteMember",
+			Handler:    _AgencyService_InviteMember_Handler,
+		},
+		{
+			MethodName: "ListMyInvitations",
+			Handler:    _AgencyService_ListMyInvitations_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _AgencyService_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "DeclineInvitation",
+			Handler:    _AgencyService_DeclineInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _AgencyService_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "UpdateMemberRole",
+			Handler:    _AgencyService_UpdateMemberRole_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _AgencyService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "ReassignMemberAssets",
+			Handler:    _AgencyService_ReassignMemberAssets_Handler,
+		},
+		{
+			MethodName: "GetTeamDealReport",
+			Handler:    _AgencyService_GetTeamDealReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agency.proto",
+}
@@ -294,10 +294,15 @@ func (x *GetDealRequest) GetDealId() string {
 }
 
 type ListDealsRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Filter        *ListDealsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState   `protogen:"open.v1"`
+	Filter    *ListDealsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize  *int32                   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	PageToken *string                  `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	// order_by — created_at (по умолчанию), updated_at или price
+	OrderBy        *string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`
+	OrderDirection *string `protobuf:"bytes,5,opt,name=order_direction,json=orderDirection,proto3,oneof" json:"order_direction,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListDealsRequest) Reset() {
@@ -337,9 +342,42 @@ func (x *ListDealsRequest) GetFilter() *ListDealsRequest_Filter {
 	return nil
 }
 
+func (x *ListDealsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDealsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDealsRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListDealsRequest) GetOrderDirection() string {
+	if x != nil && x.OrderDirection != nil {
+		return *x.OrderDirection
+	}
+	return ""
+}
+
 type ListDealsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deals         []*Deal                `protobuf:"bytes,1,rep,name=deals,proto3" json:"deals,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Deals []*Deal                `protobuf:"bytes,1,rep,name=deals,proto3" json:"deals,omitempty"`
+	// next_page_token — токен следующей страницы; пустой, если страниц больше нет
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HasMore       bool   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// total_count — сколько записей подходит под фильтр без учёта пагинации
+	TotalCount    int32 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -381,6 +419,28 @@ func (x *ListDealsResponse) GetDeals() []*Deal {
 	return nil
 }
 
+func (x *ListDealsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListDealsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListDealsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// UpdateDealRequest — смена статуса или цены; оба поля в одном запросе не принимаются.
 type UpdateDealRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DealId        string                 `protobuf:"bytes,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
@@ -485,6 +545,138 @@ func (x *AcceptDealRequest) GetDealId() string {
 	return ""
 }
 
+type CompleteDealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DealId        string                 `protobuf:"bytes,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteDealRequest) Reset() {
+	*x = CompleteDealRequest{}
+	mi := &file_deal_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteDealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteDealRequest) ProtoMessage() {}
+
+func (x *CompleteDealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deal_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteDealRequest.ProtoReflect.Descriptor instead.
+func (*CompleteDealRequest) Descriptor() ([]byte, []int) {
+	return file_deal_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CompleteDealRequest) GetDealId() string {
+	if x != nil {
+		return x.DealId
+	}
+	return ""
+}
+
+type CancelDealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DealId        string                 `protobuf:"bytes,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelDealRequest) Reset() {
+	*x = CancelDealRequest{}
+	mi := &file_deal_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelDealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDealRequest) ProtoMessage() {}
+
+func (x *CancelDealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deal_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDealRequest.ProtoReflect.Descriptor instead.
+func (*CancelDealRequest) Descriptor() ([]byte, []int) {
+	return file_deal_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelDealRequest) GetDealId() string {
+	if x != nil {
+		return x.DealId
+	}
+	return ""
+}
+
+type RejectDealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DealId        string                 `protobuf:"bytes,1,opt,name=deal_id,json=dealId,proto3" json:"deal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectDealRequest) Reset() {
+	*x = RejectDealRequest{}
+	mi := &file_deal_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectDealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectDealRequest) ProtoMessage() {}
+
+func (x *RejectDealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_deal_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectDealRequest.ProtoReflect.Descriptor instead.
+func (*RejectDealRequest) Descriptor() ([]byte, []int) {
+	return file_deal_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RejectDealRequest) GetDealId() string {
+	if x != nil {
+		return x.DealId
+	}
+	return ""
+}
+
 type DealResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Deal          *Deal                  `protobuf:"bytes,1,opt,name=deal,proto3" json:"deal,omitempty"`
@@ -494,7 +686,7 @@ type DealResponse struct {
 
 func (x *DealResponse) Reset() {
 	*x = DealResponse{}
-	mi := &file_deal_proto_msgTypes[7]
+	mi := &file_deal_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -506,7 +698,7 @@ func (x *DealResponse) String() string {
 func (*DealResponse) ProtoMessage() {}
 
 func (x *DealResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_deal_proto_msgTypes[7]
+	mi := &file_deal_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +711,7 @@ func (x *DealResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DealResponse.ProtoReflect.Descriptor instead.
 func (*DealResponse) Descriptor() ([]byte, []int) {
-	return file_deal_proto_rawDescGZIP(), []int{7}
+	return file_deal_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DealResponse) GetDeal() *Deal {
@@ -543,7 +735,7 @@ type ListDealsRequest_Filter struct {
 
 func (x *ListDealsRequest_Filter) Reset() {
 	*x = ListDealsRequest_Filter{}
-	mi := &file_deal_proto_msgTypes[8]
+	mi := &file_deal_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +747,7 @@ func (x *ListDealsRequest_Filter) String() string {
 func (*ListDealsRequest_Filter) ProtoMessage() {}
 
 func (x *ListDealsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_deal_proto_msgTypes[8]
+	mi := &file_deal_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,9 +826,14 @@ const file_deal_proto_rawDesc = "" +
 	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\x12$\n" +
 	"\x05price\x18\x02 \x01(\x01B\x0e\xfaB\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\"3\n" +
 	"\x0eGetDealRequest\x12!\n" +
-	"\adeal_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06dealId\"\xa7\x03\n" +
+	"\adeal_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06dealId\"\xf9\x04\n" +
 	"\x10ListDealsRequest\x12@\n" +
-	"\x06filter\x18\x01 \x01(\v2(.leadexchange.v1.ListDealsRequest.FilterR\x06filter\x1a\xd0\x02\n" +
+	"\x06filter\x18\x01 \x01(\v2(.leadexchange.v1.ListDealsRequest.FilterR\x06filter\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x00R\bpageSize\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12\x1e\n" +
+	"\border_by\x18\x04 \x01(\tH\x02R\aorderBy\x88\x01\x01\x12,\n" +
+	"\x0forder_direction\x18\x05 \x01(\tH\x03R\x0eorderDirection\x88\x01\x01\x1a\xd0\x02\n" +
 	"\x06Filter\x12\x1c\n" +
 	"\alead_id\x18\x01 \x01(\tH\x00R\x06leadId\x88\x01\x01\x12)\n" +
 	"\x0eseller_user_id\x18\x02 \x01(\tH\x01R\fsellerUserId\x88\x01\x01\x12'\n" +
@@ -652,9 +849,18 @@ const file_deal_proto_rawDesc = "" +
 	"\n" +
 	"_min_priceB\f\n" +
 	"\n" +
-	"_max_price\"@\n" +
+	"_max_priceB\f\n" +
+	"\n" +
+	"_page_sizeB\r\n" +
+	"\v_page_tokenB\v\n" +
+	"\t_order_byB\x12\n" +
+	"\x10_order_direction\"\xa4\x01\n" +
 	"\x11ListDealsResponse\x12+\n" +
-	"\x05deals\x18\x01 \x03(\v2\x15.leadexchange.v1.DealR\x05deals\"\xa0\x01\n" +
+	"\x05deals\x18\x01 \x03(\v2\x15.leadexchange.v1.DealR\x05deals\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x05R\n" +
+	"totalCount\"\xa0\x01\n" +
 	"\x11UpdateDealRequest\x12!\n" +
 	"\adeal_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06dealId\x128\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1b.leadexchange.v1.DealStatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
@@ -662,6 +868,12 @@ const file_deal_proto_rawDesc = "" +
 	"\a_statusB\b\n" +
 	"\x06_price\"6\n" +
 	"\x11AcceptDealRequest\x12!\n" +
+	"\adeal_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06dealId\"8\n" +
+	"\x13CompleteDealRequest\x12!\n" +
+	"\adeal_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06dealId\"6\n" +
+	"\x11CancelDealRequest\x12!\n" +
+	"\adeal_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06dealId\"6\n" +
+	"\x11RejectDealRequest\x12!\n" +
 	"\adeal_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06dealId\"9\n" +
 	"\fDealResponse\x12)\n" +
 	"\x04deal\x18\x01 \x01(\v2\x15.leadexchange.v1.DealR\x04deal*\xac\x01\n" +
@@ -672,7 +884,7 @@ const file_deal_proto_rawDesc = "" +
 	"\x14DEAL_STATUS_ACCEPTED\x10\x02\x12\x19\n" +
 	"\x15DEAL_STATUS_COMPLETED\x10\x03\x12\x19\n" +
 	"\x15DEAL_STATUS_CANCELLED\x10\x04\x12\x18\n" +
-	"\x14DEAL_STATUS_REJECTED\x10\x052\xac\x04\n" +
+	"\x14DEAL_STATUS_REJECTED\x10\x052\x9a\a\n" +
 	"\vDealService\x12e\n" +
 	"\n" +
 	"CreateDeal\x12\".leadexchange.v1.CreateDealRequest\x1a\x1d.leadexchange.v1.DealResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/deals\x12f\n" +
@@ -681,7 +893,12 @@ const file_deal_proto_rawDesc = "" +
 	"\n" +
 	"UpdateDeal\x12\".leadexchange.v1.UpdateDealRequest\x1a\x1d.leadexchange.v1.DealResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/deals/{deal_id}\x12v\n" +
 	"\n" +
-	"AcceptDeal\x12\".leadexchange.v1.AcceptDealRequest\x1a\x1d.leadexchange.v1.DealResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/deals/{deal_id}/acceptB4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
+	"AcceptDeal\x12\".leadexchange.v1.AcceptDealRequest\x1a\x1d.leadexchange.v1.DealResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/deals/{deal_id}/accept\x12|\n" +
+	"\fCompleteDeal\x12$.leadexchange.v1.CompleteDealRequest\x1a\x1d.leadexchange.v1.DealResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/deals/{deal_id}/complete\x12v\n" +
+	"\n" +
+	"CancelDeal\x12\".leadexchange.v1.CancelDealRequest\x1a\x1d.leadexchange.v1.DealResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/deals/{deal_id}/cancel\x12v\n" +
+	"\n" +
+	"RejectDeal\x12\".leadexchange.v1.RejectDealRequest\x1a\x1d.leadexchange.v1.DealResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/deals/{deal_id}/rejectB4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
 
 var (
 	file_deal_proto_rawDescOnce sync.Once
@@ -696,7 +913,7 @@ func file_deal_proto_rawDescGZIP() []byte {
 }
 
 var file_deal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_deal_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_deal_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_deal_proto_goTypes = []any{
 	(DealStatus)(0),                 // 0: leadexchange.v1.DealStatus
 	(*Deal)(nil),                    // 1: leadexchange.v1.Deal
@@ -706,12 +923,15 @@ var file_deal_proto_goTypes = []any{
 	(*ListDealsResponse)(nil),       // 5: leadexchange.v1.ListDealsResponse
 	(*UpdateDealRequest)(nil),       // 6: leadexchange.v1.UpdateDealRequest
 	(*AcceptDealRequest)(nil),       // 7: leadexchange.v1.AcceptDealRequest
-	(*DealResponse)(nil),            // 8: leadexchange.v1.DealResponse
-	(*ListDealsRequest_Filter)(nil), // 9: leadexchange.v1.ListDealsRequest.Filter
+	(*CompleteDealRequest)(nil),     // 8: leadexchange.v1.CompleteDealRequest
+	(*CancelDealRequest)(nil),       // 9: leadexchange.v1.CancelDealRequest
+	(*RejectDealRequest)(nil),       // 10: leadexchange.v1.RejectDealRequest
+	(*DealResponse)(nil),            // 11: leadexchange.v1.DealResponse
+	(*ListDealsRequest_Filter)(nil), // 12: leadexchange.v1.ListDealsRequest.Filter
 }
 var file_deal_proto_depIdxs = []int32{
 	0,  // 0: leadexchange.v1.Deal.status:type_name -> leadexchange.v1.DealStatus
-	9,  // 1: leadexchange.v1.ListDealsRequest.filter:type_name -> leadexchange.v1.ListDealsRequest.Filter
+	12, // 1: leadexchange.v1.ListDealsRequest.filter:type_name -> leadexchange.v1.ListDealsRequest.Filter
 	1,  // 2: leadexchange.v1.ListDealsResponse.deals:type_name -> leadexchange.v1.Deal
 	0,  // 3: leadexchange.v1.UpdateDealRequest.status:type_name -> leadexchange.v1.DealStatus
 	1,  // 4: leadexchange.v1.DealResponse.deal:type_name -> leadexchange.v1.Deal
@@ -721,13 +941,19 @@ var file_deal_proto_depIdxs = []int32{
 	4,  // 8: leadexchange.v1.DealService.ListDeals:input_type -> leadexchange.v1.ListDealsRequest
 	6,  // 9: leadexchange.v1.DealService.UpdateDeal:input_type -> leadexchange.v1.UpdateDealRequest
 	7,  // 10: leadexchange.v1.DealService.AcceptDeal:input_type -> leadexchange.v1.AcceptDealRequest
-	8,  // 11: leadexchange.v1.DealService.CreateDeal:output_type -> leadexchange.v1.DealResponse
-	8,  // 12: leadexchange.v1.DealService.GetDeal:output_type -> leadexchange.v1.DealResponse
-	5,  // 13: leadexchange.v1.DealService.ListDeals:output_type -> leadexchange.v1.ListDealsResponse
-	8,  // 14: leadexchange.v1.DealService.UpdateDeal:output_type -> leadexchange.v1.DealResponse
-	8,  // 15: leadexchange.v1.DealService.AcceptDeal:output_type -> leadexchange.v1.DealResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
+	8,  // 11: leadexchange.v1.DealService.CompleteDeal:input_type -> leadexchange.v1.CompleteDealRequest
+	9,  // 12: leadexchange.v1.DealService.CancelDeal:input_type -> leadexchange.v1.CancelDealRequest
+	10, // 13: leadexchange.v1.DealService.RejectDeal:input_type -> leadexchange.v1.RejectDealRequest
+	11, // 14: leadexchange.v1.DealService.CreateDeal:output_type -> leadexchange.v1.DealResponse
+	11, // 15: leadexchange.v1.DealService.GetDeal:output_type -> leadexchange.v1.DealResponse
+	5,  // 16: leadexchange.v1.DealService.ListDeals:output_type -> leadexchange.v1.ListDealsResponse
+	11, // 17: leadexchange.v1.DealService.UpdateDeal:output_type -> leadexchange.v1.DealResponse
+	11, // 18: leadexchange.v1.DealService.AcceptDeal:output_type -> leadexchange.v1.DealResponse
+	11, // 19: leadexchange.v1.DealService.CompleteDeal:output_type -> leadexchange.v1.DealResponse
+	11, // 20: leadexchange.v1.DealService.CancelDeal:output_type -> leadexchange.v1.DealResponse
+	11, // 21: leadexchange.v1.DealService.RejectDeal:output_type -> leadexchange.v1.DealResponse
+	14, // [14:22] is the sub-list for method output_type
+	6,  // [6:14] is the sub-list for method input_type
 	6,  // [6:6] is the sub-list for extension type_name
 	6,  // [6:6] is the sub-list for extension extendee
 	0,  // [0:6] is the sub-list for field type_name
@@ -738,15 +964,16 @@ func file_deal_proto_init() {
 	if File_deal_proto != nil {
 		return
 	}
+	file_deal_proto_msgTypes[3].OneofWrappers = []any{}
 	file_deal_proto_msgTypes[5].OneofWrappers = []any{}
-	file_deal_proto_msgTypes[8].OneofWrappers = []any{}
+	file_deal_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_deal_proto_rawDesc), len(file_deal_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -493,6 +493,22 @@ func (m *ListDealsRequest) validate(all bool) error {
 		}
 	}
 
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.PageToken != nil {
+		// no validation rules for PageToken
+	}
+
+	if m.OrderBy != nil {
+		// no validation rules for OrderBy
+	}
+
+	if m.OrderDirection != nil {
+		// no validation rules for OrderDirection
+	}
+
 	if len(errors) > 0 {
 		return ListDealsRequestMultiError(errors)
 	}
@@ -627,6 +643,12 @@ func (m *ListDealsResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
+	// no validation rules for HasMore
+
+	// no validation rules for TotalCount
+
 	if len(errors) > 0 {
 		return ListDealsResponseMultiError(errors)
 	}
@@ -959,6 +981,372 @@ var _ interface {
 	ErrorName() string
 } = AcceptDealRequestValidationError{}
 
+// Validate checks the field values on CompleteDealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteDealRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteDealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteDealRequestMultiError, or nil if none found.
+func (m *CompleteDealRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteDealRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetDealId()); err != nil {
+		err = CompleteDealRequestValidationError{
+			field:  "DealId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CompleteDealRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CompleteDealRequest) _validateUuid(uuid string) error {
+	if matched := _deal_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CompleteDealRequestMultiError is an error wrapping multiple validation
+// errors returned by CompleteDealRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CompleteDealRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteDealRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteDealRequestMultiError) AllErrors() []error { return m }
+
+// CompleteDealRequestValidationError is the validation error returned by
+// CompleteDealRequest.Validate if the designated constraints aren't met.
+type CompleteDealRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteDealRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteDealRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteDealRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteDealRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteDealRequestValidationError) ErrorName() string {
+	return "CompleteDealRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteDealRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteDealRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteDealRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteDealRequestValidationError{}
+
+// Validate checks the field values on CancelDealRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CancelDealRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelDealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelDealRequestMultiError, or nil if none found.
+func (m *CancelDealRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelDealRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetDealId()); err != nil {
+		err = CancelDealRequestValidationError{
+			field:  "DealId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelDealRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CancelDealRequest) _validateUuid(uuid string) error {
+	if matched := _deal_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CancelDealRequestMultiError is an error wrapping multiple validation errors
+// returned by CancelDealRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CancelDealRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelDealRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelDealRequestMultiError) AllErrors() []error { return m }
+
+// CancelDealRequestValidationError is the validation error returned by
+// CancelDealRequest.Validate if the designated constraints aren't met.
+type CancelDealRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelDealRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelDealRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelDealRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelDealRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelDealRequestValidationError) ErrorName() string {
+	return "CancelDealRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelDealRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelDealRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelDealRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelDealRequestValidationError{}
+
+// Validate checks the field values on RejectDealRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RejectDealRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectDealRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectDealRequestMultiError, or nil if none found.
+func (m *RejectDealRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectDealRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetDealId()); err != nil {
+		err = RejectDealRequestValidationError{
+			field:  "DealId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RejectDealRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RejectDealRequest) _validateUuid(uuid string) error {
+	if matched := _deal_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RejectDealRequestMultiError is an error wrapping multiple validation errors
+// returned by RejectDealRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RejectDealRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectDealRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectDealRequestMultiError) AllErrors() []error { return m }
+
+// RejectDealRequestValidationError is the validation error returned by
+// RejectDealRequest.Validate if the designated constraints aren't met.
+type RejectDealRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectDealRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectDealRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectDealRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectDealRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectDealRequestValidationError) ErrorName() string {
+	return "RejectDealRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectDealRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectDealRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectDealRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectDealRequestValidationError{}
+
 // Validate checks the field values on DealResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -19,11 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DealService_CreateDeal_FullMethodName = "/leadexchange.v1.DealService/CreateDeal"
-	DealService_GetDeal_FullMethodName    = "/leadexchange.v1.DealService/GetDeal"
-	DealService_ListDeals_FullMethodName  = "/leadexchange.v1.DealService/ListDeals"
-	DealService_UpdateDeal_FullMethodName = "/leadexchange.v1.DealService/UpdateDeal"
-	DealService_AcceptDeal_FullMethodName = "/leadexchange.v1.DealService/AcceptDeal"
+	DealService_CreateDeal_FullMethodName   = "/leadexchange.v1.DealService/CreateDeal"
+	DealService_GetDeal_FullMethodName      = "/leadexchange.v1.DealService/GetDeal"
+	DealService_ListDeals_FullMethodName    = "/leadexchange.v1.DealService/ListDeals"
+	DealService_UpdateDeal_FullMethodName   = "/leadexchange.v1.DealService/UpdateDeal"
+	DealService_AcceptDeal_FullMethodName   = "/leadexchange.v1.DealService/AcceptDeal"
+	DealService_CompleteDeal_FullMethodName = "/leadexchange.v1.DealService/CompleteDeal"
+	DealService_CancelDeal_FullMethodName   = "/leadexchange.v1.DealService/CancelDeal"
+	DealService_RejectDeal_FullMethodName   = "/leadexchange.v1.DealService/RejectDeal"
 )
 
 // DealServiceClient is the client API for DealService service.
@@ -40,6 +43,12 @@ type DealServiceClient interface {
 	UpdateDeal(ctx context.Context, in *UpdateDealRequest, opts ...grpc.CallOption) (*DealResponse, error)
 	// Принять сделку (покупатель принимает предложение).
 	AcceptDeal(ctx context.Context, in *AcceptDealRequest, opts ...grpc.CallOption) (*DealResponse, error)
+	// Завершить сделку (покупатель подтверждает получение лида).
+	CompleteDeal(ctx context.Context, in *CompleteDealRequest, opts ...grpc.CallOption) (*DealResponse, error)
+	// Отменить сделку (продавец снимает предложение).
+	CancelDeal(ctx context.Context, in *CancelDealRequest, opts ...grpc.CallOption) (*DealResponse, error)
+	// Отклонить сделку (покупатель отказывается от принятой сделки).
+	RejectDeal(ctx context.Context, in *RejectDealRequest, opts ...grpc.CallOption) (*DealResponse, error)
 }
 
 type dealServiceClient struct {
@@ -100,6 +109,36 @@ func (c *dealServiceClient) AcceptDeal(ctx context.Context, in *AcceptDealReques
 	return out, nil
 }
 
+func (c *dealServiceClient) CompleteDeal(ctx context.Context, in *CompleteDealRequest, opts ...grpc.CallOption) (*DealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DealResponse)
+	err := c.cc.Invoke(ctx, DealService_CompleteDeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dealServiceClient) CancelDeal(ctx context.Context, in *CancelDealRequest, opts ...grpc.CallOption) (*DealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DealResponse)
+	err := c.cc.Invoke(ctx, DealService_CancelDeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dealServiceClient) RejectDeal(ctx context.Context, in *RejectDealRequest, opts ...grpc.CallOption) (*DealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DealResponse)
+	err := c.cc.Invoke(ctx, DealService_RejectDeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DealServiceServer is the server API for DealService service.
 // All implementations must embed UnimplementedDealServiceServer
 // for forward compatibility.
@@ -114,6 +153,12 @@ type DealServiceServer interface {
 	UpdateDeal(context.Context, *UpdateDealRequest) (*DealResponse, error)
 	// Принять сделку (покупатель принимает предложение).
 	AcceptDeal(context.Context, *AcceptDealRequest) (*DealResponse, error)
+	// Завершить сделку (покупатель подтверждает получение лида).
+	CompleteDeal(context.Context, *CompleteDealRequest) (*DealResponse, error)
+	// Отменить сделку (продавец снимает предложение).
+	CancelDeal(context.Context, *CancelDealRequest) (*DealResponse, error)
+	// Отклонить сделку (покупатель отказывается от принятой сделки).
+	RejectDeal(context.Context, *RejectDealRequest) (*DealResponse, error)
 	mustEmbedUnimplementedDealServiceServer()
 }
 
@@ -139,6 +184,15 @@ func (UnimplementedDealServiceServer) UpdateDeal(context.Context, *UpdateDealReq
 func (UnimplementedDealServiceServer) AcceptDeal(context.Context, *AcceptDealRequest) (*DealResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AcceptDeal not implemented")
 }
+func (UnimplementedDealServiceServer) CompleteDeal(context.Context, *CompleteDealRequest) (*DealResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteDeal not implemented")
+}
+func (UnimplementedDealServiceServer) CancelDeal(context.Context, *CancelDealRequest) (*DealResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelDeal not implemented")
+}
+func (UnimplementedDealServiceServer) RejectDeal(context.Context, *RejectDealRequest) (*DealResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectDeal not implemented")
+}
 func (UnimplementedDealServiceServer) mustEmbedUnimplementedDealServiceServer() {}
 func (UnimplementedDealServiceServer) testEmbeddedByValue()                     {}
 
@@ -250,6 +304,60 @@ func _DealService_AcceptDeal_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DealService_CompleteDeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteDealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DealServiceServer).CompleteDeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DealService_CompleteDeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DealServiceServer).CompleteDeal(ctx, req.(*CompleteDealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DealService_CancelDeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DealServiceServer).CancelDeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DealService_CancelDeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DealServiceServer).CancelDeal(ctx, req.(*CancelDealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DealService_RejectDeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectDealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DealServiceServer).RejectDeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DealService_RejectDeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DealServiceServer).RejectDeal(ctx, req.(*RejectDealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DealService_ServiceDesc is the grpc.ServiceDesc for DealService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,6 +385,18 @@ var DealService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptDeal",
 			Handler:    _DealService_AcceptDeal_Handler,
 		},
+		{
+			MethodName: "CompleteDeal",
+			Handler:    _DealService_CompleteDeal_Handler,
+		},
+		{
+			MethodName: "CancelDeal",
+			Handler:    _DealService_CancelDeal_Handler,
+		},
+		{
+			MethodName: "RejectDeal",
+			Handler:    _DealService_RejectDeal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "deal.proto",
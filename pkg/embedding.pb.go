@@ -0,0 +1,325 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: embedding.proto
+
+package leadexchangev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EmbeddingJobStatus — статус задачи очереди embedding.
+type EmbeddingJobStatus int32
+
+const (
+	EmbeddingJobStatus_EMBEDDING_JOB_STATUS_UNSPECIFIED EmbeddingJobStatus = 0
+	EmbeddingJobStatus_EMBEDDING_JOB_STATUS_PENDING     EmbeddingJobStatus = 1
+	EmbeddingJobStatus_EMBEDDING_JOB_STATUS_RUNNING     EmbeddingJobStatus = 2
+	EmbeddingJobStatus_EMBEDDING_JOB_STATUS_DONE        EmbeddingJobStatus = 3
+	EmbeddingJobStatus_EMBEDDING_JOB_STATUS_DEAD        EmbeddingJobStatus = 4
+)
+
+// Enum value maps for EmbeddingJobStatus.
+var (
+	EmbeddingJobStatus_name = map[int32]string{
+		0: "EMBEDDING_JOB_STATUS_UNSPECIFIED",
+		1: "EMBEDDING_JOB_STATUS_PENDING",
+		2: "EMBEDDING_JOB_STATUS_RUNNING",
+		3: "EMBEDDING_JOB_STATUS_DONE",
+		4: "EMBEDDING_JOB_STATUS_DEAD",
+	}
+	EmbeddingJobStatus_value = map[string]int32{
+		"EMBEDDING_JOB_STATUS_UNSPECIFIED": 0,
+		"EMBEDDING_JOB_STATUS_PENDING":     1,
+		"EMBEDDING_JOB_STATUS_RUNNING":     2,
+		"EMBEDDING_JOB_STATUS_DONE":        3,
+		"EMBEDDING_JOB_STATUS_DEAD":        4,
+	}
+)
+
+func (x EmbeddingJobStatus) Enum() *EmbeddingJobStatus {
+	p := new(EmbeddingJobStatus)
+	*p = x
+	return p
+}
+
+func (x EmbeddingJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmbeddingJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_embedding_proto_enumTypes[0].Descriptor()
+}
+
+func (EmbeddingJobStatus) Type() protoreflect.EnumType {
+	return &file_embedding_proto_enumTypes[0]
+}
+
+func (x EmbeddingJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmbeddingJobStatus.Descriptor instead.
+func (EmbeddingJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_embedding_proto_rawDescGZIP(), []int{0}
+}
+
+// EmbeddingJob — задача генерации embedding для лида или объекта.
+type EmbeddingJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Status        EmbeddingJobStatus     `protobuf:"varint,3,opt,name=status,proto3,enum=leadexchange.v1.EmbeddingJobStatus" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	MaxAttempts   int32                  `protobuf:"varint,5,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	LastError     *string                `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	RunAt         string                 `protobuf:"bytes,7,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbeddingJob) Reset() {
+	*x = EmbeddingJob{}
+	mi := &file_embedding_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbeddingJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddingJob) ProtoMessage() {}
+
+func (x *EmbeddingJob) ProtoReflect() protoreflect.Message {
+	mi := &file_embedding_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddingJob.ProtoReflect.Descriptor instead.
+func (*EmbeddingJob) Descriptor() ([]byte, []int) {
+	return file_embedding_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EmbeddingJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *EmbeddingJob) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *EmbeddingJob) GetStatus() EmbeddingJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EmbeddingJobStatus_EMBEDDING_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *EmbeddingJob) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *EmbeddingJob) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *EmbeddingJob) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *EmbeddingJob) GetRunAt() string {
+	if x != nil {
+		return x.RunAt
+	}
+	return ""
+}
+
+func (x *EmbeddingJob) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *EmbeddingJob) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// EmbeddingStatusResponse — состояние embedding сущности.
+type EmbeddingStatusResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	HasEmbedding bool                   `protobuf:"varint,1,opt,name=has_embedding,json=hasEmbedding,proto3" json:"has_embedding,omitempty"`
+	// Последняя задача очереди; отсутствует, если сущность в очередь не ставилась
+	Job           *EmbeddingJob `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbeddingStatusResponse) Reset() {
+	*x = EmbeddingStatusResponse{}
+	mi := &file_embedding_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbeddingStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddingStatusResponse) ProtoMessage() {}
+
+func (x *EmbeddingStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_embedding_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddingStatusResponse.ProtoReflect.Descriptor instead.
+func (*EmbeddingStatusResponse) Descriptor() ([]byte, []int) {
+	return file_embedding_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EmbeddingStatusResponse) GetHasEmbedding() bool {
+	if x != nil {
+		return x.HasEmbedding
+	}
+	return false
+}
+
+func (x *EmbeddingStatusResponse) GetJob() *EmbeddingJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+var File_embedding_proto protoreflect.FileDescriptor
+
+const file_embedding_proto_rawDesc = "" +
+	"\n" +
+	"\x0fembedding.proto\x12\x0fleadexchange.v1\"\xc7\x02\n" +
+	"\fEmbeddingJob\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12;\n" +
+	"\x06status\x18\x03 \x01(\x0e2#.leadexchange.v1.EmbeddingJobStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x04 \x01(\x05R\battempts\x12!\n" +
+	"\fmax_attempts\x18\x05 \x01(\x05R\vmaxAttempts\x12\"\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tH\x00R\tlastError\x88\x01\x01\x12\x15\n" +
+	"\x06run_at\x18\a \x01(\tR\x05runAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAtB\r\n" +
+	"\v_last_error\"o\n" +
+	"\x17EmbeddingStatusResponse\x12#\n" +
+	"\rhas_embedding\x18\x01 \x01(\bR\fhasEmbedding\x12/\n" +
+	"\x03job\x18\x02 \x01(\v2\x1d.leadexchange.v1.EmbeddingJobR\x03job*\xbc\x01\n" +
+	"\x12EmbeddingJobStatus\x12$\n" +
+	" EMBEDDING_JOB_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cEMBEDDING_JOB_STATUS_PENDING\x10\x01\x12 \n" +
+	"\x1cEMBEDDING_JOB_STATUS_RUNNING\x10\x02\x12\x1d\n" +
+	"\x19EMBEDDING_JOB_STATUS_DONE\x10\x03\x12\x1d\n" +
+	"\x19EMBEDDING_JOB_STATUS_DEAD\x10\x04B4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
+
+var (
+	file_embedding_proto_rawDescOnce sync.Once
+	file_embedding_proto_rawDescData []byte
+)
+
+func file_embedding_proto_rawDescGZIP() []byte {
+	file_embedding_proto_rawDescOnce.Do(func() {
+		file_embedding_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_embedding_proto_rawDesc), len(file_embedding_proto_rawDesc)))
+	})
+	return file_embedding_proto_rawDescData
+}
+
+var file_embedding_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_embedding_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_embedding_proto_goTypes = []any{
+	(EmbeddingJobStatus)(0),         // 0: leadexchange.v1.EmbeddingJobStatus
+	(*EmbeddingJob)(nil),            // 1: leadexchange.v1.EmbeddingJob
+	(*EmbeddingStatusResponse)(nil), // 2: leadexchange.v1.EmbeddingStatusResponse
+}
+var file_embedding_proto_depIdxs = []int32{
+	0, // 0: leadexchange.v1.EmbeddingJob.status:type_name -> leadexchange.v1.EmbeddingJobStatus
+	1, // 1: leadexchange.v1.EmbeddingStatusResponse.job:type_name -> leadexchange.v1.EmbeddingJob
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_embedding_proto_init() }
+func file_embedding_proto_init() {
+	if File_embedding_proto != nil {
+		return
+	}
+	file_embedding_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_embedding_proto_rawDesc), len(file_embedding_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_embedding_proto_goTypes,
+		DependencyIndexes: file_embedding_proto_depIdxs,
+		EnumInfos:         file_embedding_proto_enumTypes,
+		MessageInfos:      file_embedding_proto_msgTypes,
+	}.Build()
+	File_embedding_proto = out.File
+	file_embedding_proto_goTypes = nil
+	file_embedding_proto_depIdxs = nil
+}
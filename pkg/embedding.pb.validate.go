@@ -0,0 +1,288 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: embedding.proto
+
+package leadexchangev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on EmbeddingJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EmbeddingJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmbeddingJob with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EmbeddingJobMultiError, or
+// nil if none found.
+func (m *EmbeddingJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmbeddingJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for Operation
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for MaxAttempts
+
+	// no validation rules for RunAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if m.LastError != nil {
+		// no validation rules for LastError
+	}
+
+	if len(errors) > 0 {
+		return EmbeddingJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// EmbeddingJobMultiError is an error wrapping multiple validation errors
+// returned by EmbeddingJob.ValidateAll() if the designated constraints aren't met.
+type EmbeddingJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmbeddingJobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmbeddingJobMultiError) AllErrors() []error { return m }
+
+// EmbeddingJobValidationError is the validation error returned by
+// EmbeddingJob.Validate if the designated constraints aren't met.
+type EmbeddingJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmbeddingJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmbeddingJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmbeddingJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmbeddingJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmbeddingJobValidationError) ErrorName() string { return "EmbeddingJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EmbeddingJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmbeddingJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmbeddingJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmbeddingJobValidationError{}
+
+// Validate checks the field values on EmbeddingStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EmbeddingStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmbeddingStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EmbeddingStatusResponseMultiError, or nil if none found.
+func (m *EmbeddingStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmbeddingStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HasEmbedding
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EmbeddingStatusResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EmbeddingStatusResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EmbeddingStatusResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EmbeddingStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EmbeddingStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by EmbeddingStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EmbeddingStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmbeddingStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmbeddingStatusResponseMultiError) AllErrors() []error { return m }
+
+// EmbeddingStatusResponseValidationError is the validation error returned by
+// EmbeddingStatusResponse.Validate if the designated constraints aren't met.
+type EmbeddingStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmbeddingStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmbeddingStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmbeddingStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmbeddingStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmbeddingStatusResponseValidationError) ErrorName() string {
+	return "EmbeddingStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EmbeddingStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmbeddingStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmbeddingStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmbeddingStatusResponseValidationError{}
@@ -85,7 +85,6 @@ type Lead struct {
 	LeadId        string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Requirement   []byte                 `protobuf:"bytes,4,opt,name=requirement,proto3" json:"requirement,omitempty"`
 	ContactName   string                 `protobuf:"bytes,5,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
 	ContactPhone  string                 `protobuf:"bytes,6,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
 	ContactEmail  string                 `protobuf:"bytes,7,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
@@ -96,8 +95,11 @@ type Lead struct {
 	UpdatedAt     string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	City          *string                `protobuf:"bytes,13,opt,name=city,proto3,oneof" json:"city,omitempty"`
 	PropertyType  PropertyType           `protobuf:"varint,14,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType" json:"property_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Контакты скрыты: пользователь не владелец, не создатель и не купил лид
+	ContactsMasked bool             `protobuf:"varint,15,opt,name=contacts_masked,json=contactsMasked,proto3" json:"contacts_masked,omitempty"`
+	Requirement    *LeadRequirement `protobuf:"bytes,16,opt,name=requirement,proto3" json:"requirement,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Lead) Reset() {
@@ -151,13 +153,6 @@ func (x *Lead) GetDescription() string {
 	return ""
 }
 
-func (x *Lead) GetRequirement() []byte {
-	if x != nil {
-		return x.Requirement
-	}
-	return nil
-}
-
 func (x *Lead) GetContactName() string {
 	if x != nil {
 		return x.ContactName
@@ -228,23 +223,300 @@ func (x *Lead) GetPropertyType() PropertyType {
 	return PropertyType_PROPERTY_TYPE_UNSPECIFIED
 }
 
+func (x *Lead) GetContactsMasked() bool {
+	if x != nil {
+		return x.ContactsMasked
+	}
+	return false
+}
+
+func (x *Lead) GetRequirement() *LeadRequirement {
+	if x != nil {
+		return x.Requirement
+	}
+	return nil
+}
+
+// LeadRequirement — требования лида к объекту (версионированная схема).
+type LeadRequirement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version — версия схемы; при создании проставляется сервером
+	Version int32        `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Price   *Int64Range  `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	Rooms   *Int32Range  `protobuf:"bytes,3,opt,name=rooms,proto3" json:"rooms,omitempty"`
+	Area    *DoubleRange `protobuf:"bytes,4,opt,name=area,proto3" json:"area,omitempty"`
+	// districts — районы в порядке предпочтения, первый считается целевым
+	Districts  []string `protobuf:"bytes,5,rep,name=districts,proto3" json:"districts,omitempty"`
+	MustHave   []string `protobuf:"bytes,6,rep,name=must_have,json=mustHave,proto3" json:"must_have,omitempty"`
+	NiceToHave []string `protobuf:"bytes,7,rep,name=nice_to_have,json=niceToHave,proto3" json:"nice_to_have,omitempty"`
+	// visual_preferences — что должно быть видно на фото: «renovated», «park_view», «балкон»
+	VisualPreferences []string `protobuf:"bytes,8,rep,name=visual_preferences,json=visualPreferences,proto3" json:"visual_preferences,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LeadRequirement) Reset() {
+	*x = LeadRequirement{}
+	mi := &file_lead_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeadRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeadRequirement) ProtoMessage() {}
+
+func (x *LeadRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeadRequirement.ProtoReflect.Descriptor instead.
+func (*LeadRequirement) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LeadRequirement) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LeadRequirement) GetPrice() *Int64Range {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *LeadRequirement) GetRooms() *Int32Range {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *LeadRequirement) GetArea() *DoubleRange {
+	if x != nil {
+		return x.Area
+	}
+	return nil
+}
+
+func (x *LeadRequirement) GetDistricts() []string {
+	if x != nil {
+		return x.Districts
+	}
+	return nil
+}
+
+func (x *LeadRequirement) GetMustHave() []string {
+	if x != nil {
+		return x.MustHave
+	}
+	return nil
+}
+
+func (x *LeadRequirement) GetNiceToHave() []string {
+	if x != nil {
+		return x.NiceToHave
+	}
+	return nil
+}
+
+func (x *LeadRequirement) GetVisualPreferences() []string {
+	if x != nil {
+		return x.VisualPreferences
+	}
+	return nil
+}
+
+// Int64Range — диапазон целых значений; любая граница может отсутствовать.
+type Int64Range struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *int64                 `protobuf:"varint,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *int64                 `protobuf:"varint,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int64Range) Reset() {
+	*x = Int64Range{}
+	mi := &file_lead_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int64Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int64Range) ProtoMessage() {}
+
+func (x *Int64Range) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int64Range.ProtoReflect.Descriptor instead.
+func (*Int64Range) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Int64Range) GetMin() int64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *Int64Range) GetMax() int64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// Int32Range — диапазон целых значений; любая граница может отсутствовать.
+type Int32Range struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *int32                 `protobuf:"varint,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *int32                 `protobuf:"varint,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int32Range) Reset() {
+	*x = Int32Range{}
+	mi := &file_lead_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int32Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int32Range) ProtoMessage() {}
+
+func (x *Int32Range) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Int32Range.ProtoReflect.Descriptor instead.
+func (*Int32Range) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Int32Range) GetMin() int32 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *Int32Range) GetMax() int32 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// DoubleRange — диапазон дробных значений; любая граница может отсутствовать.
+type DoubleRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *float64               `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoubleRange) Reset() {
+	*x = DoubleRange{}
+	mi := &file_lead_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoubleRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleRange) ProtoMessage() {}
+
+func (x *DoubleRange) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleRange.ProtoReflect.Descriptor instead.
+func (*DoubleRange) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DoubleRange) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *DoubleRange) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
 type CreateLeadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Requirement   []byte                 `protobuf:"bytes,3,opt,name=requirement,proto3" json:"requirement,omitempty"`
 	ContactName   string                 `protobuf:"bytes,4,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
 	ContactPhone  string                 `protobuf:"bytes,5,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
 	ContactEmail  string                 `protobuf:"bytes,6,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
 	City          *string                `protobuf:"bytes,7,opt,name=city,proto3,oneof" json:"city,omitempty"`
 	PropertyType  PropertyType           `protobuf:"varint,8,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType" json:"property_type,omitempty"`
+	Requirement   *LeadRequirement       `protobuf:"bytes,9,opt,name=requirement,proto3" json:"requirement,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateLeadRequest) Reset() {
 	*x = CreateLeadRequest{}
-	mi := &file_lead_proto_msgTypes[1]
+	mi := &file_lead_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -256,7 +528,7 @@ func (x *CreateLeadRequest) String() string {
 func (*CreateLeadRequest) ProtoMessage() {}
 
 func (x *CreateLeadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[1]
+	mi := &file_lead_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,7 +541,7 @@ func (x *CreateLeadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLeadRequest.ProtoReflect.Descriptor instead.
 func (*CreateLeadRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{1}
+	return file_lead_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateLeadRequest) GetTitle() string {
@@ -286,13 +558,6 @@ func (x *CreateLeadRequest) GetDescription() string {
 	return ""
 }
 
-func (x *CreateLeadRequest) GetRequirement() []byte {
-	if x != nil {
-		return x.Requirement
-	}
-	return nil
-}
-
 func (x *CreateLeadRequest) GetContactName() string {
 	if x != nil {
 		return x.ContactName
@@ -328,6 +593,13 @@ func (x *CreateLeadRequest) GetPropertyType() PropertyType {
 	return PropertyType_PROPERTY_TYPE_UNSPECIFIED
 }
 
+func (x *CreateLeadRequest) GetRequirement() *LeadRequirement {
+	if x != nil {
+		return x.Requirement
+	}
+	return nil
+}
+
 type GetLeadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LeadId        string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
@@ -337,7 +609,7 @@ type GetLeadRequest struct {
 
 func (x *GetLeadRequest) Reset() {
 	*x = GetLeadRequest{}
-	mi := &file_lead_proto_msgTypes[2]
+	mi := &file_lead_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -349,7 +621,7 @@ func (x *GetLeadRequest) String() string {
 func (*GetLeadRequest) ProtoMessage() {}
 
 func (x *GetLeadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[2]
+	mi := &file_lead_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,7 +634,7 @@ func (x *GetLeadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeadRequest.ProtoReflect.Descriptor instead.
 func (*GetLeadRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{2}
+	return file_lead_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetLeadRequest) GetLeadId() string {
@@ -385,7 +657,7 @@ type ListLeadsRequest struct {
 
 func (x *ListLeadsRequest) Reset() {
 	*x = ListLeadsRequest{}
-	mi := &file_lead_proto_msgTypes[3]
+	mi := &file_lead_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -397,7 +669,7 @@ func (x *ListLeadsRequest) String() string {
 func (*ListLeadsRequest) ProtoMessage() {}
 
 func (x *ListLeadsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[3]
+	mi := &file_lead_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,7 +682,7 @@ func (x *ListLeadsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLeadsRequest.ProtoReflect.Descriptor instead.
 func (*ListLeadsRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{3}
+	return file_lead_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListLeadsRequest) GetFilter() *ListLeadsRequest_Filter {
@@ -457,7 +729,7 @@ type ReindexLeadRequest struct {
 
 func (x *ReindexLeadRequest) Reset() {
 	*x = ReindexLeadRequest{}
-	mi := &file_lead_proto_msgTypes[4]
+	mi := &file_lead_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -469,7 +741,7 @@ func (x *ReindexLeadRequest) String() string {
 func (*ReindexLeadRequest) ProtoMessage() {}
 
 func (x *ReindexLeadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[4]
+	mi := &file_lead_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +754,7 @@ func (x *ReindexLeadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReindexLeadRequest.ProtoReflect.Descriptor instead.
 func (*ReindexLeadRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{4}
+	return file_lead_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ReindexLeadRequest) GetLeadId() string {
@@ -502,7 +774,7 @@ type ReindexLeadResponse struct {
 
 func (x *ReindexLeadResponse) Reset() {
 	*x = ReindexLeadResponse{}
-	mi := &file_lead_proto_msgTypes[5]
+	mi := &file_lead_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -514,7 +786,7 @@ func (x *ReindexLeadResponse) String() string {
 func (*ReindexLeadResponse) ProtoMessage() {}
 
 func (x *ReindexLeadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[5]
+	mi := &file_lead_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,7 +799,7 @@ func (x *ReindexLeadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReindexLeadResponse.ProtoReflect.Descriptor instead.
 func (*ReindexLeadResponse) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{5}
+	return file_lead_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ReindexLeadResponse) GetSuccess() bool {
@@ -544,16 +816,65 @@ func (x *ReindexLeadResponse) GetMessage() string {
 	return ""
 }
 
-type ListLeadsResponse struct {
+type GetLeadEmbeddingStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Leads         []*Lead                `protobuf:"bytes,1,rep,name=leads,proto3" json:"leads,omitempty"`
+	LeadId        string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeadEmbeddingStatusRequest) Reset() {
+	*x = GetLeadEmbeddingStatusRequest{}
+	mi := &file_lead_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeadEmbeddingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeadEmbeddingStatusRequest) ProtoMessage() {}
+
+func (x *GetLeadEmbeddingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeadEmbeddingStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetLeadEmbeddingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetLeadEmbeddingStatusRequest) GetLeadId() string {
+	if x != nil {
+		return x.LeadId
+	}
+	return ""
+}
+
+type ListLeadsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Leads []*Lead                `protobuf:"bytes,1,rep,name=leads,proto3" json:"leads,omitempty"`
+	// next_page_token — токен следующей страницы; пустой, если страниц больше нет
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HasMore       bool   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// total_count — сколько записей подходит под фильтр без учёта пагинации
+	TotalCount    int32 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListLeadsResponse) Reset() {
 	*x = ListLeadsResponse{}
-	mi := &file_lead_proto_msgTypes[6]
+	mi := &file_lead_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -565,7 +886,7 @@ func (x *ListLeadsResponse) String() string {
 func (*ListLeadsResponse) ProtoMessage() {}
 
 func (x *ListLeadsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[6]
+	mi := &file_lead_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,7 +899,7 @@ func (x *ListLeadsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLeadsResponse.ProtoReflect.Descriptor instead.
 func (*ListLeadsResponse) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{6}
+	return file_lead_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListLeadsResponse) GetLeads() []*Lead {
@@ -588,23 +909,45 @@ func (x *ListLeadsResponse) GetLeads() []*Lead {
 	return nil
 }
 
+func (x *ListLeadsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListLeadsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListLeadsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type UpdateLeadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LeadId        string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Requirement   []byte                 `protobuf:"bytes,4,opt,name=requirement,proto3,oneof" json:"requirement,omitempty"`
-	Status        *LeadStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=leadexchange.v1.LeadStatus,oneof" json:"status,omitempty"`
-	OwnerUserId   *string                `protobuf:"bytes,6,opt,name=owner_user_id,json=ownerUserId,proto3,oneof" json:"owner_user_id,omitempty"`
-	City          *string                `protobuf:"bytes,7,opt,name=city,proto3,oneof" json:"city,omitempty"`
-	PropertyType  *PropertyType          `protobuf:"varint,8,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType,oneof" json:"property_type,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	LeadId       string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	Title        *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description  *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Status       *LeadStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=leadexchange.v1.LeadStatus,oneof" json:"status,omitempty"`
+	OwnerUserId  *string                `protobuf:"bytes,6,opt,name=owner_user_id,json=ownerUserId,proto3,oneof" json:"owner_user_id,omitempty"`
+	City         *string                `protobuf:"bytes,7,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	PropertyType *PropertyType          `protobuf:"varint,8,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType,oneof" json:"property_type,omitempty"`
+	// requirement — заменяет требования целиком; не задано — без изменений
+	Requirement   *LeadRequirement `protobuf:"bytes,9,opt,name=requirement,proto3" json:"requirement,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateLeadRequest) Reset() {
 	*x = UpdateLeadRequest{}
-	mi := &file_lead_proto_msgTypes[7]
+	mi := &file_lead_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -616,7 +959,7 @@ func (x *UpdateLeadRequest) String() string {
 func (*UpdateLeadRequest) ProtoMessage() {}
 
 func (x *UpdateLeadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[7]
+	mi := &file_lead_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +972,7 @@ func (x *UpdateLeadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLeadRequest.ProtoReflect.Descriptor instead.
 func (*UpdateLeadRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{7}
+	return file_lead_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateLeadRequest) GetLeadId() string {
@@ -653,13 +996,6 @@ func (x *UpdateLeadRequest) GetDescription() string {
 	return ""
 }
 
-func (x *UpdateLeadRequest) GetRequirement() []byte {
-	if x != nil {
-		return x.Requirement
-	}
-	return nil
-}
-
 func (x *UpdateLeadRequest) GetStatus() LeadStatus {
 	if x != nil && x.Status != nil {
 		return *x.Status
@@ -688,6 +1024,13 @@ func (x *UpdateLeadRequest) GetPropertyType() PropertyType {
 	return PropertyType_PROPERTY_TYPE_UNSPECIFIED
 }
 
+func (x *UpdateLeadRequest) GetRequirement() *LeadRequirement {
+	if x != nil {
+		return x.Requirement
+	}
+	return nil
+}
+
 type LeadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Lead          *Lead                  `protobuf:"bytes,1,opt,name=lead,proto3" json:"lead,omitempty"`
@@ -697,7 +1040,7 @@ type LeadResponse struct {
 
 func (x *LeadResponse) Reset() {
 	*x = LeadResponse{}
-	mi := &file_lead_proto_msgTypes[8]
+	mi := &file_lead_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -709,7 +1052,7 @@ func (x *LeadResponse) String() string {
 func (*LeadResponse) ProtoMessage() {}
 
 func (x *LeadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[8]
+	mi := &file_lead_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,7 +1065,7 @@ func (x *LeadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeadResponse.ProtoReflect.Descriptor instead.
 func (*LeadResponse) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{8}
+	return file_lead_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *LeadResponse) GetLead() *Lead {
@@ -732,6 +1075,221 @@ func (x *LeadResponse) GetLead() *Lead {
 	return nil
 }
 
+// MatchLeadsRequest — запрос на поиск подходящих лидов для объекта.
+type MatchLeadsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	PropertyId    string                    `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Filter        *MatchLeadsRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit         *int32                    `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchLeadsRequest) Reset() {
+	*x = MatchLeadsRequest{}
+	mi := &file_lead_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchLeadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchLeadsRequest) ProtoMessage() {}
+
+func (x *MatchLeadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchLeadsRequest.ProtoReflect.Descriptor instead.
+func (*MatchLeadsRequest) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MatchLeadsRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *MatchLeadsRequest) GetFilter() *MatchLeadsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *MatchLeadsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// MatchedLead — лид с коэффициентом схожести и взвешенными scores.
+type MatchedLead struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Lead             *Lead                  `protobuf:"bytes,1,opt,name=lead,proto3" json:"lead,omitempty"`
+	Similarity       float64                `protobuf:"fixed64,2,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	TotalScore       *float64               `protobuf:"fixed64,3,opt,name=total_score,json=totalScore,proto3,oneof" json:"total_score,omitempty"`
+	PriceScore       *float64               `protobuf:"fixed64,4,opt,name=price_score,json=priceScore,proto3,oneof" json:"price_score,omitempty"`
+	DistrictScore    *float64               `protobuf:"fixed64,5,opt,name=district_score,json=districtScore,proto3,oneof" json:"district_score,omitempty"`
+	RoomsScore       *float64               `protobuf:"fixed64,6,opt,name=rooms_score,json=roomsScore,proto3,oneof" json:"rooms_score,omitempty"`
+	AreaScore        *float64               `protobuf:"fixed64,7,opt,name=area_score,json=areaScore,proto3,oneof" json:"area_score,omitempty"`
+	SemanticScore    *float64               `protobuf:"fixed64,8,opt,name=semantic_score,json=semanticScore,proto3,oneof" json:"semantic_score,omitempty"`
+	MatchExplanation *string                `protobuf:"bytes,9,opt,name=match_explanation,json=matchExplanation,proto3,oneof" json:"match_explanation,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MatchedLead) Reset() {
+	*x = MatchedLead{}
+	mi := &file_lead_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchedLead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchedLead) ProtoMessage() {}
+
+func (x *MatchedLead) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchedLead.ProtoReflect.Descriptor instead.
+func (*MatchedLead) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MatchedLead) GetLead() *Lead {
+	if x != nil {
+		return x.Lead
+	}
+	return nil
+}
+
+func (x *MatchedLead) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetTotalScore() float64 {
+	if x != nil && x.TotalScore != nil {
+		return *x.TotalScore
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetPriceScore() float64 {
+	if x != nil && x.PriceScore != nil {
+		return *x.PriceScore
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetDistrictScore() float64 {
+	if x != nil && x.DistrictScore != nil {
+		return *x.DistrictScore
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetRoomsScore() float64 {
+	if x != nil && x.RoomsScore != nil {
+		return *x.RoomsScore
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetAreaScore() float64 {
+	if x != nil && x.AreaScore != nil {
+		return *x.AreaScore
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetSemanticScore() float64 {
+	if x != nil && x.SemanticScore != nil {
+		return *x.SemanticScore
+	}
+	return 0
+}
+
+func (x *MatchedLead) GetMatchExplanation() string {
+	if x != nil && x.MatchExplanation != nil {
+		return *x.MatchExplanation
+	}
+	return ""
+}
+
+// MatchLeadsResponse — ответ с подходящими лидами.
+type MatchLeadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*MatchedLead         `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchLeadsResponse) Reset() {
+	*x = MatchLeadsResponse{}
+	mi := &file_lead_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchLeadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchLeadsResponse) ProtoMessage() {}
+
+func (x *MatchLeadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchLeadsResponse.ProtoReflect.Descriptor instead.
+func (*MatchLeadsResponse) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MatchLeadsResponse) GetMatches() []*MatchedLead {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
 type GetClarificationQuestionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	LeadId        string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
@@ -741,7 +1299,7 @@ type GetClarificationQuestionsRequest struct {
 
 func (x *GetClarificationQuestionsRequest) Reset() {
 	*x = GetClarificationQuestionsRequest{}
-	mi := &file_lead_proto_msgTypes[9]
+	mi := &file_lead_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -753,7 +1311,7 @@ func (x *GetClarificationQuestionsRequest) String() string {
 func (*GetClarificationQuestionsRequest) ProtoMessage() {}
 
 func (x *GetClarificationQuestionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[9]
+	mi := &file_lead_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,7 +1324,7 @@ func (x *GetClarificationQuestionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClarificationQuestionsRequest.ProtoReflect.Descriptor instead.
 func (*GetClarificationQuestionsRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{9}
+	return file_lead_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetClarificationQuestionsRequest) GetLeadId() string {
@@ -789,7 +1347,7 @@ type ClarificationQuestion struct {
 
 func (x *ClarificationQuestion) Reset() {
 	*x = ClarificationQuestion{}
-	mi := &file_lead_proto_msgTypes[10]
+	mi := &file_lead_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -801,7 +1359,7 @@ func (x *ClarificationQuestion) String() string {
 func (*ClarificationQuestion) ProtoMessage() {}
 
 func (x *ClarificationQuestion) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[10]
+	mi := &file_lead_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +1372,7 @@ func (x *ClarificationQuestion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClarificationQuestion.ProtoReflect.Descriptor instead.
 func (*ClarificationQuestion) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{10}
+	return file_lead_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ClarificationQuestion) GetField() string {
@@ -859,13 +1417,15 @@ type GetClarificationQuestionsResponse struct {
 	Priority           string                   `protobuf:"bytes,3,opt,name=priority,proto3" json:"priority,omitempty"`
 	MissingFields      []string                 `protobuf:"bytes,4,rep,name=missing_fields,json=missingFields,proto3" json:"missing_fields,omitempty"`
 	LeadQualityScore   float64                  `protobuf:"fixed64,5,opt,name=lead_quality_score,json=leadQualityScore,proto3" json:"lead_quality_score,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// clarification_id — открытая сессия уточнения, которую закроет ApplyClarificationAnswers
+	ClarificationId string `protobuf:"bytes,6,opt,name=clarification_id,json=clarificationId,proto3" json:"clarification_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetClarificationQuestionsResponse) Reset() {
 	*x = GetClarificationQuestionsResponse{}
-	mi := &file_lead_proto_msgTypes[11]
+	mi := &file_lead_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -877,7 +1437,7 @@ func (x *GetClarificationQuestionsResponse) String() string {
 func (*GetClarificationQuestionsResponse) ProtoMessage() {}
 
 func (x *GetClarificationQuestionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[11]
+	mi := &file_lead_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,7 +1450,7 @@ func (x *GetClarificationQuestionsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetClarificationQuestionsResponse.ProtoReflect.Descriptor instead.
 func (*GetClarificationQuestionsResponse) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{11}
+	return file_lead_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetClarificationQuestionsResponse) GetNeedsClarification() bool {
@@ -928,6 +1488,13 @@ func (x *GetClarificationQuestionsResponse) GetLeadQualityScore() float64 {
 	return 0
 }
 
+func (x *GetClarificationQuestionsResponse) GetClarificationId() string {
+	if x != nil {
+		return x.ClarificationId
+	}
+	return ""
+}
+
 type ClarificationAnswer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
@@ -938,7 +1505,7 @@ type ClarificationAnswer struct {
 
 func (x *ClarificationAnswer) Reset() {
 	*x = ClarificationAnswer{}
-	mi := &file_lead_proto_msgTypes[12]
+	mi := &file_lead_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -950,7 +1517,7 @@ func (x *ClarificationAnswer) String() string {
 func (*ClarificationAnswer) ProtoMessage() {}
 
 func (x *ClarificationAnswer) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[12]
+	mi := &file_lead_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,7 +1530,7 @@ func (x *ClarificationAnswer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClarificationAnswer.ProtoReflect.Descriptor instead.
 func (*ClarificationAnswer) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{12}
+	return file_lead_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ClarificationAnswer) GetField() string {
@@ -990,7 +1557,7 @@ type ApplyClarificationAnswersRequest struct {
 
 func (x *ApplyClarificationAnswersRequest) Reset() {
 	*x = ApplyClarificationAnswersRequest{}
-	mi := &file_lead_proto_msgTypes[13]
+	mi := &file_lead_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1002,7 +1569,7 @@ func (x *ApplyClarificationAnswersRequest) String() string {
 func (*ApplyClarificationAnswersRequest) ProtoMessage() {}
 
 func (x *ApplyClarificationAnswersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[13]
+	mi := &file_lead_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,7 +1582,7 @@ func (x *ApplyClarificationAnswersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyClarificationAnswersRequest.ProtoReflect.Descriptor instead.
 func (*ApplyClarificationAnswersRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{13}
+	return file_lead_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ApplyClarificationAnswersRequest) GetLeadId() string {
@@ -1035,15 +1602,16 @@ func (x *ApplyClarificationAnswersRequest) GetAnswers() []*ClarificationAnswer {
 type ApplyClarificationAnswersResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	NewRequirement []byte                 `protobuf:"bytes,2,opt,name=new_requirement,json=newRequirement,proto3" json:"new_requirement,omitempty"`
 	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	NewRequirement *LeadRequirement       `protobuf:"bytes,4,opt,name=new_requirement,json=newRequirement,proto3" json:"new_requirement,omitempty"`
+	Clarification  *LeadClarification     `protobuf:"bytes,5,opt,name=clarification,proto3" json:"clarification,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ApplyClarificationAnswersResponse) Reset() {
 	*x = ApplyClarificationAnswersResponse{}
-	mi := &file_lead_proto_msgTypes[14]
+	mi := &file_lead_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1055,7 +1623,7 @@ func (x *ApplyClarificationAnswersResponse) String() string {
 func (*ApplyClarificationAnswersResponse) ProtoMessage() {}
 
 func (x *ApplyClarificationAnswersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[14]
+	mi := &file_lead_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1636,7 @@ func (x *ApplyClarificationAnswersResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ApplyClarificationAnswersResponse.ProtoReflect.Descriptor instead.
 func (*ApplyClarificationAnswersResponse) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{14}
+	return file_lead_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ApplyClarificationAnswersResponse) GetSuccess() bool {
@@ -1078,34 +1646,253 @@ func (x *ApplyClarificationAnswersResponse) GetSuccess() bool {
 	return false
 }
 
-func (x *ApplyClarificationAnswersResponse) GetNewRequirement() []byte {
+func (x *ApplyClarificationAnswersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApplyClarificationAnswersResponse) GetNewRequirement() *LeadRequirement {
 	if x != nil {
 		return x.NewRequirement
 	}
 	return nil
 }
 
-func (x *ApplyClarificationAnswersResponse) GetMessage() string {
+func (x *ApplyClarificationAnswersResponse) GetClarification() *LeadClarification {
 	if x != nil {
-		return x.Message
+		return x.Clarification
+	}
+	return nil
+}
+
+// LeadClarification — сессия уточнения лида.
+type LeadClarification struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	ClarificationId string                   `protobuf:"bytes,1,opt,name=clarification_id,json=clarificationId,proto3" json:"clarification_id,omitempty"`
+	LeadId          string                   `protobuf:"bytes,2,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	Questions       []*ClarificationQuestion `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
+	Answers         []*ClarificationAnswer   `protobuf:"bytes,4,rep,name=answers,proto3" json:"answers,omitempty"`
+	Priority        string                   `protobuf:"bytes,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	// status — pending, answered или skipped (по лиду начата новая сессия)
+	Status             string   `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	QualityScoreBefore *float64 `protobuf:"fixed64,7,opt,name=quality_score_before,json=qualityScoreBefore,proto3,oneof" json:"quality_score_before,omitempty"`
+	QualityScoreAfter  *float64 `protobuf:"fixed64,8,opt,name=quality_score_after,json=qualityScoreAfter,proto3,oneof" json:"quality_score_after,omitempty"`
+	CreatedAt          string   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AnsweredAt         *string  `protobuf:"bytes,10,opt,name=answered_at,json=answeredAt,proto3,oneof" json:"answered_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LeadClarification) Reset() {
+	*x = LeadClarification{}
+	mi := &file_lead_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeadClarification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeadClarification) ProtoMessage() {}
+
+func (x *LeadClarification) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeadClarification.ProtoReflect.Descriptor instead.
+func (*LeadClarification) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LeadClarification) GetClarificationId() string {
+	if x != nil {
+		return x.ClarificationId
 	}
 	return ""
 }
 
-type MatchWeights struct {
+func (x *LeadClarification) GetLeadId() string {
+	if x != nil {
+		return x.LeadId
+	}
+	return ""
+}
+
+func (x *LeadClarification) GetQuestions() []*ClarificationQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *LeadClarification) GetAnswers() []*ClarificationAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *LeadClarification) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *LeadClarification) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LeadClarification) GetQualityScoreBefore() float64 {
+	if x != nil && x.QualityScoreBefore != nil {
+		return *x.QualityScoreBefore
+	}
+	return 0
+}
+
+func (x *LeadClarification) GetQualityScoreAfter() float64 {
+	if x != nil && x.QualityScoreAfter != nil {
+		return *x.QualityScoreAfter
+	}
+	return 0
+}
+
+func (x *LeadClarification) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *LeadClarification) GetAnsweredAt() string {
+	if x != nil && x.AnsweredAt != nil {
+		return *x.AnsweredAt
+	}
+	return ""
+}
+
+type ListClarificationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
-	District      float64                `protobuf:"fixed64,2,opt,name=district,proto3" json:"district,omitempty"`
-	Rooms         float64                `protobuf:"fixed64,3,opt,name=rooms,proto3" json:"rooms,omitempty"`
-	Area          float64                `protobuf:"fixed64,4,opt,name=area,proto3" json:"area,omitempty"`
-	Semantic      float64                `protobuf:"fixed64,5,opt,name=semantic,proto3" json:"semantic,omitempty"`
+	LeadId        string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClarificationsRequest) Reset() {
+	*x = ListClarificationsRequest{}
+	mi := &file_lead_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClarificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClarificationsRequest) ProtoMessage() {}
+
+func (x *ListClarificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClarificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListClarificationsRequest) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListClarificationsRequest) GetLeadId() string {
+	if x != nil {
+		return x.LeadId
+	}
+	return ""
+}
+
+type ListClarificationsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Clarifications []*LeadClarification   `protobuf:"bytes,1,rep,name=clarifications,proto3" json:"clarifications,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListClarificationsResponse) Reset() {
+	*x = ListClarificationsResponse{}
+	mi := &file_lead_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClarificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClarificationsResponse) ProtoMessage() {}
+
+func (x *ListClarificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClarificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListClarificationsResponse) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListClarificationsResponse) GetClarifications() []*LeadClarification {
+	if x != nil {
+		return x.Clarifications
+	}
+	return nil
+}
+
+type MatchWeights struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Price    float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	District float64                `protobuf:"fixed64,2,opt,name=district,proto3" json:"district,omitempty"`
+	Rooms    float64                `protobuf:"fixed64,3,opt,name=rooms,proto3" json:"rooms,omitempty"`
+	Area     float64                `protobuf:"fixed64,4,opt,name=area,proto3" json:"area,omitempty"`
+	Semantic float64                `protobuf:"fixed64,5,opt,name=semantic,proto3" json:"semantic,omitempty"`
+	// distance — вес удалённости от центра гео-поиска
+	Distance float64 `protobuf:"fixed64,6,opt,name=distance,proto3" json:"distance,omitempty"`
+	// visual — вес соответствия фото визуальным предпочтениям лида
+	Visual float64 `protobuf:"fixed64,7,opt,name=visual,proto3" json:"visual,omitempty"`
+	// features — вес желательных особенностей лида (nice_to_have)
+	Features      float64 `protobuf:"fixed64,8,opt,name=features,proto3" json:"features,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MatchWeights) Reset() {
 	*x = MatchWeights{}
-	mi := &file_lead_proto_msgTypes[15]
+	mi := &file_lead_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1117,7 +1904,7 @@ func (x *MatchWeights) String() string {
 func (*MatchWeights) ProtoMessage() {}
 
 func (x *MatchWeights) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[15]
+	mi := &file_lead_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,7 +1917,7 @@ func (x *MatchWeights) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchWeights.ProtoReflect.Descriptor instead.
 func (*MatchWeights) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{15}
+	return file_lead_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *MatchWeights) GetPrice() float64 {
@@ -1168,6 +1955,27 @@ func (x *MatchWeights) GetSemantic() float64 {
 	return 0
 }
 
+func (x *MatchWeights) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *MatchWeights) GetVisual() float64 {
+	if x != nil {
+		return x.Visual
+	}
+	return 0
+}
+
+func (x *MatchWeights) GetFeatures() float64 {
+	if x != nil {
+		return x.Features
+	}
+	return 0
+}
+
 type ExtractedCriteria struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	TargetPrice        *int64                 `protobuf:"varint,1,opt,name=target_price,json=targetPrice,proto3,oneof" json:"target_price,omitempty"`
@@ -1183,7 +1991,7 @@ type ExtractedCriteria struct {
 
 func (x *ExtractedCriteria) Reset() {
 	*x = ExtractedCriteria{}
-	mi := &file_lead_proto_msgTypes[16]
+	mi := &file_lead_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1195,7 +2003,7 @@ func (x *ExtractedCriteria) String() string {
 func (*ExtractedCriteria) ProtoMessage() {}
 
 func (x *ExtractedCriteria) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[16]
+	mi := &file_lead_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1208,7 +2016,7 @@ func (x *ExtractedCriteria) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtractedCriteria.ProtoReflect.Descriptor instead.
 func (*ExtractedCriteria) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{16}
+	return file_lead_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ExtractedCriteria) GetTargetPrice() int64 {
@@ -1269,7 +2077,7 @@ type AnalyzeLeadIntentRequest struct {
 
 func (x *AnalyzeLeadIntentRequest) Reset() {
 	*x = AnalyzeLeadIntentRequest{}
-	mi := &file_lead_proto_msgTypes[17]
+	mi := &file_lead_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1281,7 +2089,7 @@ func (x *AnalyzeLeadIntentRequest) String() string {
 func (*AnalyzeLeadIntentRequest) ProtoMessage() {}
 
 func (x *AnalyzeLeadIntentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[17]
+	mi := &file_lead_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1294,7 +2102,7 @@ func (x *AnalyzeLeadIntentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnalyzeLeadIntentRequest.ProtoReflect.Descriptor instead.
 func (*AnalyzeLeadIntentRequest) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{17}
+	return file_lead_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *AnalyzeLeadIntentRequest) GetLeadId() string {
@@ -1318,7 +2126,7 @@ type AnalyzeLeadIntentResponse struct {
 
 func (x *AnalyzeLeadIntentResponse) Reset() {
 	*x = AnalyzeLeadIntentResponse{}
-	mi := &file_lead_proto_msgTypes[18]
+	mi := &file_lead_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1330,7 +2138,7 @@ func (x *AnalyzeLeadIntentResponse) String() string {
 func (*AnalyzeLeadIntentResponse) ProtoMessage() {}
 
 func (x *AnalyzeLeadIntentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[18]
+	mi := &file_lead_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,7 +2151,7 @@ func (x *AnalyzeLeadIntentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnalyzeLeadIntentResponse.ProtoReflect.Descriptor instead.
 func (*AnalyzeLeadIntentResponse) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{18}
+	return file_lead_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AnalyzeLeadIntentResponse) GetRecommendedWeights() *MatchWeights {
@@ -1395,13 +2203,15 @@ type ListLeadsRequest_Filter struct {
 	CreatedUserId *string                `protobuf:"bytes,3,opt,name=created_user_id,json=createdUserId,proto3,oneof" json:"created_user_id,omitempty"`
 	City          *string                `protobuf:"bytes,4,opt,name=city,proto3,oneof" json:"city,omitempty"`
 	PropertyType  *PropertyType          `protobuf:"varint,5,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType,oneof" json:"property_type,omitempty"`
+	// team — лиды всех участников агентства текущего пользователя
+	Team          *bool `protobuf:"varint,6,opt,name=team,proto3,oneof" json:"team,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListLeadsRequest_Filter) Reset() {
 	*x = ListLeadsRequest_Filter{}
-	mi := &file_lead_proto_msgTypes[19]
+	mi := &file_lead_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1413,7 +2223,7 @@ func (x *ListLeadsRequest_Filter) String() string {
 func (*ListLeadsRequest_Filter) ProtoMessage() {}
 
 func (x *ListLeadsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_lead_proto_msgTypes[19]
+	mi := &file_lead_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1426,7 +2236,7 @@ func (x *ListLeadsRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLeadsRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListLeadsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_lead_proto_rawDescGZIP(), []int{3, 0}
+	return file_lead_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *ListLeadsRequest_Filter) GetStatus() LeadStatus {
@@ -1464,17 +2274,83 @@ func (x *ListLeadsRequest_Filter) GetPropertyType() PropertyType {
 	return PropertyType_PROPERTY_TYPE_UNSPECIFIED
 }
 
+func (x *ListLeadsRequest_Filter) GetTeam() bool {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return false
+}
+
+type MatchLeadsRequest_Filter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *LeadStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=leadexchange.v1.LeadStatus,oneof" json:"status,omitempty"`
+	PropertyType  *PropertyType          `protobuf:"varint,2,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType,oneof" json:"property_type,omitempty"`
+	City          *string                `protobuf:"bytes,3,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchLeadsRequest_Filter) Reset() {
+	*x = MatchLeadsRequest_Filter{}
+	mi := &file_lead_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchLeadsRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchLeadsRequest_Filter) ProtoMessage() {}
+
+func (x *MatchLeadsRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchLeadsRequest_Filter.ProtoReflect.Descriptor instead.
+func (*MatchLeadsRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *MatchLeadsRequest_Filter) GetStatus() LeadStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return LeadStatus_LEAD_STATUS_UNSPECIFIED
+}
+
+func (x *MatchLeadsRequest_Filter) GetPropertyType() PropertyType {
+	if x != nil && x.PropertyType != nil {
+		return *x.PropertyType
+	}
+	return PropertyType_PROPERTY_TYPE_UNSPECIFIED
+}
+
+func (x *MatchLeadsRequest_Filter) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
 var File_lead_proto protoreflect.FileDescriptor
 
 const file_lead_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"lead.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x0eproperty.proto\"\xd9\x04\n" +
+	"lead.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x0eproperty.proto\x1a\x0fembedding.proto\"\xaa\x05\n" +
 	"\x04Lead\x12\x17\n" +
 	"\alead_id\x18\x01 \x01(\tR\x06leadId\x12\x1d\n" +
 	"\x05title\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x03R\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
-	"\vrequirement\x18\x04 \x01(\fR\vrequirement\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12*\n" +
 	"\fcontact_name\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\vcontactName\x12?\n" +
 	"\rcontact_phone\x18\x06 \x01(\tB\x1a\xfaB\x17r\x152\x13^\\+?[0-9\\s()-]{7,}$R\fcontactPhone\x12/\n" +
 	"\rcontact_email\x18\a \x01(\tB\n" +
@@ -1488,39 +2364,70 @@ const file_lead_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\f \x01(\tR\tupdatedAt\x12\x17\n" +
 	"\x04city\x18\r \x01(\tH\x00R\x04city\x88\x01\x01\x12B\n" +
-	"\rproperty_type\x18\x0e \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeR\fpropertyTypeB\a\n" +
-	"\x05_city\"\xfa\x02\n" +
+	"\rproperty_type\x18\x0e \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeR\fpropertyType\x12'\n" +
+	"\x0fcontacts_masked\x18\x0f \x01(\bR\x0econtactsMasked\x12B\n" +
+	"\vrequirement\x18\x10 \x01(\v2 .leadexchange.v1.LeadRequirementR\vrequirementB\a\n" +
+	"\x05_cityJ\x04\b\x04\x10\x05\"\x87\x03\n" +
+	"\x0fLeadRequirement\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x121\n" +
+	"\x05price\x18\x02 \x01(\v2\x1b.leadexchange.v1.Int64RangeR\x05price\x121\n" +
+	"\x05rooms\x18\x03 \x01(\v2\x1b.leadexchange.v1.Int32RangeR\x05rooms\x120\n" +
+	"\x04area\x18\x04 \x01(\v2\x1c.leadexchange.v1.DoubleRangeR\x04area\x12*\n" +
+	"\tdistricts\x18\x05 \x03(\tB\f\xfaB\t\x92\x01\x06\"\x04r\x02\x10\x01R\tdistricts\x12)\n" +
+	"\tmust_have\x18\x06 \x03(\tB\f\xfaB\t\x92\x01\x06\"\x04r\x02\x10\x01R\bmustHave\x12.\n" +
+	"\fnice_to_have\x18\a \x03(\tB\f\xfaB\t\x92\x01\x06\"\x04r\x02\x10\x01R\n" +
+	"niceToHave\x12;\n" +
+	"\x12visual_preferences\x18\b \x03(\tB\f\xfaB\t\x92\x01\x06\"\x04r\x02\x10\x01R\x11visualPreferences\"\\\n" +
+	"\n" +
+	"Int64Range\x12\x1e\n" +
+	"\x03min\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x00H\x00R\x03min\x88\x01\x01\x12\x1e\n" +
+	"\x03max\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x00H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\\\n" +
+	"\n" +
+	"Int32Range\x12\x1e\n" +
+	"\x03min\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\x00R\x03min\x88\x01\x01\x12\x1e\n" +
+	"\x03max\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"k\n" +
+	"\vDoubleRange\x12%\n" +
+	"\x03min\x18\x01 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\x03min\x88\x01\x01\x12%\n" +
+	"\x03max\x18\x02 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\xa2\x03\n" +
 	"\x11CreateLeadRequest\x12\x1d\n" +
 	"\x05title\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x03R\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
-	"\vrequirement\x18\x03 \x01(\fR\vrequirement\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12*\n" +
 	"\fcontact_name\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\vcontactName\x12?\n" +
 	"\rcontact_phone\x18\x05 \x01(\tB\x1a\xfaB\x17r\x152\x13^\\+?[0-9\\s()-]{7,}$R\fcontactPhone\x12/\n" +
 	"\rcontact_email\x18\x06 \x01(\tB\n" +
 	"\xfaB\ar\x05\xd0\x01\x01`\x01R\fcontactEmail\x12\x17\n" +
 	"\x04city\x18\a \x01(\tH\x00R\x04city\x88\x01\x01\x12B\n" +
-	"\rproperty_type\x18\b \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeR\fpropertyTypeB\a\n" +
-	"\x05_city\"3\n" +
+	"\rproperty_type\x18\b \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeR\fpropertyType\x12B\n" +
+	"\vrequirement\x18\t \x01(\v2 .leadexchange.v1.LeadRequirementR\vrequirementB\a\n" +
+	"\x05_cityJ\x04\b\x03\x10\x04\"3\n" +
 	"\x0eGetLeadRequest\x12!\n" +
-	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\"\xef\x04\n" +
+	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\"\x91\x05\n" +
 	"\x10ListLeadsRequest\x12@\n" +
 	"\x06filter\x18\x01 \x01(\v2(.leadexchange.v1.ListLeadsRequest.FilterR\x06filter\x12 \n" +
 	"\tpage_size\x18\x02 \x01(\x05H\x00R\bpageSize\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"page_token\x18\x03 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12\x1e\n" +
 	"\border_by\x18\x04 \x01(\tH\x02R\aorderBy\x88\x01\x01\x12,\n" +
-	"\x0forder_direction\x18\x05 \x01(\tH\x03R\x0eorderDirection\x88\x01\x01\x1a\xc6\x02\n" +
+	"\x0forder_direction\x18\x05 \x01(\tH\x03R\x0eorderDirection\x88\x01\x01\x1a\xe8\x02\n" +
 	"\x06Filter\x128\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x1b.leadexchange.v1.LeadStatusH\x00R\x06status\x88\x01\x01\x12'\n" +
 	"\rowner_user_id\x18\x02 \x01(\tH\x01R\vownerUserId\x88\x01\x01\x12+\n" +
 	"\x0fcreated_user_id\x18\x03 \x01(\tH\x02R\rcreatedUserId\x88\x01\x01\x12\x17\n" +
 	"\x04city\x18\x04 \x01(\tH\x03R\x04city\x88\x01\x01\x12G\n" +
-	"\rproperty_type\x18\x05 \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeH\x04R\fpropertyType\x88\x01\x01B\t\n" +
+	"\rproperty_type\x18\x05 \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeH\x04R\fpropertyType\x88\x01\x01\x12\x17\n" +
+	"\x04team\x18\x06 \x01(\bH\x05R\x04team\x88\x01\x01B\t\n" +
 	"\a_statusB\x10\n" +
 	"\x0e_owner_user_idB\x12\n" +
 	"\x10_created_user_idB\a\n" +
 	"\x05_cityB\x10\n" +
-	"\x0e_property_typeB\f\n" +
+	"\x0e_property_typeB\a\n" +
+	"\x05_teamB\f\n" +
 	"\n" +
 	"_page_sizeB\r\n" +
 	"\v_page_tokenB\v\n" +
@@ -1530,27 +2437,70 @@ const file_lead_proto_rawDesc = "" +
 	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\"I\n" +
 	"\x13ReindexLeadResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"@\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"B\n" +
+	"\x1dGetLeadEmbeddingStatusRequest\x12!\n" +
+	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\"\xa4\x01\n" +
 	"\x11ListLeadsResponse\x12+\n" +
-	"\x05leads\x18\x01 \x03(\v2\x15.leadexchange.v1.LeadR\x05leads\"\xc6\x03\n" +
+	"\x05leads\x18\x01 \x03(\v2\x15.leadexchange.v1.LeadR\x05leads\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x05R\n" +
+	"totalCount\"\xd9\x03\n" +
 	"\x11UpdateLeadRequest\x12!\n" +
 	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12%\n" +
-	"\vrequirement\x18\x04 \x01(\fH\x02R\vrequirement\x88\x01\x01\x128\n" +
-	"\x06status\x18\x05 \x01(\x0e2\x1b.leadexchange.v1.LeadStatusH\x03R\x06status\x88\x01\x01\x12'\n" +
-	"\rowner_user_id\x18\x06 \x01(\tH\x04R\vownerUserId\x88\x01\x01\x12\x17\n" +
-	"\x04city\x18\a \x01(\tH\x05R\x04city\x88\x01\x01\x12G\n" +
-	"\rproperty_type\x18\b \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeH\x06R\fpropertyType\x88\x01\x01B\b\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x128\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1b.leadexchange.v1.LeadStatusH\x02R\x06status\x88\x01\x01\x12'\n" +
+	"\rowner_user_id\x18\x06 \x01(\tH\x03R\vownerUserId\x88\x01\x01\x12\x17\n" +
+	"\x04city\x18\a \x01(\tH\x04R\x04city\x88\x01\x01\x12G\n" +
+	"\rproperty_type\x18\b \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeH\x05R\fpropertyType\x88\x01\x01\x12B\n" +
+	"\vrequirement\x18\t \x01(\v2 .leadexchange.v1.LeadRequirementR\vrequirementB\b\n" +
 	"\x06_titleB\x0e\n" +
-	"\f_descriptionB\x0e\n" +
-	"\f_requirementB\t\n" +
+	"\f_descriptionB\t\n" +
 	"\a_statusB\x10\n" +
 	"\x0e_owner_user_idB\a\n" +
 	"\x05_cityB\x10\n" +
-	"\x0e_property_type\"9\n" +
+	"\x0e_property_typeJ\x04\b\x04\x10\x05\"9\n" +
 	"\fLeadResponse\x12)\n" +
-	"\x04lead\x18\x01 \x01(\v2\x15.leadexchange.v1.LeadR\x04lead\"E\n" +
+	"\x04lead\x18\x01 \x01(\v2\x15.leadexchange.v1.LeadR\x04lead\"\xf3\x02\n" +
+	"\x11MatchLeadsRequest\x12)\n" +
+	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"propertyId\x12A\n" +
+	"\x06filter\x18\x02 \x01(\v2).leadexchange.v1.MatchLeadsRequest.FilterR\x06filter\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x05H\x00R\x05limit\x88\x01\x01\x1a\xca\x01\n" +
+	"\x06Filter\x128\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.leadexchange.v1.LeadStatusH\x00R\x06status\x88\x01\x01\x12G\n" +
+	"\rproperty_type\x18\x02 \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeH\x01R\fpropertyType\x88\x01\x01\x12\x17\n" +
+	"\x04city\x18\x03 \x01(\tH\x02R\x04city\x88\x01\x01B\t\n" +
+	"\a_statusB\x10\n" +
+	"\x0e_property_typeB\a\n" +
+	"\x05_cityB\b\n" +
+	"\x06_limit\"\xf3\x03\n" +
+	"\vMatchedLead\x12)\n" +
+	"\x04lead\x18\x01 \x01(\v2\x15.leadexchange.v1.LeadR\x04lead\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x02 \x01(\x01R\n" +
+	"similarity\x12$\n" +
+	"\vtotal_score\x18\x03 \x01(\x01H\x00R\n" +
+	"totalScore\x88\x01\x01\x12$\n" +
+	"\vprice_score\x18\x04 \x01(\x01H\x01R\n" +
+	"priceScore\x88\x01\x01\x12*\n" +
+	"\x0edistrict_score\x18\x05 \x01(\x01H\x02R\rdistrictScore\x88\x01\x01\x12$\n" +
+	"\vrooms_score\x18\x06 \x01(\x01H\x03R\n" +
+	"roomsScore\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"area_score\x18\a \x01(\x01H\x04R\tareaScore\x88\x01\x01\x12*\n" +
+	"\x0esemantic_score\x18\b \x01(\x01H\x05R\rsemanticScore\x88\x01\x01\x120\n" +
+	"\x11match_explanation\x18\t \x01(\tH\x06R\x10matchExplanation\x88\x01\x01B\x0e\n" +
+	"\f_total_scoreB\x0e\n" +
+	"\f_price_scoreB\x11\n" +
+	"\x0f_district_scoreB\x0e\n" +
+	"\f_rooms_scoreB\r\n" +
+	"\v_area_scoreB\x11\n" +
+	"\x0f_semantic_scoreB\x14\n" +
+	"\x12_match_explanation\"L\n" +
+	"\x12MatchLeadsResponse\x126\n" +
+	"\amatches\x18\x01 \x03(\v2\x1c.leadexchange.v1.MatchedLeadR\amatches\"E\n" +
 	" GetClarificationQuestionsRequest\x12!\n" +
 	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\"\xbb\x01\n" +
 	"\x15ClarificationQuestion\x12\x14\n" +
@@ -1560,29 +2510,55 @@ const file_lead_proto_rawDesc = "" +
 	"\x11suggested_options\x18\x04 \x03(\tR\x10suggestedOptions\x12\x1e\n" +
 	"\n" +
 	"importance\x18\x05 \x01(\tR\n" +
-	"importance\"\x8b\x02\n" +
+	"importance\"\xb6\x02\n" +
 	"!GetClarificationQuestionsResponse\x12/\n" +
 	"\x13needs_clarification\x18\x01 \x01(\bR\x12needsClarification\x12D\n" +
 	"\tquestions\x18\x02 \x03(\v2&.leadexchange.v1.ClarificationQuestionR\tquestions\x12\x1a\n" +
 	"\bpriority\x18\x03 \x01(\tR\bpriority\x12%\n" +
 	"\x0emissing_fields\x18\x04 \x03(\tR\rmissingFields\x12,\n" +
-	"\x12lead_quality_score\x18\x05 \x01(\x01R\x10leadQualityScore\"A\n" +
+	"\x12lead_quality_score\x18\x05 \x01(\x01R\x10leadQualityScore\x12)\n" +
+	"\x10clarification_id\x18\x06 \x01(\tR\x0fclarificationId\"A\n" +
 	"\x13ClarificationAnswer\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value\"\x85\x01\n" +
 	" ApplyClarificationAnswersRequest\x12!\n" +
 	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\x12>\n" +
-	"\aanswers\x18\x02 \x03(\v2$.leadexchange.v1.ClarificationAnswerR\aanswers\"\x80\x01\n" +
+	"\aanswers\x18\x02 \x03(\v2$.leadexchange.v1.ClarificationAnswerR\aanswers\"\xf2\x01\n" +
 	"!ApplyClarificationAnswersResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12'\n" +
-	"\x0fnew_requirement\x18\x02 \x01(\fR\x0enewRequirement\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\x86\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12I\n" +
+	"\x0fnew_requirement\x18\x04 \x01(\v2 .leadexchange.v1.LeadRequirementR\x0enewRequirement\x12H\n" +
+	"\rclarification\x18\x05 \x01(\v2\".leadexchange.v1.LeadClarificationR\rclarificationJ\x04\b\x02\x10\x03\"\x83\x04\n" +
+	"\x11LeadClarification\x12)\n" +
+	"\x10clarification_id\x18\x01 \x01(\tR\x0fclarificationId\x12\x17\n" +
+	"\alead_id\x18\x02 \x01(\tR\x06leadId\x12D\n" +
+	"\tquestions\x18\x03 \x03(\v2&.leadexchange.v1.ClarificationQuestionR\tquestions\x12>\n" +
+	"\aanswers\x18\x04 \x03(\v2$.leadexchange.v1.ClarificationAnswerR\aanswers\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\tR\bpriority\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x125\n" +
+	"\x14quality_score_before\x18\a \x01(\x01H\x00R\x12qualityScoreBefore\x88\x01\x01\x123\n" +
+	"\x13quality_score_after\x18\b \x01(\x01H\x01R\x11qualityScoreAfter\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12$\n" +
+	"\vanswered_at\x18\n" +
+	" \x01(\tH\x02R\n" +
+	"answeredAt\x88\x01\x01B\x17\n" +
+	"\x15_quality_score_beforeB\x16\n" +
+	"\x14_quality_score_afterB\x0e\n" +
+	"\f_answered_at\">\n" +
+	"\x19ListClarificationsRequest\x12!\n" +
+	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\"h\n" +
+	"\x1aListClarificationsResponse\x12J\n" +
+	"\x0eclarifications\x18\x01 \x03(\v2\".leadexchange.v1.LeadClarificationR\x0eclarifications\"\xd6\x01\n" +
 	"\fMatchWeights\x12\x14\n" +
 	"\x05price\x18\x01 \x01(\x01R\x05price\x12\x1a\n" +
 	"\bdistrict\x18\x02 \x01(\x01R\bdistrict\x12\x14\n" +
 	"\x05rooms\x18\x03 \x01(\x01R\x05rooms\x12\x12\n" +
 	"\x04area\x18\x04 \x01(\x01R\x04area\x12\x1a\n" +
-	"\bsemantic\x18\x05 \x01(\x01R\bsemantic\"\x8f\x03\n" +
+	"\bsemantic\x18\x05 \x01(\x01R\bsemantic\x12\x1a\n" +
+	"\bdistance\x18\x06 \x01(\x01R\bdistance\x12\x16\n" +
+	"\x06visual\x18\a \x01(\x01R\x06visual\x12\x1a\n" +
+	"\bfeatures\x18\b \x01(\x01R\bfeatures\"\x8f\x03\n" +
 	"\x11ExtractedCriteria\x12&\n" +
 	"\ftarget_price\x18\x01 \x01(\x03H\x00R\vtargetPrice\x88\x01\x01\x12,\n" +
 	"\x0ftarget_district\x18\x02 \x01(\tH\x01R\x0etargetDistrict\x88\x01\x01\x12&\n" +
@@ -1613,7 +2589,7 @@ const file_lead_proto_rawDesc = "" +
 	"\x0fLEAD_STATUS_NEW\x10\x01\x12\x19\n" +
 	"\x15LEAD_STATUS_PUBLISHED\x10\x02\x12\x19\n" +
 	"\x15LEAD_STATUS_PURCHASED\x10\x03\x12\x17\n" +
-	"\x13LEAD_STATUS_DELETED\x10\x042\xac\b\n" +
+	"\x13LEAD_STATUS_DELETED\x10\x042\xd7\v\n" +
 	"\vLeadService\x12e\n" +
 	"\n" +
 	"CreateLead\x12\".leadexchange.v1.CreateLeadRequest\x1a\x1d.leadexchange.v1.LeadResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/leads\x12f\n" +
@@ -1621,9 +2597,13 @@ const file_lead_proto_rawDesc = "" +
 	"\tListLeads\x12!.leadexchange.v1.ListLeadsRequest\x1a\".leadexchange.v1.ListLeadsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/leads\x12o\n" +
 	"\n" +
 	"UpdateLead\x12\".leadexchange.v1.UpdateLeadRequest\x1a\x1d.leadexchange.v1.LeadResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/leads/{lead_id}\x12\x80\x01\n" +
-	"\vReindexLead\x12#.leadexchange.v1.ReindexLeadRequest\x1a$.leadexchange.v1.ReindexLeadResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/leads/{lead_id}/reindex\x12\xad\x01\n" +
+	"\vReindexLead\x12#.leadexchange.v1.ReindexLeadRequest\x1a$.leadexchange.v1.ReindexLeadResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/leads/{lead_id}/reindex\x12\x99\x01\n" +
+	"\x16GetLeadEmbeddingStatus\x12..leadexchange.v1.GetLeadEmbeddingStatusRequest\x1a(.leadexchange.v1.EmbeddingStatusResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/leads/{lead_id}/embedding\x12q\n" +
+	"\n" +
+	"MatchLeads\x12\".leadexchange.v1.MatchLeadsRequest\x1a#.leadexchange.v1.MatchLeadsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/leads/match\x12\xad\x01\n" +
 	"\x19GetClarificationQuestions\x121.leadexchange.v1.GetClarificationQuestionsRequest\x1a2.leadexchange.v1.GetClarificationQuestionsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/leads/{lead_id}/clarification\x12\xb0\x01\n" +
-	"\x19ApplyClarificationAnswers\x121.leadexchange.v1.ApplyClarificationAnswersRequest\x1a2.leadexchange.v1.ApplyClarificationAnswersResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/leads/{lead_id}/clarification\x12\x8f\x01\n" +
+	"\x19ApplyClarificationAnswers\x121.leadexchange.v1.ApplyClarificationAnswersRequest\x1a2.leadexchange.v1.ApplyClarificationAnswersResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/leads/{lead_id}/clarification\x12\x99\x01\n" +
+	"\x12ListClarifications\x12*.leadexchange.v1.ListClarificationsRequest\x1a+.leadexchange.v1.ListClarificationsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/leads/{lead_id}/clarifications\x12\x8f\x01\n" +
 	"\x11AnalyzeLeadIntent\x12).leadexchange.v1.AnalyzeLeadIntentRequest\x1a*.leadexchange.v1.AnalyzeLeadIntentResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/leads/{lead_id}/analyzeB4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
 
 var (
@@ -1639,67 +2619,102 @@ func file_lead_proto_rawDescGZIP() []byte {
 }
 
 var file_lead_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_lead_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_lead_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_lead_proto_goTypes = []any{
 	(LeadStatus)(0),                           // 0: leadexchange.v1.LeadStatus
 	(*Lead)(nil),                              // 1: leadexchange.v1.Lead
-	(*CreateLeadRequest)(nil),                 // 2: leadexchange.v1.CreateLeadRequest
-	(*GetLeadRequest)(nil),                    // 3: leadexchange.v1.GetLeadRequest
-	(*ListLeadsRequest)(nil),                  // 4: leadexchange.v1.ListLeadsRequest
-	(*ReindexLeadRequest)(nil),                // 5: leadexchange.v1.ReindexLeadRequest
-	(*ReindexLeadResponse)(nil),               // 6: leadexchange.v1.ReindexLeadResponse
-	(*ListLeadsResponse)(nil),                 // 7: leadexchange.v1.ListLeadsResponse
-	(*UpdateLeadRequest)(nil),                 // 8: leadexchange.v1.UpdateLeadRequest
-	(*LeadResponse)(nil),                      // 9: leadexchange.v1.LeadResponse
-	(*GetClarificationQuestionsRequest)(nil),  // 10: leadexchange.v1.GetClarificationQuestionsRequest
-	(*ClarificationQuestion)(nil),             // 11: leadexchange.v1.ClarificationQuestion
-	(*GetClarificationQuestionsResponse)(nil), // 12: leadexchange.v1.GetClarificationQuestionsResponse
-	(*ClarificationAnswer)(nil),               // 13: leadexchange.v1.ClarificationAnswer
-	(*ApplyClarificationAnswersRequest)(nil),  // 14: leadexchange.v1.ApplyClarificationAnswersRequest
-	(*ApplyClarificationAnswersResponse)(nil), // 15: leadexchange.v1.ApplyClarificationAnswersResponse
-	(*MatchWeights)(nil),                      // 16: leadexchange.v1.MatchWeights
-	(*ExtractedCriteria)(nil),                 // 17: leadexchange.v1.ExtractedCriteria
-	(*AnalyzeLeadIntentRequest)(nil),          // 18: leadexchange.v1.AnalyzeLeadIntentRequest
-	(*AnalyzeLeadIntentResponse)(nil),         // 19: leadexchange.v1.AnalyzeLeadIntentResponse
-	(*ListLeadsRequest_Filter)(nil),           // 20: leadexchange.v1.ListLeadsRequest.Filter
-	(PropertyType)(0),                         // 21: leadexchange.v1.PropertyType
+	(*LeadRequirement)(nil),                   // 2: leadexchange.v1.LeadRequirement
+	(*Int64Range)(nil),                        // 3: leadexchange.v1.Int64Range
+	(*Int32Range)(nil),                        // 4: leadexchange.v1.Int32Range
+	(*DoubleRange)(nil),                       // 5: leadexchange.v1.DoubleRange
+	(*CreateLeadRequest)(nil),                 // 6: leadexchange.v1.CreateLeadRequest
+	(*GetLeadRequest)(nil),                    // 7: leadexchange.v1.GetLeadRequest
+	(*ListLeadsRequest)(nil),                  // 8: leadexchange.v1.ListLeadsRequest
+	(*ReindexLeadRequest)(nil),                // 9: leadexchange.v1.ReindexLeadRequest
+	(*ReindexLeadResponse)(nil),               // 10: leadexchange.v1.ReindexLeadResponse
+	(*GetLeadEmbeddingStatusRequest)(nil),     // 11: leadexchange.v1.GetLeadEmbeddingStatusRequest
+	(*ListLeadsResponse)(nil),                 // 12: leadexchange.v1.ListLeadsResponse
+	(*UpdateLeadRequest)(nil),                 // 13: leadexchange.v1.UpdateLeadRequest
+	(*LeadResponse)(nil),                      // 14: leadexchange.v1.LeadResponse
+	(*MatchLeadsRequest)(nil),                 // 15: leadexchange.v1.MatchLeadsRequest
+	(*MatchedLead)(nil),                       // 16: leadexchange.v1.MatchedLead
+	(*MatchLeadsResponse)(nil),                // 17: leadexchange.v1.MatchLeadsResponse
+	(*GetClarificationQuestionsRequest)(nil),  // 18: leadexchange.v1.GetClarificationQuestionsRequest
+	(*ClarificationQuestion)(nil),             // 19: leadexchange.v1.ClarificationQuestion
+	(*GetClarificationQuestionsResponse)(nil), // 20: leadexchange.v1.GetClarificationQuestionsResponse
+	(*ClarificationAnswer)(nil),               // 21: leadexchange.v1.ClarificationAnswer
+	(*ApplyClarificationAnswersRequest)(nil),  // 22: leadexchange.v1.ApplyClarificationAnswersRequest
+	(*ApplyClarificationAnswersResponse)(nil), // 23: leadexchange.v1.ApplyClarificationAnswersResponse
+	(*LeadClarification)(nil),                 // 24: leadexchange.v1.LeadClarification
+	(*ListClarificationsRequest)(nil),         // 25: leadexchange.v1.ListClarificationsRequest
+	(*ListClarificationsResponse)(nil),        // 26: leadexchange.v1.ListClarificationsResponse
+	(*MatchWeights)(nil),                      // 27: leadexchange.v1.MatchWeights
+	(*ExtractedCriteria)(nil),                 // 28: leadexchange.v1.ExtractedCriteria
+	(*AnalyzeLeadIntentRequest)(nil),          // 29: leadexchange.v1.AnalyzeLeadIntentRequest
+	(*AnalyzeLeadIntentResponse)(nil),         // 30: leadexchange.v1.AnalyzeLeadIntentResponse
+	(*ListLeadsRequest_Filter)(nil),           // 31: leadexchange.v1.ListLeadsRequest.Filter
+	(*MatchLeadsRequest_Filter)(nil),          // 32: leadexchange.v1.MatchLeadsRequest.Filter
+	(PropertyType)(0),                         // 33: leadexchange.v1.PropertyType
+	(*EmbeddingStatusResponse)(nil),           // 34: leadexchange.v1.EmbeddingStatusResponse
 }
 var file_lead_proto_depIdxs = []int32{
 	0,  // 0: leadexchange.v1.Lead.status:type_name -> leadexchange.v1.LeadStatus
-	21, // 1: leadexchange.v1.Lead.property_type:type_name -> leadexchange.v1.PropertyType
-	21, // 2: leadexchange.v1.CreateLeadRequest.property_type:type_name -> leadexchange.v1.PropertyType
-	20, // 3: leadexchange.v1.ListLeadsRequest.filter:type_name -> leadexchange.v1.ListLeadsRequest.Filter
-	1,  // 4: leadexchange.v1.ListLeadsResponse.leads:type_name -> leadexchange.v1.Lead
-	0,  // 5: leadexchange.v1.UpdateLeadRequest.status:type_name -> leadexchange.v1.LeadStatus
-	21, // 6: leadexchange.v1.UpdateLeadRequest.property_type:type_name -> leadexchange.v1.PropertyType
-	1,  // 7: leadexchange.v1.LeadResponse.lead:type_name -> leadexchange.v1.Lead
-	11, // 8: leadexchange.v1.GetClarificationQuestionsResponse.questions:type_name -> leadexchange.v1.ClarificationQuestion
-	13, // 9: leadexchange.v1.ApplyClarificationAnswersRequest.answers:type_name -> leadexchange.v1.ClarificationAnswer
-	16, // 10: leadexchange.v1.AnalyzeLeadIntentResponse.recommended_weights:type_name -> leadexchange.v1.MatchWeights
-	17, // 11: leadexchange.v1.AnalyzeLeadIntentResponse.extracted_criteria:type_name -> leadexchange.v1.ExtractedCriteria
-	0,  // 12: leadexchange.v1.ListLeadsRequest.Filter.status:type_name -> leadexchange.v1.LeadStatus
-	21, // 13: leadexchange.v1.ListLeadsRequest.Filter.property_type:type_name -> leadexchange.v1.PropertyType
-	2,  // 14: leadexchange.v1.LeadService.CreateLead:input_type -> leadexchange.v1.CreateLeadRequest
-	3,  // 15: leadexchange.v1.LeadService.GetLead:input_type -> leadexchange.v1.GetLeadRequest
-	4,  // 16: leadexchange.v1.LeadService.ListLeads:input_type -> leadexchange.v1.ListLeadsRequest
-	8,  // 17: leadexchange.v1.LeadService.UpdateLead:input_type -> leadexchange.v1.UpdateLeadRequest
-	5,  // 18: leadexchange.v1.LeadService.ReindexLead:input_type -> leadexchange.v1.ReindexLeadRequest
-	10, // 19: leadexchange.v1.LeadService.GetClarificationQuestions:input_type -> leadexchange.v1.GetClarificationQuestionsRequest
-	14, // 20: leadexchange.v1.LeadService.ApplyClarificationAnswers:input_type -> leadexchange.v1.ApplyClarificationAnswersRequest
-	18, // 21: leadexchange.v1.LeadService.AnalyzeLeadIntent:input_type -> leadexchange.v1.AnalyzeLeadIntentRequest
-	9,  // 22: leadexchange.v1.LeadService.CreateLead:output_type -> leadexchange.v1.LeadResponse
-	9,  // 23: leadexchange.v1.LeadService.GetLead:output_type -> leadexchange.v1.LeadResponse
-	7,  // 24: leadexchange.v1.LeadService.ListLeads:output_type -> leadexchange.v1.ListLeadsResponse
-	9,  // 25: leadexchange.v1.LeadService.UpdateLead:output_type -> leadexchange.v1.LeadResponse
-	6,  // 26: leadexchange.v1.LeadService.ReindexLead:output_type -> leadexchange.v1.ReindexLeadResponse
-	12, // 27: leadexchange.v1.LeadService.GetClarificationQuestions:output_type -> leadexchange.v1.GetClarificationQuestionsResponse
-	15, // 28: leadexchange.v1.LeadService.ApplyClarificationAnswers:output_type -> leadexchange.v1.ApplyClarificationAnswersResponse
-	19, // 29: leadexchange.v1.LeadService.AnalyzeLeadIntent:output_type -> leadexchange.v1.AnalyzeLeadIntentResponse
-	22, // [22:30] is the sub-list for method output_type
-	14, // [14:22] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	33, // 1: leadexchange.v1.Lead.property_type:type_name -> leadexchange.v1.PropertyType
+	2,  // 2: leadexchange.v1.Lead.requirement:type_name -> leadexchange.v1.LeadRequirement
+	3,  // 3: leadexchange.v1.LeadRequirement.price:type_name -> leadexchange.v1.Int64Range
+	4,  // 4: leadexchange.v1.LeadRequirement.rooms:type_name -> leadexchange.v1.Int32Range
+	5,  // 5: leadexchange.v1.LeadRequirement.area:type_name -> leadexchange.v1.DoubleRange
+	33, // 6: leadexchange.v1.CreateLeadRequest.property_type:type_name -> leadexchange.v1.PropertyType
+	2,  // 7: leadexchange.v1.CreateLeadRequest.requirement:type_name -> leadexchange.v1.LeadRequirement
+	31, // 8: leadexchange.v1.ListLeadsRequest.filter:type_name -> leadexchange.v1.ListLeadsRequest.Filter
+	1,  // 9: leadexchange.v1.ListLeadsResponse.leads:type_name -> leadexchange.v1.Lead
+	0,  // 10: leadexchange.v1.UpdateLeadRequest.status:type_name -> leadexchange.v1.LeadStatus
+	33, // 11: leadexchange.v1.UpdateLeadRequest.property_type:type_name -> leadexchange.v1.PropertyType
+	2,  // 12: leadexchange.v1.UpdateLeadRequest.requirement:type_name -> leadexchange.v1.LeadRequirement
+	1,  // 13: leadexchange.v1.LeadResponse.lead:type_name -> leadexchange.v1.Lead
+	32, // 14: leadexchange.v1.MatchLeadsRequest.filter:type_name -> leadexchange.v1.MatchLeadsRequest.Filter
+	1,  // 15: leadexchange.v1.MatchedLead.lead:type_name -> leadexchange.v1.Lead
+	16, // 16: leadexchange.v1.MatchLeadsResponse.matches:type_name -> leadexchange.v1.MatchedLead
+	19, // 17: leadexchange.v1.GetClarificationQuestionsResponse.questions:type_name -> leadexchange.v1.ClarificationQuestion
+	21, // 18: leadexchange.v1.ApplyClarificationAnswersRequest.answers:type_name -> leadexchange.v1.ClarificationAnswer
+	2,  // 19: leadexchange.v1.ApplyClarificationAnswersResponse.new_requirement:type_name -> leadexchange.v1.LeadRequirement
+	24, // 20: leadexchange.v1.ApplyClarificationAnswersResponse.clarification:type_name -> leadexchange.v1.LeadClarification
+	19, // 21: leadexchange.v1.LeadClarification.questions:type_name -> leadexchange.v1.ClarificationQuestion
+	21, // 22: leadexchange.v1.LeadClarification.answers:type_name -> leadexchange.v1.ClarificationAnswer
+	24, // 23: leadexchange.v1.ListClarificationsResponse.clarifications:type_name -> leadexchange.v1.LeadClarification
+	27, // 24: leadexchange.v1.AnalyzeLeadIntentResponse.recommended_weights:type_name -> leadexchange.v1.MatchWeights
+	28, // 25: leadexchange.v1.AnalyzeLeadIntentResponse.extracted_criteria:type_name -> leadexchange.v1.ExtractedCriteria
+	0,  // 26: leadexchange.v1.ListLeadsRequest.Filter.status:type_name -> leadexchange.v1.LeadStatus
+	33, // 27: leadexchange.v1.ListLeadsRequest.Filter.property_type:type_name -> leadexchange.v1.PropertyType
+	0,  // 28: leadexchange.v1.MatchLeadsRequest.Filter.status:type_name -> leadexchange.v1.LeadStatus
+	33, // 29: leadexchange.v1.MatchLeadsRequest.Filter.property_type:type_name -> leadexchange.v1.PropertyType
+	6,  // 30: leadexchange.v1.LeadService.CreateLead:input_type -> leadexchange.v1.CreateLeadRequest
+	7,  // 31: leadexchange.v1.LeadService.GetLead:input_type -> leadexchange.v1.GetLeadRequest
+	8,  // 32: leadexchange.v1.LeadService.ListLeads:input_type -> leadexchange.v1.ListLeadsRequest
+	13, // 33: leadexchange.v1.LeadService.UpdateLead:input_type -> leadexchange.v1.UpdateLeadRequest
+	9,  // 34: leadexchange.v1.LeadService.ReindexLead:input_type -> leadexchange.v1.ReindexLeadRequest
+	11, // 35: leadexchange.v1.LeadService.GetLeadEmbeddingStatus:input_type -> leadexchange.v1.GetLeadEmbeddingStatusRequest
+	15, // 36: leadexchange.v1.LeadService.MatchLeads:input_type -> leadexchange.v1.MatchLeadsRequest
+	18, // 37: leadexchange.v1.LeadService.GetClarificationQuestions:input_type -> leadexchange.v1.GetClarificationQuestionsRequest
+	22, // 38: leadexchange.v1.LeadService.ApplyClarificationAnswers:input_type -> leadexchange.v1.ApplyClarificationAnswersRequest
+	25, // 39: leadexchange.v1.LeadService.ListClarifications:input_type -> leadexchange.v1.ListClarificationsRequest
+	29, // 40: leadexchange.v1.LeadService.AnalyzeLeadIntent:input_type -> leadexchange.v1.AnalyzeLeadIntentRequest
+	14, // 41: leadexchange.v1.LeadService.CreateLead:output_type -> leadexchange.v1.LeadResponse
+	14, // 42: leadexchange.v1.LeadService.GetLead:output_type -> leadexchange.v1.LeadResponse
+	12, // 43: leadexchange.v1.LeadService.ListLeads:output_type -> leadexchange.v1.ListLeadsResponse
+	14, // 44: leadexchange.v1.LeadService.UpdateLead:output_type -> leadexchange.v1.LeadResponse
+	10, // 45: leadexchange.v1.LeadService.ReindexLead:output_type -> leadexchange.v1.ReindexLeadResponse
+	34, // 46: leadexchange.v1.LeadService.GetLeadEmbeddingStatus:output_type -> leadexchange.v1.EmbeddingStatusResponse
+	17, // 47: leadexchange.v1.LeadService.MatchLeads:output_type -> leadexchange.v1.MatchLeadsResponse
+	20, // 48: leadexchange.v1.LeadService.GetClarificationQuestions:output_type -> leadexchange.v1.GetClarificationQuestionsResponse
+	23, // 49: leadexchange.v1.LeadService.ApplyClarificationAnswers:output_type -> leadexchange.v1.ApplyClarificationAnswersResponse
+	26, // 50: leadexchange.v1.LeadService.ListClarifications:output_type -> leadexchange.v1.ListClarificationsResponse
+	30, // 51: leadexchange.v1.LeadService.AnalyzeLeadIntent:output_type -> leadexchange.v1.AnalyzeLeadIntentResponse
+	41, // [41:52] is the sub-list for method output_type
+	30, // [30:41] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_lead_proto_init() }
@@ -1708,19 +2723,27 @@ func file_lead_proto_init() {
 		return
 	}
 	file_property_proto_init()
+	file_embedding_proto_init()
 	file_lead_proto_msgTypes[0].OneofWrappers = []any{}
-	file_lead_proto_msgTypes[1].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[2].OneofWrappers = []any{}
 	file_lead_proto_msgTypes[3].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[4].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[5].OneofWrappers = []any{}
 	file_lead_proto_msgTypes[7].OneofWrappers = []any{}
-	file_lead_proto_msgTypes[16].OneofWrappers = []any{}
-	file_lead_proto_msgTypes[19].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[12].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[14].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[15].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[23].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[27].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[30].OneofWrappers = []any{}
+	file_lead_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lead_proto_rawDesc), len(file_lead_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   20,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -74,8 +74,6 @@ func (m *Lead) validate(all bool) error {
 
 	// no validation rules for Description
 
-	// no validation rules for Requirement
-
 	if utf8.RuneCountInString(m.GetContactName()) < 2 {
 		err := LeadValidationError{
 			field:  "ContactName",
@@ -146,6 +144,37 @@ func (m *Lead) validate(all bool) error {
 
 	// no validation rules for PropertyType
 
+	// no validation rules for ContactsMasked
+
+	if all {
+		switch v := interface{}(m.GetRequirement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeadValidationError{
+					field:  "Requirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeadValidationError{
+					field:  "Requirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequirement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeadValidationError{
+				field:  "Requirement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.City != nil {
 		// no validation rules for City
 	}
@@ -287,72 +316,124 @@ var _ interface {
 
 var _Lead_ContactPhone_Pattern = regexp.MustCompile("^\\+?[0-9\\s()-]{7,}$")
 
-// Validate checks the field values on CreateLeadRequest with the rules defined
+// Validate checks the field values on LeadRequirement with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *CreateLeadRequest) Validate() error {
+func (m *LeadRequirement) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateLeadRequest with the rules
+// ValidateAll checks the field values on LeadRequirement with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateLeadRequestMultiError, or nil if none found.
-func (m *CreateLeadRequest) ValidateAll() error {
+// LeadRequirementMultiError, or nil if none found.
+func (m *LeadRequirement) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateLeadRequest) validate(all bool) error {
+func (m *LeadRequirement) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetTitle()) < 3 {
-		err := CreateLeadRequestValidationError{
-			field:  "Title",
-			reason: "value length must be at least 3 runes",
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeadRequirementValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeadRequirementValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeadRequirementValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Description
-
-	// no validation rules for Requirement
-
-	if utf8.RuneCountInString(m.GetContactName()) < 2 {
-		err := CreateLeadRequestValidationError{
-			field:  "ContactName",
-			reason: "value length must be at least 2 runes",
+	if all {
+		switch v := interface{}(m.GetRooms()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeadRequirementValidationError{
+					field:  "Rooms",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeadRequirementValidationError{
+					field:  "Rooms",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetRooms()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeadRequirementValidationError{
+				field:  "Rooms",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if !_CreateLeadRequest_ContactPhone_Pattern.MatchString(m.GetContactPhone()) {
-		err := CreateLeadRequestValidationError{
-			field:  "ContactPhone",
-			reason: "value does not match regex pattern \"^\\\\+?[0-9\\\\s()-]{7,}$\"",
+	if all {
+		switch v := interface{}(m.GetArea()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeadRequirementValidationError{
+					field:  "Area",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeadRequirementValidationError{
+					field:  "Area",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetArea()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeadRequirementValidationError{
+				field:  "Area",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if m.GetContactEmail() != "" {
+	for idx, item := range m.GetDistricts() {
+		_, _ = idx, item
 
-		if err := m._validateEmail(m.GetContactEmail()); err != nil {
-			err = CreateLeadRequestValidationError{
-				field:  "ContactEmail",
-				reason: "value must be a valid email address",
-				cause:  err,
+		if utf8.RuneCountInString(item) < 1 {
+			err := LeadRequirementValidationError{
+				field:  fmt.Sprintf("Districts[%v]", idx),
+				reason: "value length must be at least 1 runes",
 			}
 			if !all {
 				return err
@@ -362,76 +443,68 @@ func (m *CreateLeadRequest) validate(all bool) error {
 
 	}
 
-	// no validation rules for PropertyType
+	for idx, item := range m.GetMustHave() {
+		_, _ = idx, item
 
-	if m.City != nil {
-		// no validation rules for City
-	}
+		if utf8.RuneCountInString(item) < 1 {
+			err := LeadRequirementValidationError{
+				field:  fmt.Sprintf("MustHave[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	if len(errors) > 0 {
-		return CreateLeadRequestMultiError(errors)
 	}
 
-	return nil
-}
-
-func (m *CreateLeadRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
+	for idx, item := range m.GetNiceToHave() {
+		_, _ = idx, item
 
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		if utf8.RuneCountInString(item) < 1 {
+			err := LeadRequirementValidationError{
+				field:  fmt.Sprintf("NiceToHave[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
 
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+	}
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+	for idx, item := range m.GetVisualPreferences() {
+		_, _ = idx, item
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+		if utf8.RuneCountInString(item) < 1 {
+			err := LeadRequirementValidationError{
+				field:  fmt.Sprintf("VisualPreferences[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	}
-
-	return nil
-}
-
-func (m *CreateLeadRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
 
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return LeadRequirementMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// CreateLeadRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateLeadRequest.ValidateAll() if the designated constraints
+// LeadRequirementMultiError is an error wrapping multiple validation errors
+// returned by LeadRequirement.ValidateAll() if the designated constraints
 // aren't met.
-type CreateLeadRequestMultiError []error
+type LeadRequirementMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateLeadRequestMultiError) Error() string {
+func (m LeadRequirementMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -440,11 +513,11 @@ func (m CreateLeadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateLeadRequestMultiError) AllErrors() []error { return m }
+func (m LeadRequirementMultiError) AllErrors() []error { return m }
 
-// CreateLeadRequestValidationError is the validation error returned by
-// CreateLeadRequest.Validate if the designated constraints aren't met.
-type CreateLeadRequestValidationError struct {
+// LeadRequirementValidationError is the validation error returned by
+// LeadRequirement.Validate if the designated constraints aren't met.
+type LeadRequirementValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -452,24 +525,22 @@ type CreateLeadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateLeadRequestValidationError) Field() string { return e.field }
+func (e LeadRequirementValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateLeadRequestValidationError) Reason() string { return e.reason }
+func (e LeadRequirementValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateLeadRequestValidationError) Cause() error { return e.cause }
+func (e LeadRequirementValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateLeadRequestValidationError) Key() bool { return e.key }
+func (e LeadRequirementValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateLeadRequestValidationError) ErrorName() string {
-	return "CreateLeadRequestValidationError"
-}
+func (e LeadRequirementValidationError) ErrorName() string { return "LeadRequirementValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateLeadRequestValidationError) Error() string {
+func (e LeadRequirementValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -481,14 +552,14 @@ func (e CreateLeadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateLeadRequest.%s: %s%s",
+		"invalid %sLeadRequirement.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateLeadRequestValidationError{}
+var _ error = LeadRequirementValidationError{}
 
 var _ interface {
 	Field() string
@@ -496,66 +567,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateLeadRequestValidationError{}
-
-var _CreateLeadRequest_ContactPhone_Pattern = regexp.MustCompile("^\\+?[0-9\\s()-]{7,}$")
+} = LeadRequirementValidationError{}
 
-// Validate checks the field values on GetLeadRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Int64Range with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GetLeadRequest) Validate() error {
+func (m *Int64Range) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetLeadRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetLeadRequestMultiError,
-// or nil if none found.
-func (m *GetLeadRequest) ValidateAll() error {
+// ValidateAll checks the field values on Int64Range with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Int64RangeMultiError, or
+// nil if none found.
+func (m *Int64Range) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetLeadRequest) validate(all bool) error {
+func (m *Int64Range) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetLeadId()); err != nil {
-		err = GetLeadRequestValidationError{
-			field:  "LeadId",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
+	if m.Min != nil {
+
+		if m.GetMin() < 0 {
+			err := Int64RangeValidationError{
+				field:  "Min",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
-	}
 
-	if len(errors) > 0 {
-		return GetLeadRequestMultiError(errors)
 	}
 
-	return nil
-}
+	if m.Max != nil {
 
-func (m *GetLeadRequest) _validateUuid(uuid string) error {
-	if matched := _lead_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+		if m.GetMax() < 0 {
+			err := Int64RangeValidationError{
+				field:  "Max",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return Int64RangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetLeadRequestMultiError is an error wrapping multiple validation errors
-// returned by GetLeadRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetLeadRequestMultiError []error
+// Int64RangeMultiError is an error wrapping multiple validation errors
+// returned by Int64Range.ValidateAll() if the designated constraints aren't met.
+type Int64RangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetLeadRequestMultiError) Error() string {
+func (m Int64RangeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -564,11 +642,11 @@ func (m GetLeadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetLeadRequestMultiError) AllErrors() []error { return m }
+func (m Int64RangeMultiError) AllErrors() []error { return m }
 
-// GetLeadRequestValidationError is the validation error returned by
-// GetLeadRequest.Validate if the designated constraints aren't met.
-type GetLeadRequestValidationError struct {
+// Int64RangeValidationError is the validation error returned by
+// Int64Range.Validate if the designated constraints aren't met.
+type Int64RangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -576,22 +654,22 @@ type GetLeadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetLeadRequestValidationError) Field() string { return e.field }
+func (e Int64RangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetLeadRequestValidationError) Reason() string { return e.reason }
+func (e Int64RangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetLeadRequestValidationError) Cause() error { return e.cause }
+func (e Int64RangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetLeadRequestValidationError) Key() bool { return e.key }
+func (e Int64RangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetLeadRequestValidationError) ErrorName() string { return "GetLeadRequestValidationError" }
+func (e Int64RangeValidationError) ErrorName() string { return "Int64RangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetLeadRequestValidationError) Error() string {
+func (e Int64RangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -603,14 +681,14 @@ func (e GetLeadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetLeadRequest.%s: %s%s",
+		"invalid %sInt64Range.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetLeadRequestValidationError{}
+var _ error = Int64RangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -618,89 +696,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetLeadRequestValidationError{}
+} = Int64RangeValidationError{}
 
-// Validate checks the field values on ListLeadsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListLeadsRequest) Validate() error {
+// Validate checks the field values on Int32Range with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Int32Range) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListLeadsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListLeadsRequestMultiError, or nil if none found.
-func (m *ListLeadsRequest) ValidateAll() error {
+// ValidateAll checks the field values on Int32Range with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Int32RangeMultiError, or
+// nil if none found.
+func (m *Int32Range) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListLeadsRequest) validate(all bool) error {
+func (m *Int32Range) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListLeadsRequestValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListLeadsRequestValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if m.Min != nil {
+
+		if m.GetMin() < 0 {
+			err := Int32RangeValidationError{
+				field:  "Min",
+				reason: "value must be greater than or equal to 0",
 			}
-		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListLeadsRequestValidationError{
-				field:  "Filter",
-				reason: "embedded message failed validation",
-				cause:  err,
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	}
 
-	if m.PageSize != nil {
-		// no validation rules for PageSize
 	}
 
-	if m.PageToken != nil {
-		// no validation rules for PageToken
-	}
+	if m.Max != nil {
 
-	if m.OrderBy != nil {
-		// no validation rules for OrderBy
-	}
+		if m.GetMax() < 0 {
+			err := Int32RangeValidationError{
+				field:  "Max",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	if m.OrderDirection != nil {
-		// no validation rules for OrderDirection
 	}
 
 	if len(errors) > 0 {
-		return ListLeadsRequestMultiError(errors)
+		return Int32RangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListLeadsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListLeadsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListLeadsRequestMultiError []error
+// Int32RangeMultiError is an error wrapping multiple validation errors
+// returned by Int32Range.ValidateAll() if the designated constraints aren't met.
+type Int32RangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListLeadsRequestMultiError) Error() string {
+func (m Int32RangeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -709,11 +771,11 @@ func (m ListLeadsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListLeadsRequestMultiError) AllErrors() []error { return m }
+func (m Int32RangeMultiError) AllErrors() []error { return m }
 
-// ListLeadsRequestValidationError is the validation error returned by
-// ListLeadsRequest.Validate if the designated constraints aren't met.
-type ListLeadsRequestValidationError struct {
+// Int32RangeValidationError is the validation error returned by
+// Int32Range.Validate if the designated constraints aren't met.
+type Int32RangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -721,22 +783,22 @@ type ListLeadsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListLeadsRequestValidationError) Field() string { return e.field }
+func (e Int32RangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListLeadsRequestValidationError) Reason() string { return e.reason }
+func (e Int32RangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListLeadsRequestValidationError) Cause() error { return e.cause }
+func (e Int32RangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListLeadsRequestValidationError) Key() bool { return e.key }
+func (e Int32RangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListLeadsRequestValidationError) ErrorName() string { return "ListLeadsRequestValidationError" }
+func (e Int32RangeValidationError) ErrorName() string { return "Int32RangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListLeadsRequestValidationError) Error() string {
+func (e Int32RangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -748,14 +810,14 @@ func (e ListLeadsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListLeadsRequest.%s: %s%s",
+		"invalid %sInt32Range.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListLeadsRequestValidationError{}
+var _ error = Int32RangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -763,64 +825,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListLeadsRequestValidationError{}
+} = Int32RangeValidationError{}
 
-// Validate checks the field values on ReindexLeadRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReindexLeadRequest) Validate() error {
+// Validate checks the field values on DoubleRange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DoubleRange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReindexLeadRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ReindexLeadRequestMultiError, or nil if none found.
-func (m *ReindexLeadRequest) ValidateAll() error {
+// ValidateAll checks the field values on DoubleRange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DoubleRangeMultiError, or
+// nil if none found.
+func (m *DoubleRange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReindexLeadRequest) validate(all bool) error {
+func (m *DoubleRange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetLeadId()); err != nil {
-		err = ReindexLeadRequestValidationError{
-			field:  "LeadId",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
+	if m.Min != nil {
+
+		if m.GetMin() < 0 {
+			err := DoubleRangeValidationError{
+				field:  "Min",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
-	}
 
-	if len(errors) > 0 {
-		return ReindexLeadRequestMultiError(errors)
 	}
 
-	return nil
-}
+	if m.Max != nil {
 
-func (m *ReindexLeadRequest) _validateUuid(uuid string) error {
-	if matched := _lead_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+		if m.GetMax() < 0 {
+			err := DoubleRangeValidationError{
+				field:  "Max",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DoubleRangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReindexLeadRequestMultiError is an error wrapping multiple validation errors
-// returned by ReindexLeadRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ReindexLeadRequestMultiError []error
+// DoubleRangeMultiError is an error wrapping multiple validation errors
+// returned by DoubleRange.ValidateAll() if the designated constraints aren't met.
+type DoubleRangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReindexLeadRequestMultiError) Error() string {
+func (m DoubleRangeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -829,11 +900,11 @@ func (m ReindexLeadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReindexLeadRequestMultiError) AllErrors() []error { return m }
+func (m DoubleRangeMultiError) AllErrors() []error { return m }
 
-// ReindexLeadRequestValidationError is the validation error returned by
-// ReindexLeadRequest.Validate if the designated constraints aren't met.
-type ReindexLeadRequestValidationError struct {
+// DoubleRangeValidationError is the validation error returned by
+// DoubleRange.Validate if the designated constraints aren't met.
+type DoubleRangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -841,24 +912,22 @@ type ReindexLeadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReindexLeadRequestValidationError) Field() string { return e.field }
+func (e DoubleRangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReindexLeadRequestValidationError) Reason() string { return e.reason }
+func (e DoubleRangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReindexLeadRequestValidationError) Cause() error { return e.cause }
+func (e DoubleRangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReindexLeadRequestValidationError) Key() bool { return e.key }
+func (e DoubleRangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReindexLeadRequestValidationError) ErrorName() string {
-	return "ReindexLeadRequestValidationError"
-}
+func (e DoubleRangeValidationError) ErrorName() string { return "DoubleRangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReindexLeadRequestValidationError) Error() string {
+func (e DoubleRangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -870,14 +939,14 @@ func (e ReindexLeadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReindexLeadRequest.%s: %s%s",
+		"invalid %sDoubleRange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReindexLeadRequestValidationError{}
+var _ error = DoubleRangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -885,184 +954,180 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReindexLeadRequestValidationError{}
+} = DoubleRangeValidationError{}
 
-// Validate checks the field values on ReindexLeadResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReindexLeadResponse) Validate() error {
+// Validate checks the field values on CreateLeadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateLeadRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReindexLeadResponse with the rules
+// ValidateAll checks the field values on CreateLeadRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReindexLeadResponseMultiError, or nil if none found.
-func (m *ReindexLeadResponse) ValidateAll() error {
+// CreateLeadRequestMultiError, or nil if none found.
+func (m *CreateLeadRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReindexLeadResponse) validate(all bool) error {
+func (m *CreateLeadRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
-
-	// no validation rules for Message
-
-	if len(errors) > 0 {
-		return ReindexLeadResponseMultiError(errors)
+	if utf8.RuneCountInString(m.GetTitle()) < 3 {
+		err := CreateLeadRequestValidationError{
+			field:  "Title",
+			reason: "value length must be at least 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
-
-// ReindexLeadResponseMultiError is an error wrapping multiple validation
-// errors returned by ReindexLeadResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ReindexLeadResponseMultiError []error
+	// no validation rules for Description
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m ReindexLeadResponseMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if utf8.RuneCountInString(m.GetContactName()) < 2 {
+		err := CreateLeadRequestValidationError{
+			field:  "ContactName",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m ReindexLeadResponseMultiError) AllErrors() []error { return m }
-
-// ReindexLeadResponseValidationError is the validation error returned by
-// ReindexLeadResponse.Validate if the designated constraints aren't met.
-type ReindexLeadResponseValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e ReindexLeadResponseValidationError) Field() string { return e.field }
 
-// Reason function returns reason value.
-func (e ReindexLeadResponseValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e ReindexLeadResponseValidationError) Cause() error { return e.cause }
+	if !_CreateLeadRequest_ContactPhone_Pattern.MatchString(m.GetContactPhone()) {
+		err := CreateLeadRequestValidationError{
+			field:  "ContactPhone",
+			reason: "value does not match regex pattern \"^\\\\+?[0-9\\\\s()-]{7,}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-// Key function returns key value.
-func (e ReindexLeadResponseValidationError) Key() bool { return e.key }
+	if m.GetContactEmail() != "" {
 
-// ErrorName returns error name.
-func (e ReindexLeadResponseValidationError) ErrorName() string {
-	return "ReindexLeadResponseValidationError"
-}
+		if err := m._validateEmail(m.GetContactEmail()); err != nil {
+			err = CreateLeadRequestValidationError{
+				field:  "ContactEmail",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Error satisfies the builtin error interface
-func (e ReindexLeadResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	key := ""
-	if e.key {
-		key = "key for "
-	}
+	// no validation rules for PropertyType
 
-	return fmt.Sprintf(
-		"invalid %sReindexLeadResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
+	if all {
+		switch v := interface{}(m.GetRequirement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateLeadRequestValidationError{
+					field:  "Requirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateLeadRequestValidationError{
+					field:  "Requirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequirement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateLeadRequestValidationError{
+				field:  "Requirement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-var _ error = ReindexLeadResponseValidationError{}
+	if m.City != nil {
+		// no validation rules for City
+	}
 
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ReindexLeadResponseValidationError{}
+	if len(errors) > 0 {
+		return CreateLeadRequestMultiError(errors)
+	}
 
-// Validate checks the field values on ListLeadsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListLeadsResponse) Validate() error {
-	return m.validate(false)
+	return nil
 }
 
-// ValidateAll checks the field values on ListLeadsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListLeadsResponseMultiError, or nil if none found.
-func (m *ListLeadsResponse) ValidateAll() error {
-	return m.validate(true)
-}
+func (m *CreateLeadRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
-func (m *ListLeadsResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
 	}
 
-	var errors []error
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
 
-	for idx, item := range m.GetLeads() {
-		_, _ = idx, item
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListLeadsResponseValidationError{
-						field:  fmt.Sprintf("Leads[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListLeadsResponseValidationError{
-						field:  fmt.Sprintf("Leads[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListLeadsResponseValidationError{
-					field:  fmt.Sprintf("Leads[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
 			}
 		}
+	}
+
+	return nil
+}
+
+func (m *CreateLeadRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
 
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
 	}
 
-	if len(errors) > 0 {
-		return ListLeadsResponseMultiError(errors)
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
 	}
 
-	return nil
+	return m._validateHostname(parts[1])
 }
 
-// ListLeadsResponseMultiError is an error wrapping multiple validation errors
-// returned by ListLeadsResponse.ValidateAll() if the designated constraints
+// CreateLeadRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateLeadRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ListLeadsResponseMultiError []error
+type CreateLeadRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListLeadsResponseMultiError) Error() string {
+func (m CreateLeadRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1071,11 +1136,11 @@ func (m ListLeadsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListLeadsResponseMultiError) AllErrors() []error { return m }
+func (m CreateLeadRequestMultiError) AllErrors() []error { return m }
 
-// ListLeadsResponseValidationError is the validation error returned by
-// ListLeadsResponse.Validate if the designated constraints aren't met.
-type ListLeadsResponseValidationError struct {
+// CreateLeadRequestValidationError is the validation error returned by
+// CreateLeadRequest.Validate if the designated constraints aren't met.
+type CreateLeadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1083,24 +1148,24 @@ type ListLeadsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListLeadsResponseValidationError) Field() string { return e.field }
+func (e CreateLeadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListLeadsResponseValidationError) Reason() string { return e.reason }
+func (e CreateLeadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListLeadsResponseValidationError) Cause() error { return e.cause }
+func (e CreateLeadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListLeadsResponseValidationError) Key() bool { return e.key }
+func (e CreateLeadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListLeadsResponseValidationError) ErrorName() string {
-	return "ListLeadsResponseValidationError"
+func (e CreateLeadRequestValidationError) ErrorName() string {
+	return "CreateLeadRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListLeadsResponseValidationError) Error() string {
+func (e CreateLeadRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1112,14 +1177,14 @@ func (e ListLeadsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListLeadsResponse.%s: %s%s",
+		"invalid %sCreateLeadRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListLeadsResponseValidationError{}
+var _ error = CreateLeadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1127,24 +1192,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListLeadsResponseValidationError{}
+} = CreateLeadRequestValidationError{}
 
-// Validate checks the field values on UpdateLeadRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UpdateLeadRequest) Validate() error {
+var _CreateLeadRequest_ContactPhone_Pattern = regexp.MustCompile("^\\+?[0-9\\s()-]{7,}$")
+
+// Validate checks the field values on GetLeadRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetLeadRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateLeadRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateLeadRequestMultiError, or nil if none found.
-func (m *UpdateLeadRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetLeadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetLeadRequestMultiError,
+// or nil if none found.
+func (m *GetLeadRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateLeadRequest) validate(all bool) error {
+func (m *GetLeadRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1152,7 +1219,7 @@ func (m *UpdateLeadRequest) validate(all bool) error {
 	var errors []error
 
 	if err := m._validateUuid(m.GetLeadId()); err != nil {
-		err = UpdateLeadRequestValidationError{
+		err = GetLeadRequestValidationError{
 			field:  "LeadId",
 			reason: "value must be a valid UUID",
 			cause:  err,
@@ -1163,42 +1230,14 @@ func (m *UpdateLeadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.Title != nil {
-		// no validation rules for Title
-	}
-
-	if m.Description != nil {
-		// no validation rules for Description
-	}
-
-	if m.Requirement != nil {
-		// no validation rules for Requirement
-	}
-
-	if m.Status != nil {
-		// no validation rules for Status
-	}
-
-	if m.OwnerUserId != nil {
-		// no validation rules for OwnerUserId
-	}
-
-	if m.City != nil {
-		// no validation rules for City
-	}
-
-	if m.PropertyType != nil {
-		// no validation rules for PropertyType
-	}
-
 	if len(errors) > 0 {
-		return UpdateLeadRequestMultiError(errors)
+		return GetLeadRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *UpdateLeadRequest) _validateUuid(uuid string) error {
+func (m *GetLeadRequest) _validateUuid(uuid string) error {
 	if matched := _lead_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -1206,13 +1245,13 @@ func (m *UpdateLeadRequest) _validateUuid(uuid string) error {
 	return nil
 }
 
-// UpdateLeadRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateLeadRequest.ValidateAll() if the designated constraints
+// GetLeadRequestMultiError is an error wrapping multiple validation errors
+// returned by GetLeadRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UpdateLeadRequestMultiError []error
+type GetLeadRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateLeadRequestMultiError) Error() string {
+func (m GetLeadRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1221,11 +1260,11 @@ func (m UpdateLeadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateLeadRequestMultiError) AllErrors() []error { return m }
+func (m GetLeadRequestMultiError) AllErrors() []error { return m }
 
-// UpdateLeadRequestValidationError is the validation error returned by
-// UpdateLeadRequest.Validate if the designated constraints aren't met.
-type UpdateLeadRequestValidationError struct {
+// GetLeadRequestValidationError is the validation error returned by
+// GetLeadRequest.Validate if the designated constraints aren't met.
+type GetLeadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1233,24 +1272,22 @@ type UpdateLeadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateLeadRequestValidationError) Field() string { return e.field }
+func (e GetLeadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateLeadRequestValidationError) Reason() string { return e.reason }
+func (e GetLeadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateLeadRequestValidationError) Cause() error { return e.cause }
+func (e GetLeadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateLeadRequestValidationError) Key() bool { return e.key }
+func (e GetLeadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateLeadRequestValidationError) ErrorName() string {
-	return "UpdateLeadRequestValidationError"
-}
+func (e GetLeadRequestValidationError) ErrorName() string { return "GetLeadRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateLeadRequestValidationError) Error() string {
+func (e GetLeadRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1262,14 +1299,14 @@ func (e UpdateLeadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateLeadRequest.%s: %s%s",
+		"invalid %sGetLeadRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateLeadRequestValidationError{}
+var _ error = GetLeadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1277,24 +1314,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateLeadRequestValidationError{}
+} = GetLeadRequestValidationError{}
 
-// Validate checks the field values on LeadResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *LeadResponse) Validate() error {
+// Validate checks the field values on ListLeadsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListLeadsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LeadResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LeadResponseMultiError, or
-// nil if none found.
-func (m *LeadResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListLeadsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLeadsRequestMultiError, or nil if none found.
+func (m *ListLeadsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LeadResponse) validate(all bool) error {
+func (m *ListLeadsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1302,47 +1339,64 @@ func (m *LeadResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetLead()).(type) {
+		switch v := interface{}(m.GetFilter()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LeadResponseValidationError{
-					field:  "Lead",
+				errors = append(errors, ListLeadsRequestValidationError{
+					field:  "Filter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, LeadResponseValidationError{
-					field:  "Lead",
+				errors = append(errors, ListLeadsRequestValidationError{
+					field:  "Filter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetLead()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return LeadResponseValidationError{
-				field:  "Lead",
+			return ListLeadsRequestValidationError{
+				field:  "Filter",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.PageToken != nil {
+		// no validation rules for PageToken
+	}
+
+	if m.OrderBy != nil {
+		// no validation rules for OrderBy
+	}
+
+	if m.OrderDirection != nil {
+		// no validation rules for OrderDirection
+	}
+
 	if len(errors) > 0 {
-		return LeadResponseMultiError(errors)
+		return ListLeadsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// LeadResponseMultiError is an error wrapping multiple validation errors
-// returned by LeadResponse.ValidateAll() if the designated constraints aren't met.
-type LeadResponseMultiError []error
+// ListLeadsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListLeadsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListLeadsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LeadResponseMultiError) Error() string {
+func (m ListLeadsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1351,11 +1405,11 @@ func (m LeadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LeadResponseMultiError) AllErrors() []error { return m }
+func (m ListLeadsRequestMultiError) AllErrors() []error { return m }
 
-// LeadResponseValidationError is the validation error returned by
-// LeadResponse.Validate if the designated constraints aren't met.
-type LeadResponseValidationError struct {
+// ListLeadsRequestValidationError is the validation error returned by
+// ListLeadsRequest.Validate if the designated constraints aren't met.
+type ListLeadsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1363,22 +1417,22 @@ type LeadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e LeadResponseValidationError) Field() string { return e.field }
+func (e ListLeadsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LeadResponseValidationError) Reason() string { return e.reason }
+func (e ListLeadsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LeadResponseValidationError) Cause() error { return e.cause }
+func (e ListLeadsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LeadResponseValidationError) Key() bool { return e.key }
+func (e ListLeadsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LeadResponseValidationError) ErrorName() string { return "LeadResponseValidationError" }
+func (e ListLeadsRequestValidationError) ErrorName() string { return "ListLeadsRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LeadResponseValidationError) Error() string {
+func (e ListLeadsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1390,14 +1444,14 @@ func (e LeadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLeadResponse.%s: %s%s",
+		"invalid %sListLeadsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LeadResponseValidationError{}
+var _ error = ListLeadsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1405,25 +1459,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LeadResponseValidationError{}
+} = ListLeadsRequestValidationError{}
 
-// Validate checks the field values on GetClarificationQuestionsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetClarificationQuestionsRequest) Validate() error {
+// Validate checks the field values on ReindexLeadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReindexLeadRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetClarificationQuestionsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetClarificationQuestionsRequestMultiError, or nil if none found.
-func (m *GetClarificationQuestionsRequest) ValidateAll() error {
+// ValidateAll checks the field values on ReindexLeadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReindexLeadRequestMultiError, or nil if none found.
+func (m *ReindexLeadRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetClarificationQuestionsRequest) validate(all bool) error {
+func (m *ReindexLeadRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1431,7 +1484,7 @@ func (m *GetClarificationQuestionsRequest) validate(all bool) error {
 	var errors []error
 
 	if err := m._validateUuid(m.GetLeadId()); err != nil {
-		err = GetClarificationQuestionsRequestValidationError{
+		err = ReindexLeadRequestValidationError{
 			field:  "LeadId",
 			reason: "value must be a valid UUID",
 			cause:  err,
@@ -1443,13 +1496,13 @@ func (m *GetClarificationQuestionsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetClarificationQuestionsRequestMultiError(errors)
+		return ReindexLeadRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *GetClarificationQuestionsRequest) _validateUuid(uuid string) error {
+func (m *ReindexLeadRequest) _validateUuid(uuid string) error {
 	if matched := _lead_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -1457,14 +1510,13 @@ func (m *GetClarificationQuestionsRequest) _validateUuid(uuid string) error {
 	return nil
 }
 
-// GetClarificationQuestionsRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// GetClarificationQuestionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetClarificationQuestionsRequestMultiError []error
+// ReindexLeadRequestMultiError is an error wrapping multiple validation errors
+// returned by ReindexLeadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReindexLeadRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetClarificationQuestionsRequestMultiError) Error() string {
+func (m ReindexLeadRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1473,12 +1525,11 @@ func (m GetClarificationQuestionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetClarificationQuestionsRequestMultiError) AllErrors() []error { return m }
+func (m ReindexLeadRequestMultiError) AllErrors() []error { return m }
 
-// GetClarificationQuestionsRequestValidationError is the validation error
-// returned by GetClarificationQuestionsRequest.Validate if the designated
-// constraints aren't met.
-type GetClarificationQuestionsRequestValidationError struct {
+// ReindexLeadRequestValidationError is the validation error returned by
+// ReindexLeadRequest.Validate if the designated constraints aren't met.
+type ReindexLeadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1486,24 +1537,24 @@ type GetClarificationQuestionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetClarificationQuestionsRequestValidationError) Field() string { return e.field }
+func (e ReindexLeadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetClarificationQuestionsRequestValidationError) Reason() string { return e.reason }
+func (e ReindexLeadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetClarificationQuestionsRequestValidationError) Cause() error { return e.cause }
+func (e ReindexLeadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetClarificationQuestionsRequestValidationError) Key() bool { return e.key }
+func (e ReindexLeadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetClarificationQuestionsRequestValidationError) ErrorName() string {
-	return "GetClarificationQuestionsRequestValidationError"
+func (e ReindexLeadRequestValidationError) ErrorName() string {
+	return "ReindexLeadRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetClarificationQuestionsRequestValidationError) Error() string {
+func (e ReindexLeadRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1515,14 +1566,14 @@ func (e GetClarificationQuestionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetClarificationQuestionsRequest.%s: %s%s",
+		"invalid %sReindexLeadRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetClarificationQuestionsRequestValidationError{}
+var _ error = ReindexLeadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1530,52 +1581,1711 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetClarificationQuestionsRequestValidationError{}
+} = ReindexLeadRequestValidationError{}
 
-// Validate checks the field values on ClarificationQuestion with the rules
+// Validate checks the field values on ReindexLeadResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ClarificationQuestion) Validate() error {
+func (m *ReindexLeadResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ClarificationQuestion with the rules
+// ValidateAll checks the field values on ReindexLeadResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ClarificationQuestionMultiError, or nil if none found.
-func (m *ClarificationQuestion) ValidateAll() error {
+// ReindexLeadResponseMultiError, or nil if none found.
+func (m *ReindexLeadResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ClarificationQuestion) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *ReindexLeadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ReindexLeadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReindexLeadResponseMultiError is an error wrapping multiple validation
+// errors returned by ReindexLeadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReindexLeadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReindexLeadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReindexLeadResponseMultiError) AllErrors() []error { return m }
+
+// ReindexLeadResponseValidationError is the validation error returned by
+// ReindexLeadResponse.Validate if the designated constraints aren't met.
+type ReindexLeadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReindexLeadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReindexLeadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReindexLeadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReindexLeadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReindexLeadResponseValidationError) ErrorName() string {
+	return "ReindexLeadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReindexLeadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReindexLeadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReindexLeadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReindexLeadResponseValidationError{}
+
+// Validate checks the field values on GetLeadEmbeddingStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLeadEmbeddingStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLeadEmbeddingStatusRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetLeadEmbeddingStatusRequestMultiError, or nil if none found.
+func (m *GetLeadEmbeddingStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLeadEmbeddingStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetLeadId()); err != nil {
+		err = GetLeadEmbeddingStatusRequestValidationError{
+			field:  "LeadId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetLeadEmbeddingStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetLeadEmbeddingStatusRequest) _validateUuid(uuid string) error {
+	if matched := _lead_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetLeadEmbeddingStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetLeadEmbeddingStatusRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetLeadEmbeddingStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLeadEmbeddingStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLeadEmbeddingStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetLeadEmbeddingStatusRequestValidationError is the validation error
+// returned by GetLeadEmbeddingStatusRequest.Validate if the designated
+// constraints aren't met.
+type GetLeadEmbeddingStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLeadEmbeddingStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLeadEmbeddingStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLeadEmbeddingStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLeadEmbeddingStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLeadEmbeddingStatusRequestValidationError) ErrorName() string {
+	return "GetLeadEmbeddingStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLeadEmbeddingStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLeadEmbeddingStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLeadEmbeddingStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLeadEmbeddingStatusRequestValidationError{}
+
+// Validate checks the field values on ListLeadsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListLeadsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLeadsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLeadsResponseMultiError, or nil if none found.
+func (m *ListLeadsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLeadsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLeads() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLeadsResponseValidationError{
+						field:  fmt.Sprintf("Leads[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLeadsResponseValidationError{
+						field:  fmt.Sprintf("Leads[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLeadsResponseValidationError{
+					field:  fmt.Sprintf("Leads[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for HasMore
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return ListLeadsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLeadsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListLeadsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListLeadsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLeadsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLeadsResponseMultiError) AllErrors() []error { return m }
+
+// ListLeadsResponseValidationError is the validation error returned by
+// ListLeadsResponse.Validate if the designated constraints aren't met.
+type ListLeadsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLeadsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLeadsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLeadsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLeadsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLeadsResponseValidationError) ErrorName() string {
+	return "ListLeadsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLeadsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLeadsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLeadsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLeadsResponseValidationError{}
+
+// Validate checks the field values on UpdateLeadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateLeadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateLeadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateLeadRequestMultiError, or nil if none found.
+func (m *UpdateLeadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateLeadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetLeadId()); err != nil {
+		err = UpdateLeadRequestValidationError{
+			field:  "LeadId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequirement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateLeadRequestValidationError{
+					field:  "Requirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateLeadRequestValidationError{
+					field:  "Requirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequirement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateLeadRequestValidationError{
+				field:  "Requirement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Title != nil {
+		// no validation rules for Title
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.OwnerUserId != nil {
+		// no validation rules for OwnerUserId
+	}
+
+	if m.City != nil {
+		// no validation rules for City
+	}
+
+	if m.PropertyType != nil {
+		// no validation rules for PropertyType
+	}
+
+	if len(errors) > 0 {
+		return UpdateLeadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateLeadRequest) _validateUuid(uuid string) error {
+	if matched := _lead_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateLeadRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateLeadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateLeadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateLeadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateLeadRequestMultiError) AllErrors() []error { return m }
+
+// UpdateLeadRequestValidationError is the validation error returned by
+// UpdateLeadRequest.Validate if the designated constraints aren't met.
+type UpdateLeadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateLeadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateLeadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateLeadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateLeadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateLeadRequestValidationError) ErrorName() string {
+	return "UpdateLeadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateLeadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateLeadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateLeadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateLeadRequestValidationError{}
+
+// Validate checks the field values on LeadResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LeadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeadResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LeadResponseMultiError, or
+// nil if none found.
+func (m *LeadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLead()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeadResponseValidationError{
+					field:  "Lead",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeadResponseValidationError{
+					field:  "Lead",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLead()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeadResponseValidationError{
+				field:  "Lead",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LeadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeadResponseMultiError is an error wrapping multiple validation errors
+// returned by LeadResponse.ValidateAll() if the designated constraints aren't met.
+type LeadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeadResponseMultiError) AllErrors() []error { return m }
+
+// LeadResponseValidationError is the validation error returned by
+// LeadResponse.Validate if the designated constraints aren't met.
+type LeadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeadResponseValidationError) ErrorName() string { return "LeadResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LeadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeadResponseValidationError{}
+
+// Validate checks the field values on MatchLeadsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MatchLeadsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MatchLeadsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MatchLeadsRequestMultiError, or nil if none found.
+func (m *MatchLeadsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MatchLeadsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPropertyId()); err != nil {
+		err = MatchLeadsRequestValidationError{
+			field:  "PropertyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MatchLeadsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MatchLeadsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MatchLeadsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Limit != nil {
+		// no validation rules for Limit
+	}
+
+	if len(errors) > 0 {
+		return MatchLeadsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *MatchLeadsRequest) _validateUuid(uuid string) error {
+	if matched := _lead_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MatchLeadsRequestMultiError is an error wrapping multiple validation errors
+// returned by MatchLeadsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MatchLeadsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MatchLeadsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MatchLeadsRequestMultiError) AllErrors() []error { return m }
+
+// MatchLeadsRequestValidationError is the validation error returned by
+// MatchLeadsRequest.Validate if the designated constraints aren't met.
+type MatchLeadsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchLeadsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchLeadsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchLeadsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchLeadsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchLeadsRequestValidationError) ErrorName() string {
+	return "MatchLeadsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MatchLeadsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchLeadsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchLeadsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchLeadsRequestValidationError{}
+
+// Validate checks the field values on MatchedLead with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MatchedLead) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MatchedLead with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MatchedLeadMultiError, or
+// nil if none found.
+func (m *MatchedLead) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MatchedLead) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLead()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MatchedLeadValidationError{
+					field:  "Lead",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MatchedLeadValidationError{
+					field:  "Lead",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLead()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MatchedLeadValidationError{
+				field:  "Lead",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Similarity
+
+	if m.TotalScore != nil {
+		// no validation rules for TotalScore
+	}
+
+	if m.PriceScore != nil {
+		// no validation rules for PriceScore
+	}
+
+	if m.DistrictScore != nil {
+		// no validation rules for DistrictScore
+	}
+
+	if m.RoomsScore != nil {
+		// no validation rules for RoomsScore
+	}
+
+	if m.AreaScore != nil {
+		// no validation rules for AreaScore
+	}
+
+	if m.SemanticScore != nil {
+		// no validation rules for SemanticScore
+	}
+
+	if m.MatchExplanation != nil {
+		// no validation rules for MatchExplanation
+	}
+
+	if len(errors) > 0 {
+		return MatchedLeadMultiError(errors)
+	}
+
+	return nil
+}
+
+// MatchedLeadMultiError is an error wrapping multiple validation errors
+// returned by MatchedLead.ValidateAll() if the designated constraints aren't met.
+type MatchedLeadMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MatchedLeadMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MatchedLeadMultiError) AllErrors() []error { return m }
+
+// MatchedLeadValidationError is the validation error returned by
+// MatchedLead.Validate if the designated constraints aren't met.
+type MatchedLeadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchedLeadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchedLeadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchedLeadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchedLeadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchedLeadValidationError) ErrorName() string { return "MatchedLeadValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MatchedLeadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchedLead.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchedLeadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchedLeadValidationError{}
+
+// Validate checks the field values on MatchLeadsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MatchLeadsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MatchLeadsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MatchLeadsResponseMultiError, or nil if none found.
+func (m *MatchLeadsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MatchLeadsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MatchLeadsResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MatchLeadsResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MatchLeadsResponseValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MatchLeadsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MatchLeadsResponseMultiError is an error wrapping multiple validation errors
+// returned by MatchLeadsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MatchLeadsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MatchLeadsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MatchLeadsResponseMultiError) AllErrors() []error { return m }
+
+// MatchLeadsResponseValidationError is the validation error returned by
+// MatchLeadsResponse.Validate if the designated constraints aren't met.
+type MatchLeadsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchLeadsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchLeadsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchLeadsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchLeadsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchLeadsResponseValidationError) ErrorName() string {
+	return "MatchLeadsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MatchLeadsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchLeadsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchLeadsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchLeadsResponseValidationError{}
+
+// Validate checks the field values on GetClarificationQuestionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetClarificationQuestionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClarificationQuestionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetClarificationQuestionsRequestMultiError, or nil if none found.
+func (m *GetClarificationQuestionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClarificationQuestionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetLeadId()); err != nil {
+		err = GetClarificationQuestionsRequestValidationError{
+			field:  "LeadId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetClarificationQuestionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetClarificationQuestionsRequest) _validateUuid(uuid string) error {
+	if matched := _lead_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetClarificationQuestionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetClarificationQuestionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetClarificationQuestionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClarificationQuestionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClarificationQuestionsRequestMultiError) AllErrors() []error { return m }
+
+// GetClarificationQuestionsRequestValidationError is the validation error
+// returned by GetClarificationQuestionsRequest.Validate if the designated
+// constraints aren't met.
+type GetClarificationQuestionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClarificationQuestionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClarificationQuestionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClarificationQuestionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClarificationQuestionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClarificationQuestionsRequestValidationError) ErrorName() string {
+	return "GetClarificationQuestionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClarificationQuestionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClarificationQuestionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClarificationQuestionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClarificationQuestionsRequestValidationError{}
+
+// Validate checks the field values on ClarificationQuestion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClarificationQuestion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClarificationQuestion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClarificationQuestionMultiError, or nil if none found.
+func (m *ClarificationQuestion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClarificationQuestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Question
+
+	// no validation rules for QuestionType
+
+	// no validation rules for Importance
+
+	if len(errors) > 0 {
+		return ClarificationQuestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClarificationQuestionMultiError is an error wrapping multiple validation
+// errors returned by ClarificationQuestion.ValidateAll() if the designated
+// constraints aren't met.
+type ClarificationQuestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClarificationQuestionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClarificationQuestionMultiError) AllErrors() []error { return m }
+
+// ClarificationQuestionValidationError is the validation error returned by
+// ClarificationQuestion.Validate if the designated constraints aren't met.
+type ClarificationQuestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClarificationQuestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClarificationQuestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClarificationQuestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClarificationQuestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClarificationQuestionValidationError) ErrorName() string {
+	return "ClarificationQuestionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClarificationQuestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClarificationQuestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClarificationQuestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClarificationQuestionValidationError{}
+
+// Validate checks the field values on GetClarificationQuestionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetClarificationQuestionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClarificationQuestionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetClarificationQuestionsResponseMultiError, or nil if none found.
+func (m *GetClarificationQuestionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClarificationQuestionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NeedsClarification
+
+	for idx, item := range m.GetQuestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetClarificationQuestionsResponseValidationError{
+						field:  fmt.Sprintf("Questions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetClarificationQuestionsResponseValidationError{
+						field:  fmt.Sprintf("Questions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetClarificationQuestionsResponseValidationError{
+					field:  fmt.Sprintf("Questions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Priority
+
+	// no validation rules for LeadQualityScore
+
+	// no validation rules for ClarificationId
+
+	if len(errors) > 0 {
+		return GetClarificationQuestionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClarificationQuestionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetClarificationQuestionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetClarificationQuestionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClarificationQuestionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClarificationQuestionsResponseMultiError) AllErrors() []error { return m }
+
+// GetClarificationQuestionsResponseValidationError is the validation error
+// returned by GetClarificationQuestionsResponse.Validate if the designated
+// constraints aren't met.
+type GetClarificationQuestionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClarificationQuestionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClarificationQuestionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClarificationQuestionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClarificationQuestionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClarificationQuestionsResponseValidationError) ErrorName() string {
+	return "GetClarificationQuestionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClarificationQuestionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClarificationQuestionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClarificationQuestionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClarificationQuestionsResponseValidationError{}
+
+// Validate checks the field values on ClarificationAnswer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClarificationAnswer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClarificationAnswer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClarificationAnswerMultiError, or nil if none found.
+func (m *ClarificationAnswer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClarificationAnswer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return ClarificationAnswerMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClarificationAnswerMultiError is an error wrapping multiple validation
+// errors returned by ClarificationAnswer.ValidateAll() if the designated
+// constraints aren't met.
+type ClarificationAnswerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClarificationAnswerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClarificationAnswerMultiError) AllErrors() []error { return m }
+
+// ClarificationAnswerValidationError is the validation error returned by
+// ClarificationAnswer.Validate if the designated constraints aren't met.
+type ClarificationAnswerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClarificationAnswerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClarificationAnswerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClarificationAnswerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClarificationAnswerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClarificationAnswerValidationError) ErrorName() string {
+	return "ClarificationAnswerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClarificationAnswerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClarificationAnswer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClarificationAnswerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClarificationAnswerValidationError{}
+
+// Validate checks the field values on ApplyClarificationAnswersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ApplyClarificationAnswersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplyClarificationAnswersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ApplyClarificationAnswersRequestMultiError, or nil if none found.
+func (m *ApplyClarificationAnswersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplyClarificationAnswersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetLeadId()); err != nil {
+		err = ApplyClarificationAnswersRequestValidationError{
+			field:  "LeadId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAnswers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApplyClarificationAnswersRequestValidationError{
+						field:  fmt.Sprintf("Answers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApplyClarificationAnswersRequestValidationError{
+						field:  fmt.Sprintf("Answers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApplyClarificationAnswersRequestValidationError{
+					field:  fmt.Sprintf("Answers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
-
-	// no validation rules for Field
-
-	// no validation rules for Question
-
-	// no validation rules for QuestionType
+	if len(errors) > 0 {
+		return ApplyClarificationAnswersRequestMultiError(errors)
+	}
 
-	// no validation rules for Importance
+	return nil
+}
 
-	if len(errors) > 0 {
-		return ClarificationQuestionMultiError(errors)
+func (m *ApplyClarificationAnswersRequest) _validateUuid(uuid string) error {
+	if matched := _lead_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
 	}
 
 	return nil
 }
 
-// ClarificationQuestionMultiError is an error wrapping multiple validation
-// errors returned by ClarificationQuestion.ValidateAll() if the designated
+// ApplyClarificationAnswersRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ApplyClarificationAnswersRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ClarificationQuestionMultiError []error
+type ApplyClarificationAnswersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ClarificationQuestionMultiError) Error() string {
+func (m ApplyClarificationAnswersRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1584,11 +3294,12 @@ func (m ClarificationQuestionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ClarificationQuestionMultiError) AllErrors() []error { return m }
+func (m ApplyClarificationAnswersRequestMultiError) AllErrors() []error { return m }
 
-// ClarificationQuestionValidationError is the validation error returned by
-// ClarificationQuestion.Validate if the designated constraints aren't met.
-type ClarificationQuestionValidationError struct {
+// ApplyClarificationAnswersRequestValidationError is the validation error
+// returned by ApplyClarificationAnswersRequest.Validate if the designated
+// constraints aren't met.
+type ApplyClarificationAnswersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1596,24 +3307,24 @@ type ClarificationQuestionValidationError struct {
 }
 
 // Field function returns field value.
-func (e ClarificationQuestionValidationError) Field() string { return e.field }
+func (e ApplyClarificationAnswersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ClarificationQuestionValidationError) Reason() string { return e.reason }
+func (e ApplyClarificationAnswersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ClarificationQuestionValidationError) Cause() error { return e.cause }
+func (e ApplyClarificationAnswersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ClarificationQuestionValidationError) Key() bool { return e.key }
+func (e ApplyClarificationAnswersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ClarificationQuestionValidationError) ErrorName() string {
-	return "ClarificationQuestionValidationError"
+func (e ApplyClarificationAnswersRequestValidationError) ErrorName() string {
+	return "ApplyClarificationAnswersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ClarificationQuestionValidationError) Error() string {
+func (e ApplyClarificationAnswersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1625,14 +3336,14 @@ func (e ClarificationQuestionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sClarificationQuestion.%s: %s%s",
+		"invalid %sApplyClarificationAnswersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ClarificationQuestionValidationError{}
+var _ error = ApplyClarificationAnswersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1640,86 +3351,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ClarificationQuestionValidationError{}
+} = ApplyClarificationAnswersRequestValidationError{}
 
-// Validate checks the field values on GetClarificationQuestionsResponse with
+// Validate checks the field values on ApplyClarificationAnswersResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
 // no violations.
-func (m *GetClarificationQuestionsResponse) Validate() error {
+func (m *ApplyClarificationAnswersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetClarificationQuestionsResponse
+// ValidateAll checks the field values on ApplyClarificationAnswersResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// GetClarificationQuestionsResponseMultiError, or nil if none found.
-func (m *GetClarificationQuestionsResponse) ValidateAll() error {
+// ApplyClarificationAnswersResponseMultiError, or nil if none found.
+func (m *ApplyClarificationAnswersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetClarificationQuestionsResponse) validate(all bool) error {
+func (m *ApplyClarificationAnswersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for NeedsClarification
+	// no validation rules for Success
 
-	for idx, item := range m.GetQuestions() {
-		_, _ = idx, item
+	// no validation rules for Message
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetClarificationQuestionsResponseValidationError{
-						field:  fmt.Sprintf("Questions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetClarificationQuestionsResponseValidationError{
-						field:  fmt.Sprintf("Questions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetNewRequirement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplyClarificationAnswersResponseValidationError{
+					field:  "NewRequirement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetClarificationQuestionsResponseValidationError{
-					field:  fmt.Sprintf("Questions[%v]", idx),
+				errors = append(errors, ApplyClarificationAnswersResponseValidationError{
+					field:  "NewRequirement",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNewRequirement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplyClarificationAnswersResponseValidationError{
+				field:  "NewRequirement",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Priority
-
-	// no validation rules for LeadQualityScore
+	if all {
+		switch v := interface{}(m.GetClarification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplyClarificationAnswersResponseValidationError{
+					field:  "Clarification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplyClarificationAnswersResponseValidationError{
+					field:  "Clarification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClarification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplyClarificationAnswersResponseValidationError{
+				field:  "Clarification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetClarificationQuestionsResponseMultiError(errors)
+		return ApplyClarificationAnswersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetClarificationQuestionsResponseMultiError is an error wrapping multiple
+// ApplyClarificationAnswersResponseMultiError is an error wrapping multiple
 // validation errors returned by
-// GetClarificationQuestionsResponse.ValidateAll() if the designated
+// ApplyClarificationAnswersResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetClarificationQuestionsResponseMultiError []error
+type ApplyClarificationAnswersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetClarificationQuestionsResponseMultiError) Error() string {
+func (m ApplyClarificationAnswersResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1728,12 +3461,12 @@ func (m GetClarificationQuestionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetClarificationQuestionsResponseMultiError) AllErrors() []error { return m }
+func (m ApplyClarificationAnswersResponseMultiError) AllErrors() []error { return m }
 
-// GetClarificationQuestionsResponseValidationError is the validation error
-// returned by GetClarificationQuestionsResponse.Validate if the designated
+// ApplyClarificationAnswersResponseValidationError is the validation error
+// returned by ApplyClarificationAnswersResponse.Validate if the designated
 // constraints aren't met.
-type GetClarificationQuestionsResponseValidationError struct {
+type ApplyClarificationAnswersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1741,24 +3474,24 @@ type GetClarificationQuestionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetClarificationQuestionsResponseValidationError) Field() string { return e.field }
+func (e ApplyClarificationAnswersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetClarificationQuestionsResponseValidationError) Reason() string { return e.reason }
+func (e ApplyClarificationAnswersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetClarificationQuestionsResponseValidationError) Cause() error { return e.cause }
+func (e ApplyClarificationAnswersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetClarificationQuestionsResponseValidationError) Key() bool { return e.key }
+func (e ApplyClarificationAnswersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetClarificationQuestionsResponseValidationError) ErrorName() string {
-	return "GetClarificationQuestionsResponseValidationError"
+func (e ApplyClarificationAnswersResponseValidationError) ErrorName() string {
+	return "ApplyClarificationAnswersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetClarificationQuestionsResponseValidationError) Error() string {
+func (e ApplyClarificationAnswersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1770,14 +3503,14 @@ func (e GetClarificationQuestionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetClarificationQuestionsResponse.%s: %s%s",
+		"invalid %sApplyClarificationAnswersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetClarificationQuestionsResponseValidationError{}
+var _ error = ApplyClarificationAnswersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1785,48 +3518,134 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetClarificationQuestionsResponseValidationError{}
+} = ApplyClarificationAnswersResponseValidationError{}
 
-// Validate checks the field values on ClarificationAnswer with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ClarificationAnswer) Validate() error {
+// Validate checks the field values on LeadClarification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LeadClarification) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ClarificationAnswer with the rules
+// ValidateAll checks the field values on LeadClarification with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ClarificationAnswerMultiError, or nil if none found.
-func (m *ClarificationAnswer) ValidateAll() error {
+// LeadClarificationMultiError, or nil if none found.
+func (m *LeadClarification) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ClarificationAnswer) validate(all bool) error {
+func (m *LeadClarification) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Field
+	// no validation rules for ClarificationId
+
+	// no validation rules for LeadId
+
+	for idx, item := range m.GetQuestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeadClarificationValidationError{
+						field:  fmt.Sprintf("Questions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeadClarificationValidationError{
+						field:  fmt.Sprintf("Questions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeadClarificationValidationError{
+					field:  fmt.Sprintf("Questions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAnswers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeadClarificationValidationError{
+						field:  fmt.Sprintf("Answers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeadClarificationValidationError{
+						field:  fmt.Sprintf("Answers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeadClarificationValidationError{
+					field:  fmt.Sprintf("Answers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Priority
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	if m.QualityScoreBefore != nil {
+		// no validation rules for QualityScoreBefore
+	}
+
+	if m.QualityScoreAfter != nil {
+		// no validation rules for QualityScoreAfter
+	}
 
-	// no validation rules for Value
+	if m.AnsweredAt != nil {
+		// no validation rules for AnsweredAt
+	}
 
 	if len(errors) > 0 {
-		return ClarificationAnswerMultiError(errors)
+		return LeadClarificationMultiError(errors)
 	}
 
 	return nil
 }
 
-// ClarificationAnswerMultiError is an error wrapping multiple validation
-// errors returned by ClarificationAnswer.ValidateAll() if the designated
-// constraints aren't met.
-type ClarificationAnswerMultiError []error
+// LeadClarificationMultiError is an error wrapping multiple validation errors
+// returned by LeadClarification.ValidateAll() if the designated constraints
+// aren't met.
+type LeadClarificationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ClarificationAnswerMultiError) Error() string {
+func (m LeadClarificationMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1835,11 +3654,11 @@ func (m ClarificationAnswerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ClarificationAnswerMultiError) AllErrors() []error { return m }
+func (m LeadClarificationMultiError) AllErrors() []error { return m }
 
-// ClarificationAnswerValidationError is the validation error returned by
-// ClarificationAnswer.Validate if the designated constraints aren't met.
-type ClarificationAnswerValidationError struct {
+// LeadClarificationValidationError is the validation error returned by
+// LeadClarification.Validate if the designated constraints aren't met.
+type LeadClarificationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1847,24 +3666,24 @@ type ClarificationAnswerValidationError struct {
 }
 
 // Field function returns field value.
-func (e ClarificationAnswerValidationError) Field() string { return e.field }
+func (e LeadClarificationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ClarificationAnswerValidationError) Reason() string { return e.reason }
+func (e LeadClarificationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ClarificationAnswerValidationError) Cause() error { return e.cause }
+func (e LeadClarificationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ClarificationAnswerValidationError) Key() bool { return e.key }
+func (e LeadClarificationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ClarificationAnswerValidationError) ErrorName() string {
-	return "ClarificationAnswerValidationError"
+func (e LeadClarificationValidationError) ErrorName() string {
+	return "LeadClarificationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ClarificationAnswerValidationError) Error() string {
+func (e LeadClarificationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1876,14 +3695,14 @@ func (e ClarificationAnswerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sClarificationAnswer.%s: %s%s",
+		"invalid %sLeadClarification.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ClarificationAnswerValidationError{}
+var _ error = LeadClarificationValidationError{}
 
 var _ interface {
 	Field() string
@@ -1891,25 +3710,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ClarificationAnswerValidationError{}
+} = LeadClarificationValidationError{}
 
-// Validate checks the field values on ApplyClarificationAnswersRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ApplyClarificationAnswersRequest) Validate() error {
+// Validate checks the field values on ListClarificationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListClarificationsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ApplyClarificationAnswersRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ApplyClarificationAnswersRequestMultiError, or nil if none found.
-func (m *ApplyClarificationAnswersRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListClarificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClarificationsRequestMultiError, or nil if none found.
+func (m *ListClarificationsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ApplyClarificationAnswersRequest) validate(all bool) error {
+func (m *ListClarificationsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1917,7 +3735,7 @@ func (m *ApplyClarificationAnswersRequest) validate(all bool) error {
 	var errors []error
 
 	if err := m._validateUuid(m.GetLeadId()); err != nil {
-		err = ApplyClarificationAnswersRequestValidationError{
+		err = ListClarificationsRequestValidationError{
 			field:  "LeadId",
 			reason: "value must be a valid UUID",
 			cause:  err,
@@ -1928,48 +3746,14 @@ func (m *ApplyClarificationAnswersRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetAnswers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ApplyClarificationAnswersRequestValidationError{
-						field:  fmt.Sprintf("Answers[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ApplyClarificationAnswersRequestValidationError{
-						field:  fmt.Sprintf("Answers[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ApplyClarificationAnswersRequestValidationError{
-					field:  fmt.Sprintf("Answers[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ApplyClarificationAnswersRequestMultiError(errors)
+		return ListClarificationsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *ApplyClarificationAnswersRequest) _validateUuid(uuid string) error {
+func (m *ListClarificationsRequest) _validateUuid(uuid string) error {
 	if matched := _lead_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -1977,14 +3761,13 @@ func (m *ApplyClarificationAnswersRequest) _validateUuid(uuid string) error {
 	return nil
 }
 
-// ApplyClarificationAnswersRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// ApplyClarificationAnswersRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ApplyClarificationAnswersRequestMultiError []error
+// ListClarificationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListClarificationsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListClarificationsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ApplyClarificationAnswersRequestMultiError) Error() string {
+func (m ListClarificationsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1993,12 +3776,11 @@ func (m ApplyClarificationAnswersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ApplyClarificationAnswersRequestMultiError) AllErrors() []error { return m }
+func (m ListClarificationsRequestMultiError) AllErrors() []error { return m }
 
-// ApplyClarificationAnswersRequestValidationError is the validation error
-// returned by ApplyClarificationAnswersRequest.Validate if the designated
-// constraints aren't met.
-type ApplyClarificationAnswersRequestValidationError struct {
+// ListClarificationsRequestValidationError is the validation error returned by
+// ListClarificationsRequest.Validate if the designated constraints aren't met.
+type ListClarificationsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2006,24 +3788,24 @@ type ApplyClarificationAnswersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApplyClarificationAnswersRequestValidationError) Field() string { return e.field }
+func (e ListClarificationsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApplyClarificationAnswersRequestValidationError) Reason() string { return e.reason }
+func (e ListClarificationsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApplyClarificationAnswersRequestValidationError) Cause() error { return e.cause }
+func (e ListClarificationsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApplyClarificationAnswersRequestValidationError) Key() bool { return e.key }
+func (e ListClarificationsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApplyClarificationAnswersRequestValidationError) ErrorName() string {
-	return "ApplyClarificationAnswersRequestValidationError"
+func (e ListClarificationsRequestValidationError) ErrorName() string {
+	return "ListClarificationsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ApplyClarificationAnswersRequestValidationError) Error() string {
+func (e ListClarificationsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2035,14 +3817,14 @@ func (e ApplyClarificationAnswersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApplyClarificationAnswersRequest.%s: %s%s",
+		"invalid %sListClarificationsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApplyClarificationAnswersRequestValidationError{}
+var _ error = ListClarificationsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2050,52 +3832,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApplyClarificationAnswersRequestValidationError{}
+} = ListClarificationsRequestValidationError{}
 
-// Validate checks the field values on ApplyClarificationAnswersResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ApplyClarificationAnswersResponse) Validate() error {
+// Validate checks the field values on ListClarificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListClarificationsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ApplyClarificationAnswersResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ApplyClarificationAnswersResponseMultiError, or nil if none found.
-func (m *ApplyClarificationAnswersResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListClarificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClarificationsResponseMultiError, or nil if none found.
+func (m *ListClarificationsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ApplyClarificationAnswersResponse) validate(all bool) error {
+func (m *ListClarificationsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	for idx, item := range m.GetClarifications() {
+		_, _ = idx, item
 
-	// no validation rules for NewRequirement
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListClarificationsResponseValidationError{
+						field:  fmt.Sprintf("Clarifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListClarificationsResponseValidationError{
+						field:  fmt.Sprintf("Clarifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListClarificationsResponseValidationError{
+					field:  fmt.Sprintf("Clarifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Message
+	}
 
 	if len(errors) > 0 {
-		return ApplyClarificationAnswersResponseMultiError(errors)
+		return ListClarificationsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ApplyClarificationAnswersResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// ApplyClarificationAnswersResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ApplyClarificationAnswersResponseMultiError []error
+// ListClarificationsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListClarificationsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListClarificationsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ApplyClarificationAnswersResponseMultiError) Error() string {
+func (m ListClarificationsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2104,12 +3912,11 @@ func (m ApplyClarificationAnswersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ApplyClarificationAnswersResponseMultiError) AllErrors() []error { return m }
+func (m ListClarificationsResponseMultiError) AllErrors() []error { return m }
 
-// ApplyClarificationAnswersResponseValidationError is the validation error
-// returned by ApplyClarificationAnswersResponse.Validate if the designated
-// constraints aren't met.
-type ApplyClarificationAnswersResponseValidationError struct {
+// ListClarificationsResponseValidationError is the validation error returned
+// by ListClarificationsResponse.Validate if the designated constraints aren't met.
+type ListClarificationsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2117,24 +3924,24 @@ type ApplyClarificationAnswersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApplyClarificationAnswersResponseValidationError) Field() string { return e.field }
+func (e ListClarificationsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApplyClarificationAnswersResponseValidationError) Reason() string { return e.reason }
+func (e ListClarificationsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApplyClarificationAnswersResponseValidationError) Cause() error { return e.cause }
+func (e ListClarificationsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApplyClarificationAnswersResponseValidationError) Key() bool { return e.key }
+func (e ListClarificationsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApplyClarificationAnswersResponseValidationError) ErrorName() string {
-	return "ApplyClarificationAnswersResponseValidationError"
+func (e ListClarificationsResponseValidationError) ErrorName() string {
+	return "ListClarificationsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ApplyClarificationAnswersResponseValidationError) Error() string {
+func (e ListClarificationsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2146,14 +3953,14 @@ func (e ApplyClarificationAnswersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApplyClarificationAnswersResponse.%s: %s%s",
+		"invalid %sListClarificationsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApplyClarificationAnswersResponseValidationError{}
+var _ error = ListClarificationsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2161,7 +3968,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApplyClarificationAnswersResponseValidationError{}
+} = ListClarificationsResponseValidationError{}
 
 // Validate checks the field values on MatchWeights with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -2195,6 +4002,12 @@ func (m *MatchWeights) validate(all bool) error {
 
 	// no validation rules for Semantic
 
+	// no validation rules for Distance
+
+	// no validation rules for Visual
+
+	// no validation rules for Features
+
 	if len(errors) > 0 {
 		return MatchWeightsMultiError(errors)
 	}
@@ -2722,6 +4535,10 @@ func (m *ListLeadsRequest_Filter) validate(all bool) error {
 		// no validation rules for PropertyType
 	}
 
+	if m.Team != nil {
+		// no validation rules for Team
+	}
+
 	if len(errors) > 0 {
 		return ListLeadsRequest_FilterMultiError(errors)
 	}
@@ -2801,3 +4618,117 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListLeadsRequest_FilterValidationError{}
+
+// Validate checks the field values on MatchLeadsRequest_Filter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MatchLeadsRequest_Filter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MatchLeadsRequest_Filter with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MatchLeadsRequest_FilterMultiError, or nil if none found.
+func (m *MatchLeadsRequest_Filter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MatchLeadsRequest_Filter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.PropertyType != nil {
+		// no validation rules for PropertyType
+	}
+
+	if m.City != nil {
+		// no validation rules for City
+	}
+
+	if len(errors) > 0 {
+		return MatchLeadsRequest_FilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// MatchLeadsRequest_FilterMultiError is an error wrapping multiple validation
+// errors returned by MatchLeadsRequest_Filter.ValidateAll() if the designated
+// constraints aren't met.
+type MatchLeadsRequest_FilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MatchLeadsRequest_FilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MatchLeadsRequest_FilterMultiError) AllErrors() []error { return m }
+
+// MatchLeadsRequest_FilterValidationError is the validation error returned by
+// MatchLeadsRequest_Filter.Validate if the designated constraints aren't met.
+type MatchLeadsRequest_FilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchLeadsRequest_FilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchLeadsRequest_FilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchLeadsRequest_FilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchLeadsRequest_FilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchLeadsRequest_FilterValidationError) ErrorName() string {
+	return "MatchLeadsRequest_FilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MatchLeadsRequest_FilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchLeadsRequest_Filter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchLeadsRequest_FilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchLeadsRequest_FilterValidationError{}
@@ -24,8 +24,11 @@ const (
 	LeadService_ListLeads_FullMethodName                 = "/leadexchange.v1.LeadService/ListLeads"
 	LeadService_UpdateLead_FullMethodName                = "/leadexchange.v1.LeadService/UpdateLead"
 	LeadService_ReindexLead_FullMethodName               = "/leadexchange.v1.LeadService/ReindexLead"
+	LeadService_GetLeadEmbeddingStatus_FullMethodName    = "/leadexchange.v1.LeadService/GetLeadEmbeddingStatus"
+	LeadService_MatchLeads_FullMethodName                = "/leadexchange.v1.LeadService/MatchLeads"
 	LeadService_GetClarificationQuestions_FullMethodName = "/leadexchange.v1.LeadService/GetClarificationQuestions"
 	LeadService_ApplyClarificationAnswers_FullMethodName = "/leadexchange.v1.LeadService/ApplyClarificationAnswers"
+	LeadService_ListClarifications_FullMethodName        = "/leadexchange.v1.LeadService/ListClarifications"
 	LeadService_AnalyzeLeadIntent_FullMethodName         = "/leadexchange.v1.LeadService/AnalyzeLeadIntent"
 )
 
@@ -43,10 +46,16 @@ type LeadServiceClient interface {
 	UpdateLead(ctx context.Context, in *UpdateLeadRequest, opts ...grpc.CallOption) (*LeadResponse, error)
 	// Переиндексировать лида вручную.
 	ReindexLead(ctx context.Context, in *ReindexLeadRequest, opts ...grpc.CallOption) (*ReindexLeadResponse, error)
+	// Статус генерации embedding лида.
+	GetLeadEmbeddingStatus(ctx context.Context, in *GetLeadEmbeddingStatusRequest, opts ...grpc.CallOption) (*EmbeddingStatusResponse, error)
+	// Обратный матчинг: найти опубликованных лидов, которым подходит объект.
+	MatchLeads(ctx context.Context, in *MatchLeadsRequest, opts ...grpc.CallOption) (*MatchLeadsResponse, error)
 	// Получить уточняющие вопросы для "короткого" лида.
 	GetClarificationQuestions(ctx context.Context, in *GetClarificationQuestionsRequest, opts ...grpc.CallOption) (*GetClarificationQuestionsResponse, error)
 	// Применить ответы на уточняющие вопросы.
 	ApplyClarificationAnswers(ctx context.Context, in *ApplyClarificationAnswersRequest, opts ...grpc.CallOption) (*ApplyClarificationAnswersResponse, error)
+	// История уточнений лида: вопросы, ответы и качество лида до и после.
+	ListClarifications(ctx context.Context, in *ListClarificationsRequest, opts ...grpc.CallOption) (*ListClarificationsResponse, error)
 	// Анализ намерений лида для определения оптимальных весов матчинга.
 	AnalyzeLeadIntent(ctx context.Context, in *AnalyzeLeadIntentRequest, opts ...grpc.CallOption) (*AnalyzeLeadIntentResponse, error)
 }
@@ -109,6 +118,26 @@ func (c *leadServiceClient) ReindexLead(ctx context.Context, in *ReindexLeadRequ
 	return out, nil
 }
 
+func (c *leadServiceClient) GetLeadEmbeddingStatus(ctx context.Context, in *GetLeadEmbeddingStatusRequest, opts ...grpc.CallOption) (*EmbeddingStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbeddingStatusResponse)
+	err := c.cc.Invoke(ctx, LeadService_GetLeadEmbeddingStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadServiceClient) MatchLeads(ctx context.Context, in *MatchLeadsRequest, opts ...grpc.CallOption) (*MatchLeadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchLeadsResponse)
+	err := c.cc.Invoke(ctx, LeadService_MatchLeads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *leadServiceClient) GetClarificationQuestions(ctx context.Context, in *GetClarificationQuestionsRequest, opts ...grpc.CallOption) (*GetClarificationQuestionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetClarificationQuestionsResponse)
@@ -129,6 +158,16 @@ func (c *leadServiceClient) ApplyClarificationAnswers(ctx context.Context, in *A
 	return out, nil
 }
 
+func (c *leadServiceClient) ListClarifications(ctx context.Context, in *ListClarificationsRequest, opts ...grpc.CallOption) (*ListClarificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClarificationsResponse)
+	err := c.cc.Invoke(ctx, LeadService_ListClarifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *leadServiceClient) AnalyzeLeadIntent(ctx context.Context, in *AnalyzeLeadIntentRequest, opts ...grpc.CallOption) (*AnalyzeLeadIntentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AnalyzeLeadIntentResponse)
@@ -153,10 +192,16 @@ type LeadServiceServer interface {
 	UpdateLead(context.Context, *UpdateLeadRequest) (*LeadResponse, error)
 	// Переиндексировать лида вручную.
 	ReindexLead(context.Context, *ReindexLeadRequest) (*ReindexLeadResponse, error)
+	// Статус генерации embedding лида.
+	GetLeadEmbeddingStatus(context.Context, *GetLeadEmbeddingStatusRequest) (*EmbeddingStatusResponse, error)
+	// Обратный матчинг: найти опубликованных лидов, которым подходит объект.
+	MatchLeads(context.Context, *MatchLeadsRequest) (*MatchLeadsResponse, error)
 	// Получить уточняющие вопросы для "короткого" лида.
 	GetClarificationQuestions(context.Context, *GetClarificationQuestionsRequest) (*GetClarificationQuestionsResponse, error)
 	// Применить ответы на уточняющие вопросы.
 	ApplyClarificationAnswers(context.Context, *ApplyClarificationAnswersRequest) (*ApplyClarificationAnswersResponse, error)
+	// История уточнений лида: вопросы, ответы и качество лида до и после.
+	ListClarifications(context.Context, *ListClarificationsRequest) (*ListClarificationsResponse, error)
 	// Анализ намерений лида для определения оптимальных весов матчинга.
 	AnalyzeLeadIntent(context.Context, *AnalyzeLeadIntentRequest) (*AnalyzeLeadIntentResponse, error)
 	mustEmbedUnimplementedLeadServiceServer()
@@ -184,12 +229,21 @@ func (UnimplementedLeadServiceServer) UpdateLead(context.Context, *UpdateLeadReq
 func (UnimplementedLeadServiceServer) ReindexLead(context.Context, *ReindexLeadRequest) (*ReindexLeadResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ReindexLead not implemented")
 }
+func (UnimplementedLeadServiceServer) GetLeadEmbeddingStatus(context.Context, *GetLeadEmbeddingStatusRequest) (*EmbeddingStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLeadEmbeddingStatus not implemented")
+}
+func (UnimplementedLeadServiceServer) MatchLeads(context.Context, *MatchLeadsRequest) (*MatchLeadsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MatchLeads not implemented")
+}
 func (UnimplementedLeadServiceServer) GetClarificationQuestions(context.Context, *GetClarificationQuestionsRequest) (*GetClarificationQuestionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetClarificationQuestions not implemented")
 }
 func (UnimplementedLeadServiceServer) ApplyClarificationAnswers(context.Context, *ApplyClarificationAnswersRequest) (*ApplyClarificationAnswersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ApplyClarificationAnswers not implemented")
 }
+func (UnimplementedLeadServiceServer) ListClarifications(context.Context, *ListClarificationsRequest) (*ListClarificationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListClarifications not implemented")
+}
 func (UnimplementedLeadServiceServer) AnalyzeLeadIntent(context.Context, *AnalyzeLeadIntentRequest) (*AnalyzeLeadIntentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AnalyzeLeadIntent not implemented")
 }
@@ -304,6 +358,42 @@ func _LeadService_ReindexLead_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeadService_GetLeadEmbeddingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeadEmbeddingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).GetLeadEmbeddingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_GetLeadEmbeddingStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).GetLeadEmbeddingStatus(ctx, req.(*GetLeadEmbeddingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadService_MatchLeads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchLeadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).MatchLeads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_MatchLeads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).MatchLeads(ctx, req.(*MatchLeadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LeadService_GetClarificationQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetClarificationQuestionsRequest)
 	if err := dec(in); err != nil {
@@ -340,6 +430,24 @@ func _LeadService_ApplyClarificationAnswers_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeadService_ListClarifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClarificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).ListClarifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_ListClarifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).ListClarifications(ctx, req.(*ListClarificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LeadService_AnalyzeLeadIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AnalyzeLeadIntentRequest)
 	if err := dec(in); err != nil {
@@ -385,6 +493,14 @@ var LeadService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReindexLead",
 			Handler:    _LeadService_ReindexLead_Handler,
 		},
+		{
+			MethodName: "GetLeadEmbeddingStatus",
+			Handler:    _LeadService_GetLeadEmbeddingStatus_Handler,
+		},
+		{
+			MethodName: "MatchLeads",
+			Handler:    _LeadService_MatchLeads_Handler,
+		},
 		{
 			MethodName: "GetClarificationQuestions",
 			Handler:    _LeadService_GetClarificationQuestions_Handler,
@@ -393,6 +509,10 @@ var LeadService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyClarificationAnswers",
 			Handler:    _LeadService_ApplyClarificationAnswers_Handler,
 		},
+		{
+			MethodName: "ListClarifications",
+			Handler:    _LeadService_ListClarifications_Handler,
+		},
 		{
 			MethodName: "AnalyzeLeadIntent",
 			Handler:    _LeadService_AnalyzeLeadIntent_Handler,
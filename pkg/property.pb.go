@@ -152,6 +152,15 @@ type Property struct {
 	CreatedAt     string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	City          *string                `protobuf:"bytes,14,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	Floor         *int32                 `protobuf:"varint,15,opt,name=floor,proto3,oneof" json:"floor,omitempty"`
+	TotalFloors   *int32                 `protobuf:"varint,16,opt,name=total_floors,json=totalFloors,proto3,oneof" json:"total_floors,omitempty"`
+	YearBuilt     *int32                 `protobuf:"varint,17,opt,name=year_built,json=yearBuilt,proto3,oneof" json:"year_built,omitempty"`
+	// district — район; при ранжировании важнее совпадения по адресу
+	District  *string  `protobuf:"bytes,18,opt,name=district,proto3,oneof" json:"district,omitempty"`
+	Latitude  *float64 `protobuf:"fixed64,19,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude *float64 `protobuf:"fixed64,20,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	// features — особенности объекта (балкон, парковка, лифт…)
+	Features      []string `protobuf:"bytes,21,rep,name=features,proto3" json:"features,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -284,6 +293,100 @@ func (x *Property) GetCity() string {
 	return ""
 }
 
+func (x *Property) GetFloor() int32 {
+	if x != nil && x.Floor != nil {
+		return *x.Floor
+	}
+	return 0
+}
+
+func (x *Property) GetTotalFloors() int32 {
+	if x != nil && x.TotalFloors != nil {
+		return *x.TotalFloors
+	}
+	return 0
+}
+
+func (x *Property) GetYearBuilt() int32 {
+	if x != nil && x.YearBuilt != nil {
+		return *x.YearBuilt
+	}
+	return 0
+}
+
+func (x *Property) GetDistrict() string {
+	if x != nil && x.District != nil {
+		return *x.District
+	}
+	return ""
+}
+
+func (x *Property) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *Property) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *Property) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// PropertyFeatures — список особенностей для частичного обновления (пустой список очищает).
+type PropertyFeatures struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyFeatures) Reset() {
+	*x = PropertyFeatures{}
+	mi := &file_property_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyFeatures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyFeatures) ProtoMessage() {}
+
+func (x *PropertyFeatures) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyFeatures.ProtoReflect.Descriptor instead.
+func (*PropertyFeatures) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PropertyFeatures) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type CreatePropertyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -294,13 +397,20 @@ type CreatePropertyRequest struct {
 	Price         *int64                 `protobuf:"varint,6,opt,name=price,proto3,oneof" json:"price,omitempty"`
 	Rooms         *int32                 `protobuf:"varint,7,opt,name=rooms,proto3,oneof" json:"rooms,omitempty"`
 	City          *string                `protobuf:"bytes,8,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	Floor         *int32                 `protobuf:"varint,9,opt,name=floor,proto3,oneof" json:"floor,omitempty"`
+	TotalFloors   *int32                 `protobuf:"varint,10,opt,name=total_floors,json=totalFloors,proto3,oneof" json:"total_floors,omitempty"`
+	YearBuilt     *int32                 `protobuf:"varint,11,opt,name=year_built,json=yearBuilt,proto3,oneof" json:"year_built,omitempty"`
+	District      *string                `protobuf:"bytes,12,opt,name=district,proto3,oneof" json:"district,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,13,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,14,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	Features      []string               `protobuf:"bytes,15,rep,name=features,proto3" json:"features,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePropertyRequest) Reset() {
 	*x = CreatePropertyRequest{}
-	mi := &file_property_proto_msgTypes[1]
+	mi := &file_property_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -312,7 +422,7 @@ func (x *CreatePropertyRequest) String() string {
 func (*CreatePropertyRequest) ProtoMessage() {}
 
 func (x *CreatePropertyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[1]
+	mi := &file_property_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +435,7 @@ func (x *CreatePropertyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePropertyRequest.ProtoReflect.Descriptor instead.
 func (*CreatePropertyRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{1}
+	return file_property_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreatePropertyRequest) GetTitle() string {
@@ -384,6 +494,55 @@ func (x *CreatePropertyRequest) GetCity() string {
 	return ""
 }
 
+func (x *CreatePropertyRequest) GetFloor() int32 {
+	if x != nil && x.Floor != nil {
+		return *x.Floor
+	}
+	return 0
+}
+
+func (x *CreatePropertyRequest) GetTotalFloors() int32 {
+	if x != nil && x.TotalFloors != nil {
+		return *x.TotalFloors
+	}
+	return 0
+}
+
+func (x *CreatePropertyRequest) GetYearBuilt() int32 {
+	if x != nil && x.YearBuilt != nil {
+		return *x.YearBuilt
+	}
+	return 0
+}
+
+func (x *CreatePropertyRequest) GetDistrict() string {
+	if x != nil && x.District != nil {
+		return *x.District
+	}
+	return ""
+}
+
+func (x *CreatePropertyRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *CreatePropertyRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *CreatePropertyRequest) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
 type GetPropertyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
@@ -393,7 +552,7 @@ type GetPropertyRequest struct {
 
 func (x *GetPropertyRequest) Reset() {
 	*x = GetPropertyRequest{}
-	mi := &file_property_proto_msgTypes[2]
+	mi := &file_property_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -405,7 +564,7 @@ func (x *GetPropertyRequest) String() string {
 func (*GetPropertyRequest) ProtoMessage() {}
 
 func (x *GetPropertyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[2]
+	mi := &file_property_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +577,7 @@ func (x *GetPropertyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPropertyRequest.ProtoReflect.Descriptor instead.
 func (*GetPropertyRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{2}
+	return file_property_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetPropertyRequest) GetPropertyId() string {
@@ -441,7 +600,7 @@ type ListPropertiesRequest struct {
 
 func (x *ListPropertiesRequest) Reset() {
 	*x = ListPropertiesRequest{}
-	mi := &file_property_proto_msgTypes[3]
+	mi := &file_property_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -453,7 +612,7 @@ func (x *ListPropertiesRequest) String() string {
 func (*ListPropertiesRequest) ProtoMessage() {}
 
 func (x *ListPropertiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[3]
+	mi := &file_property_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +625,7 @@ func (x *ListPropertiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPropertiesRequest.ProtoReflect.Descriptor instead.
 func (*ListPropertiesRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{3}
+	return file_property_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListPropertiesRequest) GetFilter() *ListPropertiesRequest_Filter {
@@ -505,15 +664,20 @@ func (x *ListPropertiesRequest) GetOrderDirection() string {
 }
 
 type ListPropertiesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Properties    []*Property            `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Properties []*Property            `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"`
+	// next_page_token — токен следующей страницы; пустой, если страниц больше нет
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HasMore       bool   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// total_count — сколько записей подходит под фильтр без учёта пагинации
+	TotalCount    int32 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListPropertiesResponse) Reset() {
 	*x = ListPropertiesResponse{}
-	mi := &file_property_proto_msgTypes[4]
+	mi := &file_property_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -525,7 +689,7 @@ func (x *ListPropertiesResponse) String() string {
 func (*ListPropertiesResponse) ProtoMessage() {}
 
 func (x *ListPropertiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[4]
+	mi := &file_property_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,7 +702,7 @@ func (x *ListPropertiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPropertiesResponse.ProtoReflect.Descriptor instead.
 func (*ListPropertiesResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{4}
+	return file_property_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListPropertiesResponse) GetProperties() []*Property {
@@ -548,6 +712,27 @@ func (x *ListPropertiesResponse) GetProperties() []*Property {
 	return nil
 }
 
+func (x *ListPropertiesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListPropertiesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListPropertiesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type UpdatePropertyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
@@ -561,13 +746,20 @@ type UpdatePropertyRequest struct {
 	Status        *PropertyStatus        `protobuf:"varint,9,opt,name=status,proto3,enum=leadexchange.v1.PropertyStatus,oneof" json:"status,omitempty"`
 	OwnerUserId   *string                `protobuf:"bytes,10,opt,name=owner_user_id,json=ownerUserId,proto3,oneof" json:"owner_user_id,omitempty"`
 	City          *string                `protobuf:"bytes,11,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	Floor         *int32                 `protobuf:"varint,12,opt,name=floor,proto3,oneof" json:"floor,omitempty"`
+	TotalFloors   *int32                 `protobuf:"varint,13,opt,name=total_floors,json=totalFloors,proto3,oneof" json:"total_floors,omitempty"`
+	YearBuilt     *int32                 `protobuf:"varint,14,opt,name=year_built,json=yearBuilt,proto3,oneof" json:"year_built,omitempty"`
+	District      *string                `protobuf:"bytes,15,opt,name=district,proto3,oneof" json:"district,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,16,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,17,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	Features      *PropertyFeatures      `protobuf:"bytes,18,opt,name=features,proto3" json:"features,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdatePropertyRequest) Reset() {
 	*x = UpdatePropertyRequest{}
-	mi := &file_property_proto_msgTypes[5]
+	mi := &file_property_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -579,7 +771,7 @@ func (x *UpdatePropertyRequest) String() string {
 func (*UpdatePropertyRequest) ProtoMessage() {}
 
 func (x *UpdatePropertyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[5]
+	mi := &file_property_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +784,7 @@ func (x *UpdatePropertyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePropertyRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePropertyRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{5}
+	return file_property_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdatePropertyRequest) GetPropertyId() string {
@@ -672,6 +864,55 @@ func (x *UpdatePropertyRequest) GetCity() string {
 	return ""
 }
 
+func (x *UpdatePropertyRequest) GetFloor() int32 {
+	if x != nil && x.Floor != nil {
+		return *x.Floor
+	}
+	return 0
+}
+
+func (x *UpdatePropertyRequest) GetTotalFloors() int32 {
+	if x != nil && x.TotalFloors != nil {
+		return *x.TotalFloors
+	}
+	return 0
+}
+
+func (x *UpdatePropertyRequest) GetYearBuilt() int32 {
+	if x != nil && x.YearBuilt != nil {
+		return *x.YearBuilt
+	}
+	return 0
+}
+
+func (x *UpdatePropertyRequest) GetDistrict() string {
+	if x != nil && x.District != nil {
+		return *x.District
+	}
+	return ""
+}
+
+func (x *UpdatePropertyRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdatePropertyRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *UpdatePropertyRequest) GetFeatures() *PropertyFeatures {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
 type PropertyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Property      *Property              `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
@@ -681,7 +922,7 @@ type PropertyResponse struct {
 
 func (x *PropertyResponse) Reset() {
 	*x = PropertyResponse{}
-	mi := &file_property_proto_msgTypes[6]
+	mi := &file_property_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -693,7 +934,7 @@ func (x *PropertyResponse) String() string {
 func (*PropertyResponse) ProtoMessage() {}
 
 func (x *PropertyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[6]
+	mi := &file_property_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +947,7 @@ func (x *PropertyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PropertyResponse.ProtoReflect.Descriptor instead.
 func (*PropertyResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{6}
+	return file_property_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PropertyResponse) GetProperty() *Property {
@@ -728,7 +969,7 @@ type MatchPropertiesRequest struct {
 
 func (x *MatchPropertiesRequest) Reset() {
 	*x = MatchPropertiesRequest{}
-	mi := &file_property_proto_msgTypes[7]
+	mi := &file_property_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -740,7 +981,7 @@ func (x *MatchPropertiesRequest) String() string {
 func (*MatchPropertiesRequest) ProtoMessage() {}
 
 func (x *MatchPropertiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[7]
+	mi := &file_property_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +994,7 @@ func (x *MatchPropertiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchPropertiesRequest.ProtoReflect.Descriptor instead.
 func (*MatchPropertiesRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{7}
+	return file_property_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MatchPropertiesRequest) GetLeadId() string {
@@ -790,13 +1031,20 @@ type MatchedProperty struct {
 	AreaScore        *float64 `protobuf:"fixed64,7,opt,name=area_score,json=areaScore,proto3,oneof" json:"area_score,omitempty"`
 	SemanticScore    *float64 `protobuf:"fixed64,8,opt,name=semantic_score,json=semanticScore,proto3,oneof" json:"semantic_score,omitempty"`
 	MatchExplanation *string  `protobuf:"bytes,9,opt,name=match_explanation,json=matchExplanation,proto3,oneof" json:"match_explanation,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Только при гео-поиске: затухание по расстоянию и расстояние до центра в км
+	DistanceScore *float64 `protobuf:"fixed64,10,opt,name=distance_score,json=distanceScore,proto3,oneof" json:"distance_score,omitempty"`
+	DistanceKm    *float64 `protobuf:"fixed64,11,opt,name=distance_km,json=distanceKm,proto3,oneof" json:"distance_km,omitempty"`
+	// Только при визуальных предпочтениях лида и проанализированных фото
+	VisualScore *float64 `protobuf:"fixed64,12,opt,name=visual_score,json=visualScore,proto3,oneof" json:"visual_score,omitempty"`
+	// Только при желательных особенностях лида: доля тех, что есть у объекта
+	FeaturesScore *float64 `protobuf:"fixed64,13,opt,name=features_score,json=featuresScore,proto3,oneof" json:"features_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MatchedProperty) Reset() {
 	*x = MatchedProperty{}
-	mi := &file_property_proto_msgTypes[8]
+	mi := &file_property_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -808,7 +1056,7 @@ func (x *MatchedProperty) String() string {
 func (*MatchedProperty) ProtoMessage() {}
 
 func (x *MatchedProperty) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[8]
+	mi := &file_property_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +1069,7 @@ func (x *MatchedProperty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchedProperty.ProtoReflect.Descriptor instead.
 func (*MatchedProperty) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{8}
+	return file_property_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *MatchedProperty) GetProperty() *Property {
@@ -887,17 +1135,47 @@ func (x *MatchedProperty) GetMatchExplanation() string {
 	return ""
 }
 
+func (x *MatchedProperty) GetDistanceScore() float64 {
+	if x != nil && x.DistanceScore != nil {
+		return *x.DistanceScore
+	}
+	return 0
+}
+
+func (x *MatchedProperty) GetDistanceKm() float64 {
+	if x != nil && x.DistanceKm != nil {
+		return *x.DistanceKm
+	}
+	return 0
+}
+
+func (x *MatchedProperty) GetVisualScore() float64 {
+	if x != nil && x.VisualScore != nil {
+		return *x.VisualScore
+	}
+	return 0
+}
+
+func (x *MatchedProperty) GetFeaturesScore() float64 {
+	if x != nil && x.FeaturesScore != nil {
+		return *x.FeaturesScore
+	}
+	return 0
+}
+
 // MatchPropertiesResponse — ответ с подходящими объектами.
 type MatchPropertiesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Matches       []*MatchedProperty     `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Matches []*MatchedProperty     `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Только для MatchPropertiesAdvanced: применённая стратегия поиска
+	SearchOptions *SearchOptions `protobuf:"bytes,2,opt,name=search_options,json=searchOptions,proto3,oneof" json:"search_options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MatchPropertiesResponse) Reset() {
 	*x = MatchPropertiesResponse{}
-	mi := &file_property_proto_msgTypes[9]
+	mi := &file_property_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -909,7 +1187,7 @@ func (x *MatchPropertiesResponse) String() string {
 func (*MatchPropertiesResponse) ProtoMessage() {}
 
 func (x *MatchPropertiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[9]
+	mi := &file_property_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1200,7 @@ func (x *MatchPropertiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchPropertiesResponse.ProtoReflect.Descriptor instead.
 func (*MatchPropertiesResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{9}
+	return file_property_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MatchPropertiesResponse) GetMatches() []*MatchedProperty {
@@ -932,6 +1210,13 @@ func (x *MatchPropertiesResponse) GetMatches() []*MatchedProperty {
 	return nil
 }
 
+func (x *MatchPropertiesResponse) GetSearchOptions() *SearchOptions {
+	if x != nil {
+		return x.SearchOptions
+	}
+	return nil
+}
+
 type ReindexPropertyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
@@ -941,7 +1226,7 @@ type ReindexPropertyRequest struct {
 
 func (x *ReindexPropertyRequest) Reset() {
 	*x = ReindexPropertyRequest{}
-	mi := &file_property_proto_msgTypes[10]
+	mi := &file_property_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -953,7 +1238,7 @@ func (x *ReindexPropertyRequest) String() string {
 func (*ReindexPropertyRequest) ProtoMessage() {}
 
 func (x *ReindexPropertyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[10]
+	mi := &file_property_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,7 +1251,7 @@ func (x *ReindexPropertyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReindexPropertyRequest.ProtoReflect.Descriptor instead.
 func (*ReindexPropertyRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{10}
+	return file_property_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ReindexPropertyRequest) GetPropertyId() string {
@@ -976,29 +1261,696 @@ func (x *ReindexPropertyRequest) GetPropertyId() string {
 	return ""
 }
 
-type ReindexPropertyResponse struct {
+type GetPropertyEmbeddingStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReindexPropertyResponse) Reset() {
-	*x = ReindexPropertyResponse{}
-	mi := &file_property_proto_msgTypes[11]
+func (x *GetPropertyEmbeddingStatusRequest) Reset() {
+	*x = GetPropertyEmbeddingStatusRequest{}
+	mi := &file_property_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPropertyEmbeddingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPropertyEmbeddingStatusRequest) ProtoMessage() {}
+
+func (x *GetPropertyEmbeddingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPropertyEmbeddingStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPropertyEmbeddingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPropertyEmbeddingStatusRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+type ReindexPropertyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexPropertyResponse) Reset() {
+	*x = ReindexPropertyResponse{}
+	mi := &file_property_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexPropertyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexPropertyResponse) ProtoMessage() {}
+
+func (x *ReindexPropertyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexPropertyResponse.ProtoReflect.Descriptor instead.
+func (*ReindexPropertyResponse) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReindexPropertyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReindexPropertyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// PropertyFilter — фильтр для поиска объектов.
+type PropertyFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          *string                `protobuf:"bytes,1,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	Status        *PropertyStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=leadexchange.v1.PropertyStatus,oneof" json:"status,omitempty"`
+	PropertyType  *PropertyType          `protobuf:"varint,3,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType,oneof" json:"property_type,omitempty"`
+	MinPrice      *int64                 `protobuf:"varint,4,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	MaxPrice      *int64                 `protobuf:"varint,5,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	MinRooms      *int32                 `protobuf:"varint,6,opt,name=min_rooms,json=minRooms,proto3,oneof" json:"min_rooms,omitempty"`
+	MaxRooms      *int32                 `protobuf:"varint,7,opt,name=max_rooms,json=maxRooms,proto3,oneof" json:"max_rooms,omitempty"`
+	Geo           *GeoFilter             `protobuf:"bytes,8,opt,name=geo,proto3" json:"geo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyFilter) Reset() {
+	*x = PropertyFilter{}
+	mi := &file_property_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyFilter) ProtoMessage() {}
+
+func (x *PropertyFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyFilter.ProtoReflect.Descriptor instead.
+func (*PropertyFilter) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PropertyFilter) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *PropertyFilter) GetStatus() PropertyStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return PropertyStatus_PROPERTY_STATUS_UNSPECIFIED
+}
+
+func (x *PropertyFilter) GetPropertyType() PropertyType {
+	if x != nil && x.PropertyType != nil {
+		return *x.PropertyType
+	}
+	return PropertyType_PROPERTY_TYPE_UNSPECIFIED
+}
+
+func (x *PropertyFilter) GetMinPrice() int64 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
+}
+
+func (x *PropertyFilter) GetMaxPrice() int64 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
+
+func (x *PropertyFilter) GetMinRooms() int32 {
+	if x != nil && x.MinRooms != nil {
+		return *x.MinRooms
+	}
+	return 0
+}
+
+func (x *PropertyFilter) GetMaxRooms() int32 {
+	if x != nil && x.MaxRooms != nil {
+		return *x.MaxRooms
+	}
+	return 0
+}
+
+func (x *PropertyFilter) GetGeo() *GeoFilter {
+	if x != nil {
+		return x.Geo
+	}
+	return nil
+}
+
+// GeoFilter — объекты в радиусе от точки (например, «2 км от метро»).
+// Объекты без координат в выборку не попадают.
+type GeoFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusKm      float64                `protobuf:"fixed64,3,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoFilter) Reset() {
+	*x = GeoFilter{}
+	mi := &file_property_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoFilter) ProtoMessage() {}
+
+func (x *GeoFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoFilter.ProtoReflect.Descriptor instead.
+func (*GeoFilter) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GeoFilter) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoFilter) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GeoFilter) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+// MatchPropertiesAdvancedRequest — запрос на расширенный поиск.
+type MatchPropertiesAdvancedRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	LeadId string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	Filter *PropertyFilter        `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Limit  *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// Переопределение стратегии поиска для этого запроса (по умолчанию — настройки сервера)
+	UseHybridSearch   *bool `protobuf:"varint,4,opt,name=use_hybrid_search,json=useHybridSearch,proto3,oneof" json:"use_hybrid_search,omitempty"`
+	UseReranker       *bool `protobuf:"varint,5,opt,name=use_reranker,json=useReranker,proto3,oneof" json:"use_reranker,omitempty"`
+	UseDynamicWeights *bool `protobuf:"varint,6,opt,name=use_dynamic_weights,json=useDynamicWeights,proto3,oneof" json:"use_dynamic_weights,omitempty"`
+	// Веса гибридного поиска; если задан один, второй дополняет его до 1
+	VectorWeight   *float64 `protobuf:"fixed64,7,opt,name=vector_weight,json=vectorWeight,proto3,oneof" json:"vector_weight,omitempty"`
+	FulltextWeight *float64 `protobuf:"fixed64,8,opt,name=fulltext_weight,json=fulltextWeight,proto3,oneof" json:"fulltext_weight,omitempty"`
+	// Количество кандидатов для ранжирования и реранкера
+	Candidates    *int32 `protobuf:"varint,9,opt,name=candidates,proto3,oneof" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchPropertiesAdvancedRequest) Reset() {
+	*x = MatchPropertiesAdvancedRequest{}
+	mi := &file_property_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchPropertiesAdvancedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchPropertiesAdvancedRequest) ProtoMessage() {}
+
+func (x *MatchPropertiesAdvancedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchPropertiesAdvancedRequest.ProtoReflect.Descriptor instead.
+func (*MatchPropertiesAdvancedRequest) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MatchPropertiesAdvancedRequest) GetLeadId() string {
+	if x != nil {
+		return x.LeadId
+	}
+	return ""
+}
+
+func (x *MatchPropertiesAdvancedRequest) GetFilter() *PropertyFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *MatchPropertiesAdvancedRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *MatchPropertiesAdvancedRequest) GetUseHybridSearch() bool {
+	if x != nil && x.UseHybridSearch != nil {
+		return *x.UseHybridSearch
+	}
+	return false
+}
+
+func (x *MatchPropertiesAdvancedRequest) GetUseReranker() bool {
+	if x != nil && x.UseReranker != nil {
+		return *x.UseReranker
+	}
+	return false
+}
+
+func (x *MatchPropertiesAdvancedRequest) GetUseDynamicWeights() bool {
+	if x != nil && x.UseDynamicWeights != nil {
+		return *x.UseDynamicWeights
+	}
+	return false
+}
+
+func (x *MatchPropertiesAdvancedRequest) GetVectorWeight() float64 {
+	if x != nil && x.VectorWeight != nil {
+		return *x.VectorWeight
+	}
+	return 0
+}
+
+func (x *MatchPropertiesAdvancedRequest) GetFulltextWeight() float64 {
+	if x != nil && x.FulltextWeight != nil {
+		return *x.FulltextWeight
+	}
+	return 0
+}
+
+func (x *MatchPropertiesAdvancedRequest) GetCandidates() int32 {
+	if x != nil && x.Candidates != nil {
+		return *x.Candidates
+	}
+	return 0
+}
+
+// SearchOptions — стратегия, с которой фактически выполнен расширенный поиск.
+type SearchOptions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HybridSearch   bool                   `protobuf:"varint,1,opt,name=hybrid_search,json=hybridSearch,proto3" json:"hybrid_search,omitempty"`
+	VectorWeight   float64                `protobuf:"fixed64,2,opt,name=vector_weight,json=vectorWeight,proto3" json:"vector_weight,omitempty"`
+	FulltextWeight float64                `protobuf:"fixed64,3,opt,name=fulltext_weight,json=fulltextWeight,proto3" json:"fulltext_weight,omitempty"`
+	UseReranker    bool                   `protobuf:"varint,4,opt,name=use_reranker,json=useReranker,proto3" json:"use_reranker,omitempty"`
+	// Реранкер был доступен и отработал без ошибок
+	RerankerApplied   bool  `protobuf:"varint,5,opt,name=reranker_applied,json=rerankerApplied,proto3" json:"reranker_applied,omitempty"`
+	Candidates        int32 `protobuf:"varint,6,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	UseDynamicWeights bool  `protobuf:"varint,7,opt,name=use_dynamic_weights,json=useDynamicWeights,proto3" json:"use_dynamic_weights,omitempty"`
+	// Веса получены анализом лида, а не взяты по умолчанию
+	DynamicWeightsApplied bool `protobuf:"varint,8,opt,name=dynamic_weights_applied,json=dynamicWeightsApplied,proto3" json:"dynamic_weights_applied,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SearchOptions) Reset() {
+	*x = SearchOptions{}
+	mi := &file_property_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOptions) ProtoMessage() {}
+
+func (x *SearchOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOptions.ProtoReflect.Descriptor instead.
+func (*SearchOptions) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchOptions) GetHybridSearch() bool {
+	if x != nil {
+		return x.HybridSearch
+	}
+	return false
+}
+
+func (x *SearchOptions) GetVectorWeight() float64 {
+	if x != nil {
+		return x.VectorWeight
+	}
+	return 0
+}
+
+func (x *SearchOptions) GetFulltextWeight() float64 {
+	if x != nil {
+		return x.FulltextWeight
+	}
+	return 0
+}
+
+func (x *SearchOptions) GetUseReranker() bool {
+	if x != nil {
+		return x.UseReranker
+	}
+	return false
+}
+
+func (x *SearchOptions) GetRerankerApplied() bool {
+	if x != nil {
+		return x.RerankerApplied
+	}
+	return false
+}
+
+func (x *SearchOptions) GetCandidates() int32 {
+	if x != nil {
+		return x.Candidates
+	}
+	return 0
+}
+
+func (x *SearchOptions) GetUseDynamicWeights() bool {
+	if x != nil {
+		return x.UseDynamicWeights
+	}
+	return false
+}
+
+func (x *SearchOptions) GetDynamicWeightsApplied() bool {
+	if x != nil {
+		return x.DynamicWeightsApplied
+	}
+	return false
+}
+
+type GetPropertyJSONLDRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PropertyId string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// base_url — адрес сайта для ссылок; по умолчанию JSONLD_BASE_URL. Разметка для другого адреса не кэшируется
+	BaseUrl       *string `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3,oneof" json:"base_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPropertyJSONLDRequest) Reset() {
+	*x = GetPropertyJSONLDRequest{}
+	mi := &file_property_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPropertyJSONLDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPropertyJSONLDRequest) ProtoMessage() {}
+
+func (x *GetPropertyJSONLDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPropertyJSONLDRequest.ProtoReflect.Descriptor instead.
+func (*GetPropertyJSONLDRequest) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetPropertyJSONLDRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *GetPropertyJSONLDRequest) GetBaseUrl() string {
+	if x != nil && x.BaseUrl != nil {
+		return *x.BaseUrl
+	}
+	return ""
+}
+
+type GetPropertyJSONLDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JsonldData    []byte                 `protobuf:"bytes,1,opt,name=jsonld_data,json=jsonldData,proto3" json:"jsonld_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPropertyJSONLDResponse) Reset() {
+	*x = GetPropertyJSONLDResponse{}
+	mi := &file_property_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPropertyJSONLDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPropertyJSONLDResponse) ProtoMessage() {}
+
+func (x *GetPropertyJSONLDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPropertyJSONLDResponse.ProtoReflect.Descriptor instead.
+func (*GetPropertyJSONLDResponse) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPropertyJSONLDResponse) GetJsonldData() []byte {
+	if x != nil {
+		return x.JsonldData
+	}
+	return nil
+}
+
+// PropertyImage — фотография из галереи объекта.
+type PropertyImage struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ImageId    string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	PropertyId string                 `protobuf:"bytes,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// url — presigned-ссылка на файл, действует ограниченное время
+	Url         string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	FileName    string `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// position — порядок в галерее, 0 — обложка
+	Position      int32  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedAt     string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyImage) Reset() {
+	*x = PropertyImage{}
+	mi := &file_property_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyImage) ProtoMessage() {}
+
+func (x *PropertyImage) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyImage.ProtoReflect.Descriptor instead.
+func (*PropertyImage) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PropertyImage) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *PropertyImage) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *PropertyImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PropertyImage) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *PropertyImage) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *PropertyImage) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PropertyImage) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// PropertyImageUpload — загружаемый файл (JPEG, PNG или WebP до 5 МБ).
+type PropertyImageUpload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          []byte                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyImageUpload) Reset() {
+	*x = PropertyImageUpload{}
+	mi := &file_property_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReindexPropertyResponse) String() string {
+func (x *PropertyImageUpload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReindexPropertyResponse) ProtoMessage() {}
+func (*PropertyImageUpload) ProtoMessage() {}
 
-func (x *ReindexPropertyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[11]
+func (x *PropertyImageUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,54 +1961,48 @@ func (x *ReindexPropertyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReindexPropertyResponse.ProtoReflect.Descriptor instead.
-func (*ReindexPropertyResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PropertyImageUpload.ProtoReflect.Descriptor instead.
+func (*PropertyImageUpload) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ReindexPropertyResponse) GetSuccess() bool {
+func (x *PropertyImageUpload) GetFile() []byte {
 	if x != nil {
-		return x.Success
+		return x.File
 	}
-	return false
+	return nil
 }
 
-func (x *ReindexPropertyResponse) GetMessage() string {
+func (x *PropertyImageUpload) GetFileName() string {
 	if x != nil {
-		return x.Message
+		return x.FileName
 	}
 	return ""
 }
 
-// PropertyFilter — фильтр для поиска объектов.
-type PropertyFilter struct {
+type AddPropertyImagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	City          *string                `protobuf:"bytes,1,opt,name=city,proto3,oneof" json:"city,omitempty"`
-	Status        *PropertyStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=leadexchange.v1.PropertyStatus,oneof" json:"status,omitempty"`
-	PropertyType  *PropertyType          `protobuf:"varint,3,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType,oneof" json:"property_type,omitempty"`
-	MinPrice      *int64                 `protobuf:"varint,4,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
-	MaxPrice      *int64                 `protobuf:"varint,5,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
-	MinRooms      *int32                 `protobuf:"varint,6,opt,name=min_rooms,json=minRooms,proto3,oneof" json:"min_rooms,omitempty"`
-	MaxRooms      *int32                 `protobuf:"varint,7,opt,name=max_rooms,json=maxRooms,proto3,oneof" json:"max_rooms,omitempty"`
+	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Images        []*PropertyImageUpload `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PropertyFilter) Reset() {
-	*x = PropertyFilter{}
-	mi := &file_property_proto_msgTypes[12]
+func (x *AddPropertyImagesRequest) Reset() {
+	*x = AddPropertyImagesRequest{}
+	mi := &file_property_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PropertyFilter) String() string {
+func (x *AddPropertyImagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PropertyFilter) ProtoMessage() {}
+func (*AddPropertyImagesRequest) ProtoMessage() {}
 
-func (x *PropertyFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[12]
+func (x *AddPropertyImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,88 +2013,92 @@ func (x *PropertyFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PropertyFilter.ProtoReflect.Descriptor instead.
-func (*PropertyFilter) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use AddPropertyImagesRequest.ProtoReflect.Descriptor instead.
+func (*AddPropertyImagesRequest) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *PropertyFilter) GetCity() string {
-	if x != nil && x.City != nil {
-		return *x.City
+func (x *AddPropertyImagesRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
 	}
 	return ""
 }
 
-func (x *PropertyFilter) GetStatus() PropertyStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *AddPropertyImagesRequest) GetImages() []*PropertyImageUpload {
+	if x != nil {
+		return x.Images
 	}
-	return PropertyStatus_PROPERTY_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *PropertyFilter) GetPropertyType() PropertyType {
-	if x != nil && x.PropertyType != nil {
-		return *x.PropertyType
-	}
-	return PropertyType_PROPERTY_TYPE_UNSPECIFIED
+type ListPropertyImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PropertyFilter) GetMinPrice() int64 {
-	if x != nil && x.MinPrice != nil {
-		return *x.MinPrice
-	}
-	return 0
+func (x *ListPropertyImagesRequest) Reset() {
+	*x = ListPropertyImagesRequest{}
+	mi := &file_property_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PropertyFilter) GetMaxPrice() int64 {
-	if x != nil && x.MaxPrice != nil {
-		return *x.MaxPrice
-	}
-	return 0
+func (x *ListPropertyImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PropertyFilter) GetMinRooms() int32 {
-	if x != nil && x.MinRooms != nil {
-		return *x.MinRooms
+func (*ListPropertyImagesRequest) ProtoMessage() {}
+
+func (x *ListPropertyImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PropertyFilter) GetMaxRooms() int32 {
-	if x != nil && x.MaxRooms != nil {
-		return *x.MaxRooms
+// Deprecated: Use ListPropertyImagesRequest.ProtoReflect.Descriptor instead.
+func (*ListPropertyImagesRequest) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListPropertyImagesRequest) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
 	}
-	return 0
+	return ""
 }
 
-// MatchPropertiesAdvancedRequest — запрос на расширенный поиск.
-type MatchPropertiesAdvancedRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	LeadId            string                 `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
-	Filter            *PropertyFilter        `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	Limit             *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	UseHybridSearch   *bool                  `protobuf:"varint,4,opt,name=use_hybrid_search,json=useHybridSearch,proto3,oneof" json:"use_hybrid_search,omitempty"`
-	UseReranker       *bool                  `protobuf:"varint,5,opt,name=use_reranker,json=useReranker,proto3,oneof" json:"use_reranker,omitempty"`
-	UseDynamicWeights *bool                  `protobuf:"varint,6,opt,name=use_dynamic_weights,json=useDynamicWeights,proto3,oneof" json:"use_dynamic_weights,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type DeletePropertyImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	ImageId       string                 `protobuf:"bytes,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MatchPropertiesAdvancedRequest) Reset() {
-	*x = MatchPropertiesAdvancedRequest{}
-	mi := &file_property_proto_msgTypes[13]
+func (x *DeletePropertyImageRequest) Reset() {
+	*x = DeletePropertyImageRequest{}
+	mi := &file_property_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchPropertiesAdvancedRequest) String() string {
+func (x *DeletePropertyImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchPropertiesAdvancedRequest) ProtoMessage() {}
+func (*DeletePropertyImageRequest) ProtoMessage() {}
 
-func (x *MatchPropertiesAdvancedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[13]
+func (x *DeletePropertyImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,76 +2109,93 @@ func (x *MatchPropertiesAdvancedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchPropertiesAdvancedRequest.ProtoReflect.Descriptor instead.
-func (*MatchPropertiesAdvancedRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DeletePropertyImageRequest.ProtoReflect.Descriptor instead.
+func (*DeletePropertyImageRequest) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MatchPropertiesAdvancedRequest) GetLeadId() string {
+func (x *DeletePropertyImageRequest) GetPropertyId() string {
 	if x != nil {
-		return x.LeadId
+		return x.PropertyId
 	}
 	return ""
 }
 
-func (x *MatchPropertiesAdvancedRequest) GetFilter() *PropertyFilter {
+func (x *DeletePropertyImageRequest) GetImageId() string {
 	if x != nil {
-		return x.Filter
+		return x.ImageId
 	}
-	return nil
+	return ""
 }
 
-func (x *MatchPropertiesAdvancedRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
-	}
-	return 0
+type DeletePropertyImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MatchPropertiesAdvancedRequest) GetUseHybridSearch() bool {
-	if x != nil && x.UseHybridSearch != nil {
-		return *x.UseHybridSearch
-	}
-	return false
+func (x *DeletePropertyImageResponse) Reset() {
+	*x = DeletePropertyImageResponse{}
+	mi := &file_property_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchPropertiesAdvancedRequest) GetUseReranker() bool {
-	if x != nil && x.UseReranker != nil {
-		return *x.UseReranker
+func (x *DeletePropertyImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePropertyImageResponse) ProtoMessage() {}
+
+func (x *DeletePropertyImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *MatchPropertiesAdvancedRequest) GetUseDynamicWeights() bool {
-	if x != nil && x.UseDynamicWeights != nil {
-		return *x.UseDynamicWeights
+// Deprecated: Use DeletePropertyImageResponse.ProtoReflect.Descriptor instead.
+func (*DeletePropertyImageResponse) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeletePropertyImageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
 	}
 	return false
 }
 
-type GetPropertyJSONLDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
-	BaseUrl       *string                `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3,oneof" json:"base_url,omitempty"`
+type ReorderPropertyImagesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PropertyId string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// image_ids — все фотографии объекта в новом порядке
+	ImageIds      []string `protobuf:"bytes,2,rep,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPropertyJSONLDRequest) Reset() {
-	*x = GetPropertyJSONLDRequest{}
-	mi := &file_property_proto_msgTypes[14]
+func (x *ReorderPropertyImagesRequest) Reset() {
+	*x = ReorderPropertyImagesRequest{}
+	mi := &file_property_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPropertyJSONLDRequest) String() string {
+func (x *ReorderPropertyImagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPropertyJSONLDRequest) ProtoMessage() {}
+func (*ReorderPropertyImagesRequest) ProtoMessage() {}
 
-func (x *GetPropertyJSONLDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[14]
+func (x *ReorderPropertyImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1239,47 +2206,48 @@ func (x *GetPropertyJSONLDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPropertyJSONLDRequest.ProtoReflect.Descriptor instead.
-func (*GetPropertyJSONLDRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ReorderPropertyImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderPropertyImagesRequest) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetPropertyJSONLDRequest) GetPropertyId() string {
+func (x *ReorderPropertyImagesRequest) GetPropertyId() string {
 	if x != nil {
 		return x.PropertyId
 	}
 	return ""
 }
 
-func (x *GetPropertyJSONLDRequest) GetBaseUrl() string {
-	if x != nil && x.BaseUrl != nil {
-		return *x.BaseUrl
+func (x *ReorderPropertyImagesRequest) GetImageIds() []string {
+	if x != nil {
+		return x.ImageIds
 	}
-	return ""
+	return nil
 }
 
-type GetPropertyJSONLDResponse struct {
+// PropertyImagesResponse — галерея объекта в порядке отображения.
+type PropertyImagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JsonldData    []byte                 `protobuf:"bytes,1,opt,name=jsonld_data,json=jsonldData,proto3" json:"jsonld_data,omitempty"`
+	Images        []*PropertyImage       `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPropertyJSONLDResponse) Reset() {
-	*x = GetPropertyJSONLDResponse{}
-	mi := &file_property_proto_msgTypes[15]
+func (x *PropertyImagesResponse) Reset() {
+	*x = PropertyImagesResponse{}
+	mi := &file_property_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPropertyJSONLDResponse) String() string {
+func (x *PropertyImagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPropertyJSONLDResponse) ProtoMessage() {}
+func (*PropertyImagesResponse) ProtoMessage() {}
 
-func (x *GetPropertyJSONLDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[15]
+func (x *PropertyImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_property_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1290,14 +2258,14 @@ func (x *GetPropertyJSONLDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPropertyJSONLDResponse.ProtoReflect.Descriptor instead.
-func (*GetPropertyJSONLDResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use PropertyImagesResponse.ProtoReflect.Descriptor instead.
+func (*PropertyImagesResponse) Descriptor() ([]byte, []int) {
+	return file_property_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetPropertyJSONLDResponse) GetJsonldData() []byte {
+func (x *PropertyImagesResponse) GetImages() []*PropertyImage {
 	if x != nil {
-		return x.JsonldData
+		return x.Images
 	}
 	return nil
 }
@@ -1320,7 +2288,7 @@ type GenerateListingContentRequest struct {
 
 func (x *GenerateListingContentRequest) Reset() {
 	*x = GenerateListingContentRequest{}
-	mi := &file_property_proto_msgTypes[16]
+	mi := &file_property_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1332,7 +2300,7 @@ func (x *GenerateListingContentRequest) String() string {
 func (*GenerateListingContentRequest) ProtoMessage() {}
 
 func (x *GenerateListingContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[16]
+	mi := &file_property_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,7 +2313,7 @@ func (x *GenerateListingContentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateListingContentRequest.ProtoReflect.Descriptor instead.
 func (*GenerateListingContentRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{16}
+	return file_property_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GenerateListingContentRequest) GetPropertyId() string {
@@ -1430,7 +2398,7 @@ type GenerateListingContentResponse struct {
 
 func (x *GenerateListingContentResponse) Reset() {
 	*x = GenerateListingContentResponse{}
-	mi := &file_property_proto_msgTypes[17]
+	mi := &file_property_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1442,7 +2410,7 @@ func (x *GenerateListingContentResponse) String() string {
 func (*GenerateListingContentResponse) ProtoMessage() {}
 
 func (x *GenerateListingContentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[17]
+	mi := &file_property_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1455,7 +2423,7 @@ func (x *GenerateListingContentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateListingContentResponse.ProtoReflect.Descriptor instead.
 func (*GenerateListingContentResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{17}
+	return file_property_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GenerateListingContentResponse) GetTitle() string {
@@ -1487,16 +2455,17 @@ func (x *GenerateListingContentResponse) GetConfidence() float64 {
 }
 
 type AnalyzePropertyImagesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PropertyId    string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
-	ImageUrls     []string               `protobuf:"bytes,2,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PropertyId string                 `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	// image_urls — внешние фотографии (добавляются в галерею); без них анализируется вся галерея объекта
+	ImageUrls     []string `protobuf:"bytes,2,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AnalyzePropertyImagesRequest) Reset() {
 	*x = AnalyzePropertyImagesRequest{}
-	mi := &file_property_proto_msgTypes[18]
+	mi := &file_property_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1508,7 +2477,7 @@ func (x *AnalyzePropertyImagesRequest) String() string {
 func (*AnalyzePropertyImagesRequest) ProtoMessage() {}
 
 func (x *AnalyzePropertyImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[18]
+	mi := &file_property_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1521,7 +2490,7 @@ func (x *AnalyzePropertyImagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnalyzePropertyImagesRequest.ProtoReflect.Descriptor instead.
 func (*AnalyzePropertyImagesRequest) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{18}
+	return file_property_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AnalyzePropertyImagesRequest) GetPropertyId() string {
@@ -1549,7 +2518,7 @@ type ImageFeature struct {
 
 func (x *ImageFeature) Reset() {
 	*x = ImageFeature{}
-	mi := &file_property_proto_msgTypes[19]
+	mi := &file_property_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1561,7 +2530,7 @@ func (x *ImageFeature) String() string {
 func (*ImageFeature) ProtoMessage() {}
 
 func (x *ImageFeature) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[19]
+	mi := &file_property_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1574,7 +2543,7 @@ func (x *ImageFeature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageFeature.ProtoReflect.Descriptor instead.
 func (*ImageFeature) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{19}
+	return file_property_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ImageFeature) GetName() string {
@@ -1605,13 +2574,15 @@ type ImageAnalysisResult struct {
 	QualityScore     float64                `protobuf:"fixed64,3,opt,name=quality_score,json=qualityScore,proto3" json:"quality_score,omitempty"`
 	ViewType         *string                `protobuf:"bytes,4,opt,name=view_type,json=viewType,proto3,oneof" json:"view_type,omitempty"`
 	Brightness       float64                `protobuf:"fixed64,5,opt,name=brightness,proto3" json:"brightness,omitempty"`
+	ImageId          string                 `protobuf:"bytes,6,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	ImageUrl         string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ImageAnalysisResult) Reset() {
 	*x = ImageAnalysisResult{}
-	mi := &file_property_proto_msgTypes[20]
+	mi := &file_property_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1623,7 +2594,7 @@ func (x *ImageAnalysisResult) String() string {
 func (*ImageAnalysisResult) ProtoMessage() {}
 
 func (x *ImageAnalysisResult) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[20]
+	mi := &file_property_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1636,7 +2607,7 @@ func (x *ImageAnalysisResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageAnalysisResult.ProtoReflect.Descriptor instead.
 func (*ImageAnalysisResult) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{20}
+	return file_property_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ImageAnalysisResult) GetDetectedFeatures() []*ImageFeature {
@@ -1674,6 +2645,20 @@ func (x *ImageAnalysisResult) GetBrightness() float64 {
 	return 0
 }
 
+func (x *ImageAnalysisResult) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *ImageAnalysisResult) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
 type AnalyzePropertyImagesResponse struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	TotalImages       int32                  `protobuf:"varint,1,opt,name=total_images,json=totalImages,proto3" json:"total_images,omitempty"`
@@ -1689,7 +2674,7 @@ type AnalyzePropertyImagesResponse struct {
 
 func (x *AnalyzePropertyImagesResponse) Reset() {
 	*x = AnalyzePropertyImagesResponse{}
-	mi := &file_property_proto_msgTypes[21]
+	mi := &file_property_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1701,7 +2686,7 @@ func (x *AnalyzePropertyImagesResponse) String() string {
 func (*AnalyzePropertyImagesResponse) ProtoMessage() {}
 
 func (x *AnalyzePropertyImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[21]
+	mi := &file_property_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,7 +2699,7 @@ func (x *AnalyzePropertyImagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnalyzePropertyImagesResponse.ProtoReflect.Descriptor instead.
 func (*AnalyzePropertyImagesResponse) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{21}
+	return file_property_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *AnalyzePropertyImagesResponse) GetTotalImages() int32 {
@@ -1777,13 +2762,16 @@ type ListPropertiesRequest_Filter struct {
 	MinPrice      *int64                 `protobuf:"varint,7,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
 	MaxPrice      *int64                 `protobuf:"varint,8,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
 	City          *string                `protobuf:"bytes,9,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	Geo           *GeoFilter             `protobuf:"bytes,10,opt,name=geo,proto3" json:"geo,omitempty"`
+	// team — объекты всех участников агентства текущего пользователя
+	Team          *bool `protobuf:"varint,11,opt,name=team,proto3,oneof" json:"team,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListPropertiesRequest_Filter) Reset() {
 	*x = ListPropertiesRequest_Filter{}
-	mi := &file_property_proto_msgTypes[22]
+	mi := &file_property_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1795,7 +2783,7 @@ func (x *ListPropertiesRequest_Filter) String() string {
 func (*ListPropertiesRequest_Filter) ProtoMessage() {}
 
 func (x *ListPropertiesRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[22]
+	mi := &file_property_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,7 +2796,7 @@ func (x *ListPropertiesRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPropertiesRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListPropertiesRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{3, 0}
+	return file_property_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *ListPropertiesRequest_Filter) GetStatus() PropertyStatus {
@@ -1874,6 +2862,20 @@ func (x *ListPropertiesRequest_Filter) GetCity() string {
 	return ""
 }
 
+func (x *ListPropertiesRequest_Filter) GetGeo() *GeoFilter {
+	if x != nil {
+		return x.Geo
+	}
+	return nil
+}
+
+func (x *ListPropertiesRequest_Filter) GetTeam() bool {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return false
+}
+
 type MatchPropertiesRequest_Filter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *PropertyStatus        `protobuf:"varint,1,opt,name=status,proto3,enum=leadexchange.v1.PropertyStatus,oneof" json:"status,omitempty"`
@@ -1889,7 +2891,7 @@ type MatchPropertiesRequest_Filter struct {
 
 func (x *MatchPropertiesRequest_Filter) Reset() {
 	*x = MatchPropertiesRequest_Filter{}
-	mi := &file_property_proto_msgTypes[23]
+	mi := &file_property_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1901,7 +2903,7 @@ func (x *MatchPropertiesRequest_Filter) String() string {
 func (*MatchPropertiesRequest_Filter) ProtoMessage() {}
 
 func (x *MatchPropertiesRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_property_proto_msgTypes[23]
+	mi := &file_property_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1914,7 +2916,7 @@ func (x *MatchPropertiesRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchPropertiesRequest_Filter.ProtoReflect.Descriptor instead.
 func (*MatchPropertiesRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_property_proto_rawDescGZIP(), []int{7, 0}
+	return file_property_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *MatchPropertiesRequest_Filter) GetStatus() PropertyStatus {
@@ -1970,7 +2972,7 @@ var File_property_proto protoreflect.FileDescriptor
 
 const file_property_proto_rawDesc = "" +
 	"\n" +
-	"\x0eproperty.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xb8\x04\n" +
+	"\x0eproperty.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x0fembedding.proto\"\xf2\x06\n" +
 	"\bProperty\x12\x1f\n" +
 	"\vproperty_id\x18\x01 \x01(\tR\n" +
 	"propertyId\x12\x1d\n" +
@@ -1989,11 +2991,28 @@ const file_property_proto_rawDesc = "" +
 	"created_at\x18\f \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\r \x01(\tR\tupdatedAt\x12\x17\n" +
-	"\x04city\x18\x0e \x01(\tH\x03R\x04city\x88\x01\x01B\a\n" +
+	"\x04city\x18\x0e \x01(\tH\x03R\x04city\x88\x01\x01\x12\x19\n" +
+	"\x05floor\x18\x0f \x01(\x05H\x04R\x05floor\x88\x01\x01\x12&\n" +
+	"\ftotal_floors\x18\x10 \x01(\x05H\x05R\vtotalFloors\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"year_built\x18\x11 \x01(\x05H\x06R\tyearBuilt\x88\x01\x01\x12\x1f\n" +
+	"\bdistrict\x18\x12 \x01(\tH\aR\bdistrict\x88\x01\x01\x12\x1f\n" +
+	"\blatitude\x18\x13 \x01(\x01H\bR\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x14 \x01(\x01H\tR\tlongitude\x88\x01\x01\x12\x1a\n" +
+	"\bfeatures\x18\x15 \x03(\tR\bfeaturesB\a\n" +
 	"\x05_areaB\b\n" +
 	"\x06_priceB\b\n" +
 	"\x06_roomsB\a\n" +
-	"\x05_city\"\xd7\x02\n" +
+	"\x05_cityB\b\n" +
+	"\x06_floorB\x0f\n" +
+	"\r_total_floorsB\r\n" +
+	"\v_year_builtB\v\n" +
+	"\t_districtB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"8\n" +
+	"\x10PropertyFeatures\x12$\n" +
+	"\x06values\x18\x01 \x03(\tB\f\xfaB\t\x92\x01\x06\"\x04r\x02\x10\x01R\x06values\"\xff\x05\n" +
 	"\x15CreatePropertyRequest\x12\x1d\n" +
 	"\x05title\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x03R\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12!\n" +
@@ -2002,21 +3021,38 @@ const file_property_proto_rawDesc = "" +
 	"\x04area\x18\x05 \x01(\x01H\x00R\x04area\x88\x01\x01\x12\x19\n" +
 	"\x05price\x18\x06 \x01(\x03H\x01R\x05price\x88\x01\x01\x12\x19\n" +
 	"\x05rooms\x18\a \x01(\x05H\x02R\x05rooms\x88\x01\x01\x12\x17\n" +
-	"\x04city\x18\b \x01(\tH\x03R\x04city\x88\x01\x01B\a\n" +
+	"\x04city\x18\b \x01(\tH\x03R\x04city\x88\x01\x01\x12.\n" +
+	"\x05floor\x18\t \x01(\x05B\x13\xfaB\x10\x1a\x0e\x18\xc8\x01(\xfb\xff\xff\xff\xff\xff\xff\xff\xff\x01H\x04R\x05floor\x88\x01\x01\x122\n" +
+	"\ftotal_floors\x18\n" +
+	" \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xc8\x01(\x01H\x05R\vtotalFloors\x88\x01\x01\x12/\n" +
+	"\n" +
+	"year_built\x18\v \x01(\x05B\v\xfaB\b\x1a\x06\x18\xb4\x10(\x88\x0eH\x06R\tyearBuilt\x88\x01\x01\x12\x1f\n" +
+	"\bdistrict\x18\f \x01(\tH\aR\bdistrict\x88\x01\x01\x128\n" +
+	"\blatitude\x18\r \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0H\bR\blatitude\x88\x01\x01\x12:\n" +
+	"\tlongitude\x18\x0e \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0H\tR\tlongitude\x88\x01\x01\x12(\n" +
+	"\bfeatures\x18\x0f \x03(\tB\f\xfaB\t\x92\x01\x06\"\x04r\x02\x10\x01R\bfeaturesB\a\n" +
 	"\x05_areaB\b\n" +
 	"\x06_priceB\b\n" +
 	"\x06_roomsB\a\n" +
-	"\x05_city\"?\n" +
+	"\x05_cityB\b\n" +
+	"\x06_floorB\x0f\n" +
+	"\r_total_floorsB\r\n" +
+	"\v_year_builtB\v\n" +
+	"\t_districtB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"?\n" +
 	"\x12GetPropertyRequest\x12)\n" +
 	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
-	"propertyId\"\xbd\x06\n" +
+	"propertyId\"\x8d\a\n" +
 	"\x15ListPropertiesRequest\x12E\n" +
 	"\x06filter\x18\x01 \x01(\v2-.leadexchange.v1.ListPropertiesRequest.FilterR\x06filter\x12 \n" +
 	"\tpage_size\x18\x02 \x01(\x05H\x00R\bpageSize\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"page_token\x18\x03 \x01(\tH\x01R\tpageToken\x88\x01\x01\x12\x1e\n" +
 	"\border_by\x18\x04 \x01(\tH\x02R\aorderBy\x88\x01\x01\x12,\n" +
-	"\x0forder_direction\x18\x05 \x01(\tH\x03R\x0eorderDirection\x88\x01\x01\x1a\x8a\x04\n" +
+	"\x0forder_direction\x18\x05 \x01(\tH\x03R\x0eorderDirection\x88\x01\x01\x1a\xda\x04\n" +
 	"\x06Filter\x12<\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x1f.leadexchange.v1.PropertyStatusH\x00R\x06status\x88\x01\x01\x12'\n" +
 	"\rowner_user_id\x18\x02 \x01(\tH\x01R\vownerUserId\x88\x01\x01\x12+\n" +
@@ -2026,7 +3062,10 @@ const file_property_proto_rawDesc = "" +
 	"\tmax_rooms\x18\x06 \x01(\x05H\x05R\bmaxRooms\x88\x01\x01\x12 \n" +
 	"\tmin_price\x18\a \x01(\x03H\x06R\bminPrice\x88\x01\x01\x12 \n" +
 	"\tmax_price\x18\b \x01(\x03H\aR\bmaxPrice\x88\x01\x01\x12\x17\n" +
-	"\x04city\x18\t \x01(\tH\bR\x04city\x88\x01\x01B\t\n" +
+	"\x04city\x18\t \x01(\tH\bR\x04city\x88\x01\x01\x12,\n" +
+	"\x03geo\x18\n" +
+	" \x01(\v2\x1a.leadexchange.v1.GeoFilterR\x03geo\x12\x17\n" +
+	"\x04team\x18\v \x01(\bH\tR\x04team\x88\x01\x01B\t\n" +
 	"\a_statusB\x10\n" +
 	"\x0e_owner_user_idB\x12\n" +
 	"\x10_created_user_idB\x10\n" +
@@ -2039,16 +3078,21 @@ const file_property_proto_rawDesc = "" +
 	"_min_priceB\f\n" +
 	"\n" +
 	"_max_priceB\a\n" +
-	"\x05_cityB\f\n" +
+	"\x05_cityB\a\n" +
+	"\x05_teamB\f\n" +
 	"\n" +
 	"_page_sizeB\r\n" +
 	"\v_page_tokenB\v\n" +
 	"\t_order_byB\x12\n" +
-	"\x10_order_direction\"S\n" +
+	"\x10_order_direction\"\xb7\x01\n" +
 	"\x16ListPropertiesResponse\x129\n" +
 	"\n" +
 	"properties\x18\x01 \x03(\v2\x19.leadexchange.v1.PropertyR\n" +
-	"properties\"\xb6\x04\n" +
+	"properties\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x05R\n" +
+	"totalCount\"\xf3\a\n" +
 	"\x15UpdatePropertyRequest\x12)\n" +
 	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
 	"propertyId\x12\x19\n" +
@@ -2062,7 +3106,17 @@ const file_property_proto_rawDesc = "" +
 	"\x06status\x18\t \x01(\x0e2\x1f.leadexchange.v1.PropertyStatusH\aR\x06status\x88\x01\x01\x12'\n" +
 	"\rowner_user_id\x18\n" +
 	" \x01(\tH\bR\vownerUserId\x88\x01\x01\x12\x17\n" +
-	"\x04city\x18\v \x01(\tH\tR\x04city\x88\x01\x01B\b\n" +
+	"\x04city\x18\v \x01(\tH\tR\x04city\x88\x01\x01\x12.\n" +
+	"\x05floor\x18\f \x01(\x05B\x13\xfaB\x10\x1a\x0e\x18\xc8\x01(\xfb\xff\xff\xff\xff\xff\xff\xff\xff\x01H\n" +
+	"R\x05floor\x88\x01\x01\x122\n" +
+	"\ftotal_floors\x18\r \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xc8\x01(\x01H\vR\vtotalFloors\x88\x01\x01\x12/\n" +
+	"\n" +
+	"year_built\x18\x0e \x01(\x05B\v\xfaB\b\x1a\x06\x18\xb4\x10(\x88\x0eH\fR\tyearBuilt\x88\x01\x01\x12\x1f\n" +
+	"\bdistrict\x18\x0f \x01(\tH\rR\bdistrict\x88\x01\x01\x128\n" +
+	"\blatitude\x18\x10 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0H\x0eR\blatitude\x88\x01\x01\x12:\n" +
+	"\tlongitude\x18\x11 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0H\x0fR\tlongitude\x88\x01\x01\x12=\n" +
+	"\bfeatures\x18\x12 \x01(\v2!.leadexchange.v1.PropertyFeaturesR\bfeaturesB\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\n" +
 	"\n" +
@@ -2073,7 +3127,14 @@ const file_property_proto_rawDesc = "" +
 	"\x06_roomsB\t\n" +
 	"\a_statusB\x10\n" +
 	"\x0e_owner_user_idB\a\n" +
-	"\x05_city\"I\n" +
+	"\x05_cityB\b\n" +
+	"\x06_floorB\x0f\n" +
+	"\r_total_floorsB\r\n" +
+	"\v_year_builtB\v\n" +
+	"\t_districtB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"I\n" +
 	"\x10PropertyResponse\x125\n" +
 	"\bproperty\x18\x01 \x01(\v2\x19.leadexchange.v1.PropertyR\bproperty\"\xb9\x04\n" +
 	"\x16MatchPropertiesRequest\x12!\n" +
@@ -2099,7 +3160,7 @@ const file_property_proto_rawDesc = "" +
 	"\n" +
 	"_max_priceB\a\n" +
 	"\x05_cityB\b\n" +
-	"\x06_limit\"\x83\x04\n" +
+	"\x06_limit\"\xf0\x05\n" +
 	"\x0fMatchedProperty\x125\n" +
 	"\bproperty\x18\x01 \x01(\v2\x19.leadexchange.v1.PropertyR\bproperty\x12\x1e\n" +
 	"\n" +
@@ -2115,22 +3176,38 @@ const file_property_proto_rawDesc = "" +
 	"\n" +
 	"area_score\x18\a \x01(\x01H\x04R\tareaScore\x88\x01\x01\x12*\n" +
 	"\x0esemantic_score\x18\b \x01(\x01H\x05R\rsemanticScore\x88\x01\x01\x120\n" +
-	"\x11match_explanation\x18\t \x01(\tH\x06R\x10matchExplanation\x88\x01\x01B\x0e\n" +
+	"\x11match_explanation\x18\t \x01(\tH\x06R\x10matchExplanation\x88\x01\x01\x12*\n" +
+	"\x0edistance_score\x18\n" +
+	" \x01(\x01H\aR\rdistanceScore\x88\x01\x01\x12$\n" +
+	"\vdistance_km\x18\v \x01(\x01H\bR\n" +
+	"distanceKm\x88\x01\x01\x12&\n" +
+	"\fvisual_score\x18\f \x01(\x01H\tR\vvisualScore\x88\x01\x01\x12*\n" +
+	"\x0efeatures_score\x18\r \x01(\x01H\n" +
+	"R\rfeaturesScore\x88\x01\x01B\x0e\n" +
 	"\f_total_scoreB\x0e\n" +
 	"\f_price_scoreB\x11\n" +
 	"\x0f_district_scoreB\x0e\n" +
 	"\f_rooms_scoreB\r\n" +
 	"\v_area_scoreB\x11\n" +
 	"\x0f_semantic_scoreB\x14\n" +
-	"\x12_match_explanation\"U\n" +
+	"\x12_match_explanationB\x11\n" +
+	"\x0f_distance_scoreB\x0e\n" +
+	"\f_distance_kmB\x0f\n" +
+	"\r_visual_scoreB\x11\n" +
+	"\x0f_features_score\"\xb4\x01\n" +
 	"\x17MatchPropertiesResponse\x12:\n" +
-	"\amatches\x18\x01 \x03(\v2 .leadexchange.v1.MatchedPropertyR\amatches\"C\n" +
+	"\amatches\x18\x01 \x03(\v2 .leadexchange.v1.MatchedPropertyR\amatches\x12J\n" +
+	"\x0esearch_options\x18\x02 \x01(\v2\x1e.leadexchange.v1.SearchOptionsH\x00R\rsearchOptions\x88\x01\x01B\x11\n" +
+	"\x0f_search_options\"C\n" +
 	"\x16ReindexPropertyRequest\x12)\n" +
 	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"propertyId\"N\n" +
+	"!GetPropertyEmbeddingStatusRequest\x12)\n" +
+	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
 	"propertyId\"M\n" +
 	"\x17ReindexPropertyResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x96\x03\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xc4\x03\n" +
 	"\x0ePropertyFilter\x12\x17\n" +
 	"\x04city\x18\x01 \x01(\tH\x00R\x04city\x88\x01\x01\x12<\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1f.leadexchange.v1.PropertyStatusH\x01R\x06status\x88\x01\x01\x12G\n" +
@@ -2138,7 +3215,8 @@ const file_property_proto_rawDesc = "" +
 	"\tmin_price\x18\x04 \x01(\x03H\x03R\bminPrice\x88\x01\x01\x12 \n" +
 	"\tmax_price\x18\x05 \x01(\x03H\x04R\bmaxPrice\x88\x01\x01\x12 \n" +
 	"\tmin_rooms\x18\x06 \x01(\x05H\x05R\bminRooms\x88\x01\x01\x12 \n" +
-	"\tmax_rooms\x18\a \x01(\x05H\x06R\bmaxRooms\x88\x01\x01B\a\n" +
+	"\tmax_rooms\x18\a \x01(\x05H\x06R\bmaxRooms\x88\x01\x01\x12,\n" +
+	"\x03geo\x18\b \x01(\v2\x1a.leadexchange.v1.GeoFilterR\x03geoB\a\n" +
 	"\x05_cityB\t\n" +
 	"\a_statusB\x10\n" +
 	"\x0e_property_typeB\f\n" +
@@ -2149,26 +3227,85 @@ const file_property_proto_rawDesc = "" +
 	"\n" +
 	"_min_roomsB\f\n" +
 	"\n" +
-	"_max_rooms\"\xee\x02\n" +
+	"_max_rooms\"\xad\x01\n" +
+	"\tGeoFilter\x123\n" +
+	"\blatitude\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\x125\n" +
+	"\tlongitude\x18\x02 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\x124\n" +
+	"\tradius_km\x18\x03 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00Y@!\x00\x00\x00\x00\x00\x00\x00\x00R\bradiusKm\"\xde\x04\n" +
 	"\x1eMatchPropertiesAdvancedRequest\x12!\n" +
 	"\alead_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06leadId\x127\n" +
 	"\x06filter\x18\x02 \x01(\v2\x1f.leadexchange.v1.PropertyFilterR\x06filter\x12\x19\n" +
 	"\x05limit\x18\x03 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12/\n" +
 	"\x11use_hybrid_search\x18\x04 \x01(\bH\x01R\x0fuseHybridSearch\x88\x01\x01\x12&\n" +
 	"\fuse_reranker\x18\x05 \x01(\bH\x02R\vuseReranker\x88\x01\x01\x123\n" +
-	"\x13use_dynamic_weights\x18\x06 \x01(\bH\x03R\x11useDynamicWeights\x88\x01\x01B\b\n" +
+	"\x13use_dynamic_weights\x18\x06 \x01(\bH\x03R\x11useDynamicWeights\x88\x01\x01\x12A\n" +
+	"\rvector_weight\x18\a \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00H\x04R\fvectorWeight\x88\x01\x01\x12E\n" +
+	"\x0ffulltext_weight\x18\b \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00H\x05R\x0efulltextWeight\x88\x01\x01\x12/\n" +
+	"\n" +
+	"candidates\x18\t \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xf4\x03(\x01H\x06R\n" +
+	"candidates\x88\x01\x01B\b\n" +
 	"\x06_limitB\x14\n" +
 	"\x12_use_hybrid_searchB\x0f\n" +
 	"\r_use_rerankerB\x16\n" +
-	"\x14_use_dynamic_weights\"r\n" +
+	"\x14_use_dynamic_weightsB\x10\n" +
+	"\x0e_vector_weightB\x12\n" +
+	"\x10_fulltext_weightB\r\n" +
+	"\v_candidates\"\xd8\x02\n" +
+	"\rSearchOptions\x12#\n" +
+	"\rhybrid_search\x18\x01 \x01(\bR\fhybridSearch\x12#\n" +
+	"\rvector_weight\x18\x02 \x01(\x01R\fvectorWeight\x12'\n" +
+	"\x0ffulltext_weight\x18\x03 \x01(\x01R\x0efulltextWeight\x12!\n" +
+	"\fuse_reranker\x18\x04 \x01(\bR\vuseReranker\x12)\n" +
+	"\x10reranker_applied\x18\x05 \x01(\bR\x0frerankerApplied\x12\x1e\n" +
+	"\n" +
+	"candidates\x18\x06 \x01(\x05R\n" +
+	"candidates\x12.\n" +
+	"\x13use_dynamic_weights\x18\a \x01(\bR\x11useDynamicWeights\x126\n" +
+	"\x17dynamic_weights_applied\x18\b \x01(\bR\x15dynamicWeightsApplied\"\x7f\n" +
 	"\x18GetPropertyJSONLDRequest\x12)\n" +
 	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
-	"propertyId\x12\x1e\n" +
-	"\bbase_url\x18\x02 \x01(\tH\x00R\abaseUrl\x88\x01\x01B\v\n" +
+	"propertyId\x12+\n" +
+	"\bbase_url\x18\x02 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\x88\x01\x01H\x00R\abaseUrl\x88\x01\x01B\v\n" +
 	"\t_base_url\"<\n" +
 	"\x19GetPropertyJSONLDResponse\x12\x1f\n" +
 	"\vjsonld_data\x18\x01 \x01(\fR\n" +
-	"jsonldData\"\xf6\x03\n" +
+	"jsonldData\"\xd8\x01\n" +
+	"\rPropertyImage\x12\x19\n" +
+	"\bimage_id\x18\x01 \x01(\tR\aimageId\x12\x1f\n" +
+	"\vproperty_id\x18\x02 \x01(\tR\n" +
+	"propertyId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"`\n" +
+	"\x13PropertyImageUpload\x12 \n" +
+	"\x04file\x18\x01 \x01(\fB\f\xfaB\tz\a\x10\x01\x18\x80\x80\xc0\x02R\x04file\x12'\n" +
+	"\tfile_name\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\bfileName\"\x8f\x01\n" +
+	"\x18AddPropertyImagesRequest\x12)\n" +
+	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"propertyId\x12H\n" +
+	"\x06images\x18\x02 \x03(\v2$.leadexchange.v1.PropertyImageUploadB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x10\n" +
+	"R\x06images\"F\n" +
+	"\x19ListPropertyImagesRequest\x12)\n" +
+	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"propertyId\"l\n" +
+	"\x1aDeletePropertyImageRequest\x12)\n" +
+	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"propertyId\x12#\n" +
+	"\bimage_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\aimageId\"7\n" +
+	"\x1bDeletePropertyImageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"w\n" +
+	"\x1cReorderPropertyImagesRequest\x12)\n" +
+	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"propertyId\x12,\n" +
+	"\timage_ids\x18\x02 \x03(\tB\x0f\xfaB\f\x92\x01\t\b\x01\"\x05r\x03\xb0\x01\x01R\bimageIds\"P\n" +
+	"\x16PropertyImagesResponse\x126\n" +
+	"\x06images\x18\x01 \x03(\v2\x1e.leadexchange.v1.PropertyImageR\x06images\"\xf6\x03\n" +
 	"\x1dGenerateListingContentRequest\x12$\n" +
 	"\vproperty_id\x18\x01 \x01(\tH\x00R\n" +
 	"propertyId\x88\x01\x01\x12(\n" +
@@ -2198,18 +3335,19 @@ const file_property_proto_rawDesc = "" +
 	"\bkeywords\x18\x03 \x03(\tR\bkeywords\x12\x1e\n" +
 	"\n" +
 	"confidence\x18\x04 \x01(\x01R\n" +
-	"confidence\"h\n" +
+	"confidence\"y\n" +
 	"\x1cAnalyzePropertyImagesRequest\x12)\n" +
 	"\vproperty_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
-	"propertyId\x12\x1d\n" +
+	"propertyId\x12.\n" +
 	"\n" +
-	"image_urls\x18\x02 \x03(\tR\timageUrls\"^\n" +
+	"image_urls\x18\x02 \x03(\tB\x0f\xfaB\f\x92\x01\t\x10\n" +
+	"\"\x05r\x03\x88\x01\x01R\timageUrls\"^\n" +
 	"\fImageFeature\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
 	"\n" +
 	"confidence\x18\x02 \x01(\x01R\n" +
 	"confidence\x12\x1a\n" +
-	"\bcategory\x18\x03 \x01(\tR\bcategory\"\x86\x02\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\"\xbe\x02\n" +
 	"\x13ImageAnalysisResult\x12J\n" +
 	"\x11detected_features\x18\x01 \x03(\v2\x1d.leadexchange.v1.ImageFeatureR\x10detectedFeatures\x12 \n" +
 	"\troom_type\x18\x02 \x01(\tH\x00R\broomType\x88\x01\x01\x12#\n" +
@@ -2217,7 +3355,9 @@ const file_property_proto_rawDesc = "" +
 	"\tview_type\x18\x04 \x01(\tH\x01R\bviewType\x88\x01\x01\x12\x1e\n" +
 	"\n" +
 	"brightness\x18\x05 \x01(\x01R\n" +
-	"brightnessB\f\n" +
+	"brightness\x12\x19\n" +
+	"\bimage_id\x18\x06 \x01(\tR\aimageId\x12\x1b\n" +
+	"\timage_url\x18\a \x01(\tR\bimageUrlB\f\n" +
 	"\n" +
 	"_room_typeB\f\n" +
 	"\n" +
@@ -2242,14 +3382,19 @@ const file_property_proto_rawDesc = "" +
 	"\x13PROPERTY_STATUS_NEW\x10\x01\x12\x1d\n" +
 	"\x19PROPERTY_STATUS_PUBLISHED\x10\x02\x12\x18\n" +
 	"\x14PROPERTY_STATUS_SOLD\x10\x03\x12\x1b\n" +
-	"\x17PROPERTY_STATUS_DELETED\x10\x042\xbc\v\n" +
+	"\x17PROPERTY_STATUS_DELETED\x10\x042\xef\x11\n" +
 	"\x0fPropertyService\x12v\n" +
 	"\x0eCreateProperty\x12&.leadexchange.v1.CreatePropertyRequest\x1a!.leadexchange.v1.PropertyResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/properties\x12{\n" +
 	"\vGetProperty\x12#.leadexchange.v1.GetPropertyRequest\x1a!.leadexchange.v1.PropertyResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/properties/{property_id}\x12y\n" +
 	"\x0eListProperties\x12&.leadexchange.v1.ListPropertiesRequest\x1a'.leadexchange.v1.ListPropertiesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/properties\x12\x84\x01\n" +
 	"\x0eUpdateProperty\x12&.leadexchange.v1.UpdatePropertyRequest\x1a!.leadexchange.v1.PropertyResponse\"'\x82\xd3\xe4\x93\x02!:\x01*2\x1c/v1/properties/{property_id}\x12\x85\x01\n" +
 	"\x0fMatchProperties\x12'.leadexchange.v1.MatchPropertiesRequest\x1a(.leadexchange.v1.MatchPropertiesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/properties/match\x12\x95\x01\n" +
-	"\x0fReindexProperty\x12'.leadexchange.v1.ReindexPropertyRequest\x1a(.leadexchange.v1.ReindexPropertyResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/properties/{property_id}/reindex\x12\x9e\x01\n" +
+	"\x0fReindexProperty\x12'.leadexchange.v1.ReindexPropertyRequest\x1a(.leadexchange.v1.ReindexPropertyResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/properties/{property_id}/reindex\x12\xaa\x01\n" +
+	"\x1aGetPropertyEmbeddingStatus\x122.leadexchange.v1.GetPropertyEmbeddingStatusRequest\x1a(.leadexchange.v1.EmbeddingStatusResponse\".\x82\xd3\xe4\x93\x02(\x12&/v1/properties/{property_id}/embedding\x12\x97\x01\n" +
+	"\x11AddPropertyImages\x12).leadexchange.v1.AddPropertyImagesRequest\x1a'.leadexchange.v1.PropertyImagesResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/properties/{property_id}/images\x12\x96\x01\n" +
+	"\x12ListPropertyImages\x12*.leadexchange.v1.ListPropertyImagesRequest\x1a'.leadexchange.v1.PropertyImagesResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/properties/{property_id}/images\x12\xa8\x01\n" +
+	"\x13DeletePropertyImage\x12+.leadexchange.v1.DeletePropertyImageRequest\x1a,.leadexchange.v1.DeletePropertyImageResponse\"6\x82\xd3\xe4\x93\x020*./v1/properties/{property_id}/images/{image_id}\x12\xa5\x01\n" +
+	"\x15ReorderPropertyImages\x12-.leadexchange.v1.ReorderPropertyImagesRequest\x1a'.leadexchange.v1.PropertyImagesResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\x1a)/v1/properties/{property_id}/images/order\x12\x9e\x01\n" +
 	"\x17MatchPropertiesAdvanced\x12/.leadexchange.v1.MatchPropertiesAdvancedRequest\x1a(.leadexchange.v1.MatchPropertiesResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/properties/match/advanced\x12\x97\x01\n" +
 	"\x11GetPropertyJSONLD\x12).leadexchange.v1.GetPropertyJSONLDRequest\x1a*.leadexchange.v1.GetPropertyJSONLDResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/properties/{property_id}/jsonld\x12\xa5\x01\n" +
 	"\x16GenerateListingContent\x12..leadexchange.v1.GenerateListingContentRequest\x1a/.leadexchange.v1.GenerateListingContentResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/properties/generate-content\x12\xae\x01\n" +
@@ -2268,82 +3413,111 @@ func file_property_proto_rawDescGZIP() []byte {
 }
 
 var file_property_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_property_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_property_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_property_proto_goTypes = []any{
-	(PropertyType)(0),                      // 0: leadexchange.v1.PropertyType
-	(PropertyStatus)(0),                    // 1: leadexchange.v1.PropertyStatus
-	(*Property)(nil),                       // 2: leadexchange.v1.Property
-	(*CreatePropertyRequest)(nil),          // 3: leadexchange.v1.CreatePropertyRequest
-	(*GetPropertyRequest)(nil),             // 4: leadexchange.v1.GetPropertyRequest
-	(*ListPropertiesRequest)(nil),          // 5: leadexchange.v1.ListPropertiesRequest
-	(*ListPropertiesResponse)(nil),         // 6: leadexchange.v1.ListPropertiesResponse
-	(*UpdatePropertyRequest)(nil),          // 7: leadexchange.v1.UpdatePropertyRequest
-	(*PropertyResponse)(nil),               // 8: leadexchange.v1.PropertyResponse
-	(*MatchPropertiesRequest)(nil),         // 9: leadexchange.v1.MatchPropertiesRequest
-	(*MatchedProperty)(nil),                // 10: leadexchange.v1.MatchedProperty
-	(*MatchPropertiesResponse)(nil),        // 11: leadexchange.v1.MatchPropertiesResponse
-	(*ReindexPropertyRequest)(nil),         // 12: leadexchange.v1.ReindexPropertyRequest
-	(*ReindexPropertyResponse)(nil),        // 13: leadexchange.v1.ReindexPropertyResponse
-	(*PropertyFilter)(nil),                 // 14: leadexchange.v1.PropertyFilter
-	(*MatchPropertiesAdvancedRequest)(nil), // 15: leadexchange.v1.MatchPropertiesAdvancedRequest
-	(*GetPropertyJSONLDRequest)(nil),       // 16: leadexchange.v1.GetPropertyJSONLDRequest
-	(*GetPropertyJSONLDResponse)(nil),      // 17: leadexchange.v1.GetPropertyJSONLDResponse
-	(*GenerateListingContentRequest)(nil),  // 18: leadexchange.v1.GenerateListingContentRequest
-	(*GenerateListingContentResponse)(nil), // 19: leadexchange.v1.GenerateListingContentResponse
-	(*AnalyzePropertyImagesRequest)(nil),   // 20: leadexchange.v1.AnalyzePropertyImagesRequest
-	(*ImageFeature)(nil),                   // 21: leadexchange.v1.ImageFeature
-	(*ImageAnalysisResult)(nil),            // 22: leadexchange.v1.ImageAnalysisResult
-	(*AnalyzePropertyImagesResponse)(nil),  // 23: leadexchange.v1.AnalyzePropertyImagesResponse
-	(*ListPropertiesRequest_Filter)(nil),   // 24: leadexchange.v1.ListPropertiesRequest.Filter
-	(*MatchPropertiesRequest_Filter)(nil),  // 25: leadexchange.v1.MatchPropertiesRequest.Filter
+	(PropertyType)(0),                         // 0: leadexchange.v1.PropertyType
+	(PropertyStatus)(0),                       // 1: leadexchange.v1.PropertyStatus
+	(*Property)(nil),                          // 2: leadexchange.v1.Property
+	(*PropertyFeatures)(nil),                  // 3: leadexchange.v1.PropertyFeatures
+	(*CreatePropertyRequest)(nil),             // 4: leadexchange.v1.CreatePropertyRequest
+	(*GetPropertyRequest)(nil),                // 5: leadexchange.v1.GetPropertyRequest
+	(*ListPropertiesRequest)(nil),             // 6: leadexchange.v1.ListPropertiesRequest
+	(*ListPropertiesResponse)(nil),            // 7: leadexchange.v1.ListPropertiesResponse
+	(*UpdatePropertyRequest)(nil),             // 8: leadexchange.v1.UpdatePropertyRequest
+	(*PropertyResponse)(nil),                  // 9: leadexchange.v1.PropertyResponse
+	(*MatchPropertiesRequest)(nil),            // 10: leadexchange.v1.MatchPropertiesRequest
+	(*MatchedProperty)(nil),                   // 11: leadexchange.v1.MatchedProperty
+	(*MatchPropertiesResponse)(nil),           // 12: leadexchange.v1.MatchPropertiesResponse
+	(*ReindexPropertyRequest)(nil),            // 13: leadexchange.v1.ReindexPropertyRequest
+	(*GetPropertyEmbeddingStatusRequest)(nil), // 14: leadexchange.v1.GetPropertyEmbeddingStatusRequest
+	(*ReindexPropertyResponse)(nil),           // 15: leadexchange.v1.ReindexPropertyResponse
+	(*PropertyFilter)(nil),                    // 16: leadexchange.v1.PropertyFilter
+	(*GeoFilter)(nil),                         // 17: leadexchange.v1.GeoFilter
+	(*MatchPropertiesAdvancedRequest)(nil),    // 18: leadexchange.v1.MatchPropertiesAdvancedRequest
+	(*SearchOptions)(nil),                     // 19: leadexchange.v1.SearchOptions
+	(*GetPropertyJSONLDRequest)(nil),          // 20: leadexchange.v1.GetPropertyJSONLDRequest
+	(*GetPropertyJSONLDResponse)(nil),         // 21: leadexchange.v1.GetPropertyJSONLDResponse
+	(*PropertyImage)(nil),                     // 22: leadexchange.v1.PropertyImage
+	(*PropertyImageUpload)(nil),               // 23: leadexchange.v1.PropertyImageUpload
+	(*AddPropertyImagesRequest)(nil),          // 24: leadexchange.v1.AddPropertyImagesRequest
+	(*ListPropertyImagesRequest)(nil),         // 25: leadexchange.v1.ListPropertyImagesRequest
+	(*DeletePropertyImageRequest)(nil),        // 26: leadexchange.v1.DeletePropertyImageRequest
+	(*DeletePropertyImageResponse)(nil),       // 27: leadexchange.v1.DeletePropertyImageResponse
+	(*ReorderPropertyImagesRequest)(nil),      // 28: leadexchange.v1.ReorderPropertyImagesRequest
+	(*PropertyImagesResponse)(nil),            // 29: leadexchange.v1.PropertyImagesResponse
+	(*GenerateListingContentRequest)(nil),     // 30: leadexchange.v1.GenerateListingContentRequest
+	(*GenerateListingContentResponse)(nil),    // 31: leadexchange.v1.GenerateListingContentResponse
+	(*AnalyzePropertyImagesRequest)(nil),      // 32: leadexchange.v1.AnalyzePropertyImagesRequest
+	(*ImageFeature)(nil),                      // 33: leadexchange.v1.ImageFeature
+	(*ImageAnalysisResult)(nil),               // 34: leadexchange.v1.ImageAnalysisResult
+	(*AnalyzePropertyImagesResponse)(nil),     // 35: leadexchange.v1.AnalyzePropertyImagesResponse
+	(*ListPropertiesRequest_Filter)(nil),      // 36: leadexchange.v1.ListPropertiesRequest.Filter
+	(*MatchPropertiesRequest_Filter)(nil),     // 37: leadexchange.v1.MatchPropertiesRequest.Filter
+	(*EmbeddingStatusResponse)(nil),           // 38: leadexchange.v1.EmbeddingStatusResponse
 }
 var file_property_proto_depIdxs = []int32{
 	0,  // 0: leadexchange.v1.Property.property_type:type_name -> leadexchange.v1.PropertyType
 	1,  // 1: leadexchange.v1.Property.status:type_name -> leadexchange.v1.PropertyStatus
 	0,  // 2: leadexchange.v1.CreatePropertyRequest.property_type:type_name -> leadexchange.v1.PropertyType
-	24, // 3: leadexchange.v1.ListPropertiesRequest.filter:type_name -> leadexchange.v1.ListPropertiesRequest.Filter
+	36, // 3: leadexchange.v1.ListPropertiesRequest.filter:type_name -> leadexchange.v1.ListPropertiesRequest.Filter
 	2,  // 4: leadexchange.v1.ListPropertiesResponse.properties:type_name -> leadexchange.v1.Property
 	0,  // 5: leadexchange.v1.UpdatePropertyRequest.property_type:type_name -> leadexchange.v1.PropertyType
 	1,  // 6: leadexchange.v1.UpdatePropertyRequest.status:type_name -> leadexchange.v1.PropertyStatus
-	2,  // 7: leadexchange.v1.PropertyResponse.property:type_name -> leadexchange.v1.Property
-	25, // 8: leadexchange.v1.MatchPropertiesRequest.filter:type_name -> leadexchange.v1.MatchPropertiesRequest.Filter
-	2,  // 9: leadexchange.v1.MatchedProperty.property:type_name -> leadexchange.v1.Property
-	10, // 10: leadexchange.v1.MatchPropertiesResponse.matches:type_name -> leadexchange.v1.MatchedProperty
-	1,  // 11: leadexchange.v1.PropertyFilter.status:type_name -> leadexchange.v1.PropertyStatus
-	0,  // 12: leadexchange.v1.PropertyFilter.property_type:type_name -> leadexchange.v1.PropertyType
-	14, // 13: leadexchange.v1.MatchPropertiesAdvancedRequest.filter:type_name -> leadexchange.v1.PropertyFilter
-	21, // 14: leadexchange.v1.ImageAnalysisResult.detected_features:type_name -> leadexchange.v1.ImageFeature
-	21, // 15: leadexchange.v1.AnalyzePropertyImagesResponse.all_features:type_name -> leadexchange.v1.ImageFeature
-	22, // 16: leadexchange.v1.AnalyzePropertyImagesResponse.image_results:type_name -> leadexchange.v1.ImageAnalysisResult
-	1,  // 17: leadexchange.v1.ListPropertiesRequest.Filter.status:type_name -> leadexchange.v1.PropertyStatus
-	0,  // 18: leadexchange.v1.ListPropertiesRequest.Filter.property_type:type_name -> leadexchange.v1.PropertyType
-	1,  // 19: leadexchange.v1.MatchPropertiesRequest.Filter.status:type_name -> leadexchange.v1.PropertyStatus
-	0,  // 20: leadexchange.v1.MatchPropertiesRequest.Filter.property_type:type_name -> leadexchange.v1.PropertyType
-	3,  // 21: leadexchange.v1.PropertyService.CreateProperty:input_type -> leadexchange.v1.CreatePropertyRequest
-	4,  // 22: leadexchange.v1.PropertyService.GetProperty:input_type -> leadexchange.v1.GetPropertyRequest
-	5,  // 23: leadexchange.v1.PropertyService.ListProperties:input_type -> leadexchange.v1.ListPropertiesRequest
-	7,  // 24: leadexchange.v1.PropertyService.UpdateProperty:input_type -> leadexchange.v1.UpdatePropertyRequest
-	9,  // 25: leadexchange.v1.PropertyService.MatchProperties:input_type -> leadexchange.v1.MatchPropertiesRequest
-	12, // 26: leadexchange.v1.PropertyService.ReindexProperty:input_type -> leadexchange.v1.ReindexPropertyRequest
-	15, // 27: leadexchange.v1.PropertyService.MatchPropertiesAdvanced:input_type -> leadexchange.v1.MatchPropertiesAdvancedRequest
-	16, // 28: leadexchange.v1.PropertyService.GetPropertyJSONLD:input_type -> leadexchange.v1.GetPropertyJSONLDRequest
-	18, // 29: leadexchange.v1.PropertyService.GenerateListingContent:input_type -> leadexchange.v1.GenerateListingContentRequest
-	20, // 30: leadexchange.v1.PropertyService.AnalyzePropertyImages:input_type -> leadexchange.v1.AnalyzePropertyImagesRequest
-	8,  // 31: leadexchange.v1.PropertyService.CreateProperty:output_type -> leadexchange.v1.PropertyResponse
-	8,  // 32: leadexchange.v1.PropertyService.GetProperty:output_type -> leadexchange.v1.PropertyResponse
-	6,  // 33: leadexchange.v1.PropertyService.ListProperties:output_type -> leadexchange.v1.ListPropertiesResponse
-	8,  // 34: leadexchange.v1.PropertyService.UpdateProperty:output_type -> leadexchange.v1.PropertyResponse
-	11, // 35: leadexchange.v1.PropertyService.MatchProperties:output_type -> leadexchange.v1.MatchPropertiesResponse
-	13, // 36: leadexchange.v1.PropertyService.ReindexProperty:output_type -> leadexchange.v1.ReindexPropertyResponse
-	11, // 37: leadexchange.v1.PropertyService.MatchPropertiesAdvanced:output_type -> leadexchange.v1.MatchPropertiesResponse
-	17, // 38: leadexchange.v1.PropertyService.GetPropertyJSONLD:output_type -> leadexchange.v1.GetPropertyJSONLDResponse
-	19, // 39: leadexchange.v1.PropertyService.GenerateListingContent:output_type -> leadexchange.v1.GenerateListingContentResponse
-	23, // 40: leadexchange.v1.PropertyService.AnalyzePropertyImages:output_type -> leadexchange.v1.AnalyzePropertyImagesResponse
-	31, // [31:41] is the sub-list for method output_type
-	21, // [21:31] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	3,  // 7: leadexchange.v1.UpdatePropertyRequest.features:type_name -> leadexchange.v1.PropertyFeatures
+	2,  // 8: leadexchange.v1.PropertyResponse.property:type_name -> leadexchange.v1.Property
+	37, // 9: leadexchange.v1.MatchPropertiesRequest.filter:type_name -> leadexchange.v1.MatchPropertiesRequest.Filter
+	2,  // 10: leadexchange.v1.MatchedProperty.property:type_name -> leadexchange.v1.Property
+	11, // 11: leadexchange.v1.MatchPropertiesResponse.matches:type_name -> leadexchange.v1.MatchedProperty
+	19, // 12: leadexchange.v1.MatchPropertiesResponse.search_options:type_name -> leadexchange.v1.SearchOptions
+	1,  // 13: leadexchange.v1.PropertyFilter.status:type_name -> leadexchange.v1.PropertyStatus
+	0,  // 14: leadexchange.v1.PropertyFilter.property_type:type_name -> leadexchange.v1.PropertyType
+	17, // 15: leadexchange.v1.PropertyFilter.geo:type_name -> leadexchange.v1.GeoFilter
+	16, // 16: leadexchange.v1.MatchPropertiesAdvancedRequest.filter:type_name -> leadexchange.v1.PropertyFilter
+	23, // 17: leadexchange.v1.AddPropertyImagesRequest.images:type_name -> leadexchange.v1.PropertyImageUpload
+	22, // 18: leadexchange.v1.PropertyImagesResponse.images:type_name -> leadexchange.v1.PropertyImage
+	33, // 19: leadexchange.v1.ImageAnalysisResult.detected_features:type_name -> leadexchange.v1.ImageFeature
+	33, // 20: leadexchange.v1.AnalyzePropertyImagesResponse.all_features:type_name -> leadexchange.v1.ImageFeature
+	34, // 21: leadexchange.v1.AnalyzePropertyImagesResponse.image_results:type_name -> leadexchange.v1.ImageAnalysisResult
+	1,  // 22: leadexchange.v1.ListPropertiesRequest.Filter.status:type_name -> leadexchange.v1.PropertyStatus
+	0,  // 23: leadexchange.v1.ListPropertiesRequest.Filter.property_type:type_name -> leadexchange.v1.PropertyType
+	17, // 24: leadexchange.v1.ListPropertiesRequest.Filter.geo:type_name -> leadexchange.v1.GeoFilter
+	1,  // 25: leadexchange.v1.MatchPropertiesRequest.Filter.status:type_name -> leadexchange.v1.PropertyStatus
+	0,  // 26: leadexchange.v1.MatchPropertiesRequest.Filter.property_type:type_name -> leadexchange.v1.PropertyType
+	4,  // 27: leadexchange.v1.PropertyService.CreateProperty:input_type -> leadexchange.v1.CreatePropertyRequest
+	5,  // 28: leadexchange.v1.PropertyService.GetProperty:input_type -> leadexchange.v1.GetPropertyRequest
+	6,  // 29: leadexchange.v1.PropertyService.ListProperties:input_type -> leadexchange.v1.ListPropertiesRequest
+	8,  // 30: leadexchange.v1.PropertyService.UpdateProperty:input_type -> leadexchange.v1.UpdatePropertyRequest
+	10, // 31: leadexchange.v1.PropertyService.MatchProperties:input_type -> leadexchange.v1.MatchPropertiesRequest
+	13, // 32: leadexchange.v1.PropertyService.ReindexProperty:input_type -> leadexchange.v1.ReindexPropertyRequest
+	14, // 33: leadexchange.v1.PropertyService.GetPropertyEmbeddingStatus:input_type -> leadexchange.v1.GetPropertyEmbeddingStatusRequest
+	24, // 34: leadexchange.v1.PropertyService.AddPropertyImages:input_type -> leadexchange.v1.AddPropertyImagesRequest
+	25, // 35: leadexchange.v1.PropertyService.ListPropertyImages:input_type -> leadexchange.v1.ListPropertyImagesRequest
+	26, // 36: leadexchange.v1.PropertyService.DeletePropertyImage:input_type -> leadexchange.v1.DeletePropertyImageRequest
+	28, // 37: leadexchange.v1.PropertyService.ReorderPropertyImages:input_type -> leadexchange.v1.ReorderPropertyImagesRequest
+	18, // 38: leadexchange.v1.PropertyService.MatchPropertiesAdvanced:input_type -> leadexchange.v1.MatchPropertiesAdvancedRequest
+	20, // 39: leadexchange.v1.PropertyService.GetPropertyJSONLD:input_type -> leadexchange.v1.GetPropertyJSONLDRequest
+	30, // 40: leadexchange.v1.PropertyService.GenerateListingContent:input_type -> leadexchange.v1.GenerateListingContentRequest
+	32, // 41: leadexchange.v1.PropertyService.AnalyzePropertyImages:input_type -> leadexchange.v1.AnalyzePropertyImagesRequest
+	9,  // 42: leadexchange.v1.PropertyService.CreateProperty:output_type -> leadexchange.v1.PropertyResponse
+	9,  // 43: leadexchange.v1.PropertyService.GetProperty:output_type -> leadexchange.v1.PropertyResponse
+	7,  // 44: leadexchange.v1.PropertyService.ListProperties:output_type -> leadexchange.v1.ListPropertiesResponse
+	9,  // 45: leadexchange.v1.PropertyService.UpdateProperty:output_type -> leadexchange.v1.PropertyResponse
+	12, // 46: leadexchange.v1.PropertyService.MatchProperties:output_type -> leadexchange.v1.MatchPropertiesResponse
+	15, // 47: leadexchange.v1.PropertyService.ReindexProperty:output_type -> leadexchange.v1.ReindexPropertyResponse
+	38, // 48: leadexchange.v1.PropertyService.GetPropertyEmbeddingStatus:output_type -> leadexchange.v1.EmbeddingStatusResponse
+	29, // 49: leadexchange.v1.PropertyService.AddPropertyImages:output_type -> leadexchange.v1.PropertyImagesResponse
+	29, // 50: leadexchange.v1.PropertyService.ListPropertyImages:output_type -> leadexchange.v1.PropertyImagesResponse
+	27, // 51: leadexchange.v1.PropertyService.DeletePropertyImage:output_type -> leadexchange.v1.DeletePropertyImageResponse
+	29, // 52: leadexchange.v1.PropertyService.ReorderPropertyImages:output_type -> leadexchange.v1.PropertyImagesResponse
+	12, // 53: leadexchange.v1.PropertyService.MatchPropertiesAdvanced:output_type -> leadexchange.v1.MatchPropertiesResponse
+	21, // 54: leadexchange.v1.PropertyService.GetPropertyJSONLD:output_type -> leadexchange.v1.GetPropertyJSONLDResponse
+	31, // 55: leadexchange.v1.PropertyService.GenerateListingContent:output_type -> leadexchange.v1.GenerateListingContentResponse
+	35, // 56: leadexchange.v1.PropertyService.AnalyzePropertyImages:output_type -> leadexchange.v1.AnalyzePropertyImagesResponse
+	42, // [42:57] is the sub-list for method output_type
+	27, // [27:42] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_property_proto_init() }
@@ -2351,26 +3525,28 @@ func file_property_proto_init() {
 	if File_property_proto != nil {
 		return
 	}
+	file_embedding_proto_init()
 	file_property_proto_msgTypes[0].OneofWrappers = []any{}
-	file_property_proto_msgTypes[1].OneofWrappers = []any{}
-	file_property_proto_msgTypes[3].OneofWrappers = []any{}
-	file_property_proto_msgTypes[5].OneofWrappers = []any{}
-	file_property_proto_msgTypes[7].OneofWrappers = []any{}
+	file_property_proto_msgTypes[2].OneofWrappers = []any{}
+	file_property_proto_msgTypes[4].OneofWrappers = []any{}
+	file_property_proto_msgTypes[6].OneofWrappers = []any{}
 	file_property_proto_msgTypes[8].OneofWrappers = []any{}
-	file_property_proto_msgTypes[12].OneofWrappers = []any{}
-	file_property_proto_msgTypes[13].OneofWrappers = []any{}
+	file_property_proto_msgTypes[9].OneofWrappers = []any{}
+	file_property_proto_msgTypes[10].OneofWrappers = []any{}
 	file_property_proto_msgTypes[14].OneofWrappers = []any{}
 	file_property_proto_msgTypes[16].OneofWrappers = []any{}
-	file_property_proto_msgTypes[20].OneofWrappers = []any{}
-	file_property_proto_msgTypes[22].OneofWrappers = []any{}
-	file_property_proto_msgTypes[23].OneofWrappers = []any{}
+	file_property_proto_msgTypes[18].OneofWrappers = []any{}
+	file_property_proto_msgTypes[28].OneofWrappers = []any{}
+	file_property_proto_msgTypes[32].OneofWrappers = []any{}
+	file_property_proto_msgTypes[34].OneofWrappers = []any{}
+	file_property_proto_msgTypes[35].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_property_proto_rawDesc), len(file_property_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   24,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -134,6 +134,30 @@ func (m *Property) validate(all bool) error {
 		// no validation rules for City
 	}
 
+	if m.Floor != nil {
+		// no validation rules for Floor
+	}
+
+	if m.TotalFloors != nil {
+		// no validation rules for TotalFloors
+	}
+
+	if m.YearBuilt != nil {
+		// no validation rules for YearBuilt
+	}
+
+	if m.District != nil {
+		// no validation rules for District
+	}
+
+	if m.Latitude != nil {
+		// no validation rules for Latitude
+	}
+
+	if m.Longitude != nil {
+		// no validation rules for Longitude
+	}
+
 	if len(errors) > 0 {
 		return PropertyMultiError(errors)
 	}
@@ -219,6 +243,122 @@ var _ interface {
 	ErrorName() string
 } = PropertyValidationError{}
 
+// Validate checks the field values on PropertyFeatures with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PropertyFeatures) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PropertyFeatures with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PropertyFeaturesMultiError, or nil if none found.
+func (m *PropertyFeatures) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PropertyFeatures) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetValues() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := PropertyFeaturesValidationError{
+				field:  fmt.Sprintf("Values[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PropertyFeaturesMultiError(errors)
+	}
+
+	return nil
+}
+
+// PropertyFeaturesMultiError is an error wrapping multiple validation errors
+// returned by PropertyFeatures.ValidateAll() if the designated constraints
+// aren't met.
+type PropertyFeaturesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PropertyFeaturesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PropertyFeaturesMultiError) AllErrors() []error { return m }
+
+// PropertyFeaturesValidationError is the validation error returned by
+// PropertyFeatures.Validate if the designated constraints aren't met.
+type PropertyFeaturesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PropertyFeaturesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PropertyFeaturesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PropertyFeaturesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PropertyFeaturesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PropertyFeaturesValidationError) ErrorName() string { return "PropertyFeaturesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PropertyFeaturesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPropertyFeatures.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PropertyFeaturesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PropertyFeaturesValidationError{}
+
 // Validate checks the field values on CreatePropertyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -276,6 +416,22 @@ func (m *CreatePropertyRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	for idx, item := range m.GetFeatures() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := CreatePropertyRequestValidationError{
+				field:  fmt.Sprintf("Features[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if m.Area != nil {
 		// no validation rules for Area
 	}
@@ -292,6 +448,85 @@ func (m *CreatePropertyRequest) validate(all bool) error {
 		// no validation rules for City
 	}
 
+	if m.Floor != nil {
+
+		if val := m.GetFloor(); val < -5 || val > 200 {
+			err := CreatePropertyRequestValidationError{
+				field:  "Floor",
+				reason: "value must be inside range [-5, 200]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.TotalFloors != nil {
+
+		if val := m.GetTotalFloors(); val < 1 || val > 200 {
+			err := CreatePropertyRequestValidationError{
+				field:  "TotalFloors",
+				reason: "value must be inside range [1, 200]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.YearBuilt != nil {
+
+		if val := m.GetYearBuilt(); val < 1800 || val > 2100 {
+			err := CreatePropertyRequestValidationError{
+				field:  "YearBuilt",
+				reason: "value must be inside range [1800, 2100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.District != nil {
+		// no validation rules for District
+	}
+
+	if m.Latitude != nil {
+
+		if val := m.GetLatitude(); val < -90 || val > 90 {
+			err := CreatePropertyRequestValidationError{
+				field:  "Latitude",
+				reason: "value must be inside range [-90, 90]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Longitude != nil {
+
+		if val := m.GetLongitude(); val < -180 || val > 180 {
+			err := CreatePropertyRequestValidationError{
+				field:  "Longitude",
+				reason: "value must be inside range [-180, 180]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreatePropertyRequestMultiError(errors)
 	}
@@ -697,6 +932,12 @@ func (m *ListPropertiesResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
+	// no validation rules for HasMore
+
+	// no validation rules for TotalCount
+
 	if len(errors) > 0 {
 		return ListPropertiesResponseMultiError(errors)
 	}
@@ -811,6 +1052,35 @@ func (m *UpdatePropertyRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetFeatures()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePropertyRequestValidationError{
+					field:  "Features",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePropertyRequestValidationError{
+					field:  "Features",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFeatures()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePropertyRequestValidationError{
+				field:  "Features",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Title != nil {
 		// no validation rules for Title
 	}
@@ -851,6 +1121,85 @@ func (m *UpdatePropertyRequest) validate(all bool) error {
 		// no validation rules for City
 	}
 
+	if m.Floor != nil {
+
+		if val := m.GetFloor(); val < -5 || val > 200 {
+			err := UpdatePropertyRequestValidationError{
+				field:  "Floor",
+				reason: "value must be inside range [-5, 200]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.TotalFloors != nil {
+
+		if val := m.GetTotalFloors(); val < 1 || val > 200 {
+			err := UpdatePropertyRequestValidationError{
+				field:  "TotalFloors",
+				reason: "value must be inside range [1, 200]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.YearBuilt != nil {
+
+		if val := m.GetYearBuilt(); val < 1800 || val > 2100 {
+			err := UpdatePropertyRequestValidationError{
+				field:  "YearBuilt",
+				reason: "value must be inside range [1800, 2100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.District != nil {
+		// no validation rules for District
+	}
+
+	if m.Latitude != nil {
+
+		if val := m.GetLatitude(); val < -90 || val > 90 {
+			err := UpdatePropertyRequestValidationError{
+				field:  "Latitude",
+				reason: "value must be inside range [-90, 90]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Longitude != nil {
+
+		if val := m.GetLongitude(); val < -180 || val > 180 {
+			err := UpdatePropertyRequestValidationError{
+				field:  "Longitude",
+				reason: "value must be inside range [-180, 180]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return UpdatePropertyRequestMultiError(errors)
 	}
@@ -1304,6 +1653,22 @@ func (m *MatchedProperty) validate(all bool) error {
 		// no validation rules for MatchExplanation
 	}
 
+	if m.DistanceScore != nil {
+		// no validation rules for DistanceScore
+	}
+
+	if m.DistanceKm != nil {
+		// no validation rules for DistanceKm
+	}
+
+	if m.VisualScore != nil {
+		// no validation rules for VisualScore
+	}
+
+	if m.FeaturesScore != nil {
+		// no validation rules for FeaturesScore
+	}
+
 	if len(errors) > 0 {
 		return MatchedPropertyMultiError(errors)
 	}
@@ -1438,6 +1803,39 @@ func (m *MatchPropertiesResponse) validate(all bool) error {
 
 	}
 
+	if m.SearchOptions != nil {
+
+		if all {
+			switch v := interface{}(m.GetSearchOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MatchPropertiesResponseValidationError{
+						field:  "SearchOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MatchPropertiesResponseValidationError{
+						field:  "SearchOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSearchOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MatchPropertiesResponseValidationError{
+					field:  "SearchOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return MatchPropertiesResponseMultiError(errors)
 	}
@@ -1640,36 +2038,161 @@ var _ interface {
 	ErrorName() string
 } = ReindexPropertyRequestValidationError{}
 
-// Validate checks the field values on ReindexPropertyResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReindexPropertyResponse) Validate() error {
+// Validate checks the field values on GetPropertyEmbeddingStatusRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetPropertyEmbeddingStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReindexPropertyResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ReindexPropertyResponseMultiError, or nil if none found.
-func (m *ReindexPropertyResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetPropertyEmbeddingStatusRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetPropertyEmbeddingStatusRequestMultiError, or nil if none found.
+func (m *GetPropertyEmbeddingStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReindexPropertyResponse) validate(all bool) error {
+func (m *GetPropertyEmbeddingStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
-
-	// no validation rules for Message
-
-	if len(errors) > 0 {
-		return ReindexPropertyResponseMultiError(errors)
-	}
-
+	if err := m._validateUuid(m.GetPropertyId()); err != nil {
+		err = GetPropertyEmbeddingStatusRequestValidationError{
+			field:  "PropertyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPropertyEmbeddingStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetPropertyEmbeddingStatusRequest) _validateUuid(uuid string) error {
+	if matched := _property_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetPropertyEmbeddingStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetPropertyEmbeddingStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPropertyEmbeddingStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPropertyEmbeddingStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPropertyEmbeddingStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetPropertyEmbeddingStatusRequestValidationError is the validation error
+// returned by GetPropertyEmbeddingStatusRequest.Validate if the designated
+// constraints aren't met.
+type GetPropertyEmbeddingStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPropertyEmbeddingStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPropertyEmbeddingStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPropertyEmbeddingStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPropertyEmbeddingStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPropertyEmbeddingStatusRequestValidationError) ErrorName() string {
+	return "GetPropertyEmbeddingStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPropertyEmbeddingStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPropertyEmbeddingStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPropertyEmbeddingStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPropertyEmbeddingStatusRequestValidationError{}
+
+// Validate checks the field values on ReindexPropertyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReindexPropertyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReindexPropertyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReindexPropertyResponseMultiError, or nil if none found.
+func (m *ReindexPropertyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReindexPropertyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ReindexPropertyResponseMultiError(errors)
+	}
+
 	return nil
 }
 
@@ -1768,6 +2291,35 @@ func (m *PropertyFilter) validate(all bool) error {
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetGeo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PropertyFilterValidationError{
+					field:  "Geo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PropertyFilterValidationError{
+					field:  "Geo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PropertyFilterValidationError{
+				field:  "Geo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.City != nil {
 		// no validation rules for City
 	}
@@ -1830,22 +2382,1399 @@ type PropertyFilterValidationError struct {
 }
 
 // Field function returns field value.
-func (e PropertyFilterValidationError) Field() string { return e.field }
+func (e PropertyFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PropertyFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PropertyFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PropertyFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PropertyFilterValidationError) ErrorName() string { return "PropertyFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PropertyFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPropertyFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PropertyFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PropertyFilterValidationError{}
+
+// Validate checks the field values on GeoFilter with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GeoFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GeoFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GeoFilterMultiError, or nil
+// if none found.
+func (m *GeoFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GeoFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLatitude(); val < -90 || val > 90 {
+		err := GeoFilterValidationError{
+			field:  "Latitude",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLongitude(); val < -180 || val > 180 {
+		err := GeoFilterValidationError{
+			field:  "Longitude",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetRadiusKm(); val <= 0 || val > 100 {
+		err := GeoFilterValidationError{
+			field:  "RadiusKm",
+			reason: "value must be inside range (0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GeoFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// GeoFilterMultiError is an error wrapping multiple validation errors returned
+// by GeoFilter.ValidateAll() if the designated constraints aren't met.
+type GeoFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GeoFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GeoFilterMultiError) AllErrors() []error { return m }
+
+// GeoFilterValidationError is the validation error returned by
+// GeoFilter.Validate if the designated constraints aren't met.
+type GeoFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GeoFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GeoFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GeoFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GeoFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GeoFilterValidationError) ErrorName() string { return "GeoFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GeoFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGeoFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GeoFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GeoFilterValidationError{}
+
+// Validate checks the field values on MatchPropertiesAdvancedRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MatchPropertiesAdvancedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MatchPropertiesAdvancedRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MatchPropertiesAdvancedRequestMultiError, or nil if none found.
+func (m *MatchPropertiesAdvancedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MatchPropertiesAdvancedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetLeadId()); err != nil {
+		err = MatchPropertiesAdvancedRequestValidationError{
+			field:  "LeadId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MatchPropertiesAdvancedRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MatchPropertiesAdvancedRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MatchPropertiesAdvancedRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Limit != nil {
+		// no validation rules for Limit
+	}
+
+	if m.UseHybridSearch != nil {
+		// no validation rules for UseHybridSearch
+	}
+
+	if m.UseReranker != nil {
+		// no validation rules for UseReranker
+	}
+
+	if m.UseDynamicWeights != nil {
+		// no validation rules for UseDynamicWeights
+	}
+
+	if m.VectorWeight != nil {
+
+		if val := m.GetVectorWeight(); val < 0 || val > 1 {
+			err := MatchPropertiesAdvancedRequestValidationError{
+				field:  "VectorWeight",
+				reason: "value must be inside range [0, 1]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.FulltextWeight != nil {
+
+		if val := m.GetFulltextWeight(); val < 0 || val > 1 {
+			err := MatchPropertiesAdvancedRequestValidationError{
+				field:  "FulltextWeight",
+				reason: "value must be inside range [0, 1]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Candidates != nil {
+
+		if val := m.GetCandidates(); val < 1 || val > 500 {
+			err := MatchPropertiesAdvancedRequestValidationError{
+				field:  "Candidates",
+				reason: "value must be inside range [1, 500]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MatchPropertiesAdvancedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *MatchPropertiesAdvancedRequest) _validateUuid(uuid string) error {
+	if matched := _property_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// MatchPropertiesAdvancedRequestMultiError is an error wrapping multiple
+// validation errors returned by MatchPropertiesAdvancedRequest.ValidateAll()
+// if the designated constraints aren't met.
+type MatchPropertiesAdvancedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MatchPropertiesAdvancedRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MatchPropertiesAdvancedRequestMultiError) AllErrors() []error { return m }
+
+// MatchPropertiesAdvancedRequestValidationError is the validation error
+// returned by MatchPropertiesAdvancedRequest.Validate if the designated
+// constraints aren't met.
+type MatchPropertiesAdvancedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchPropertiesAdvancedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchPropertiesAdvancedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchPropertiesAdvancedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchPropertiesAdvancedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchPropertiesAdvancedRequestValidationError) ErrorName() string {
+	return "MatchPropertiesAdvancedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MatchPropertiesAdvancedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatchPropertiesAdvancedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchPropertiesAdvancedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchPropertiesAdvancedRequestValidationError{}
+
+// Validate checks the field values on SearchOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchOptionsMultiError, or
+// nil if none found.
+func (m *SearchOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HybridSearch
+
+	// no validation rules for VectorWeight
+
+	// no validation rules for FulltextWeight
+
+	// no validation rules for UseReranker
+
+	// no validation rules for RerankerApplied
+
+	// no validation rules for Candidates
+
+	// no validation rules for UseDynamicWeights
+
+	// no validation rules for DynamicWeightsApplied
+
+	if len(errors) > 0 {
+		return SearchOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchOptionsMultiError is an error wrapping multiple validation errors
+// returned by SearchOptions.ValidateAll() if the designated constraints
+// aren't met.
+type SearchOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchOptionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchOptionsMultiError) AllErrors() []error { return m }
+
+// SearchOptionsValidationError is the validation error returned by
+// SearchOptions.Validate if the designated constraints aren't met.
+type SearchOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchOptionsValidationError) ErrorName() string { return "SearchOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchOptionsValidationError{}
+
+// Validate checks the field values on GetPropertyJSONLDRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPropertyJSONLDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPropertyJSONLDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPropertyJSONLDRequestMultiError, or nil if none found.
+func (m *GetPropertyJSONLDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPropertyJSONLDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPropertyId()); err != nil {
+		err = GetPropertyJSONLDRequestValidationError{
+			field:  "PropertyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.BaseUrl != nil {
+
+		if m.GetBaseUrl() != "" {
+
+			if uri, err := url.Parse(m.GetBaseUrl()); err != nil {
+				err = GetPropertyJSONLDRequestValidationError{
+					field:  "BaseUrl",
+					reason: "value must be a valid URI",
+					cause:  err,
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			} else if !uri.IsAbs() {
+				err := GetPropertyJSONLDRequestValidationError{
+					field:  "BaseUrl",
+					reason: "value must be absolute",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPropertyJSONLDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetPropertyJSONLDRequest) _validateUuid(uuid string) error {
+	if matched := _property_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetPropertyJSONLDRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPropertyJSONLDRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPropertyJSONLDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPropertyJSONLDRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPropertyJSONLDRequestMultiError) AllErrors() []error { return m }
+
+// GetPropertyJSONLDRequestValidationError is the validation error returned by
+// GetPropertyJSONLDRequest.Validate if the designated constraints aren't met.
+type GetPropertyJSONLDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPropertyJSONLDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPropertyJSONLDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPropertyJSONLDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPropertyJSONLDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPropertyJSONLDRequestValidationError) ErrorName() string {
+	return "GetPropertyJSONLDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPropertyJSONLDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPropertyJSONLDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPropertyJSONLDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPropertyJSONLDRequestValidationError{}
+
+// Validate checks the field values on GetPropertyJSONLDResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPropertyJSONLDResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPropertyJSONLDResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPropertyJSONLDResponseMultiError, or nil if none found.
+func (m *GetPropertyJSONLDResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPropertyJSONLDResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JsonldData
+
+	if len(errors) > 0 {
+		return GetPropertyJSONLDResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPropertyJSONLDResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPropertyJSONLDResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetPropertyJSONLDResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPropertyJSONLDResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPropertyJSONLDResponseMultiError) AllErrors() []error { return m }
+
+// GetPropertyJSONLDResponseValidationError is the validation error returned by
+// GetPropertyJSONLDResponse.Validate if the designated constraints aren't met.
+type GetPropertyJSONLDResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPropertyJSONLDResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPropertyJSONLDResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPropertyJSONLDResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPropertyJSONLDResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPropertyJSONLDResponseValidationError) ErrorName() string {
+	return "GetPropertyJSONLDResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPropertyJSONLDResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPropertyJSONLDResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPropertyJSONLDResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPropertyJSONLDResponseValidationError{}
+
+// Validate checks the field values on PropertyImage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PropertyImage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PropertyImage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PropertyImageMultiError, or
+// nil if none found.
+func (m *PropertyImage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PropertyImage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageId
+
+	// no validation rules for PropertyId
+
+	// no validation rules for Url
+
+	// no validation rules for FileName
+
+	// no validation rules for ContentType
+
+	// no validation rules for Position
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return PropertyImageMultiError(errors)
+	}
+
+	return nil
+}
+
+// PropertyImageMultiError is an error wrapping multiple validation errors
+// returned by PropertyImage.ValidateAll() if the designated constraints
+// aren't met.
+type PropertyImageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PropertyImageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PropertyImageMultiError) AllErrors() []error { return m }
+
+// PropertyImageValidationError is the validation error returned by
+// PropertyImage.Validate if the designated constraints aren't met.
+type PropertyImageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PropertyImageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PropertyImageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PropertyImageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PropertyImageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PropertyImageValidationError) ErrorName() string { return "PropertyImageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PropertyImageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPropertyImage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PropertyImageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PropertyImageValidationError{}
+
+// Validate checks the field values on PropertyImageUpload with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PropertyImageUpload) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PropertyImageUpload with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PropertyImageUploadMultiError, or nil if none found.
+func (m *PropertyImageUpload) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PropertyImageUpload) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetFile()); l < 1 || l > 5242880 {
+		err := PropertyImageUploadValidationError{
+			field:  "File",
+			reason: "value length must be between 1 and 5242880 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetFileName()); l < 1 || l > 255 {
+		err := PropertyImageUploadValidationError{
+			field:  "FileName",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PropertyImageUploadMultiError(errors)
+	}
+
+	return nil
+}
+
+// PropertyImageUploadMultiError is an error wrapping multiple validation
+// errors returned by PropertyImageUpload.ValidateAll() if the designated
+// constraints aren't met.
+type PropertyImageUploadMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PropertyImageUploadMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PropertyImageUploadMultiError) AllErrors() []error { return m }
+
+// PropertyImageUploadValidationError is the validation error returned by
+// PropertyImageUpload.Validate if the designated constraints aren't met.
+type PropertyImageUploadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PropertyImageUploadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PropertyImageUploadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PropertyImageUploadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PropertyImageUploadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PropertyImageUploadValidationError) ErrorName() string {
+	return "PropertyImageUploadValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PropertyImageUploadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPropertyImageUpload.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PropertyImageUploadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PropertyImageUploadValidationError{}
+
+// Validate checks the field values on AddPropertyImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddPropertyImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddPropertyImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddPropertyImagesRequestMultiError, or nil if none found.
+func (m *AddPropertyImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddPropertyImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPropertyId()); err != nil {
+		err = AddPropertyImagesRequestValidationError{
+			field:  "PropertyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetImages()); l < 1 || l > 10 {
+		err := AddPropertyImagesRequestValidationError{
+			field:  "Images",
+			reason: "value must contain between 1 and 10 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetImages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddPropertyImagesRequestValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddPropertyImagesRequestValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddPropertyImagesRequestValidationError{
+					field:  fmt.Sprintf("Images[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddPropertyImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddPropertyImagesRequest) _validateUuid(uuid string) error {
+	if matched := _property_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AddPropertyImagesRequestMultiError is an error wrapping multiple validation
+// errors returned by AddPropertyImagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddPropertyImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddPropertyImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddPropertyImagesRequestMultiError) AllErrors() []error { return m }
+
+// AddPropertyImagesRequestValidationError is the validation error returned by
+// AddPropertyImagesRequest.Validate if the designated constraints aren't met.
+type AddPropertyImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddPropertyImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddPropertyImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddPropertyImagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddPropertyImagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddPropertyImagesRequestValidationError) ErrorName() string {
+	return "AddPropertyImagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddPropertyImagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddPropertyImagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddPropertyImagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddPropertyImagesRequestValidationError{}
+
+// Validate checks the field values on ListPropertyImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPropertyImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPropertyImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPropertyImagesRequestMultiError, or nil if none found.
+func (m *ListPropertyImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPropertyImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPropertyId()); err != nil {
+		err = ListPropertyImagesRequestValidationError{
+			field:  "PropertyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPropertyImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListPropertyImagesRequest) _validateUuid(uuid string) error {
+	if matched := _property_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListPropertyImagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPropertyImagesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPropertyImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPropertyImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPropertyImagesRequestMultiError) AllErrors() []error { return m }
+
+// ListPropertyImagesRequestValidationError is the validation error returned by
+// ListPropertyImagesRequest.Validate if the designated constraints aren't met.
+type ListPropertyImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPropertyImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPropertyImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPropertyImagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPropertyImagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPropertyImagesRequestValidationError) ErrorName() string {
+	return "ListPropertyImagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPropertyImagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPropertyImagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPropertyImagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPropertyImagesRequestValidationError{}
+
+// Validate checks the field values on DeletePropertyImageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePropertyImageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePropertyImageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePropertyImageRequestMultiError, or nil if none found.
+func (m *DeletePropertyImageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePropertyImageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPropertyId()); err != nil {
+		err = DeletePropertyImageRequestValidationError{
+			field:  "PropertyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetImageId()); err != nil {
+		err = DeletePropertyImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeletePropertyImageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeletePropertyImageRequest) _validateUuid(uuid string) error {
+	if matched := _property_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeletePropertyImageRequestMultiError is an error wrapping multiple
+// validation errors returned by DeletePropertyImageRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeletePropertyImageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePropertyImageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePropertyImageRequestMultiError) AllErrors() []error { return m }
+
+// DeletePropertyImageRequestValidationError is the validation error returned
+// by DeletePropertyImageRequest.Validate if the designated constraints aren't met.
+type DeletePropertyImageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePropertyImageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PropertyFilterValidationError) Reason() string { return e.reason }
+func (e DeletePropertyImageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PropertyFilterValidationError) Cause() error { return e.cause }
+func (e DeletePropertyImageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PropertyFilterValidationError) Key() bool { return e.key }
+func (e DeletePropertyImageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PropertyFilterValidationError) ErrorName() string { return "PropertyFilterValidationError" }
+func (e DeletePropertyImageRequestValidationError) ErrorName() string {
+	return "DeletePropertyImageRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PropertyFilterValidationError) Error() string {
+func (e DeletePropertyImageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1857,14 +3786,14 @@ func (e PropertyFilterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPropertyFilter.%s: %s%s",
+		"invalid %sDeletePropertyImageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PropertyFilterValidationError{}
+var _ error = DeletePropertyImageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1872,109 +3801,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PropertyFilterValidationError{}
+} = DeletePropertyImageRequestValidationError{}
 
-// Validate checks the field values on MatchPropertiesAdvancedRequest with the
+// Validate checks the field values on DeletePropertyImageResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MatchPropertiesAdvancedRequest) Validate() error {
+func (m *DeletePropertyImageResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MatchPropertiesAdvancedRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// MatchPropertiesAdvancedRequestMultiError, or nil if none found.
-func (m *MatchPropertiesAdvancedRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeletePropertyImageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePropertyImageResponseMultiError, or nil if none found.
+func (m *DeletePropertyImageResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MatchPropertiesAdvancedRequest) validate(all bool) error {
+func (m *DeletePropertyImageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetLeadId()); err != nil {
-		err = MatchPropertiesAdvancedRequestValidationError{
-			field:  "LeadId",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MatchPropertiesAdvancedRequestValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MatchPropertiesAdvancedRequestValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MatchPropertiesAdvancedRequestValidationError{
-				field:  "Filter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.Limit != nil {
-		// no validation rules for Limit
-	}
-
-	if m.UseHybridSearch != nil {
-		// no validation rules for UseHybridSearch
-	}
-
-	if m.UseReranker != nil {
-		// no validation rules for UseReranker
-	}
-
-	if m.UseDynamicWeights != nil {
-		// no validation rules for UseDynamicWeights
-	}
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return MatchPropertiesAdvancedRequestMultiError(errors)
+		return DeletePropertyImageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *MatchPropertiesAdvancedRequest) _validateUuid(uuid string) error {
-	if matched := _property_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
-	}
-
-	return nil
-}
-
-// MatchPropertiesAdvancedRequestMultiError is an error wrapping multiple
-// validation errors returned by MatchPropertiesAdvancedRequest.ValidateAll()
-// if the designated constraints aren't met.
-type MatchPropertiesAdvancedRequestMultiError []error
+// DeletePropertyImageResponseMultiError is an error wrapping multiple
+// validation errors returned by DeletePropertyImageResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeletePropertyImageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MatchPropertiesAdvancedRequestMultiError) Error() string {
+func (m DeletePropertyImageResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1983,12 +3849,12 @@ func (m MatchPropertiesAdvancedRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MatchPropertiesAdvancedRequestMultiError) AllErrors() []error { return m }
+func (m DeletePropertyImageResponseMultiError) AllErrors() []error { return m }
 
-// MatchPropertiesAdvancedRequestValidationError is the validation error
-// returned by MatchPropertiesAdvancedRequest.Validate if the designated
-// constraints aren't met.
-type MatchPropertiesAdvancedRequestValidationError struct {
+// DeletePropertyImageResponseValidationError is the validation error returned
+// by DeletePropertyImageResponse.Validate if the designated constraints
+// aren't met.
+type DeletePropertyImageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1996,24 +3862,24 @@ type MatchPropertiesAdvancedRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MatchPropertiesAdvancedRequestValidationError) Field() string { return e.field }
+func (e DeletePropertyImageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MatchPropertiesAdvancedRequestValidationError) Reason() string { return e.reason }
+func (e DeletePropertyImageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MatchPropertiesAdvancedRequestValidationError) Cause() error { return e.cause }
+func (e DeletePropertyImageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MatchPropertiesAdvancedRequestValidationError) Key() bool { return e.key }
+func (e DeletePropertyImageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MatchPropertiesAdvancedRequestValidationError) ErrorName() string {
-	return "MatchPropertiesAdvancedRequestValidationError"
+func (e DeletePropertyImageResponseValidationError) ErrorName() string {
+	return "DeletePropertyImageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MatchPropertiesAdvancedRequestValidationError) Error() string {
+func (e DeletePropertyImageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2025,14 +3891,14 @@ func (e MatchPropertiesAdvancedRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMatchPropertiesAdvancedRequest.%s: %s%s",
+		"invalid %sDeletePropertyImageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MatchPropertiesAdvancedRequestValidationError{}
+var _ error = DeletePropertyImageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2040,24 +3906,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MatchPropertiesAdvancedRequestValidationError{}
+} = DeletePropertyImageResponseValidationError{}
 
-// Validate checks the field values on GetPropertyJSONLDRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ReorderPropertyImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetPropertyJSONLDRequest) Validate() error {
+func (m *ReorderPropertyImagesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPropertyJSONLDRequest with the
+// ValidateAll checks the field values on ReorderPropertyImagesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPropertyJSONLDRequestMultiError, or nil if none found.
-func (m *GetPropertyJSONLDRequest) ValidateAll() error {
+// ReorderPropertyImagesRequestMultiError, or nil if none found.
+func (m *ReorderPropertyImagesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPropertyJSONLDRequest) validate(all bool) error {
+func (m *ReorderPropertyImagesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2065,7 +3931,7 @@ func (m *GetPropertyJSONLDRequest) validate(all bool) error {
 	var errors []error
 
 	if err := m._validateUuid(m.GetPropertyId()); err != nil {
-		err = GetPropertyJSONLDRequestValidationError{
+		err = ReorderPropertyImagesRequestValidationError{
 			field:  "PropertyId",
 			reason: "value must be a valid UUID",
 			cause:  err,
@@ -2076,18 +3942,42 @@ func (m *GetPropertyJSONLDRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.BaseUrl != nil {
-		// no validation rules for BaseUrl
+	if len(m.GetImageIds()) < 1 {
+		err := ReorderPropertyImagesRequestValidationError{
+			field:  "ImageIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetImageIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = ReorderPropertyImagesRequestValidationError{
+				field:  fmt.Sprintf("ImageIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
 	}
 
 	if len(errors) > 0 {
-		return GetPropertyJSONLDRequestMultiError(errors)
+		return ReorderPropertyImagesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *GetPropertyJSONLDRequest) _validateUuid(uuid string) error {
+func (m *ReorderPropertyImagesRequest) _validateUuid(uuid string) error {
 	if matched := _property_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -2095,13 +3985,13 @@ func (m *GetPropertyJSONLDRequest) _validateUuid(uuid string) error {
 	return nil
 }
 
-// GetPropertyJSONLDRequestMultiError is an error wrapping multiple validation
-// errors returned by GetPropertyJSONLDRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetPropertyJSONLDRequestMultiError []error
+// ReorderPropertyImagesRequestMultiError is an error wrapping multiple
+// validation errors returned by ReorderPropertyImagesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ReorderPropertyImagesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPropertyJSONLDRequestMultiError) Error() string {
+func (m ReorderPropertyImagesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2110,11 +4000,12 @@ func (m GetPropertyJSONLDRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPropertyJSONLDRequestMultiError) AllErrors() []error { return m }
+func (m ReorderPropertyImagesRequestMultiError) AllErrors() []error { return m }
 
-// GetPropertyJSONLDRequestValidationError is the validation error returned by
-// GetPropertyJSONLDRequest.Validate if the designated constraints aren't met.
-type GetPropertyJSONLDRequestValidationError struct {
+// ReorderPropertyImagesRequestValidationError is the validation error returned
+// by ReorderPropertyImagesRequest.Validate if the designated constraints
+// aren't met.
+type ReorderPropertyImagesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2122,24 +4013,24 @@ type GetPropertyJSONLDRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPropertyJSONLDRequestValidationError) Field() string { return e.field }
+func (e ReorderPropertyImagesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPropertyJSONLDRequestValidationError) Reason() string { return e.reason }
+func (e ReorderPropertyImagesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPropertyJSONLDRequestValidationError) Cause() error { return e.cause }
+func (e ReorderPropertyImagesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPropertyJSONLDRequestValidationError) Key() bool { return e.key }
+func (e ReorderPropertyImagesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPropertyJSONLDRequestValidationError) ErrorName() string {
-	return "GetPropertyJSONLDRequestValidationError"
+func (e ReorderPropertyImagesRequestValidationError) ErrorName() string {
+	return "ReorderPropertyImagesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPropertyJSONLDRequestValidationError) Error() string {
+func (e ReorderPropertyImagesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2151,14 +4042,14 @@ func (e GetPropertyJSONLDRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPropertyJSONLDRequest.%s: %s%s",
+		"invalid %sReorderPropertyImagesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPropertyJSONLDRequestValidationError{}
+var _ error = ReorderPropertyImagesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2166,46 +4057,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPropertyJSONLDRequestValidationError{}
+} = ReorderPropertyImagesRequestValidationError{}
 
-// Validate checks the field values on GetPropertyJSONLDResponse with the rules
+// Validate checks the field values on PropertyImagesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetPropertyJSONLDResponse) Validate() error {
+func (m *PropertyImagesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPropertyJSONLDResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PropertyImagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPropertyJSONLDResponseMultiError, or nil if none found.
-func (m *GetPropertyJSONLDResponse) ValidateAll() error {
+// PropertyImagesResponseMultiError, or nil if none found.
+func (m *PropertyImagesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPropertyJSONLDResponse) validate(all bool) error {
+func (m *PropertyImagesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JsonldData
+	for idx, item := range m.GetImages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PropertyImagesResponseValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PropertyImagesResponseValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PropertyImagesResponseValidationError{
+					field:  fmt.Sprintf("Images[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetPropertyJSONLDResponseMultiError(errors)
+		return PropertyImagesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPropertyJSONLDResponseMultiError is an error wrapping multiple validation
-// errors returned by GetPropertyJSONLDResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetPropertyJSONLDResponseMultiError []error
+// PropertyImagesResponseMultiError is an error wrapping multiple validation
+// errors returned by PropertyImagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PropertyImagesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPropertyJSONLDResponseMultiError) Error() string {
+func (m PropertyImagesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2214,11 +4137,11 @@ func (m GetPropertyJSONLDResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPropertyJSONLDResponseMultiError) AllErrors() []error { return m }
+func (m PropertyImagesResponseMultiError) AllErrors() []error { return m }
 
-// GetPropertyJSONLDResponseValidationError is the validation error returned by
-// GetPropertyJSONLDResponse.Validate if the designated constraints aren't met.
-type GetPropertyJSONLDResponseValidationError struct {
+// PropertyImagesResponseValidationError is the validation error returned by
+// PropertyImagesResponse.Validate if the designated constraints aren't met.
+type PropertyImagesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2226,24 +4149,24 @@ type GetPropertyJSONLDResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPropertyJSONLDResponseValidationError) Field() string { return e.field }
+func (e PropertyImagesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPropertyJSONLDResponseValidationError) Reason() string { return e.reason }
+func (e PropertyImagesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPropertyJSONLDResponseValidationError) Cause() error { return e.cause }
+func (e PropertyImagesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPropertyJSONLDResponseValidationError) Key() bool { return e.key }
+func (e PropertyImagesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPropertyJSONLDResponseValidationError) ErrorName() string {
-	return "GetPropertyJSONLDResponseValidationError"
+func (e PropertyImagesResponseValidationError) ErrorName() string {
+	return "PropertyImagesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPropertyJSONLDResponseValidationError) Error() string {
+func (e PropertyImagesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2255,14 +4178,14 @@ func (e GetPropertyJSONLDResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPropertyJSONLDResponse.%s: %s%s",
+		"invalid %sPropertyImagesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPropertyJSONLDResponseValidationError{}
+var _ error = PropertyImagesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2270,7 +4193,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPropertyJSONLDResponseValidationError{}
+} = PropertyImagesResponseValidationError{}
 
 // Validate checks the field values on GenerateListingContentRequest with the
 // rules defined in the proto definition for this message. If any rules are
@@ -2554,6 +4477,43 @@ func (m *AnalyzePropertyImagesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if len(m.GetImageUrls()) > 10 {
+		err := AnalyzePropertyImagesRequestValidationError{
+			field:  "ImageUrls",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetImageUrls() {
+		_, _ = idx, item
+
+		if uri, err := url.Parse(item); err != nil {
+			err = AnalyzePropertyImagesRequestValidationError{
+				field:  fmt.Sprintf("ImageUrls[%v]", idx),
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := AnalyzePropertyImagesRequestValidationError{
+				field:  fmt.Sprintf("ImageUrls[%v]", idx),
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return AnalyzePropertyImagesRequestMultiError(errors)
 	}
@@ -2808,6 +4768,10 @@ func (m *ImageAnalysisResult) validate(all bool) error {
 
 	// no validation rules for Brightness
 
+	// no validation rules for ImageId
+
+	// no validation rules for ImageUrl
+
 	if m.RoomType != nil {
 		// no validation rules for RoomType
 	}
@@ -3095,6 +5059,35 @@ func (m *ListPropertiesRequest_Filter) validate(all bool) error {
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetGeo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListPropertiesRequest_FilterValidationError{
+					field:  "Geo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListPropertiesRequest_FilterValidationError{
+					field:  "Geo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListPropertiesRequest_FilterValidationError{
+				field:  "Geo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Status != nil {
 		// no validation rules for Status
 	}
@@ -3131,6 +5124,10 @@ func (m *ListPropertiesRequest_Filter) validate(all bool) error {
 		// no validation rules for City
 	}
 
+	if m.Team != nil {
+		// no validation rules for Team
+	}
+
 	if len(errors) > 0 {
 		return ListPropertiesRequest_FilterMultiError(errors)
 	}
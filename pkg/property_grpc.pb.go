@@ -19,16 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PropertyService_CreateProperty_FullMethodName          = "/leadexchange.v1.PropertyService/CreateProperty"
-	PropertyService_GetProperty_FullMethodName             = "/leadexchange.v1.PropertyService/GetProperty"
-	PropertyService_ListProperties_FullMethodName          = "/leadexchange.v1.PropertyService/ListProperties"
-	PropertyService_UpdateProperty_FullMethodName          = "/leadexchange.v1.PropertyService/UpdateProperty"
-	PropertyService_MatchProperties_FullMethodName         = "/leadexchange.v1.PropertyService/MatchProperties"
-	PropertyService_ReindexProperty_FullMethodName         = "/leadexchange.v1.PropertyService/ReindexProperty"
-	PropertyService_MatchPropertiesAdvanced_FullMethodName = "/leadexchange.v1.PropertyService/MatchPropertiesAdvanced"
-	PropertyService_GetPropertyJSONLD_FullMethodName       = "/leadexchange.v1.PropertyService/GetPropertyJSONLD"
-	PropertyService_GenerateListingContent_FullMethodName  = "/leadexchange.v1.PropertyService/GenerateListingContent"
-	PropertyService_AnalyzePropertyImages_FullMethodName   = "/leadexchange.v1.PropertyService/AnalyzePropertyImages"
+	PropertyService_CreateProperty_FullMethodName             = "/leadexchange.v1.PropertyService/CreateProperty"
+	PropertyService_GetProperty_FullMethodName                = "/leadexchange.v1.PropertyService/GetProperty"
+	PropertyService_ListProperties_FullMethodName             = "/leadexchange.v1.PropertyService/ListProperties"
+	PropertyService_UpdateProperty_FullMethodName             = "/leadexchange.v1.PropertyService/UpdateProperty"
+	PropertyService_MatchProperties_FullMethodName            = "/leadexchange.v1.PropertyService/MatchProperties"
+	PropertyService_ReindexProperty_FullMethodName            = "/leadexchange.v1.PropertyService/ReindexProperty"
+	PropertyService_GetPropertyEmbeddingStatus_FullMethodName = "/leadexchange.v1.PropertyService/GetPropertyEmbeddingStatus"
+	PropertyService_AddPropertyImages_FullMethodName          = "/leadexchange.v1.PropertyService/AddPropertyImages"
+	PropertyService_ListPropertyImages_FullMethodName         = "/leadexchange.v1.PropertyService/ListPropertyImages"
+	PropertyService_DeletePropertyImage_FullMethodName        = "/leadexchange.v1.PropertyService/DeletePropertyImage"
+	PropertyService_ReorderPropertyImages_FullMethodName      = "/leadexchange.v1.PropertyService/ReorderPropertyImages"
+	PropertyService_MatchPropertiesAdvanced_FullMethodName    = "/leadexchange.v1.PropertyService/MatchPropertiesAdvanced"
+	PropertyService_GetPropertyJSONLD_FullMethodName          = "/leadexchange.v1.PropertyService/GetPropertyJSONLD"
+	PropertyService_GenerateListingContent_FullMethodName     = "/leadexchange.v1.PropertyService/GenerateListingContent"
+	PropertyService_AnalyzePropertyImages_FullMethodName      = "/leadexchange.v1.PropertyService/AnalyzePropertyImages"
 )
 
 // PropertyServiceClient is the client API for PropertyService service.
@@ -47,13 +52,23 @@ type PropertyServiceClient interface {
 	MatchProperties(ctx context.Context, in *MatchPropertiesRequest, opts ...grpc.CallOption) (*MatchPropertiesResponse, error)
 	// Переиндексировать объект недвижимости вручную.
 	ReindexProperty(ctx context.Context, in *ReindexPropertyRequest, opts ...grpc.CallOption) (*ReindexPropertyResponse, error)
+	// Статус генерации embedding объекта.
+	GetPropertyEmbeddingStatus(ctx context.Context, in *GetPropertyEmbeddingStatusRequest, opts ...grpc.CallOption) (*EmbeddingStatusResponse, error)
+	// Загрузить фотографии объекта (добавляются в конец галереи).
+	AddPropertyImages(ctx context.Context, in *AddPropertyImagesRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error)
+	// Получить галерею объекта со свежими ссылками на фотографии.
+	ListPropertyImages(ctx context.Context, in *ListPropertyImagesRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error)
+	// Удалить фотографию из галереи.
+	DeletePropertyImage(ctx context.Context, in *DeletePropertyImageRequest, opts ...grpc.CallOption) (*DeletePropertyImageResponse, error)
+	// Изменить порядок фотографий в галерее.
+	ReorderPropertyImages(ctx context.Context, in *ReorderPropertyImagesRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error)
 	// Расширенный поиск с гибридным поиском и реранкером.
 	MatchPropertiesAdvanced(ctx context.Context, in *MatchPropertiesAdvancedRequest, opts ...grpc.CallOption) (*MatchPropertiesResponse, error)
 	// Получить JSON-LD разметку объекта недвижимости (schema.org).
 	GetPropertyJSONLD(ctx context.Context, in *GetPropertyJSONLDRequest, opts ...grpc.CallOption) (*GetPropertyJSONLDResponse, error)
 	// Сгенерировать заголовок и описание с помощью AI.
 	GenerateListingContent(ctx context.Context, in *GenerateListingContentRequest, opts ...grpc.CallOption) (*GenerateListingContentResponse, error)
-	// Анализ изображений объекта недвижимости.
+	// Анализ фотографий объекта недвижимости; результаты сохраняются.
 	AnalyzePropertyImages(ctx context.Context, in *AnalyzePropertyImagesRequest, opts ...grpc.CallOption) (*AnalyzePropertyImagesResponse, error)
 }
 
@@ -125,6 +140,56 @@ func (c *propertyServiceClient) ReindexProperty(ctx context.Context, in *Reindex
 	return out, nil
 }
 
+func (c *propertyServiceClient) GetPropertyEmbeddingStatus(ctx context.Context, in *GetPropertyEmbeddingStatusRequest, opts ...grpc.CallOption) (*EmbeddingStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbeddingStatusResponse)
+	err := c.cc.Invoke(ctx, PropertyService_GetPropertyEmbeddingStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) AddPropertyImages(ctx context.Context, in *AddPropertyImagesRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PropertyImagesResponse)
+	err := c.cc.Invoke(ctx, PropertyService_AddPropertyImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) ListPropertyImages(ctx context.Context, in *ListPropertyImagesRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PropertyImagesResponse)
+	err := c.cc.Invoke(ctx, PropertyService_ListPropertyImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) DeletePropertyImage(ctx context.Context, in *DeletePropertyImageRequest, opts ...grpc.CallOption) (*DeletePropertyImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePropertyImageResponse)
+	err := c.cc.Invoke(ctx, PropertyService_DeletePropertyImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *propertyServiceClient) ReorderPropertyImages(ctx context.Context, in *ReorderPropertyImagesRequest, opts ...grpc.CallOption) (*PropertyImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PropertyImagesResponse)
+	err := c.cc.Invoke(ctx, PropertyService_ReorderPropertyImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *propertyServiceClient) MatchPropertiesAdvanced(ctx context.Context, in *MatchPropertiesAdvancedRequest, opts ...grpc.CallOption) (*MatchPropertiesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MatchPropertiesResponse)
@@ -181,13 +246,23 @@ type PropertyServiceServer interface {
 	MatchProperties(context.Context, *MatchPropertiesRequest) (*MatchPropertiesResponse, error)
 	// Переиндексировать объект недвижимости вручную.
 	ReindexProperty(context.Context, *ReindexPropertyRequest) (*ReindexPropertyResponse, error)
+	// Статус генерации embedding объекта.
+	GetPropertyEmbeddingStatus(context.Context, *GetPropertyEmbeddingStatusRequest) (*EmbeddingStatusResponse, error)
+	// Загрузить фотографии объекта (добавляются в конец галереи).
+	AddPropertyImages(context.Context, *AddPropertyImagesRequest) (*PropertyImagesResponse, error)
+	// Получить галерею объекта со свежими ссылками на фотографии.
+	ListPropertyImages(context.Context, *ListPropertyImagesRequest) (*PropertyImagesResponse, error)
+	// Удалить фотографию из галереи.
+	DeletePropertyImage(context.Context, *DeletePropertyImageRequest) (*DeletePropertyImageResponse, error)
+	// Изменить порядок фотографий в галерее.
+	ReorderPropertyImages(context.Context, *ReorderPropertyImagesRequest) (*PropertyImagesResponse, error)
 	// Расширенный поиск с гибридным поиском и реранкером.
 	MatchPropertiesAdvanced(context.Context, *MatchPropertiesAdvancedRequest) (*MatchPropertiesResponse, error)
 	// Получить JSON-LD разметку объекта недвижимости (schema.org).
 	GetPropertyJSONLD(context.Context, *GetPropertyJSONLDRequest) (*GetPropertyJSONLDResponse, error)
 	// Сгенерировать заголовок и описание с помощью AI.
 	GenerateListingContent(context.Context, *GenerateListingContentRequest) (*GenerateListingContentResponse, error)
-	// Анализ изображений объекта недвижимости.
+	// Анализ фотографий объекта недвижимости; результаты сохраняются.
 	AnalyzePropertyImages(context.Context, *AnalyzePropertyImagesRequest) (*AnalyzePropertyImagesResponse, error)
 	mustEmbedUnimplementedPropertyServiceServer()
 }
@@ -217,6 +292,21 @@ func (UnimplementedPropertyServiceServer) MatchProperties(context.Context, *Matc
 func (UnimplementedPropertyServiceServer) ReindexProperty(context.Context, *ReindexPropertyRequest) (*ReindexPropertyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ReindexProperty not implemented")
 }
+func (UnimplementedPropertyServiceServer) GetPropertyEmbeddingStatus(context.Context, *GetPropertyEmbeddingStatusRequest) (*EmbeddingStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPropertyEmbeddingStatus not implemented")
+}
+func (UnimplementedPropertyServiceServer) AddPropertyImages(context.Context, *AddPropertyImagesRequest) (*PropertyImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddPropertyImages not implemented")
+}
+func (UnimplementedPropertyServiceServer) ListPropertyImages(context.Context, *ListPropertyImagesRequest) (*PropertyImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPropertyImages not implemented")
+}
+func (UnimplementedPropertyServiceServer) DeletePropertyImage(context.Context, *DeletePropertyImageRequest) (*DeletePropertyImageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePropertyImage not implemented")
+}
+func (UnimplementedPropertyServiceServer) ReorderPropertyImages(context.Context, *ReorderPropertyImagesRequest) (*PropertyImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderPropertyImages not implemented")
+}
 func (UnimplementedPropertyServiceServer) MatchPropertiesAdvanced(context.Context, *MatchPropertiesAdvancedRequest) (*MatchPropertiesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method MatchPropertiesAdvanced not implemented")
 }
@@ -358,6 +448,96 @@ func _PropertyService_ReindexProperty_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PropertyService_GetPropertyEmbeddingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPropertyEmbeddingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).GetPropertyEmbeddingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_GetPropertyEmbeddingStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).GetPropertyEmbeddingStatus(ctx, req.(*GetPropertyEmbeddingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_AddPropertyImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPropertyImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).AddPropertyImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_AddPropertyImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).AddPropertyImages(ctx, req.(*AddPropertyImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_ListPropertyImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPropertyImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).ListPropertyImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_ListPropertyImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).ListPropertyImages(ctx, req.(*ListPropertyImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_DeletePropertyImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePropertyImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).DeletePropertyImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_DeletePropertyImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).DeletePropertyImage(ctx, req.(*DeletePropertyImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PropertyService_ReorderPropertyImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPropertyImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PropertyServiceServer).ReorderPropertyImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PropertyService_ReorderPropertyImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PropertyServiceServer).ReorderPropertyImages(ctx, req.(*ReorderPropertyImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PropertyService_MatchPropertiesAdvanced_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MatchPropertiesAdvancedRequest)
 	if err := dec(in); err != nil {
@@ -461,6 +641,26 @@ var PropertyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReindexProperty",
 			Handler:    _PropertyService_ReindexProperty_Handler,
 		},
+		{
+			MethodName: "GetPropertyEmbeddingStatus",
+			Handler:    _PropertyService_GetPropertyEmbeddingStatus_Handler,
+		},
+		{
+			MethodName: "AddPropertyImages",
+			Handler:    _PropertyService_AddPropertyImages_Handler,
+		},
+		{
+			MethodName: "ListPropertyImages",
+			Handler:    _PropertyService_ListPropertyImages_Handler,
+		},
+		{
+			MethodName: "DeletePropertyImage",
+			Handler:    _PropertyService_DeletePropertyImage_Handler,
+		},
+		{
+			MethodName: "ReorderPropertyImages",
+			Handler:    _PropertyService_ReorderPropertyImages_Handler,
+		},
 		{
 			MethodName: "MatchPropertiesAdvanced",
 			Handler:    _PropertyService_MatchPropertiesAdvanced_Handler,
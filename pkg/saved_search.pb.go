@@ -0,0 +1,909 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: saved_search.proto
+
+package leadexchangev1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SavedSearchFilter — ad-hoc фильтр поиска без лида.
+type SavedSearchFilter struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	City         *string                `protobuf:"bytes,1,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	PropertyType *PropertyType          `protobuf:"varint,2,opt,name=property_type,json=propertyType,proto3,enum=leadexchange.v1.PropertyType,oneof" json:"property_type,omitempty"`
+	MinRooms     *int32                 `protobuf:"varint,3,opt,name=min_rooms,json=minRooms,proto3,oneof" json:"min_rooms,omitempty"`
+	MaxRooms     *int32                 `protobuf:"varint,4,opt,name=max_rooms,json=maxRooms,proto3,oneof" json:"max_rooms,omitempty"`
+	MinPrice     *int64                 `protobuf:"varint,5,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	MaxPrice     *int64                 `protobuf:"varint,6,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	// Желаемые значения для ранжирования
+	TargetPrice    *int64   `protobuf:"varint,7,opt,name=target_price,json=targetPrice,proto3,oneof" json:"target_price,omitempty"`
+	TargetRooms    *int32   `protobuf:"varint,8,opt,name=target_rooms,json=targetRooms,proto3,oneof" json:"target_rooms,omitempty"`
+	TargetArea     *float64 `protobuf:"fixed64,9,opt,name=target_area,json=targetArea,proto3,oneof" json:"target_area,omitempty"`
+	TargetDistrict *string  `protobuf:"bytes,10,opt,name=target_district,json=targetDistrict,proto3,oneof" json:"target_district,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SavedSearchFilter) Reset() {
+	*x = SavedSearchFilter{}
+	mi := &file_saved_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchFilter) ProtoMessage() {}
+
+func (x *SavedSearchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchFilter.ProtoReflect.Descriptor instead.
+func (*SavedSearchFilter) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SavedSearchFilter) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *SavedSearchFilter) GetPropertyType() PropertyType {
+	if x != nil && x.PropertyType != nil {
+		return *x.PropertyType
+	}
+	return PropertyType_PROPERTY_TYPE_UNSPECIFIED
+}
+
+func (x *SavedSearchFilter) GetMinRooms() int32 {
+	if x != nil && x.MinRooms != nil {
+		return *x.MinRooms
+	}
+	return 0
+}
+
+func (x *SavedSearchFilter) GetMaxRooms() int32 {
+	if x != nil && x.MaxRooms != nil {
+		return *x.MaxRooms
+	}
+	return 0
+}
+
+func (x *SavedSearchFilter) GetMinPrice() int64 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
+}
+
+func (x *SavedSearchFilter) GetMaxPrice() int64 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
+
+func (x *SavedSearchFilter) GetTargetPrice() int64 {
+	if x != nil && x.TargetPrice != nil {
+		return *x.TargetPrice
+	}
+	return 0
+}
+
+func (x *SavedSearchFilter) GetTargetRooms() int32 {
+	if x != nil && x.TargetRooms != nil {
+		return *x.TargetRooms
+	}
+	return 0
+}
+
+func (x *SavedSearchFilter) GetTargetArea() float64 {
+	if x != nil && x.TargetArea != nil {
+		return *x.TargetArea
+	}
+	return 0
+}
+
+func (x *SavedSearchFilter) GetTargetDistrict() string {
+	if x != nil && x.TargetDistrict != nil {
+		return *x.TargetDistrict
+	}
+	return ""
+}
+
+// SavedSearch — сохранённый поиск брокера.
+type SavedSearch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SearchId      string                 `protobuf:"bytes,1,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	LeadId        *string                `protobuf:"bytes,4,opt,name=lead_id,json=leadId,proto3,oneof" json:"lead_id,omitempty"`
+	Filter        *SavedSearchFilter     `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Weights       *MatchWeights          `protobuf:"bytes,6,opt,name=weights,proto3" json:"weights,omitempty"`
+	MinScore      float64                `protobuf:"fixed64,7,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearch) Reset() {
+	*x = SavedSearch{}
+	mi := &file_saved_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearch) ProtoMessage() {}
+
+func (x *SavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearch.ProtoReflect.Descriptor instead.
+func (*SavedSearch) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SavedSearch) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetLeadId() string {
+	if x != nil && x.LeadId != nil {
+		return *x.LeadId
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetFilter() *SavedSearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetWeights() *MatchWeights {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// SavedSearchMatch — объект во входящих сохранённого поиска.
+type SavedSearchMatch struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MatchId          string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	SearchId         string                 `protobuf:"bytes,2,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
+	Property         *Property              `protobuf:"bytes,3,opt,name=property,proto3" json:"property,omitempty"`
+	Score            float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	MatchExplanation *string                `protobuf:"bytes,5,opt,name=match_explanation,json=matchExplanation,proto3,oneof" json:"match_explanation,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SavedSearchMatch) Reset() {
+	*x = SavedSearchMatch{}
+	mi := &file_saved_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchMatch) ProtoMessage() {}
+
+func (x *SavedSearchMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchMatch.ProtoReflect.Descriptor instead.
+func (*SavedSearchMatch) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SavedSearchMatch) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *SavedSearchMatch) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+func (x *SavedSearchMatch) GetProperty() *Property {
+	if x != nil {
+		return x.Property
+	}
+	return nil
+}
+
+func (x *SavedSearchMatch) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SavedSearchMatch) GetMatchExplanation() string {
+	if x != nil && x.MatchExplanation != nil {
+		return *x.MatchExplanation
+	}
+	return ""
+}
+
+func (x *SavedSearchMatch) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateSavedSearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Поиск по лиду; если не задан, обязателен filter
+	LeadId  *string            `protobuf:"bytes,2,opt,name=lead_id,json=leadId,proto3,oneof" json:"lead_id,omitempty"`
+	Filter  *SavedSearchFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Weights *MatchWeights      `protobuf:"bytes,4,opt,name=weights,proto3" json:"weights,omitempty"`
+	// Порог TotalScore для записи во входящие (по умолчанию 0.6)
+	MinScore      *float64 `protobuf:"fixed64,5,opt,name=min_score,json=minScore,proto3,oneof" json:"min_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedSearchRequest) Reset() {
+	*x = CreateSavedSearchRequest{}
+	mi := &file_saved_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedSearchRequest) ProtoMessage() {}
+
+func (x *CreateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetLeadId() string {
+	if x != nil && x.LeadId != nil {
+		return *x.LeadId
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetFilter() *SavedSearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *CreateSavedSearchRequest) GetWeights() *MatchWeights {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *CreateSavedSearchRequest) GetMinScore() float64 {
+	if x != nil && x.MinScore != nil {
+		return *x.MinScore
+	}
+	return 0
+}
+
+type SavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearch   *SavedSearch           `protobuf:"bytes,1,opt,name=saved_search,json=savedSearch,proto3" json:"saved_search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchResponse) Reset() {
+	*x = SavedSearchResponse{}
+	mi := &file_saved_search_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchResponse) ProtoMessage() {}
+
+func (x *SavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*SavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SavedSearchResponse) GetSavedSearch() *SavedSearch {
+	if x != nil {
+		return x.SavedSearch
+	}
+	return nil
+}
+
+type ListSavedSearchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesRequest) Reset() {
+	*x = ListSavedSearchesRequest{}
+	mi := &file_saved_search_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesRequest) ProtoMessage() {}
+
+func (x *ListSavedSearchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesRequest) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{5}
+}
+
+type ListSavedSearchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearches []*SavedSearch         `protobuf:"bytes,1,rep,name=saved_searches,json=savedSearches,proto3" json:"saved_searches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesResponse) Reset() {
+	*x = ListSavedSearchesResponse{}
+	mi := &file_saved_search_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesResponse) ProtoMessage() {}
+
+func (x *ListSavedSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSavedSearchesResponse) GetSavedSearches() []*SavedSearch {
+	if x != nil {
+		return x.SavedSearches
+	}
+	return nil
+}
+
+type DeleteSavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SearchId      string                 `protobuf:"bytes,1,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchRequest) Reset() {
+	*x = DeleteSavedSearchRequest{}
+	mi := &file_saved_search_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchRequest) ProtoMessage() {}
+
+func (x *DeleteSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteSavedSearchRequest) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+type DeleteSavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchResponse) Reset() {
+	*x = DeleteSavedSearchResponse{}
+	mi := &file_saved_search_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchResponse) ProtoMessage() {}
+
+func (x *DeleteSavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteSavedSearchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListSavedSearchMatchesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ограничить выдачу одним поиском
+	SearchId *string `protobuf:"bytes,1,opt,name=search_id,json=searchId,proto3,oneof" json:"search_id,omitempty"`
+	Limit    *int32  `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// Отметить выданные совпадения просмотренными (по умолчанию true)
+	MarkSeen      *bool `protobuf:"varint,3,opt,name=mark_seen,json=markSeen,proto3,oneof" json:"mark_seen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchMatchesRequest) Reset() {
+	*x = ListSavedSearchMatchesRequest{}
+	mi := &file_saved_search_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchMatchesRequest) ProtoMessage() {}
+
+func (x *ListSavedSearchMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListSavedSearchMatchesRequest) GetSearchId() string {
+	if x != nil && x.SearchId != nil {
+		return *x.SearchId
+	}
+	return ""
+}
+
+func (x *ListSavedSearchMatchesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListSavedSearchMatchesRequest) GetMarkSeen() bool {
+	if x != nil && x.MarkSeen != nil {
+		return *x.MarkSeen
+	}
+	return false
+}
+
+type ListSavedSearchMatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*SavedSearchMatch    `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchMatchesResponse) Reset() {
+	*x = ListSavedSearchMatchesResponse{}
+	mi := &file_saved_search_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchMatchesResponse) ProtoMessage() {}
+
+func (x *ListSavedSearchMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_saved_search_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchMatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_saved_search_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSavedSearchMatchesResponse) GetMatches() []*SavedSearchMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+var File_saved_search_proto protoreflect.FileDescriptor
+
+const file_saved_search_proto_rawDesc = "" +
+	"\n" +
+	"\x12saved_search.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x0eproperty.proto\x1a\n" +
+	"lead.proto\"\xba\x04\n" +
+	"\x11SavedSearchFilter\x12\x17\n" +
+	"\x04city\x18\x01 \x01(\tH\x00R\x04city\x88\x01\x01\x12G\n" +
+	"\rproperty_type\x18\x02 \x01(\x0e2\x1d.leadexchange.v1.PropertyTypeH\x01R\fpropertyType\x88\x01\x01\x12 \n" +
+	"\tmin_rooms\x18\x03 \x01(\x05H\x02R\bminRooms\x88\x01\x01\x12 \n" +
+	"\tmax_rooms\x18\x04 \x01(\x05H\x03R\bmaxRooms\x88\x01\x01\x12 \n" +
+	"\tmin_price\x18\x05 \x01(\x03H\x04R\bminPrice\x88\x01\x01\x12 \n" +
+	"\tmax_price\x18\x06 \x01(\x03H\x05R\bmaxPrice\x88\x01\x01\x12&\n" +
+	"\ftarget_price\x18\a \x01(\x03H\x06R\vtargetPrice\x88\x01\x01\x12&\n" +
+	"\ftarget_rooms\x18\b \x01(\x05H\aR\vtargetRooms\x88\x01\x01\x12$\n" +
+	"\vtarget_area\x18\t \x01(\x01H\bR\n" +
+	"targetArea\x88\x01\x01\x12,\n" +
+	"\x0ftarget_district\x18\n" +
+	" \x01(\tH\tR\x0etargetDistrict\x88\x01\x01B\a\n" +
+	"\x05_cityB\x10\n" +
+	"\x0e_property_typeB\f\n" +
+	"\n" +
+	"_min_roomsB\f\n" +
+	"\n" +
+	"_max_roomsB\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_priceB\x0f\n" +
+	"\r_target_priceB\x0f\n" +
+	"\r_target_roomsB\x0e\n" +
+	"\f_target_areaB\x12\n" +
+	"\x10_target_district\"\xd1\x02\n" +
+	"\vSavedSearch\x12\x1b\n" +
+	"\tsearch_id\x18\x01 \x01(\tR\bsearchId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\alead_id\x18\x04 \x01(\tH\x00R\x06leadId\x88\x01\x01\x12:\n" +
+	"\x06filter\x18\x05 \x01(\v2\".leadexchange.v1.SavedSearchFilterR\x06filter\x127\n" +
+	"\aweights\x18\x06 \x01(\v2\x1d.leadexchange.v1.MatchWeightsR\aweights\x12\x1b\n" +
+	"\tmin_score\x18\a \x01(\x01R\bminScore\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAtB\n" +
+	"\n" +
+	"\b_lead_id\"\xfe\x01\n" +
+	"\x10SavedSearchMatch\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x1b\n" +
+	"\tsearch_id\x18\x02 \x01(\tR\bsearchId\x125\n" +
+	"\bproperty\x18\x03 \x01(\v2\x19.leadexchange.v1.PropertyR\bproperty\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x120\n" +
+	"\x11match_explanation\x18\x05 \x01(\tH\x00R\x10matchExplanation\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAtB\x14\n" +
+	"\x12_match_explanation\"\xa0\x02\n" +
+	"\x18CreateSavedSearchRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12&\n" +
+	"\alead_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x06leadId\x88\x01\x01\x12:\n" +
+	"\x06filter\x18\x03 \x01(\v2\".leadexchange.v1.SavedSearchFilterR\x06filter\x127\n" +
+	"\aweights\x18\x04 \x01(\v2\x1d.leadexchange.v1.MatchWeightsR\aweights\x129\n" +
+	"\tmin_score\x18\x05 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00H\x01R\bminScore\x88\x01\x01B\n" +
+	"\n" +
+	"\b_lead_idB\f\n" +
+	"\n" +
+	"_min_score\"V\n" +
+	"\x13SavedSearchResponse\x12?\n" +
+	"\fsaved_search\x18\x01 \x01(\v2\x1c.leadexchange.v1.SavedSearchR\vsavedSearch\"\x1a\n" +
+	"\x18ListSavedSearchesRequest\"`\n" +
+	"\x19ListSavedSearchesResponse\x12C\n" +
+	"\x0esaved_searches\x18\x01 \x03(\v2\x1c.leadexchange.v1.SavedSearchR\rsavedSearches\"A\n" +
+	"\x18DeleteSavedSearchRequest\x12%\n" +
+	"\tsearch_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\bsearchId\"5\n" +
+	"\x19DeleteSavedSearchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xae\x01\n" +
+	"\x1dListSavedSearchMatchesRequest\x12*\n" +
+	"\tsearch_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\bsearchId\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05H\x01R\x05limit\x88\x01\x01\x12 \n" +
+	"\tmark_seen\x18\x03 \x01(\bH\x02R\bmarkSeen\x88\x01\x01B\f\n" +
+	"\n" +
+	"_search_idB\b\n" +
+	"\x06_limitB\f\n" +
+	"\n" +
+	"_mark_seen\"]\n" +
+	"\x1eListSavedSearchMatchesResponse\x12;\n" +
+	"\amatches\x18\x01 \x03(\v2!.leadexchange.v1.SavedSearchMatchR\amatches2\xd8\x04\n" +
+	"\x12SavedSearchService\x12\x83\x01\n" +
+	"\x11CreateSavedSearch\x12).leadexchange.v1.CreateSavedSearchRequest\x1a$.leadexchange.v1.SavedSearchResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/saved-searches\x12\x86\x01\n" +
+	"\x11ListSavedSearches\x12).leadexchange.v1.ListSavedSearchesRequest\x1a*.leadexchange.v1.ListSavedSearchesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/saved-searches\x12\x92\x01\n" +
+	"\x11DeleteSavedSearch\x12).leadexchange.v1.DeleteSavedSearchRequest\x1a*.leadexchange.v1.DeleteSavedSearchResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/saved-searches/{search_id}\x12\x9d\x01\n" +
+	"\x16ListSavedSearchMatches\x12..leadexchange.v1.ListSavedSearchMatchesRequest\x1a/.leadexchange.v1.ListSavedSearchMatchesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/saved-searches/matchesB4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
+
+var (
+	file_saved_search_proto_rawDescOnce sync.Once
+	file_saved_search_proto_rawDescData []byte
+)
+
+func file_saved_search_proto_rawDescGZIP() []byte {
+	file_saved_search_proto_rawDescOnce.Do(func() {
+		file_saved_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_saved_search_proto_rawDesc), len(file_saved_search_proto_rawDesc)))
+	})
+	return file_saved_search_proto_rawDescData
+}
+
+var file_saved_search_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_saved_search_proto_goTypes = []any{
+	(*SavedSearchFilter)(nil),              // 0: leadexchange.v1.SavedSearchFilter
+	(*SavedSearch)(nil),                    // 1: leadexchange.v1.SavedSearch
+	(*SavedSearchMatch)(nil),               // 2: leadexchange.v1.SavedSearchMatch
+	(*CreateSavedSearchRequest)(nil),       // 3: leadexchange.v1.CreateSavedSearchRequest
+	(*SavedSearchResponse)(nil),            // 4: leadexchange.v1.SavedSearchResponse
+	(*ListSavedSearchesRequest)(nil),       // 5: leadexchange.v1.ListSavedSearchesRequest
+	(*ListSavedSearchesResponse)(nil),      // 6: leadexchange.v1.ListSavedSearchesResponse
+	(*DeleteSavedSearchRequest)(nil),       // 7: leadexchange.v1.DeleteSavedSearchRequest
+	(*DeleteSavedSearchResponse)(nil),      // 8: leadexchange.v1.DeleteSavedSearchResponse
+	(*ListSavedSearchMatchesRequest)(nil),  // 9: leadexchange.v1.ListSavedSearchMatchesRequest
+	(*ListSavedSearchMatchesResponse)(nil), // 10: leadexchange.v1.ListSavedSearchMatchesResponse
+	(PropertyType)(0),                      // 11: leadexchange.v1.PropertyType
+	(*MatchWeights)(nil),                   // 12: leadexchange.v1.MatchWeights
+	(*Property)(nil),                       // 13: leadexchange.v1.Property
+}
+var file_saved_search_proto_depIdxs = []int32{
+	11, // 0: leadexchange.v1.SavedSearchFilter.property_type:type_name -> leadexchange.v1.PropertyType
+	0,  // 1: leadexchange.v1.SavedSearch.filter:type_name -> leadexchange.v1.SavedSearchFilter
+	12, // 2: leadexchange.v1.SavedSearch.weights:type_name -> leadexchange.v1.MatchWeights
+	13, // 3: leadexchange.v1.SavedSearchMatch.property:type_name -> leadexchange.v1.Property
+	0,  // 4: leadexchange.v1.CreateSavedSearchRequest.filter:type_name -> leadexchange.v1.SavedSearchFilter
+	12, // 5: leadexchange.v1.CreateSavedSearchRequest.weights:type_name -> leadexchange.v1.MatchWeights
+	1,  // 6: leadexchange.v1.SavedSearchResponse.saved_search:type_name -> leadexchange.v1.SavedSearch
+	1,  // 7: leadexchange.v1.ListSavedSearchesResponse.saved_searches:type_name -> leadexchange.v1.SavedSearch
+	2,  // 8: leadexchange.v1.ListSavedSearchMatchesResponse.matches:type_name -> leadexchange.v1.SavedSearchMatch
+	3,  // 9: leadexchange.v1.SavedSearchService.CreateSavedSearch:input_type -> leadexchange.v1.CreateSavedSearchRequest
+	5,  // 10: leadexchange.v1.SavedSearchService.ListSavedSearches:input_type -> leadexchange.v1.ListSavedSearchesRequest
+	7,  // 11: leadexchange.v1.SavedSearchService.DeleteSavedSearch:input_type -> leadexchange.v1.DeleteSavedSearchRequest
+	9,  // 12: leadexchange.v1.SavedSearchService.ListSavedSearchMatches:input_type -> leadexchange.v1.ListSavedSearchMatchesRequest
+	4,  // 13: leadexchange.v1.SavedSearchService.CreateSavedSearch:output_type -> leadexchange.v1.SavedSearchResponse
+	6,  // 14: leadexchange.v1.SavedSearchService.ListSavedSearches:output_type -> leadexchange.v1.ListSavedSearchesResponse
+	8,  // 15: leadexchange.v1.SavedSearchService.DeleteSavedSearch:output_type -> leadexchange.v1.DeleteSavedSearchResponse
+	10, // 16: leadexchange.v1.SavedSearchService.ListSavedSearchMatches:output_type -> leadexchange.v1.ListSavedSearchMatchesResponse
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_saved_search_proto_init() }
+func file_saved_search_proto_init() {
+	if File_saved_search_proto != nil {
+		return
+	}
+	file_property_proto_init()
+	file_lead_proto_init()
+	file_saved_search_proto_msgTypes[0].OneofWrappers = []any{}
+	file_saved_search_proto_msgTypes[1].OneofWrappers = []any{}
+	file_saved_search_proto_msgTypes[2].OneofWrappers = []any{}
+	file_saved_search_proto_msgTypes[3].OneofWrappers = []any{}
+	file_saved_search_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_saved_search_proto_rawDesc), len(file_saved_search_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_saved_search_proto_goTypes,
+		DependencyIndexes: file_saved_search_proto_depIdxs,
+		MessageInfos:      file_saved_search_proto_msgTypes,
+	}.Build()
+	File_saved_search_proto = out.File
+	file_saved_search_proto_goTypes = nil
+	file_saved_search_proto_depIdxs = nil
+}
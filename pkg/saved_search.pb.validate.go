@@ -0,0 +1,1563 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: saved_search.proto
+
+package leadexchangev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _saved_search_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on SavedSearchFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SavedSearchFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavedSearchFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SavedSearchFilterMultiError, or nil if none found.
+func (m *SavedSearchFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavedSearchFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.City != nil {
+		// no validation rules for City
+	}
+
+	if m.PropertyType != nil {
+		// no validation rules for PropertyType
+	}
+
+	if m.MinRooms != nil {
+		// no validation rules for MinRooms
+	}
+
+	if m.MaxRooms != nil {
+		// no validation rules for MaxRooms
+	}
+
+	if m.MinPrice != nil {
+		// no validation rules for MinPrice
+	}
+
+	if m.MaxPrice != nil {
+		// no validation rules for MaxPrice
+	}
+
+	if m.TargetPrice != nil {
+		// no validation rules for TargetPrice
+	}
+
+	if m.TargetRooms != nil {
+		// no validation rules for TargetRooms
+	}
+
+	if m.TargetArea != nil {
+		// no validation rules for TargetArea
+	}
+
+	if m.TargetDistrict != nil {
+		// no validation rules for TargetDistrict
+	}
+
+	if len(errors) > 0 {
+		return SavedSearchFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavedSearchFilterMultiError is an error wrapping multiple validation errors
+// returned by SavedSearchFilter.ValidateAll() if the designated constraints
+// aren't met.
+type SavedSearchFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavedSearchFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavedSearchFilterMultiError) AllErrors() []error { return m }
+
+// SavedSearchFilterValidationError is the validation error returned by
+// SavedSearchFilter.Validate if the designated constraints aren't met.
+type SavedSearchFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavedSearchFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavedSearchFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavedSearchFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavedSearchFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavedSearchFilterValidationError) ErrorName() string {
+	return "SavedSearchFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SavedSearchFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavedSearchFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SavedSearchFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SavedSearchFilterValidationError{}
+
+// Validate checks the field values on SavedSearch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SavedSearch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavedSearch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SavedSearchMultiError, or
+// nil if none found.
+func (m *SavedSearch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavedSearch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SearchId
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavedSearchValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavedSearchValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavedSearchValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWeights()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavedSearchValidationError{
+					field:  "Weights",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavedSearchValidationError{
+					field:  "Weights",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWeights()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavedSearchValidationError{
+				field:  "Weights",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MinScore
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if m.LeadId != nil {
+		// no validation rules for LeadId
+	}
+
+	if len(errors) > 0 {
+		return SavedSearchMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavedSearchMultiError is an error wrapping multiple validation errors
+// returned by SavedSearch.ValidateAll() if the designated constraints aren't met.
+type SavedSearchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavedSearchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavedSearchMultiError) AllErrors() []error { return m }
+
+// SavedSearchValidationError is the validation error returned by
+// SavedSearch.Validate if the designated constraints aren't met.
+type SavedSearchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavedSearchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavedSearchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavedSearchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavedSearchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavedSearchValidationError) ErrorName() string { return "SavedSearchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SavedSearchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavedSearch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SavedSearchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SavedSearchValidationError{}
+
+// Validate checks the field values on SavedSearchMatch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SavedSearchMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavedSearchMatch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SavedSearchMatchMultiError, or nil if none found.
+func (m *SavedSearchMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavedSearchMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MatchId
+
+	// no validation rules for SearchId
+
+	if all {
+		switch v := interface{}(m.GetProperty()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavedSearchMatchValidationError{
+					field:  "Property",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavedSearchMatchValidationError{
+					field:  "Property",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProperty()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavedSearchMatchValidationError{
+				field:  "Property",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
+
+	// no validation rules for CreatedAt
+
+	if m.MatchExplanation != nil {
+		// no validation rules for MatchExplanation
+	}
+
+	if len(errors) > 0 {
+		return SavedSearchMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavedSearchMatchMultiError is an error wrapping multiple validation errors
+// returned by SavedSearchMatch.ValidateAll() if the designated constraints
+// aren't met.
+type SavedSearchMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavedSearchMatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavedSearchMatchMultiError) AllErrors() []error { return m }
+
+// SavedSearchMatchValidationError is the validation error returned by
+// SavedSearchMatch.Validate if the designated constraints aren't met.
+type SavedSearchMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavedSearchMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavedSearchMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavedSearchMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavedSearchMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavedSearchMatchValidationError) ErrorName() string { return "SavedSearchMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SavedSearchMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavedSearchMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SavedSearchMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SavedSearchMatchValidationError{}
+
+// Validate checks the field values on CreateSavedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSavedSearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSavedSearchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSavedSearchRequestMultiError, or nil if none found.
+func (m *CreateSavedSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSavedSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSavedSearchRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSavedSearchRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSavedSearchRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWeights()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSavedSearchRequestValidationError{
+					field:  "Weights",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSavedSearchRequestValidationError{
+					field:  "Weights",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWeights()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSavedSearchRequestValidationError{
+				field:  "Weights",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.LeadId != nil {
+
+		if err := m._validateUuid(m.GetLeadId()); err != nil {
+			err = CreateSavedSearchRequestValidationError{
+				field:  "LeadId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.MinScore != nil {
+
+		if val := m.GetMinScore(); val < 0 || val > 1 {
+			err := CreateSavedSearchRequestValidationError{
+				field:  "MinScore",
+				reason: "value must be inside range [0, 1]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateSavedSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateSavedSearchRequest) _validateUuid(uuid string) error {
+	if matched := _saved_search_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateSavedSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSavedSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSavedSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSavedSearchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSavedSearchRequestMultiError) AllErrors() []error { return m }
+
+// CreateSavedSearchRequestValidationError is the validation error returned by
+// CreateSavedSearchRequest.Validate if the designated constraints aren't met.
+type CreateSavedSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSavedSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSavedSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSavedSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSavedSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSavedSearchRequestValidationError) ErrorName() string {
+	return "CreateSavedSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSavedSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSavedSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSavedSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSavedSearchRequestValidationError{}
+
+// Validate checks the field values on SavedSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SavedSearchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavedSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SavedSearchResponseMultiError, or nil if none found.
+func (m *SavedSearchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavedSearchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSavedSearch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavedSearchResponseValidationError{
+					field:  "SavedSearch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavedSearchResponseValidationError{
+					field:  "SavedSearch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSavedSearch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavedSearchResponseValidationError{
+				field:  "SavedSearch",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SavedSearchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavedSearchResponseMultiError is an error wrapping multiple validation
+// errors returned by SavedSearchResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SavedSearchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavedSearchResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavedSearchResponseMultiError) AllErrors() []error { return m }
+
+// SavedSearchResponseValidationError is the validation error returned by
+// SavedSearchResponse.Validate if the designated constraints aren't met.
+type SavedSearchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavedSearchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavedSearchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavedSearchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavedSearchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavedSearchResponseValidationError) ErrorName() string {
+	return "SavedSearchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SavedSearchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavedSearchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SavedSearchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SavedSearchResponseValidationError{}
+
+// Validate checks the field values on ListSavedSearchesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSavedSearchesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSavedSearchesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSavedSearchesRequestMultiError, or nil if none found.
+func (m *ListSavedSearchesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSavedSearchesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSavedSearchesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSavedSearchesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSavedSearchesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSavedSearchesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSavedSearchesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSavedSearchesRequestMultiError) AllErrors() []error { return m }
+
+// ListSavedSearchesRequestValidationError is the validation error returned by
+// ListSavedSearchesRequest.Validate if the designated constraints aren't met.
+type ListSavedSearchesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSavedSearchesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSavedSearchesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSavedSearchesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSavedSearchesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSavedSearchesRequestValidationError) ErrorName() string {
+	return "ListSavedSearchesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSavedSearchesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSavedSearchesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSavedSearchesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSavedSearchesRequestValidationError{}
+
+// Validate checks the field values on ListSavedSearchesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSavedSearchesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSavedSearchesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSavedSearchesResponseMultiError, or nil if none found.
+func (m *ListSavedSearchesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSavedSearchesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSavedSearches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSavedSearchesResponseValidationError{
+						field:  fmt.Sprintf("SavedSearches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSavedSearchesResponseValidationError{
+						field:  fmt.Sprintf("SavedSearches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSavedSearchesResponseValidationError{
+					field:  fmt.Sprintf("SavedSearches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSavedSearchesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSavedSearchesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSavedSearchesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListSavedSearchesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSavedSearchesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSavedSearchesResponseMultiError) AllErrors() []error { return m }
+
+// ListSavedSearchesResponseValidationError is the validation error returned by
+// ListSavedSearchesResponse.Validate if the designated constraints aren't met.
+type ListSavedSearchesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSavedSearchesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSavedSearchesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSavedSearchesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSavedSearchesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSavedSearchesResponseValidationError) ErrorName() string {
+	return "ListSavedSearchesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSavedSearchesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSavedSearchesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSavedSearchesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSavedSearchesResponseValidationError{}
+
+// Validate checks the field values on DeleteSavedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSavedSearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSavedSearchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSavedSearchRequestMultiError, or nil if none found.
+func (m *DeleteSavedSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSavedSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSearchId()); err != nil {
+		err = DeleteSavedSearchRequestValidationError{
+			field:  "SearchId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteSavedSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteSavedSearchRequest) _validateUuid(uuid string) error {
+	if matched := _saved_search_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteSavedSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSavedSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSavedSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSavedSearchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSavedSearchRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSavedSearchRequestValidationError is the validation error returned by
+// DeleteSavedSearchRequest.Validate if the designated constraints aren't met.
+type DeleteSavedSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSavedSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSavedSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSavedSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSavedSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSavedSearchRequestValidationError) ErrorName() string {
+	return "DeleteSavedSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSavedSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSavedSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSavedSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSavedSearchRequestValidationError{}
+
+// Validate checks the field values on DeleteSavedSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSavedSearchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSavedSearchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSavedSearchResponseMultiError, or nil if none found.
+func (m *DeleteSavedSearchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSavedSearchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeleteSavedSearchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSavedSearchResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSavedSearchResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteSavedSearchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSavedSearchResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSavedSearchResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSavedSearchResponseValidationError is the validation error returned by
+// DeleteSavedSearchResponse.Validate if the designated constraints aren't met.
+type DeleteSavedSearchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSavedSearchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSavedSearchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSavedSearchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSavedSearchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSavedSearchResponseValidationError) ErrorName() string {
+	return "DeleteSavedSearchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSavedSearchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSavedSearchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSavedSearchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSavedSearchResponseValidationError{}
+
+// Validate checks the field values on ListSavedSearchMatchesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSavedSearchMatchesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSavedSearchMatchesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListSavedSearchMatchesRequestMultiError, or nil if none found.
+func (m *ListSavedSearchMatchesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSavedSearchMatchesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.SearchId != nil {
+
+		if err := m._validateUuid(m.GetSearchId()); err != nil {
+			err = ListSavedSearchMatchesRequestValidationError{
+				field:  "SearchId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Limit != nil {
+		// no validation rules for Limit
+	}
+
+	if m.MarkSeen != nil {
+		// no validation rules for MarkSeen
+	}
+
+	if len(errors) > 0 {
+		return ListSavedSearchMatchesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListSavedSearchMatchesRequest) _validateUuid(uuid string) error {
+	if matched := _saved_search_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListSavedSearchMatchesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListSavedSearchMatchesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListSavedSearchMatchesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSavedSearchMatchesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSavedSearchMatchesRequestMultiError) AllErrors() []error { return m }
+
+// ListSavedSearchMatchesRequestValidationError is the validation error
+// returned by ListSavedSearchMatchesRequest.Validate if the designated
+// constraints aren't met.
+type ListSavedSearchMatchesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSavedSearchMatchesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSavedSearchMatchesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSavedSearchMatchesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSavedSearchMatchesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSavedSearchMatchesRequestValidationError) ErrorName() string {
+	return "ListSavedSearchMatchesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSavedSearchMatchesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSavedSearchMatchesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSavedSearchMatchesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSavedSearchMatchesRequestValidationError{}
+
+// Validate checks the field values on ListSavedSearchMatchesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSavedSearchMatchesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSavedSearchMatchesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListSavedSearchMatchesResponseMultiError, or nil if none found.
+func (m *ListSavedSearchMatchesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSavedSearchMatchesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSavedSearchMatchesResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSavedSearchMatchesResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSavedSearchMatchesResponseValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSavedSearchMatchesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSavedSearchMatchesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListSavedSearchMatchesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListSavedSearchMatchesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSavedSearchMatchesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSavedSearchMatchesResponseMultiError) AllErrors() []error { return m }
+
+// ListSavedSearchMatchesResponseValidationError is the validation error
+// returned by ListSavedSearchMatchesResponse.Validate if the designated
+// constraints aren't met.
+type ListSavedSearchMatchesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSavedSearchMatchesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSavedSearchMatchesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSavedSearchMatchesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSavedSearchMatchesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSavedSearchMatchesResponseValidationError) ErrorName() string {
+	return "ListSavedSearchMatchesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSavedSearchMatchesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSavedSearchMatchesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSavedSearchMatchesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSavedSearchMatchesResponseValidationError{}
@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: saved_search.proto
+
+package leadexchangev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SavedSearchService_CreateSavedSearch_FullMethodName      = "/leadexchange.v1.SavedSearchService/CreateSavedSearch"
+	SavedSearchService_ListSavedSearches_FullMethodName      = "/leadexchange.v1.SavedSearchService/ListSavedSearches"
+	SavedSearchService_DeleteSavedSearch_FullMethodName      = "/leadexchange.v1.SavedSearchService/DeleteSavedSearch"
+	SavedSearchService_ListSavedSearchMatches_FullMethodName = "/leadexchange.v1.SavedSearchService/ListSavedSearchMatches"
+)
+
+// SavedSearchServiceClient is the client API for SavedSearchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SavedSearchServiceClient interface {
+	// Сохранить поиск по лиду или по фильтру.
+	CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error)
+	// Получить сохранённые поиски текущего пользователя.
+	ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error)
+	// Удалить сохранённый поиск.
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error)
+	// Получить новые (непросмотренные) объекты, найденные сохранёнными поисками.
+	ListSavedSearchMatches(ctx context.Context, in *ListSavedSearchMatchesRequest, opts ...grpc.CallOption) (*ListSavedSearchMatchesResponse, error)
+}
+
+type savedSearchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSavedSearchServiceClient(cc grpc.ClientConnInterface) SavedSearchServiceClient {
+	return &savedSearchServiceClient{cc}
+}
+
+func (c *savedSearchServiceClient) CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearchResponse)
+	err := c.cc.Invoke(ctx, SavedSearchService_CreateSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchServiceClient) ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedSearchesResponse)
+	err := c.cc.Invoke(ctx, SavedSearchService_ListSavedSearches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchServiceClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSavedSearchResponse)
+	err := c.cc.Invoke(ctx, SavedSearchService_DeleteSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchServiceClient) ListSavedSearchMatches(ctx context.Context, in *ListSavedSearchMatchesRequest, opts ...grpc.CallOption) (*ListSavedSearchMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedSearchMatchesResponse)
+	err := c.cc.Invoke(ctx, SavedSearchService_ListSavedSearchMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SavedSearchServiceServer is the server API for SavedSearchService service.
+// All implementations must embed UnimplementedSavedSearchServiceServer
+// for forward compatibility.
+type SavedSearchServiceServer interface {
+	// Сохранить поиск по лиду или по фильтру.
+	CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearchResponse, error)
+	// Получить сохранённые поиски текущего пользователя.
+	ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error)
+	// Удалить сохранённый поиск.
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error)
+	// Получить новые (непросмотренные) объекты, найденные сохранёнными поисками.
+	ListSavedSearchMatches(context.Context, *ListSavedSearchMatchesRequest) (*ListSavedSearchMatchesResponse, error)
+	mustEmbedUnimplementedSavedSearchServiceServer()
+}
+
+// UnimplementedSavedSearchServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSavedSearchServiceServer struct{}
+
+func (UnimplementedSavedSearchServiceServer) CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSavedSearches not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) ListSavedSearchMatches(context.Context, *ListSavedSearchMatchesRequest) (*ListSavedSearchMatchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSavedSearchMatches not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) mustEmbedUnimplementedSavedSearchServiceServer() {}
+func (UnimplementedSavedSearchServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeSavedSearchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SavedSearchServiceServer will
+// result in compilation errors.
+type UnsafeSavedSearchServiceServer interface {
+	mustEmbedUnimplementedSavedSearchServiceServer()
+}
+
+func RegisterSavedSearchServiceServer(s grpc.ServiceRegistrar, srv SavedSearchServiceServer) {
+	// If the following call panics, it indicates UnimplementedSavedSearchServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SavedSearchService_ServiceDesc, srv)
+}
+
+func _SavedSearchService_CreateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).CreateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_CreateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).CreateSavedSearch(ctx, req.(*CreateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearchService_ListSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).ListSavedSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_ListSavedSearches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).ListSavedSearches(ctx, req.(*ListSavedSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearchService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_DeleteSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearchService_ListSavedSearchMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).ListSavedSearchMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_ListSavedSearchMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).ListSavedSearchMatches(ctx, req.(*ListSavedSearchMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SavedSearchService_ServiceDesc is the grpc.ServiceDesc for SavedSearchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SavedSearchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "leadexchange.v1.SavedSearchService",
+	HandlerType: (*SavedSearchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSavedSearch",
+			Handler:    _SavedSearchService_CreateSavedSearch_Handler,
+		},
+		{
+			MethodName: "ListSavedSearches",
+			Handler:    _SavedSearchService_ListSavedSearches_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _SavedSearchService_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "ListSavedSearchMatches",
+			Handler:    _SavedSearchService_ListSavedSearchMatches_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "saved_search.proto",
+}
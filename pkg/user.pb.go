@@ -145,6 +145,7 @@ type UserProfile struct {
 	AvatarUrl     *string                `protobuf:"bytes,7,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
 	Role          UserRole               `protobuf:"varint,8,opt,name=role,proto3,enum=leadexchange.v1.UserRole" json:"role,omitempty"`
 	Status        UserStatus             `protobuf:"varint,9,opt,name=status,proto3,enum=leadexchange.v1.UserStatus" json:"status,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,10,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -242,6 +243,13 @@ func (x *UserProfile) GetStatus() UserStatus {
 	return UserStatus_USER_STATUS_UNSPECIFIED
 }
 
+func (x *UserProfile) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
 type UpdateProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FirstName     *string                `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
@@ -371,10 +379,15 @@ func (x *UpdateUserStatusRequest) GetStatus() UserStatus {
 }
 
 type ListUsersRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Filter        *ListUsersRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState   `protogen:"open.v1"`
+	Filter    *ListUsersRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	PageSize  *int32                   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	PageToken *string                  `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	// order_by — created_at (по умолчанию) или email
+	OrderBy        *string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3,oneof" json:"order_by,omitempty"`
+	OrderDirection *string `protobuf:"bytes,5,opt,name=order_direction,json=orderDirection,proto3,oneof" json:"order_direction,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListUsersRequest) Reset() {
@@ -414,9 +427,42 @@ func (x *ListUsersRequest) GetFilter() *ListUsersRequest_Filter {
 	return nil
 }
 
+func (x *ListUsersRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetOrderBy() string {
+	if x != nil && x.OrderBy != nil {
+		return *x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetOrderDirection() string {
+	if x != nil && x.OrderDirection != nil {
+		return *x.OrderDirection
+	}
+	return ""
+}
+
 type ListUsersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*UserProfile         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Users []*UserProfile         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// next_page_token — токен следующей страницы; пустой, если страниц больше нет
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HasMore       bool   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// total_count — сколько записей подходит под фильтр без учёта пагинации
+	TotalCount    int32 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -458,6 +504,27 @@ func (x *ListUsersResponse) GetUsers() []*UserProfile {
 	return nil
 }
 
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListUsersResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListUsersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type ListUsersRequest_Filter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         *string                `protobuf:"bytes,1,opt,name=email,proto3,oneof" json:"email,omitempty"`
@@ -555,7 +622,7 @@ var File_user_proto protoreflect.FileDescriptor
 const file_user_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"user.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\"\xe1\x02\n" +
+	"user.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\"\x88\x03\n" +
 	"\vUserProfile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
@@ -568,7 +635,9 @@ const file_user_proto_rawDesc = "" +
 	"\n" +
 	"avatar_url\x18\a \x01(\tH\x02R\tavatarUrl\x88\x01\x01\x12-\n" +
 	"\x04role\x18\b \x01(\x0e2\x19.leadexchange.v1.UserRoleR\x04role\x123\n" +
-	"\x06status\x18\t \x01(\x0e2\x1b.leadexchange.v1.UserStatusR\x06statusB\b\n" +
+	"\x06status\x18\t \x01(\x0e2\x1b.leadexchange.v1.UserStatusR\x06status\x12%\n" +
+	"\x0eemail_verified\x18\n" +
+	" \x01(\bR\remailVerifiedB\b\n" +
 	"\x06_phoneB\x0e\n" +
 	"\f_agency_nameB\r\n" +
 	"\v_avatar_url\"\xb7\x02\n" +
@@ -589,9 +658,14 @@ const file_user_proto_rawDesc = "" +
 	"\v_avatar_url\"{\n" +
 	"\x17UpdateUserStatusRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12=\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x1b.leadexchange.v1.UserStatusB\b\xfaB\x05\x82\x01\x02 \x00R\x06status\"\xd4\x03\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.leadexchange.v1.UserStatusB\b\xfaB\x05\x82\x01\x02 \x00R\x06status\"\xa6\x05\n" +
 	"\x10ListUsersRequest\x12E\n" +
-	"\x06filter\x18\x01 \x01(\v2(.leadexchange.v1.ListUsersRequest.FilterH\x00R\x06filter\x88\x01\x01\x1a\xed\x02\n" +
+	"\x06filter\x18\x01 \x01(\v2(.leadexchange.v1.ListUsersRequest.FilterH\x00R\x06filter\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tH\x02R\tpageToken\x88\x01\x01\x12\x1e\n" +
+	"\border_by\x18\x04 \x01(\tH\x03R\aorderBy\x88\x01\x01\x12,\n" +
+	"\x0forder_direction\x18\x05 \x01(\tH\x04R\x0eorderDirection\x88\x01\x01\x1a\xed\x02\n" +
 	"\x06Filter\x12\x19\n" +
 	"\x05email\x18\x01 \x01(\tH\x00R\x05email\x88\x01\x01\x12\"\n" +
 	"\n" +
@@ -610,9 +684,18 @@ const file_user_proto_rawDesc = "" +
 	"\f_agency_nameB\a\n" +
 	"\x05_roleB\t\n" +
 	"\a_statusB\t\n" +
-	"\a_filter\"G\n" +
+	"\a_filterB\f\n" +
+	"\n" +
+	"_page_sizeB\r\n" +
+	"\v_page_tokenB\v\n" +
+	"\t_order_byB\x12\n" +
+	"\x10_order_direction\"\xab\x01\n" +
 	"\x11ListUsersResponse\x122\n" +
-	"\x05users\x18\x01 \x03(\v2\x1c.leadexchange.v1.UserProfileR\x05users*N\n" +
+	"\x05users\x18\x01 \x03(\v2\x1c.leadexchange.v1.UserProfileR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x05R\n" +
+	"totalCount*N\n" +
 	"\bUserRole\x12\x19\n" +
 	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eUSER_ROLE_USER\x10\x01\x12\x13\n" +
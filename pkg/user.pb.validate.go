@@ -72,6 +72,8 @@ func (m *UserProfile) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for EmailVerified
+
 	if m.Phone != nil {
 		// no validation rules for Phone
 	}
@@ -510,6 +512,22 @@ func (m *ListUsersRequest) validate(all bool) error {
 
 	}
 
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.PageToken != nil {
+		// no validation rules for PageToken
+	}
+
+	if m.OrderBy != nil {
+		// no validation rules for OrderBy
+	}
+
+	if m.OrderDirection != nil {
+		// no validation rules for OrderDirection
+	}
+
 	if len(errors) > 0 {
 		return ListUsersRequestMultiError(errors)
 	}
@@ -644,6 +662,12 @@ func (m *ListUsersResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
+	// no validation rules for HasMore
+
+	// no validation rules for TotalCount
+
 	if len(errors) > 0 {
 		return ListUsersResponseMultiError(errors)
 	}
@@ -0,0 +1,972 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: wallet.proto
+
+package leadexchangev1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LedgerTransactionKind — тип операции.
+type LedgerTransactionKind int32
+
+const (
+	LedgerTransactionKind_LEDGER_TRANSACTION_KIND_UNSPECIFIED LedgerTransactionKind = 0
+	// Пополнение админом
+	LedgerTransactionKind_LEDGER_TRANSACTION_KIND_TOP_UP LedgerTransactionKind = 1
+	// Вывод админом
+	LedgerTransactionKind_LEDGER_TRANSACTION_KIND_WITHDRAWAL LedgerTransactionKind = 2
+	// Блокировка цены при принятии сделки
+	LedgerTransactionKind_LEDGER_TRANSACTION_KIND_DEAL_HOLD LedgerTransactionKind = 3
+	// Перевод продавцу при завершении сделки
+	LedgerTransactionKind_LEDGER_TRANSACTION_KIND_DEAL_RELEASE LedgerTransactionKind = 4
+	// Возврат покупателю при отмене или отклонении сделки
+	LedgerTransactionKind_LEDGER_TRANSACTION_KIND_DEAL_REFUND LedgerTransactionKind = 5
+)
+
+// Enum value maps for LedgerTransactionKind.
+var (
+	LedgerTransactionKind_name = map[int32]string{
+		0: "LEDGER_TRANSACTION_KIND_UNSPECIFIED",
+		1: "LEDGER_TRANSACTION_KIND_TOP_UP",
+		2: "LEDGER_TRANSACTION_KIND_WITHDRAWAL",
+		3: "LEDGER_TRANSACTION_KIND_DEAL_HOLD",
+		4: "LEDGER_TRANSACTION_KIND_DEAL_RELEASE",
+		5: "LEDGER_TRANSACTION_KIND_DEAL_REFUND",
+	}
+	LedgerTransactionKind_value = map[string]int32{
+		"LEDGER_TRANSACTION_KIND_UNSPECIFIED":  0,
+		"LEDGER_TRANSACTION_KIND_TOP_UP":       1,
+		"LEDGER_TRANSACTION_KIND_WITHDRAWAL":   2,
+		"LEDGER_TRANSACTION_KIND_DEAL_HOLD":    3,
+		"LEDGER_TRANSACTION_KIND_DEAL_RELEASE": 4,
+		"LEDGER_TRANSACTION_KIND_DEAL_REFUND":  5,
+	}
+)
+
+func (x LedgerTransactionKind) Enum() *LedgerTransactionKind {
+	p := new(LedgerTransactionKind)
+	*p = x
+	return p
+}
+
+func (x LedgerTransactionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LedgerTransactionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallet_proto_enumTypes[0].Descriptor()
+}
+
+func (LedgerTransactionKind) Type() protoreflect.EnumType {
+	return &file_wallet_proto_enumTypes[0]
+}
+
+func (x LedgerTransactionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LedgerTransactionKind.Descriptor instead.
+func (LedgerTransactionKind) EnumDescriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{0}
+}
+
+// WalletAccountKind — счёт кошелька, по которому прошла проводка.
+type WalletAccountKind int32
+
+const (
+	WalletAccountKind_WALLET_ACCOUNT_KIND_UNSPECIFIED WalletAccountKind = 0
+	WalletAccountKind_WALLET_ACCOUNT_KIND_AVAILABLE   WalletAccountKind = 1
+	WalletAccountKind_WALLET_ACCOUNT_KIND_HELD        WalletAccountKind = 2
+)
+
+// Enum value maps for WalletAccountKind.
+var (
+	WalletAccountKind_name = map[int32]string{
+		0: "WALLET_ACCOUNT_KIND_UNSPECIFIED",
+		1: "WALLET_ACCOUNT_KIND_AVAILABLE",
+		2: "WALLET_ACCOUNT_KIND_HELD",
+	}
+	WalletAccountKind_value = map[string]int32{
+		"WALLET_ACCOUNT_KIND_UNSPECIFIED": 0,
+		"WALLET_ACCOUNT_KIND_AVAILABLE":   1,
+		"WALLET_ACCOUNT_KIND_HELD":        2,
+	}
+)
+
+func (x WalletAccountKind) Enum() *WalletAccountKind {
+	p := new(WalletAccountKind)
+	*p = x
+	return p
+}
+
+func (x WalletAccountKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WalletAccountKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallet_proto_enumTypes[1].Descriptor()
+}
+
+func (WalletAccountKind) Type() protoreflect.EnumType {
+	return &file_wallet_proto_enumTypes[1]
+}
+
+func (x WalletAccountKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WalletAccountKind.Descriptor instead.
+func (WalletAccountKind) EnumDescriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{1}
+}
+
+// WalletBalance — остатки кошелька в кредитах.
+type WalletBalance struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// available — свободные кредиты
+	Available int64 `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	// held — кредиты, заблокированные под принятые сделки
+	Held          int64 `protobuf:"varint,3,opt,name=held,proto3" json:"held,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletBalance) Reset() {
+	*x = WalletBalance{}
+	mi := &file_wallet_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletBalance) ProtoMessage() {}
+
+func (x *WalletBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletBalance.ProtoReflect.Descriptor instead.
+func (*WalletBalance) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WalletBalance) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WalletBalance) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *WalletBalance) GetHeld() int64 {
+	if x != nil {
+		return x.Held
+	}
+	return 0
+}
+
+// StatementEntry — строка выписки.
+type StatementEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Kind          LedgerTransactionKind  `protobuf:"varint,3,opt,name=kind,proto3,enum=leadexchange.v1.LedgerTransactionKind" json:"kind,omitempty"`
+	Account       WalletAccountKind      `protobuf:"varint,4,opt,name=account,proto3,enum=leadexchange.v1.WalletAccountKind" json:"account,omitempty"`
+	DealId        *string                `protobuf:"bytes,5,opt,name=deal_id,json=dealId,proto3,oneof" json:"deal_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	// amount — положительная сумма для зачисления, отрицательная для списания
+	Amount int64 `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	// balance_after — остаток счёта после проводки
+	BalanceAfter  int64  `protobuf:"varint,8,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	CreatedAt     string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatementEntry) Reset() {
+	*x = StatementEntry{}
+	mi := &file_wallet_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatementEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementEntry) ProtoMessage() {}
+
+func (x *StatementEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementEntry.ProtoReflect.Descriptor instead.
+func (*StatementEntry) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StatementEntry) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *StatementEntry) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *StatementEntry) GetKind() LedgerTransactionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LedgerTransactionKind_LEDGER_TRANSACTION_KIND_UNSPECIFIED
+}
+
+func (x *StatementEntry) GetAccount() WalletAccountKind {
+	if x != nil {
+		return x.Account
+	}
+	return WalletAccountKind_WALLET_ACCOUNT_KIND_UNSPECIFIED
+}
+
+func (x *StatementEntry) GetDealId() string {
+	if x != nil && x.DealId != nil {
+		return *x.DealId
+	}
+	return ""
+}
+
+func (x *StatementEntry) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *StatementEntry) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetBalanceAfter() int64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// LedgerTransaction — проведённая операция пополнения или вывода.
+type LedgerTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Kind          LedgerTransactionKind  `protobuf:"varint,2,opt,name=kind,proto3,enum=leadexchange.v1.LedgerTransactionKind" json:"kind,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerTransaction) Reset() {
+	*x = LedgerTransaction{}
+	mi := &file_wallet_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerTransaction) ProtoMessage() {}
+
+func (x *LedgerTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerTransaction.ProtoReflect.Descriptor instead.
+func (*LedgerTransaction) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LedgerTransaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *LedgerTransaction) GetKind() LedgerTransactionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LedgerTransactionKind_LEDGER_TRANSACTION_KIND_UNSPECIFIED
+}
+
+func (x *LedgerTransaction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LedgerTransaction) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *LedgerTransaction) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *LedgerTransaction) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetBalanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id — чужой кошелёк (только для админа); по умолчанию — свой
+	UserId        *string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_wallet_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetBalanceRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+type WalletBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balance       *WalletBalance         `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletBalanceResponse) Reset() {
+	*x = WalletBalanceResponse{}
+	mi := &file_wallet_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletBalanceResponse) ProtoMessage() {}
+
+func (x *WalletBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletBalanceResponse.ProtoReflect.Descriptor instead.
+func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WalletBalanceResponse) GetBalance() *WalletBalance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type ListStatementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id — чужой кошелёк (только для админа); по умолчанию — свой
+	UserId *string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	// Период, RFC 3339; границы необязательны
+	From          *string `protobuf:"bytes,2,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            *string `protobuf:"bytes,3,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	PageSize      *int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	PageToken     *string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatementRequest) Reset() {
+	*x = ListStatementRequest{}
+	mi := &file_wallet_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatementRequest) ProtoMessage() {}
+
+func (x *ListStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatementRequest.ProtoReflect.Descriptor instead.
+func (*ListStatementRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListStatementRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ListStatementRequest) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *ListStatementRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+func (x *ListStatementRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStatementRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+type ListStatementResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Entries []*StatementEntry      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// next_page_token — токен следующей страницы; пустой, если страниц больше нет
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	HasMore       bool   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	TotalCount    int32  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatementResponse) Reset() {
+	*x = ListStatementResponse{}
+	mi := &file_wallet_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatementResponse) ProtoMessage() {}
+
+func (x *ListStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatementResponse.ProtoReflect.Descriptor instead.
+func (*ListStatementResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListStatementResponse) GetEntries() []*StatementEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListStatementResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListStatementResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListStatementResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type TopUpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpRequest) Reset() {
+	*x = TopUpRequest{}
+	mi := &file_wallet_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpRequest) ProtoMessage() {}
+
+func (x *TopUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpRequest.ProtoReflect.Descriptor instead.
+func (*TopUpRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TopUpRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TopUpRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TopUpRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type WithdrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawRequest) Reset() {
+	*x = WithdrawRequest{}
+	mi := &file_wallet_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest) ProtoMessage() {}
+
+func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WithdrawRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *WithdrawRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type WalletTransactionResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Transaction *LedgerTransaction     `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// balance — остатки после операции
+	Balance       *WalletBalance `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletTransactionResponse) Reset() {
+	*x = WalletTransactionResponse{}
+	mi := &file_wallet_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletTransactionResponse) ProtoMessage() {}
+
+func (x *WalletTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletTransactionResponse.ProtoReflect.Descriptor instead.
+func (*WalletTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WalletTransactionResponse) GetTransaction() *LedgerTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *WalletTransactionResponse) GetBalance() *WalletBalance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+var File_wallet_proto protoreflect.FileDescriptor
+
+const file_wallet_proto_rawDesc = "" +
+	"\n" +
+	"\fwallet.proto\x12\x0fleadexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"Z\n" +
+	"\rWalletBalance\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\x03R\tavailable\x12\x12\n" +
+	"\x04held\x18\x03 \x01(\x03R\x04held\"\xec\x02\n" +
+	"\x0eStatementEntry\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12:\n" +
+	"\x04kind\x18\x03 \x01(\x0e2&.leadexchange.v1.LedgerTransactionKindR\x04kind\x12<\n" +
+	"\aaccount\x18\x04 \x01(\x0e2\".leadexchange.v1.WalletAccountKindR\aaccount\x12\x1c\n" +
+	"\adeal_id\x18\x05 \x01(\tH\x00R\x06dealId\x88\x01\x01\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x03R\x06amount\x12#\n" +
+	"\rbalance_after\x18\b \x01(\x03R\fbalanceAfter\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAtB\n" +
+	"\n" +
+	"\b_deal_id\"\xe6\x01\n" +
+	"\x11LedgerTransaction\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12:\n" +
+	"\x04kind\x18\x02 \x01(\x0e2&.leadexchange.v1.LedgerTransactionKindR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"G\n" +
+	"\x11GetBalanceRequest\x12&\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"Q\n" +
+	"\x15WalletBalanceResponse\x128\n" +
+	"\abalance\x18\x01 \x01(\v2\x1e.leadexchange.v1.WalletBalanceR\abalance\"\xeb\x01\n" +
+	"\x14ListStatementRequest\x12&\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x06userId\x88\x01\x01\x12\x17\n" +
+	"\x04from\x18\x02 \x01(\tH\x01R\x04from\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x03 \x01(\tH\x02R\x02to\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x04 \x01(\x05H\x03R\bpageSize\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tH\x04R\tpageToken\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\a\n" +
+	"\x05_fromB\x05\n" +
+	"\x03_toB\f\n" +
+	"\n" +
+	"_page_sizeB\r\n" +
+	"\v_page_token\"\xb6\x01\n" +
+	"\x15ListStatementResponse\x129\n" +
+	"\aentries\x18\x01 \x03(\v2\x1f.leadexchange.v1.StatementEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x05R\n" +
+	"totalCount\"v\n" +
+	"\fTopUpRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x1f\n" +
+	"\x06amount\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06amount\x12\"\n" +
+	"\acomment\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\acomment\"y\n" +
+	"\x0fWithdrawRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x1f\n" +
+	"\x06amount\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06amount\x12\"\n" +
+	"\acomment\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\acomment\"\x9b\x01\n" +
+	"\x19WalletTransactionResponse\x12D\n" +
+	"\vtransaction\x18\x01 \x01(\v2\".leadexchange.v1.LedgerTransactionR\vtransaction\x128\n" +
+	"\abalance\x18\x02 \x01(\v2\x1e.leadexchange.v1.WalletBalanceR\abalance*\x86\x02\n" +
+	"\x15LedgerTransactionKind\x12'\n" +
+	"#LEDGER_TRANSACTION_KIND_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eLEDGER_TRANSACTION_KIND_TOP_UP\x10\x01\x12&\n" +
+	"\"LEDGER_TRANSACTION_KIND_WITHDRAWAL\x10\x02\x12%\n" +
+	"!LEDGER_TRANSACTION_KIND_DEAL_HOLD\x10\x03\x12(\n" +
+	"$LEDGER_TRANSACTION_KIND_DEAL_RELEASE\x10\x04\x12'\n" +
+	"#LEDGER_TRANSACTION_KIND_DEAL_REFUND\x10\x05*y\n" +
+	"\x11WalletAccountKind\x12#\n" +
+	"\x1fWALLET_ACCOUNT_KIND_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dWALLET_ACCOUNT_KIND_AVAILABLE\x10\x01\x12\x1c\n" +
+	"\x18WALLET_ACCOUNT_KIND_HELD\x10\x022\xfe\x03\n" +
+	"\rWalletService\x12l\n" +
+	"\n" +
+	"GetBalance\x12\".leadexchange.v1.GetBalanceRequest\x1a&.leadexchange.v1.WalletBalanceResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/wallet\x12|\n" +
+	"\rListStatement\x12%.leadexchange.v1.ListStatementRequest\x1a&.leadexchange.v1.ListStatementResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/wallet/statement\x12{\n" +
+	"\x05TopUp\x12\x1d.leadexchange.v1.TopUpRequest\x1a*.leadexchange.v1.WalletTransactionResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/wallets/{user_id}/top-up\x12\x83\x01\n" +
+	"\bWithdraw\x12 .leadexchange.v1.WithdrawRequest\x1a*.leadexchange.v1.WalletTransactionResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/wallets/{user_id}/withdrawB4Z2leadexchange/gen/go/leadexchange/v1;leadexchangev1b\x06proto3"
+
+var (
+	file_wallet_proto_rawDescOnce sync.Once
+	file_wallet_proto_rawDescData []byte
+)
+
+func file_wallet_proto_rawDescGZIP() []byte {
+	file_wallet_proto_rawDescOnce.Do(func() {
+		file_wallet_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_wallet_proto_rawDesc), len(file_wallet_proto_rawDesc)))
+	})
+	return file_wallet_proto_rawDescData
+}
+
+var file_wallet_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_wallet_proto_goTypes = []any{
+	(LedgerTransactionKind)(0),        // 0: leadexchange.v1.LedgerTransactionKind
+	(WalletAccountKind)(0),            // 1: leadexchange.v1.WalletAccountKind
+	(*WalletBalance)(nil),             // 2: leadexchange.v1.WalletBalance
+	(*StatementEntry)(nil),            // 3: leadexchange.v1.StatementEntry
+	(*LedgerTransaction)(nil),         // 4: leadexchange.v1.LedgerTransaction
+	(*GetBalanceRequest)(nil),         // 5: leadexchange.v1.GetBalanceRequest
+	(*WalletBalanceResponse)(nil),     // 6: leadexchange.v1.WalletBalanceResponse
+	(*ListStatementRequest)(nil),      // 7: leadexchange.v1.ListStatementRequest
+	(*ListStatementResponse)(nil),     // 8: leadexchange.v1.ListStatementResponse
+	(*TopUpRequest)(nil),              // 9: leadexchange.v1.TopUpRequest
+	(*WithdrawRequest)(nil),           // 10: leadexchange.v1.WithdrawRequest
+	(*WalletTransactionResponse)(nil), // 11: leadexchange.v1.WalletTransactionResponse
+}
+var file_wallet_proto_depIdxs = []int32{
+	0,  // 0: leadexchange.v1.StatementEntry.kind:type_name -> leadexchange.v1.LedgerTransactionKind
+	1,  // 1: leadexchange.v1.StatementEntry.account:type_name -> leadexchange.v1.WalletAccountKind
+	0,  // 2: leadexchange.v1.LedgerTransaction.kind:type_name -> leadexchange.v1.LedgerTransactionKind
+	2,  // 3: leadexchange.v1.WalletBalanceResponse.balance:type_name -> leadexchange.v1.WalletBalance
+	3,  // 4: leadexchange.v1.ListStatementResponse.entries:type_name -> leadexchange.v1.StatementEntry
+	4,  // 5: leadexchange.v1.WalletTransactionResponse.transaction:type_name -> leadexchange.v1.LedgerTransaction
+	2,  // 6: leadexchange.v1.WalletTransactionResponse.balance:type_name -> leadexchange.v1.WalletBalance
+	5,  // 7: leadexchange.v1.WalletService.GetBalance:input_type -> leadexchange.v1.GetBalanceRequest
+	7,  // 8: leadexchange.v1.WalletService.ListStatement:input_type -> leadexchange.v1.ListStatementRequest
+	9,  // 9: leadexchange.v1.WalletService.TopUp:input_type -> leadexchange.v1.TopUpRequest
+	10, // 10: leadexchange.v1.WalletService.Withdraw:input_type -> leadexchange.v1.WithdrawRequest
+	6,  // 11: leadexchange.v1.WalletService.GetBalance:output_type -> leadexchange.v1.WalletBalanceResponse
+	8,  // 12: leadexchange.v1.WalletService.ListStatement:output_type -> leadexchange.v1.ListStatementResponse
+	11, // 13: leadexchange.v1.WalletService.TopUp:output_type -> leadexchange.v1.WalletTransactionResponse
+	11, // 14: leadexchange.v1.WalletService.Withdraw:output_type -> leadexchange.v1.WalletTransactionResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_wallet_proto_init() }
+func file_wallet_proto_init() {
+	if File_wallet_proto != nil {
+		return
+	}
+	file_wallet_proto_msgTypes[1].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[3].OneofWrappers = []any{}
+	file_wallet_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wallet_proto_rawDesc), len(file_wallet_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wallet_proto_goTypes,
+		DependencyIndexes: file_wallet_proto_depIdxs,
+		EnumInfos:         file_wallet_proto_enumTypes,
+		MessageInfos:      file_wallet_proto_msgTypes,
+	}.Build()
+	File_wallet_proto = out.File
+	file_wallet_proto_goTypes = nil
+	file_wallet_proto_depIdxs = nil
+}
@@ -0,0 +1,1363 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: wallet.proto
+
+package leadexchangev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _wallet_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on WalletBalance with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WalletBalance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WalletBalance with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WalletBalanceMultiError, or
+// nil if none found.
+func (m *WalletBalance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WalletBalance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Available
+
+	// no validation rules for Held
+
+	if len(errors) > 0 {
+		return WalletBalanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// WalletBalanceMultiError is an error wrapping multiple validation errors
+// returned by WalletBalance.ValidateAll() if the designated constraints
+// aren't met.
+type WalletBalanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WalletBalanceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WalletBalanceMultiError) AllErrors() []error { return m }
+
+// WalletBalanceValidationError is the validation error returned by
+// WalletBalance.Validate if the designated constraints aren't met.
+type WalletBalanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WalletBalanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WalletBalanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WalletBalanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WalletBalanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WalletBalanceValidationError) ErrorName() string { return "WalletBalanceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WalletBalanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWalletBalance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WalletBalanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WalletBalanceValidationError{}
+
+// Validate checks the field values on StatementEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatementEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatementEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatementEntryMultiError,
+// or nil if none found.
+func (m *StatementEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatementEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntryId
+
+	// no validation rules for TransactionId
+
+	// no validation rules for Kind
+
+	// no validation rules for Account
+
+	// no validation rules for Comment
+
+	// no validation rules for Amount
+
+	// no validation rules for BalanceAfter
+
+	// no validation rules for CreatedAt
+
+	if m.DealId != nil {
+		// no validation rules for DealId
+	}
+
+	if len(errors) > 0 {
+		return StatementEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatementEntryMultiError is an error wrapping multiple validation errors
+// returned by StatementEntry.ValidateAll() if the designated constraints
+// aren't met.
+type StatementEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatementEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatementEntryMultiError) AllErrors() []error { return m }
+
+// StatementEntryValidationError is the validation error returned by
+// StatementEntry.Validate if the designated constraints aren't met.
+type StatementEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatementEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatementEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatementEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatementEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatementEntryValidationError) ErrorName() string { return "StatementEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatementEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatementEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatementEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatementEntryValidationError{}
+
+// Validate checks the field values on LedgerTransaction with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LedgerTransaction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LedgerTransaction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LedgerTransactionMultiError, or nil if none found.
+func (m *LedgerTransaction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LedgerTransaction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransactionId
+
+	// no validation rules for Kind
+
+	// no validation rules for Amount
+
+	// no validation rules for Comment
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return LedgerTransactionMultiError(errors)
+	}
+
+	return nil
+}
+
+// LedgerTransactionMultiError is an error wrapping multiple validation errors
+// returned by LedgerTransaction.ValidateAll() if the designated constraints
+// aren't met.
+type LedgerTransactionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LedgerTransactionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LedgerTransactionMultiError) AllErrors() []error { return m }
+
+// LedgerTransactionValidationError is the validation error returned by
+// LedgerTransaction.Validate if the designated constraints aren't met.
+type LedgerTransactionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LedgerTransactionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LedgerTransactionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LedgerTransactionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LedgerTransactionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LedgerTransactionValidationError) ErrorName() string {
+	return "LedgerTransactionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LedgerTransactionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLedgerTransaction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LedgerTransactionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LedgerTransactionValidationError{}
+
+// Validate checks the field values on GetBalanceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBalanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBalanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBalanceRequestMultiError, or nil if none found.
+func (m *GetBalanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBalanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.UserId != nil {
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = GetBalanceRequestValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetBalanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetBalanceRequest) _validateUuid(uuid string) error {
+	if matched := _wallet_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetBalanceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBalanceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBalanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBalanceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBalanceRequestMultiError) AllErrors() []error { return m }
+
+// GetBalanceRequestValidationError is the validation error returned by
+// GetBalanceRequest.Validate if the designated constraints aren't met.
+type GetBalanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBalanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBalanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBalanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBalanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBalanceRequestValidationError) ErrorName() string {
+	return "GetBalanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBalanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBalanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBalanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBalanceRequestValidationError{}
+
+// Validate checks the field values on WalletBalanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WalletBalanceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WalletBalanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WalletBalanceResponseMultiError, or nil if none found.
+func (m *WalletBalanceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WalletBalanceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBalance()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WalletBalanceResponseValidationError{
+					field:  "Balance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WalletBalanceResponseValidationError{
+					field:  "Balance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBalance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WalletBalanceResponseValidationError{
+				field:  "Balance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WalletBalanceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WalletBalanceResponseMultiError is an error wrapping multiple validation
+// errors returned by WalletBalanceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WalletBalanceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WalletBalanceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WalletBalanceResponseMultiError) AllErrors() []error { return m }
+
+// WalletBalanceResponseValidationError is the validation error returned by
+// WalletBalanceResponse.Validate if the designated constraints aren't met.
+type WalletBalanceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WalletBalanceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WalletBalanceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WalletBalanceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WalletBalanceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WalletBalanceResponseValidationError) ErrorName() string {
+	return "WalletBalanceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WalletBalanceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWalletBalanceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WalletBalanceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WalletBalanceResponseValidationError{}
+
+// Validate checks the field values on ListStatementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStatementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStatementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStatementRequestMultiError, or nil if none found.
+func (m *ListStatementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStatementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.UserId != nil {
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = ListStatementRequestValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.From != nil {
+		// no validation rules for From
+	}
+
+	if m.To != nil {
+		// no validation rules for To
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.PageToken != nil {
+		// no validation rules for PageToken
+	}
+
+	if len(errors) > 0 {
+		return ListStatementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListStatementRequest) _validateUuid(uuid string) error {
+	if matched := _wallet_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListStatementRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStatementRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListStatementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStatementRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStatementRequestMultiError) AllErrors() []error { return m }
+
+// ListStatementRequestValidationError is the validation error returned by
+// ListStatementRequest.Validate if the designated constraints aren't met.
+type ListStatementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStatementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStatementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStatementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStatementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStatementRequestValidationError) ErrorName() string {
+	return "ListStatementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStatementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStatementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStatementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStatementRequestValidationError{}
+
+// Validate checks the field values on ListStatementResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStatementResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStatementResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStatementResponseMultiError, or nil if none found.
+func (m *ListStatementResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStatementResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStatementResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStatementResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStatementResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for HasMore
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return ListStatementResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStatementResponseMultiError is an error wrapping multiple validation
+// errors returned by ListStatementResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListStatementResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStatementResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStatementResponseMultiError) AllErrors() []error { return m }
+
+// ListStatementResponseValidationError is the validation error returned by
+// ListStatementResponse.Validate if the designated constraints aren't met.
+type ListStatementResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStatementResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStatementResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStatementResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStatementResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStatementResponseValidationError) ErrorName() string {
+	return "ListStatementResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStatementResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStatementResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStatementResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStatementResponseValidationError{}
+
+// Validate checks the field values on TopUpRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TopUpRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TopUpRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TopUpRequestMultiError, or
+// nil if none found.
+func (m *TopUpRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TopUpRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = TopUpRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := TopUpRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 500 {
+		err := TopUpRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TopUpRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *TopUpRequest) _validateUuid(uuid string) error {
+	if matched := _wallet_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// TopUpRequestMultiError is an error wrapping multiple validation errors
+// returned by TopUpRequest.ValidateAll() if the designated constraints aren't met.
+type TopUpRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TopUpRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TopUpRequestMultiError) AllErrors() []error { return m }
+
+// TopUpRequestValidationError is the validation error returned by
+// TopUpRequest.Validate if the designated constraints aren't met.
+type TopUpRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TopUpRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TopUpRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TopUpRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TopUpRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TopUpRequestValidationError) ErrorName() string { return "TopUpRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TopUpRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTopUpRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TopUpRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TopUpRequestValidationError{}
+
+// Validate checks the field values on WithdrawRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawRequestMultiError, or nil if none found.
+func (m *WithdrawRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = WithdrawRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := WithdrawRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 500 {
+		err := WithdrawRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WithdrawRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *WithdrawRequest) _validateUuid(uuid string) error {
+	if matched := _wallet_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// WithdrawRequestMultiError is an error wrapping multiple validation errors
+// returned by WithdrawRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WithdrawRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawRequestValidationError is the validation error returned by
+// WithdrawRequest.Validate if the designated constraints aren't met.
+type WithdrawRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawRequestValidationError) ErrorName() string { return "WithdrawRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WithdrawRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawRequestValidationError{}
+
+// Validate checks the field values on WalletTransactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WalletTransactionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WalletTransactionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WalletTransactionResponseMultiError, or nil if none found.
+func (m *WalletTransactionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WalletTransactionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTransaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WalletTransactionResponseValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WalletTransactionResponseValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WalletTransactionResponseValidationError{
+				field:  "Transaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBalance()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WalletTransactionResponseValidationError{
+					field:  "Balance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WalletTransactionResponseValidationError{
+					field:  "Balance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBalance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WalletTransactionResponseValidationError{
+				field:  "Balance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WalletTransactionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WalletTransactionResponseMultiError is an error wrapping multiple validation
+// errors returned by WalletTransactionResponse.ValidateAll() if the
+// designated constraints aren't met.
+type WalletTransactionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WalletTransactionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WalletTransactionResponseMultiError) AllErrors() []error { return m }
+
+// WalletTransactionResponseValidationError is the validation error returned by
+// WalletTransactionResponse.Validate if the designated constraints aren't met.
+type WalletTransactionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WalletTransactionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WalletTransactionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WalletTransactionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WalletTransactionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WalletTransactionResponseValidationError) ErrorName() string {
+	return "WalletTransactionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WalletTransactionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWalletTransactionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WalletTransactionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WalletTransactionResponseValidationError{}